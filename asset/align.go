@@ -0,0 +1,98 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset
+
+import (
+	"github.com/cinar/indicator/v2/helper"
+)
+
+// Align aligns the snapshots of several assets by their dates, so that they can be consumed together. It
+// yields only the snapshots with the dates that all of the assets have, and skips the others. The snapshots
+// are expected to be in the ascending order of their dates. Like helper.Duplicate, the resulting channels
+// should be consumed concurrently.
+//
+// Example:
+//
+//	aligned := asset.Align(kos, peps)
+//	kos, peps = aligned[0], aligned[1]
+func Align(snapshots ...<-chan *Snapshot) []<-chan *Snapshot {
+	outputs := make([]chan *Snapshot, len(snapshots))
+	results := make([]<-chan *Snapshot, len(snapshots))
+
+	for i := range outputs {
+		outputs[i] = make(chan *Snapshot, cap(snapshots[i]))
+		results[i] = outputs[i]
+	}
+
+	go func() {
+		defer func() {
+			for i, output := range outputs {
+				close(output)
+				go helper.Drain(snapshots[i])
+			}
+		}()
+
+		if len(snapshots) == 0 {
+			return
+		}
+
+		heads := make([]*Snapshot, len(snapshots))
+
+		for i, c := range snapshots {
+			head, ok := <-c
+			if !ok {
+				return
+			}
+
+			heads[i] = head
+		}
+
+		for {
+			latest := heads[0].Date
+			for _, head := range heads[1:] {
+				if head.Date.After(latest) {
+					latest = head.Date
+				}
+			}
+
+			aligned := true
+
+			// Skip the snapshots before the latest date.
+			for i, c := range snapshots {
+				for heads[i].Date.Before(latest) {
+					head, ok := <-c
+					if !ok {
+						return
+					}
+
+					heads[i] = head
+				}
+
+				if !heads[i].Date.Equal(latest) {
+					aligned = false
+				}
+			}
+
+			if !aligned {
+				continue
+			}
+
+			for i, output := range outputs {
+				output <- heads[i]
+			}
+
+			for i, c := range snapshots {
+				head, ok := <-c
+				if !ok {
+					return
+				}
+
+				heads[i] = head
+			}
+		}
+	}()
+
+	return results
+}
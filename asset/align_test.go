@@ -0,0 +1,52 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestAlign(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	snapshots := func(days ...int) <-chan *asset.Snapshot {
+		result := make([]*asset.Snapshot, len(days))
+		for i, d := range days {
+			result[i] = &asset.Snapshot{Date: day(d), Close: float64(d)}
+		}
+
+		return helper.SliceToChan(result)
+	}
+
+	aligned := asset.Align(
+		snapshots(1, 2, 3, 5, 6, 8),
+		snapshots(2, 3, 4, 5, 8, 9),
+		snapshots(1, 2, 3, 4, 5, 7, 8),
+	)
+
+	expected := []float64{2, 3, 5, 8}
+
+	err := helper.CheckEquals(
+		asset.SnapshotsAsClosings(aligned[0]), helper.SliceToChan(expected),
+		asset.SnapshotsAsClosings(aligned[1]), helper.SliceToChan(expected),
+		asset.SnapshotsAsClosings(aligned[2]), helper.SliceToChan(expected),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAlignEmpty(t *testing.T) {
+	aligned := asset.Align()
+	if len(aligned) != 0 {
+		t.Fatalf("actual %d expected 0", len(aligned))
+	}
+}
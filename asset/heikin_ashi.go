@@ -0,0 +1,53 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset
+
+import "math"
+
+// HeikinAshi converts the given snapshots into Heikin-Ashi candles. Heikin-Ashi candles average
+// the prices to filter out the noise, making the trends easier to spot. Each candle keeps the
+// date and the volume of its snapshot.
+//
+//	HA Close = (Open + High + Low + Close) / 4
+//	HA Open = (Previous HA Open + Previous HA Close) / 2
+//	HA High = Max(High, HA Open, HA Close)
+//	HA Low = Min(Low, HA Open, HA Close)
+//
+// The first HA Open is the average of the first open and close.
+//
+// Example:
+//
+//	candles := asset.HeikinAshi(snapshots)
+func HeikinAshi(snapshots <-chan *Snapshot) <-chan *Snapshot {
+	result := make(chan *Snapshot, cap(snapshots))
+
+	go func() {
+		defer close(result)
+
+		var previous *Snapshot
+
+		for snapshot := range snapshots {
+			candle := &Snapshot{
+				Date:   snapshot.Date,
+				Close:  (snapshot.Open + snapshot.High + snapshot.Low + snapshot.Close) / 4,
+				Volume: snapshot.Volume,
+			}
+
+			if previous == nil {
+				candle.Open = (snapshot.Open + snapshot.Close) / 2
+			} else {
+				candle.Open = (previous.Open + previous.Close) / 2
+			}
+
+			candle.High = math.Max(snapshot.High, math.Max(candle.Open, candle.Close))
+			candle.Low = math.Min(snapshot.Low, math.Min(candle.Open, candle.Close))
+
+			result <- candle
+			previous = candle
+		}
+	}()
+
+	return result
+}
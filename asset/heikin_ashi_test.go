@@ -0,0 +1,36 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestHeikinAshi(t *testing.T) {
+	date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	snapshots := helper.SliceToChan([]*asset.Snapshot{
+		{Date: date, Open: 10, High: 12, Low: 9, Close: 11, Volume: 100},
+		{Date: date.AddDate(0, 0, 1), Open: 11, High: 14, Low: 10, Close: 13, Volume: 200},
+		{Date: date.AddDate(0, 0, 2), Open: 13, High: 13, Low: 8, Close: 9, Volume: 300},
+	})
+
+	expected := []*asset.Snapshot{
+		{Date: date, Open: 10.5, High: 12, Low: 9, Close: 10.5, Volume: 100},
+		{Date: date.AddDate(0, 0, 1), Open: 10.5, High: 14, Low: 10, Close: 12, Volume: 200},
+		{Date: date.AddDate(0, 0, 2), Open: 11.25, High: 13, Low: 8, Close: 10.75, Volume: 300},
+	}
+
+	actual := helper.ChanToSlice(asset.HeikinAshi(snapshots))
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset
+
+import "math"
+
+const (
+	// DefaultPointAndFigureReversal is the default number of boxes required for a reversal.
+	DefaultPointAndFigureReversal = 3
+)
+
+// PointAndFigure converts the given snapshots into point-and-figure columns using the closings. Prices
+// are rounded into boxes of the given size. A rising X column is extended while the closing rises by
+// at least a box, and a falling O column is extended while the closing falls by at least a box. The
+// column reverses once the closing moves in the opposite direction by the given number of boxes.
+//
+// Each column is emitted as a snapshot once it reverses, or once the snapshots channel is closed. An X
+// column opens at its bottom and closes at its top, while an O column opens at its top and closes at
+// its bottom. The column is dated at the snapshot that started it, and carries the total volume of its
+// snapshots.
+//
+// Example:
+//
+//	columns := asset.PointAndFigure(snapshots, 1, asset.DefaultPointAndFigureReversal)
+func PointAndFigure(snapshots <-chan *Snapshot, size float64, reversal int) <-chan *Snapshot {
+	result := make(chan *Snapshot, cap(snapshots))
+
+	go func() {
+		defer close(result)
+
+		// boxes returns the number of whole boxes in the given distance.
+		boxes := func(distance float64) float64 {
+			return math.Floor(distance/size + 1e-9)
+		}
+
+		var column *Snapshot
+		direction := 0
+		top, bottom := 0.0, 0.0
+
+		emit := func() {
+			if direction > 0 {
+				column.Open, column.Close = bottom, top
+			} else {
+				column.Open, column.Close = top, bottom
+			}
+
+			column.High, column.Low = top, bottom
+			result <- column
+		}
+
+		for snapshot := range snapshots {
+			closing := snapshot.Close
+
+			if column == nil {
+				// The first closing is the base of the columns.
+				top = boxes(closing) * size
+				bottom = top
+				column = &Snapshot{Date: snapshot.Date, Volume: snapshot.Volume}
+				continue
+			}
+
+			switch {
+			case direction >= 0 && closing >= top+size:
+				top += boxes(closing-top) * size
+				direction = 1
+
+			case direction <= 0 && closing <= bottom-size:
+				bottom -= boxes(bottom-closing) * size
+				direction = -1
+
+			case direction > 0 && closing <= top-float64(reversal)*size:
+				emit()
+				bottom = top - boxes(top-closing)*size
+				top -= size
+				column = &Snapshot{Date: snapshot.Date}
+				direction = -1
+
+			case direction < 0 && closing >= bottom+float64(reversal)*size:
+				emit()
+				top = bottom + boxes(closing-bottom)*size
+				bottom += size
+				column = &Snapshot{Date: snapshot.Date}
+				direction = 1
+			}
+
+			column.Volume += snapshot.Volume
+		}
+
+		if column != nil && direction != 0 {
+			emit()
+		}
+	}()
+
+	return result
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestPointAndFigure(t *testing.T) {
+	date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	snapshots := closingSnapshots(date, 10, 12.3, 14.1, 13.5, 11.2, 10.4, 9.3, 12, 13.6)
+
+	expected := []*asset.Snapshot{
+		{Date: date, Open: 10, High: 14, Low: 10, Close: 14, Volume: 5},
+		{Date: date.AddDate(0, 0, 5), Open: 13, High: 13, Low: 10, Close: 10, Volume: 3},
+		{Date: date.AddDate(0, 0, 8), Open: 11, High: 13, Low: 11, Close: 13, Volume: 1},
+	}
+
+	actual := helper.ChanToSlice(asset.PointAndFigure(helper.SliceToChan(snapshots), 1, asset.DefaultPointAndFigureReversal))
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestPointAndFigureNoColumns(t *testing.T) {
+	date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	snapshots := closingSnapshots(date, 10, 10.5, 9.5)
+
+	actual := helper.ChanToSlice(asset.PointAndFigure(helper.SliceToChan(snapshots), 1, asset.DefaultPointAndFigureReversal))
+
+	if len(actual) != 0 {
+		t.Fatalf("actual %v", actual)
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset
+
+import "math"
+
+// RangeBars aggregates the given snapshots into bars spanning at least the given price range. A bar
+// accumulates consecutive snapshots until its high minus its low reaches the range, and it is then
+// emitted. Since the snapshots are already aggregated, a bar may span more than the given range.
+// The last bar is emitted when the snapshots channel is closed even if it has not reached the range.
+// Each bar is dated at its first snapshot.
+//
+// Example:
+//
+//	bars := asset.RangeBars(snapshots, 5)
+func RangeBars(snapshots <-chan *Snapshot, size float64) <-chan *Snapshot {
+	return aggregateUntil(snapshots, func(bar *Snapshot) bool {
+		return bar.High-bar.Low >= size
+	})
+}
+
+// aggregateUntil aggregates the given snapshots into bars, emitting each bar once the given
+// function reports that it is complete.
+func aggregateUntil(snapshots <-chan *Snapshot, complete func(bar *Snapshot) bool) <-chan *Snapshot {
+	result := make(chan *Snapshot, cap(snapshots))
+
+	go func() {
+		defer close(result)
+
+		var bar *Snapshot
+
+		for snapshot := range snapshots {
+			if bar == nil {
+				bar = &Snapshot{
+					Date:   snapshot.Date,
+					Open:   snapshot.Open,
+					High:   snapshot.High,
+					Low:    snapshot.Low,
+					Close:  snapshot.Close,
+					Volume: snapshot.Volume,
+				}
+			} else {
+				bar.High = math.Max(bar.High, snapshot.High)
+				bar.Low = math.Min(bar.Low, snapshot.Low)
+				bar.Close = snapshot.Close
+				bar.Volume += snapshot.Volume
+			}
+
+			if complete(bar) {
+				result <- bar
+				bar = nil
+			}
+		}
+
+		if bar != nil {
+			result <- bar
+		}
+	}()
+
+	return result
+}
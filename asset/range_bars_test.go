@@ -0,0 +1,37 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestRangeBars(t *testing.T) {
+	date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	snapshots := helper.SliceToChan([]*asset.Snapshot{
+		{Date: date, Open: 10, High: 11, Low: 9, Close: 10, Volume: 100},
+		{Date: date.AddDate(0, 0, 1), Open: 10, High: 12, Low: 10, Close: 12, Volume: 200},
+		{Date: date.AddDate(0, 0, 2), Open: 12, High: 17, Low: 12, Close: 16, Volume: 300},
+		{Date: date.AddDate(0, 0, 3), Open: 16, High: 17, Low: 15, Close: 15, Volume: 400},
+	})
+
+	expected := []*asset.Snapshot{
+		{Date: date, Open: 10, High: 12, Low: 9, Close: 12, Volume: 300},
+		{Date: date.AddDate(0, 0, 2), Open: 12, High: 17, Low: 12, Close: 16, Volume: 300},
+		{Date: date.AddDate(0, 0, 3), Open: 16, High: 17, Low: 15, Close: 15, Volume: 400},
+	}
+
+	actual := helper.ChanToSlice(asset.RangeBars(snapshots, 3))
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset
+
+import (
+	"math"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/volatility"
+)
+
+// Renko converts the given snapshots into Renko bricks of the given fixed size. A new brick is
+// formed each time the closing moves by a full brick size beyond the last brick in the same
+// direction, or by two brick sizes in the opposite direction. A single snapshot can form multiple
+// bricks. Each brick is dated at the snapshot that formed it, and carries the volume accumulated
+// since the previous brick on the first brick of that snapshot.
+//
+// Example:
+//
+//	bricks := asset.Renko(snapshots, 2.5)
+func Renko(snapshots <-chan *Snapshot, size float64) <-chan *Snapshot {
+	snapshotsSplice := helper.Duplicate(snapshots, 2)
+
+	sizes := helper.Map(snapshotsSplice[1], func(*Snapshot) float64 {
+		return size
+	})
+
+	return renko(snapshotsSplice[0], sizes)
+}
+
+// RenkoWithAtr converts the given snapshots into Renko bricks sized by the Average True Range (ATR)
+// at each snapshot, so that the bricks adapt to the volatility. The snapshots in the ATR idle period
+// are skipped.
+//
+// Example:
+//
+//	bricks := asset.RenkoWithAtr(snapshots, volatility.NewAtr[float64]())
+func RenkoWithAtr(snapshots <-chan *Snapshot, atr *volatility.Atr[float64]) <-chan *Snapshot {
+	snapshotsSplice := helper.Duplicate(snapshots, 4)
+
+	highs := SnapshotsAsHighs(snapshotsSplice[0])
+	lows := SnapshotsAsLows(snapshotsSplice[1])
+	closings := SnapshotsAsClosings(snapshotsSplice[2])
+
+	sizes := atr.Compute(highs, lows, closings)
+	snapshotsSplice[3] = helper.Skip(snapshotsSplice[3], atr.IdlePeriod())
+
+	return renko(snapshotsSplice[3], sizes)
+}
+
+// renko converts the given snapshots into Renko bricks using the brick size given for each snapshot.
+func renko(snapshots <-chan *Snapshot, sizes <-chan float64) <-chan *Snapshot {
+	result := make(chan *Snapshot, cap(snapshots))
+
+	go func() {
+		defer close(result)
+		defer helper.Drain(snapshots)
+		defer helper.Drain(sizes)
+
+		var last *Snapshot
+		direction := 0
+		volume := 0.0
+
+		for snapshot := range snapshots {
+			size, ok := <-sizes
+			if !ok {
+				break
+			}
+
+			volume += snapshot.Volume
+
+			if last == nil {
+				// The first closing is the base of the bricks.
+				last = &Snapshot{Open: snapshot.Close, Close: snapshot.Close}
+				continue
+			}
+
+			if size <= 0 {
+				continue
+			}
+
+			for {
+				open, closing, ok := nextBrick(last, direction, snapshot.Close, size)
+				if !ok {
+					break
+				}
+
+				if closing > open {
+					direction = 1
+				} else {
+					direction = -1
+				}
+
+				last = &Snapshot{
+					Date:   snapshot.Date,
+					Open:   open,
+					High:   math.Max(open, closing),
+					Low:    math.Min(open, closing),
+					Close:  closing,
+					Volume: volume,
+				}
+
+				volume = 0
+				result <- last
+			}
+		}
+	}()
+
+	return result
+}
+
+// nextBrick returns the opening and the closing of the next brick after the given last brick, if the
+// given closing moves far enough to form one.
+func nextBrick(last *Snapshot, direction int, closing, size float64) (float64, float64, bool) {
+	switch {
+	case direction >= 0 && closing >= last.Close+size:
+		return last.Close, last.Close + size, true
+
+	case direction <= 0 && closing <= last.Close-size:
+		return last.Close, last.Close - size, true
+
+	case direction > 0 && closing <= last.Open-size:
+		return last.Open, last.Open - size, true
+
+	case direction < 0 && closing >= last.Open+size:
+		return last.Open, last.Open + size, true
+
+	default:
+		return 0, 0, false
+	}
+}
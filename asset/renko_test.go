@@ -0,0 +1,70 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/volatility"
+)
+
+// closingSnapshots returns daily snapshots with the given closings, a range of one around the
+// closing, and a volume of one.
+func closingSnapshots(date time.Time, closings ...float64) []*asset.Snapshot {
+	snapshots := make([]*asset.Snapshot, len(closings))
+
+	for i, closing := range closings {
+		snapshots[i] = &asset.Snapshot{
+			Date:   date.AddDate(0, 0, i),
+			Open:   closing,
+			High:   closing + 0.5,
+			Low:    closing - 0.5,
+			Close:  closing,
+			Volume: 1,
+		}
+	}
+
+	return snapshots
+}
+
+func TestRenko(t *testing.T) {
+	date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	snapshots := closingSnapshots(date, 10, 11.5, 13.2, 12.5, 10.9, 9.5, 12.2)
+
+	expected := []*asset.Snapshot{
+		{Date: date.AddDate(0, 0, 1), Open: 10, High: 11, Low: 10, Close: 11, Volume: 2},
+		{Date: date.AddDate(0, 0, 2), Open: 11, High: 12, Low: 11, Close: 12, Volume: 1},
+		{Date: date.AddDate(0, 0, 2), Open: 12, High: 13, Low: 12, Close: 13, Volume: 0},
+		{Date: date.AddDate(0, 0, 4), Open: 12, High: 12, Low: 11, Close: 11, Volume: 2},
+		{Date: date.AddDate(0, 0, 5), Open: 11, High: 11, Low: 10, Close: 10, Volume: 1},
+		{Date: date.AddDate(0, 0, 6), Open: 11, High: 12, Low: 11, Close: 12, Volume: 1},
+	}
+
+	actual := helper.ChanToSlice(asset.Renko(helper.SliceToChan(snapshots), 1))
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestRenkoWithAtr(t *testing.T) {
+	date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// The true range of each snapshot is one.
+	snapshots := closingSnapshots(date, 10, 10.5, 11, 11.5, 12, 11.5, 11, 10.5, 10, 9.5, 9)
+
+	atr := volatility.NewAtrWithPeriod[float64](1)
+
+	expected := helper.ChanToSlice(asset.Renko(helper.SliceToChan(snapshots[atr.IdlePeriod():]), 1))
+	actual := helper.ChanToSlice(asset.RenkoWithAtr(helper.SliceToChan(snapshots), atr))
+
+	if len(actual) == 0 || !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
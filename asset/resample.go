@@ -0,0 +1,99 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset
+
+import (
+	"math"
+	"time"
+)
+
+// Timeframe maps the given snapshot date to the start of the bar that it belongs to.
+// Snapshots mapping to the same start date are aggregated into the same bar.
+type Timeframe func(date time.Time) time.Time
+
+// Minutes returns a timeframe that groups snapshots into bars of the given number of minutes.
+func Minutes(minutes int) Timeframe {
+	return truncateTimeframe(time.Duration(minutes) * time.Minute)
+}
+
+// Hours returns a timeframe that groups snapshots into bars of the given number of hours.
+func Hours(hours int) Timeframe {
+	return truncateTimeframe(time.Duration(hours) * time.Hour)
+}
+
+// Daily groups snapshots into bars by their calendar day in their own location.
+func Daily(date time.Time) time.Time {
+	year, month, day := date.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, date.Location())
+}
+
+// Weekly groups snapshots into bars by their calendar week starting on Monday.
+func Weekly(date time.Time) time.Time {
+	day := Daily(date)
+	return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+}
+
+// Monthly groups snapshots into bars by their calendar month.
+func Monthly(date time.Time) time.Time {
+	year, month, _ := date.Date()
+	return time.Date(year, month, 1, 0, 0, 0, 0, date.Location())
+}
+
+// Resample aggregates the given snapshots into bars of the given timeframe. Each bar is dated at the start
+// of its timeframe, opens with its first snapshot, closes with its last snapshot, and spans the highest high,
+// lowest low, and total volume. A bar is emitted only once the first snapshot of the next bar arrives, or
+// the snapshots channel is closed, therefore a bar is never emitted before it is complete.
+//
+// Example:
+//
+//	weekly := asset.Resample(snapshots, asset.Weekly)
+func Resample(snapshots <-chan *Snapshot, timeframe Timeframe) <-chan *Snapshot {
+	result := make(chan *Snapshot, cap(snapshots))
+
+	go func() {
+		defer close(result)
+
+		var bar *Snapshot
+
+		for snapshot := range snapshots {
+			start := timeframe(snapshot.Date)
+
+			if bar != nil && bar.Date.Equal(start) {
+				bar.High = math.Max(bar.High, snapshot.High)
+				bar.Low = math.Min(bar.Low, snapshot.Low)
+				bar.Close = snapshot.Close
+				bar.Volume += snapshot.Volume
+				continue
+			}
+
+			if bar != nil {
+				result <- bar
+			}
+
+			bar = &Snapshot{
+				Date:   start,
+				Open:   snapshot.Open,
+				High:   snapshot.High,
+				Low:    snapshot.Low,
+				Close:  snapshot.Close,
+				Volume: snapshot.Volume,
+			}
+		}
+
+		if bar != nil {
+			result <- bar
+		}
+	}()
+
+	return result
+}
+
+// truncateTimeframe returns a timeframe that truncates dates to a multiple of the given duration since the
+// zero time.
+func truncateTimeframe(duration time.Duration) Timeframe {
+	return func(date time.Time) time.Time {
+		return date.Truncate(duration)
+	}
+}
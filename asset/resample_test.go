@@ -0,0 +1,71 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestResample(t *testing.T) {
+	date := time.Date(2024, 1, 1, 9, 20, 0, 0, time.UTC)
+
+	snapshots := helper.SliceToChan([]*asset.Snapshot{
+		{Date: date, Open: 10, High: 12, Low: 9, Close: 11, Volume: 100},
+		{Date: date.Add(10 * time.Minute), Open: 11, High: 14, Low: 10, Close: 13, Volume: 200},
+		{Date: date.Add(20 * time.Minute), Open: 13, High: 13, Low: 8, Close: 9, Volume: 300},
+		{Date: date.Add(40 * time.Minute), Open: 9, High: 10, Low: 7, Close: 8, Volume: 400},
+	})
+
+	expected := []*asset.Snapshot{
+		{Date: date, Open: 10, High: 14, Low: 9, Close: 13, Volume: 300},
+		{Date: date.Add(20 * time.Minute), Open: 13, High: 13, Low: 8, Close: 9, Volume: 300},
+		{Date: date.Add(40 * time.Minute), Open: 9, High: 10, Low: 7, Close: 8, Volume: 400},
+	}
+
+	actual := helper.ChanToSlice(asset.Resample(snapshots, asset.Minutes(20)))
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestResampleEmpty(t *testing.T) {
+	actual := helper.ChanToSlice(asset.Resample(helper.SliceToChan([]*asset.Snapshot{}), asset.Daily))
+	if len(actual) != 0 {
+		t.Fatalf("actual %v", actual)
+	}
+}
+
+func TestTimeframes(t *testing.T) {
+	date := time.Date(2024, 5, 16, 14, 45, 30, 0, time.UTC)
+
+	timeframes := []struct {
+		timeframe asset.Timeframe
+		expected  time.Time
+	}{
+		{asset.Minutes(15), time.Date(2024, 5, 16, 14, 45, 0, 0, time.UTC)},
+		{asset.Hours(4), time.Date(2024, 5, 16, 12, 0, 0, 0, time.UTC)},
+		{asset.Daily, time.Date(2024, 5, 16, 0, 0, 0, 0, time.UTC)},
+		{asset.Weekly, time.Date(2024, 5, 13, 0, 0, 0, 0, time.UTC)},
+		{asset.Monthly, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, timeframe := range timeframes {
+		actual := timeframe.timeframe(date)
+		if !actual.Equal(timeframe.expected) {
+			t.Fatalf("actual %v expected %v", actual, timeframe.expected)
+		}
+	}
+
+	sunday := time.Date(2024, 5, 19, 23, 0, 0, 0, time.UTC)
+	if actual := asset.Weekly(sunday); !actual.Equal(time.Date(2024, 5, 13, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("actual %v", actual)
+	}
+}
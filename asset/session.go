@@ -0,0 +1,47 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset
+
+import "time"
+
+// Session returns a timeframe that groups intraday snapshots into trading sessions starting at the
+// given time of day in the given location. The dates are converted to the location first, so that
+// the sessions follow the exchange time regardless of the time zone of the snapshots. For example,
+// futures sessions starting at 18:00 New York time include the evening before their trading day.
+//
+// Example:
+//
+//	newYork, _ := time.LoadLocation("America/New_York")
+//	session := asset.Session(newYork, 18*time.Hour)
+func Session(location *time.Location, start time.Duration) Timeframe {
+	return func(date time.Time) time.Time {
+		local := date.In(location)
+
+		year, month, day := local.Date()
+		begin := time.Date(year, month, day, 0, 0, 0, int(start), location)
+
+		if local.Before(begin) {
+			begin = time.Date(year, month, day-1, 0, 0, 0, int(start), location)
+		}
+
+		return begin
+	}
+}
+
+// Anchored returns a timeframe with a single period starting at the given anchor date. The dates
+// before the anchor belong to a single period starting at the zero time.
+//
+// Example:
+//
+//	anchored := asset.Anchored(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))
+func Anchored(anchor time.Time) Timeframe {
+	return func(date time.Time) time.Time {
+		if date.Before(anchor) {
+			return time.Time{}
+		}
+
+		return anchor
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+)
+
+func TestSession(t *testing.T) {
+	newYork := time.FixedZone("EST", -5*60*60)
+	session := asset.Session(newYork, 18*time.Hour)
+
+	dates := []struct {
+		date     time.Time
+		expected time.Time
+	}{
+		// 22:00 UTC is 17:00 New York, before the session start.
+		{time.Date(2024, 1, 10, 22, 0, 0, 0, time.UTC), time.Date(2024, 1, 9, 18, 0, 0, 0, newYork)},
+		// 23:00 UTC is 18:00 New York, the start of the session.
+		{time.Date(2024, 1, 10, 23, 0, 0, 0, time.UTC), time.Date(2024, 1, 10, 18, 0, 0, 0, newYork)},
+		// 14:30 UTC on the next day still belongs to the same session.
+		{time.Date(2024, 1, 11, 14, 30, 0, 0, time.UTC), time.Date(2024, 1, 10, 18, 0, 0, 0, newYork)},
+	}
+
+	for _, date := range dates {
+		actual := session(date.date)
+		if !actual.Equal(date.expected) {
+			t.Fatalf("actual %v expected %v", actual, date.expected)
+		}
+	}
+}
+
+func TestAnchored(t *testing.T) {
+	anchor := time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC)
+	anchored := asset.Anchored(anchor)
+
+	if actual := anchored(anchor.Add(-time.Minute)); !actual.IsZero() {
+		t.Fatalf("actual %v expected zero", actual)
+	}
+
+	if actual := anchored(anchor); !actual.Equal(anchor) {
+		t.Fatalf("actual %v expected %v", actual, anchor)
+	}
+
+	if actual := anchored(anchor.AddDate(0, 1, 0)); !actual.Equal(anchor) {
+		t.Fatalf("actual %v expected %v", actual, anchor)
+	}
+}
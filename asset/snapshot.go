@@ -90,3 +90,21 @@ func SnapshotsAsVolumes(snapshots <-chan *Snapshot) <-chan float64 {
 		return snapshot.Volume
 	})
 }
+
+// SnapshotsAsSeries extracts the field returned by the given function from each snapshot as a
+// series keyed by the snapshot date.
+//
+// Example:
+//
+//	closings := asset.SnapshotsAsSeries(snapshots, func(s *asset.Snapshot) float64 {
+//		return s.Close
+//	})
+func SnapshotsAsSeries(snapshots <-chan *Snapshot, field func(*Snapshot) float64) helper.Series[float64] {
+	return helper.Map(snapshots, func(snapshot *Snapshot) helper.SeriesPoint[float64] {
+		return helper.SeriesPoint[float64]{
+			Time:  snapshot.Date,
+			Value: field(snapshot),
+			Valid: true,
+		}
+	})
+}
@@ -59,3 +59,28 @@ func TestSnapshotsAs(t *testing.T) {
 		}
 	}
 }
+
+func TestSnapshotsAsSeries(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/repository/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshotsCopies := helper.Duplicate(snapshots, 2)
+
+	series := asset.SnapshotsAsSeries(snapshotsCopies[0], func(snapshot *asset.Snapshot) float64 {
+		return snapshot.Close
+	})
+
+	for snapshot := range snapshotsCopies[1] {
+		point := <-series
+
+		if !point.Valid || !point.Time.Equal(snapshot.Date) || point.Value != snapshot.Close {
+			t.Fatalf("actual %v expected %v %v", point, snapshot.Date, snapshot.Close)
+		}
+	}
+
+	if _, ok := <-series; ok {
+		t.Fatal("series not closed")
+	}
+}
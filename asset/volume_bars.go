@@ -0,0 +1,19 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset
+
+// VolumeBars aggregates the given snapshots into bars of at least the given traded volume. A bar
+// accumulates consecutive snapshots until its total volume reaches the given volume, and it is then
+// emitted. The last bar is emitted when the snapshots channel is closed even if it has not reached
+// the volume. Each bar is dated at its first snapshot.
+//
+// Example:
+//
+//	bars := asset.VolumeBars(snapshots, 1000000)
+func VolumeBars(snapshots <-chan *Snapshot, volume float64) <-chan *Snapshot {
+	return aggregateUntil(snapshots, func(bar *Snapshot) bool {
+		return bar.Volume >= volume
+	})
+}
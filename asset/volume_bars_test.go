@@ -0,0 +1,37 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package asset_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestVolumeBars(t *testing.T) {
+	date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	snapshots := helper.SliceToChan([]*asset.Snapshot{
+		{Date: date, Open: 10, High: 12, Low: 9, Close: 11, Volume: 100},
+		{Date: date.AddDate(0, 0, 1), Open: 11, High: 14, Low: 10, Close: 13, Volume: 200},
+		{Date: date.AddDate(0, 0, 2), Open: 13, High: 13, Low: 8, Close: 9, Volume: 500},
+		{Date: date.AddDate(0, 0, 3), Open: 9, High: 10, Low: 7, Close: 8, Volume: 100},
+	})
+
+	expected := []*asset.Snapshot{
+		{Date: date, Open: 10, High: 14, Low: 9, Close: 13, Volume: 300},
+		{Date: date.AddDate(0, 0, 2), Open: 13, High: 13, Low: 8, Close: 9, Volume: 500},
+		{Date: date.AddDate(0, 0, 3), Open: 9, High: 10, Low: 7, Close: 8, Volume: 100},
+	}
+
+	actual := helper.ChanToSlice(asset.VolumeBars(snapshots, 300))
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
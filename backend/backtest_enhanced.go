@@ -444,7 +444,10 @@ func formatDuration(duration time.Duration) string {
 }
 
 func getStrategyByName(name string) (strategy.Strategy, error) {
-        strategies := GetAllCustomStrategies()
+        strategies, err := GetAllCustomStrategies()
+        if err != nil {
+                return nil, err
+        }
         
         for _, strat := range strategies {
                 if strat.Name() == name {
@@ -8,4 +8,6 @@ require (
 	github.com/rs/cors v1.10.1
 )
 
+require gopkg.in/yaml.v3 v3.0.1 // indirect
+
 replace github.com/cinar/indicator/v2 => ../
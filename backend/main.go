@@ -16,6 +16,7 @@ import (
 	"github.com/cinar/indicator/v2/volatility"
 	"github.com/cinar/indicator/v2/volume"
 	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/strategy/spec"
 	"github.com/cinar/indicator/v2/asset"
 	
 	"github.com/rs/cors"
@@ -117,6 +118,12 @@ func main() {
 	// Data generation
 	api.HandleFunc("/data/nq-dummy", handleGenerateNQData).Methods("POST")
 	
+	// Paper trading
+	if err := initPaperBroker(); err != nil {
+		log.Fatal(err)
+	}
+	registerPaperRoutes(api)
+	
 	// Health check
 	api.HandleFunc("/health", handleHealth).Methods("GET")
 	
@@ -143,6 +150,7 @@ type StrategyInfo struct {
 	WinRate     float64                `json:"winRate"`
 	ProfitFactor float64               `json:"profitFactor"`
 	MaxDrawdown float64                `json:"maxDrawdown"`
+	Spec        *spec.StrategySpec     `json:"spec"`
 }
 
 
@@ -193,71 +201,10 @@ func handleEnhancedBacktest(w http.ResponseWriter, r *http.Request) {
 
 // Get strategies list with detailed information
 func handleGetStrategiesList(w http.ResponseWriter, r *http.Request) {
-	strategies := []StrategyInfo{
-		{
-			Name:         "VWAP EMA Crossover (9,21,20)",
-			Description:  "Combines VWAP, fast EMA (9), and slow EMA (21) for trend-following entries with volume confirmation",
-			Category:     "Trend Following",
-			WinRate:      65.0,
-			ProfitFactor: 1.8,
-			MaxDrawdown:  12.5,
-			Parameters: map[string]interface{}{
-				"emaFastPeriod":      9,
-				"emaSlowPeriod":      21,
-				"vwapPeriod":         20,
-				"scalpTarget":        5.0,
-				"stopLoss":           10.0,
-				"minVolumeThreshold": 1000,
-			},
-		},
-		{
-			Name:         "Donchian Channel Breakout (20)",
-			Description:  "Breakout strategy using 20-period Donchian Channel for trend momentum entries",
-			Category:     "Breakout",
-			WinRate:      58.0,
-			ProfitFactor: 2.1,
-			MaxDrawdown:  18.0,
-			Parameters: map[string]interface{}{
-				"period": 20,
-			},
-		},
-		{
-			Name:         "RSI Divergence Strategy (14)",
-			Description:  "Mean reversion strategy using RSI overbought/oversold levels with divergence detection",
-			Category:     "Mean Reversion",
-			WinRate:      72.0,
-			ProfitFactor: 1.6,
-			MaxDrawdown:  8.5,
-			Parameters: map[string]interface{}{
-				"rsiPeriod":       14,
-				"overboughtLevel": 70,
-				"oversoldLevel":   30,
-			},
-		},
-		{
-			Name:         "Moving Average Crossover (10,30)",
-			Description:  "Classic golden cross/death cross strategy using fast and slow moving averages",
-			Category:     "Trend Following",
-			WinRate:      60.0,
-			ProfitFactor: 1.7,
-			MaxDrawdown:  15.0,
-			Parameters: map[string]interface{}{
-				"fastPeriod": 10,
-				"slowPeriod": 30,
-			},
-		},
-		{
-			Name:         "Bollinger Mean Reversion (20)",
-			Description:  "Mean reversion strategy using Bollinger Band touches for contrarian entries",
-			Category:     "Mean Reversion",
-			WinRate:      68.0,
-			ProfitFactor: 1.9,
-			MaxDrawdown:  11.0,
-			Parameters: map[string]interface{}{
-				"period":       20,
-				"stdDeviation": 2.0,
-			},
-		},
+	strategies, err := GetCustomStrategyInfos()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -270,24 +217,10 @@ func handleGetStrategyDetails(w http.ResponseWriter, r *http.Request) {
 	strategyName := vars["name"]
 
 	// Find strategy in the list
-	strategies := []StrategyInfo{
-		// Same strategies as above - in production, this would be refactored to avoid duplication
-		{
-			Name:         "VWAP EMA Crossover (9,21,20)",
-			Description:  "Combines VWAP, fast EMA (9), and slow EMA (21) for trend-following entries with volume confirmation. Entry conditions: price > VWAP, EMA fast crosses above EMA slow, volume > threshold. Exit conditions: opposite signals, stop loss, or take profit.",
-			Category:     "Trend Following",
-			WinRate:      65.0,
-			ProfitFactor: 1.8,
-			MaxDrawdown:  12.5,
-			Parameters: map[string]interface{}{
-				"emaFastPeriod":      9,
-				"emaSlowPeriod":      21,
-				"vwapPeriod":         20,
-				"scalpTarget":        5.0,
-				"stopLoss":           10.0,
-				"minVolumeThreshold": 1000,
-			},
-		},
+	strategies, err := GetCustomStrategyInfos()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
 	}
 
 	for _, strategy := range strategies {
@@ -875,19 +808,7 @@ func handleVWAP(w http.ResponseWriter, r *http.Request) {
 
 // Get available strategies
 func handleGetStrategies(w http.ResponseWriter, r *http.Request) {
-	strategies := []string{
-		"buy_and_hold",
-		"rsi",
-		"macd",
-		"bollinger_bands",
-		"golden_cross",
-		"awesome_oscillator",
-		"stochastic_rsi",
-		"chaikin_money_flow",
-		"donchian_channel",
-		"aroon",
-		"cci",
-	}
+	strategies := spec.Types()
 	
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(strategies)
@@ -921,26 +842,27 @@ func handleBacktest(w http.ResponseWriter, r *http.Request) {
 	}
 	close(snapshotChan)
 	
-	// Select strategy based on request
-	var strat strategy.Strategy
-	switch req.Strategy {
-	case "buy_and_hold":
-		strat = strategy.NewBuyAndHoldStrategy()
-	case "rsi":
-		// RSI strategy not available in basic momentum package
-		http.Error(w, "RSI strategy not implemented in this version", http.StatusNotImplemented)
-		return
-	default:
-		http.Error(w, "Unsupported strategy: "+req.Strategy, http.StatusBadRequest)
+	// Build the strategy from the registry using the config fields declared by the strategy as its parameters
+	strat, err := spec.Build(&spec.StrategySpec{
+		Type:       req.Strategy,
+		Parameters: strategyParameters(req.Strategy, req.Config),
+	})
+	if err != nil {
+		http.Error(w, "Unsupported strategy: "+err.Error(), http.StatusBadRequest)
 		return
 	}
 	
 	// Run strategy
 	actionChan, outcomeChan := strategy.ComputeWithOutcome(strat, snapshotChan)
 	
-	// Collect results
+	// Collect results together, as the actions and the outcomes are computed in lockstep
+	outcomesChan := make(chan []float64)
+	go func() {
+		outcomesChan <- helper.ChanToSlice(outcomeChan)
+	}()
+
 	actions := helper.ChanToSlice(actionChan)
-	outcomes := helper.ChanToSlice(outcomeChan)
+	outcomes := <-outcomesChan
 	
 	// Convert actions to integers
 	actionInts := make([]int, len(actions))
@@ -0,0 +1,222 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/paper"
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/gorilla/mux"
+)
+
+// paperBroker is the paper trading broker persisted between restarts
+var paperBroker *paper.Broker
+
+// paperLastDates are the dates of the last bars seen for each asset, keyed by the asset name
+var paperLastDates = map[string]time.Time{}
+
+// paperLastDatesMu guards the paper last dates
+var paperLastDatesMu sync.Mutex
+
+// PaperAccountResponse represents the paper trading account state
+type PaperAccountResponse struct {
+	*paper.Account
+	Equity      float64 `json:"equity"`
+	Exposure    float64 `json:"exposure"`
+	Margin      float64 `json:"margin"`
+	BuyingPower float64 `json:"buyingPower"`
+}
+
+// PaperOrderRequest represents a paper trading order
+type PaperOrderRequest struct {
+	Asset    string  `json:"asset"`
+	Side     string  `json:"side"` // "buy" or "sell"
+	Type     string  `json:"type"` // "market", "limit" or "stop"
+	Quantity float64 `json:"quantity"`
+	Price    float64 `json:"price"`
+	Time     int64   `json:"time,omitempty"` // optional, unix seconds
+}
+
+// PaperUpdateRequest represents a new bar for the paper trading account
+type PaperUpdateRequest struct {
+	Asset string `json:"asset"`
+	Bar   OHLCV  `json:"bar"`
+}
+
+// initPaperBroker opens the paper trading account file given by PAPER_ACCOUNT_FILE
+func initPaperBroker() error {
+	fileName := os.Getenv("PAPER_ACCOUNT_FILE")
+	if fileName == "" {
+		fileName = "paper_account.json"
+	}
+
+	cash := float64(paper.DefaultAccountCash)
+	if value := os.Getenv("PAPER_ACCOUNT_CASH"); value != "" {
+		parsed, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		cash = parsed
+	}
+
+	broker, err := paper.OpenBroker(fileName, cash)
+	if err != nil {
+		return err
+	}
+
+	paperBroker = broker
+	return nil
+}
+
+// registerPaperRoutes registers the paper trading endpoints
+func registerPaperRoutes(api *mux.Router) {
+	api.HandleFunc("/paper/account", handlePaperAccount).Methods("GET")
+	api.HandleFunc("/paper/orders", handlePaperOrders).Methods("GET")
+	api.HandleFunc("/paper/orders", handlePaperSubmitOrder).Methods("POST")
+	api.HandleFunc("/paper/orders/{id}", handlePaperCancelOrder).Methods("DELETE")
+	api.HandleFunc("/paper/fills", handlePaperFills).Methods("GET")
+	api.HandleFunc("/paper/update", handlePaperUpdate).Methods("POST")
+}
+
+// Paper trading account endpoint
+func handlePaperAccount(w http.ResponseWriter, r *http.Request) {
+	account := paperBroker.Account()
+
+	writePaperJSON(w, PaperAccountResponse{
+		Account:     account,
+		Equity:      account.Equity(),
+		Exposure:    account.Exposure(),
+		Margin:      account.Margin(),
+		BuyingPower: account.BuyingPower(),
+	})
+}
+
+// Paper trading open orders endpoint
+func handlePaperOrders(w http.ResponseWriter, r *http.Request) {
+	writePaperJSON(w, paperBroker.Account().Orders)
+}
+
+// Paper trading fill history endpoint
+func handlePaperFills(w http.ResponseWriter, r *http.Request) {
+	writePaperJSON(w, paperBroker.Account().Fills)
+}
+
+// Paper trading order submission endpoint
+func handlePaperSubmitOrder(w http.ResponseWriter, r *http.Request) {
+	var req PaperOrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	action := strategy.Hold
+	switch req.Side {
+	case "buy":
+		action = strategy.Buy
+	case "sell":
+		action = strategy.Sell
+	}
+
+	order, err := paperBroker.Submit(&paper.Order{
+		Asset:    req.Asset,
+		Action:   action,
+		Type:     paper.OrderType(req.Type),
+		Quantity: req.Quantity,
+		Price:    req.Price,
+		Date:     paperOrderDate(req),
+	})
+	if errors.Is(err, paper.ErrInvalidOrder) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writePaperJSON(w, order)
+}
+
+// Paper trading order cancellation endpoint
+func handlePaperCancelOrder(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = paperBroker.Cancel(id)
+	if errors.Is(err, paper.ErrOrderNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Paper trading bar update endpoint, fills the open orders against the given bar
+func handlePaperUpdate(w http.ResponseWriter, r *http.Request) {
+	var req PaperUpdateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	date := time.Unix(req.Bar.Time, 0)
+
+	paperLastDatesMu.Lock()
+	if date.After(paperLastDates[req.Asset]) {
+		paperLastDates[req.Asset] = date
+	}
+	paperLastDatesMu.Unlock()
+
+	err := paperBroker.Update(req.Asset, &asset.Snapshot{
+		Date:   date,
+		Open:   req.Bar.Open,
+		High:   req.Bar.High,
+		Low:    req.Bar.Low,
+		Close:  req.Bar.Close,
+		Volume: req.Bar.Volume,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	handlePaperAccount(w, r)
+}
+
+// paperOrderDate returns the submission date for the given order. It is the time given in the
+// request if any, otherwise the date of the last bar seen for the asset, so that the orders are
+// filled by the following bars when the historical bars are replayed. It is the current time if
+// no bars are seen for the asset yet.
+func paperOrderDate(req PaperOrderRequest) time.Time {
+	if req.Time != 0 {
+		return time.Unix(req.Time, 0)
+	}
+
+	paperLastDatesMu.Lock()
+	defer paperLastDatesMu.Unlock()
+
+	if date, ok := paperLastDates[req.Asset]; ok {
+		return date
+	}
+
+	return time.Now()
+}
+
+// writePaperJSON writes the given value as JSON
+func writePaperJSON(w http.ResponseWriter, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(value)
+}
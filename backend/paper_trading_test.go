@@ -0,0 +1,123 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/paper"
+	"github.com/gorilla/mux"
+)
+
+// newPaperTestRouter returns a router with the paper trading routes backed by an in-memory account
+func newPaperTestRouter() *mux.Router {
+	paperBroker = paper.NewBroker(paper.NewAccount(10000))
+	paperLastDates = map[string]time.Time{}
+
+	r := mux.NewRouter()
+	registerPaperRoutes(r.PathPrefix("/api").Subrouter())
+
+	return r
+}
+
+// postPaperJSON posts the given value as JSON to the given path
+func postPaperJSON(t *testing.T, r http.Handler, path string, value interface{}) *httptest.ResponseRecorder {
+	t.Helper()
+
+	body, err := json.Marshal(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("POST", path, bytes.NewReader(body)))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("%s status %d: %s", path, w.Code, w.Body.String())
+	}
+
+	return w
+}
+
+func TestPaperReplayFillsSubmittedOrder(t *testing.T) {
+	r := newPaperTestRouter()
+
+	start := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	bar := func(day int, price float64) PaperUpdateRequest {
+		return PaperUpdateRequest{
+			Asset: "AAPL",
+			Bar: OHLCV{
+				Time:   start.AddDate(0, 0, day).Unix(),
+				Open:   price,
+				High:   price + 1,
+				Low:    price - 1,
+				Close:  price,
+				Volume: 1000,
+			},
+		}
+	}
+
+	postPaperJSON(t, r, "/api/paper/update", bar(0, 100))
+
+	postPaperJSON(t, r, "/api/paper/orders", PaperOrderRequest{
+		Asset:    "AAPL",
+		Side:     "buy",
+		Type:     "market",
+		Quantity: 10,
+	})
+
+	postPaperJSON(t, r, "/api/paper/update", bar(1, 101))
+
+	account := paperBroker.Account()
+
+	if len(account.Orders) != 0 {
+		t.Fatalf("open orders %d", len(account.Orders))
+	}
+
+	if len(account.Fills) != 1 {
+		t.Fatalf("fills %d", len(account.Fills))
+	}
+
+	if !account.Fills[0].Date.Equal(start.AddDate(0, 0, 1)) {
+		t.Fatalf("fill date %v", account.Fills[0].Date)
+	}
+
+	if account.Positions["AAPL"].Quantity != 10 {
+		t.Fatalf("position %v", account.Positions["AAPL"].Quantity)
+	}
+}
+
+func TestPaperReplayOrderWithTime(t *testing.T) {
+	r := newPaperTestRouter()
+
+	start := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	postPaperJSON(t, r, "/api/paper/orders", PaperOrderRequest{
+		Asset:    "AAPL",
+		Side:     "buy",
+		Type:     "market",
+		Quantity: 5,
+		Time:     start.Unix(),
+	})
+
+	postPaperJSON(t, r, "/api/paper/update", PaperUpdateRequest{
+		Asset: "AAPL",
+		Bar: OHLCV{
+			Time:  start.AddDate(0, 0, 1).Unix(),
+			Open:  50,
+			High:  51,
+			Low:   49,
+			Close: 50,
+		},
+	})
+
+	account := paperBroker.Account()
+
+	if len(account.Fills) != 1 {
+		t.Fatalf("fills %d", len(account.Fills))
+	}
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cinar/indicator/v2/strategy/spec"
+)
+
+// newStrategyTestRouter returns a router with the strategy and the backtest routes
+func newStrategyTestRouter() *mux.Router {
+	r := mux.NewRouter()
+
+	api := r.PathPrefix("/api").Subrouter()
+	api.HandleFunc("/backtest", handleBacktest).Methods("POST")
+	api.HandleFunc("/strategies/list", handleGetStrategiesList).Methods("GET")
+	api.HandleFunc("/strategies/details/{name}", handleGetStrategyDetails).Methods("GET")
+
+	return r
+}
+
+func TestGetAllCustomStrategies(t *testing.T) {
+	strategies, err := GetAllCustomStrategies()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(strategies) != len(customStrategies) {
+		t.Fatalf("strategies %d specs %d", len(strategies), len(customStrategies))
+	}
+
+	// The custom strategies only use the registered types that the MCP server offers as well
+	for _, c := range customStrategies {
+		if !slices.Contains(spec.Types(), c.Spec.Type) {
+			t.Fatalf("unregistered type %s", c.Spec.Type)
+		}
+	}
+}
+
+func TestStrategiesListMatchesStrategyNames(t *testing.T) {
+	r := newStrategyTestRouter()
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/api/strategies/list", nil))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status %d: %s", w.Code, w.Body.String())
+	}
+
+	var infos []StrategyInfo
+
+	err := json.Unmarshal(w.Body.Bytes(), &infos)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(infos) != len(customStrategies) {
+		t.Fatalf("strategies %d expected %d", len(infos), len(customStrategies))
+	}
+
+	for _, info := range infos {
+		// The listed names are the ones the strategies are looked up by
+		_, err := getStrategyByName(info.Name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest("GET", "/api/strategies/details/"+url.PathEscape(info.Name), nil))
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("details of %s status %d: %s", info.Name, w.Code, w.Body.String())
+		}
+
+		// The listed parameters are the ones the strategy is built with
+		_, err = spec.Build(&spec.StrategySpec{
+			Type:       info.Spec.Type,
+			Parameters: info.Parameters,
+			Strategies: info.Spec.Strategies,
+		})
+		if err != nil {
+			t.Fatalf("parameters of %s: %v", info.Name, err)
+		}
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/api/strategies/details/unknown", nil))
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("status %d expected %d", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestBacktestIgnoresUndeclaredConfig(t *testing.T) {
+	r := newStrategyTestRouter()
+
+	start := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	data := make([]OHLCV, 60)
+	for i := range data {
+		price := 100 + float64(i%10)
+
+		data[i] = OHLCV{
+			Time:   start.AddDate(0, 0, i).Unix(),
+			Open:   price,
+			High:   price + 1,
+			Low:    price - 1,
+			Close:  price,
+			Volume: 1000,
+		}
+	}
+
+	body, err := json.Marshal(BacktestRequest{
+		Data:     data,
+		Strategy: "rsi",
+		Config: map[string]interface{}{
+			"period":       7,
+			"stopLoss":     2,
+			"instrument":   "NQ",
+			"positionSize": 1,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("POST", "/api/backtest", bytes.NewReader(body)))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status %d: %s", w.Code, w.Body.String())
+	}
+
+	var response BacktestResponse
+
+	err = json.Unmarshal(w.Body.Bytes(), &response)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(response.Actions) != len(data) {
+		t.Fatalf("actions %d expected %d", len(response.Actions), len(data))
+	}
+
+	// The declared parameters are still validated
+	body, err = json.Marshal(BacktestRequest{
+		Data:     data,
+		Strategy: "rsi",
+		Config: map[string]interface{}{
+			"period": "seven",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("POST", "/api/backtest", bytes.NewReader(body)))
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("status %d expected %d", w.Code, http.StatusBadRequest)
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/cinar/indicator/v2/asset"
 	"github.com/cinar/indicator/v2/helper"
 	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/strategy/arbitrage"
 )
 
 const (
@@ -53,6 +54,12 @@ type Backtest struct {
 	// Strategies is the list of strategies to apply.
 	Strategies []strategy.Strategy
 
+	// Groups is the names of the groups of assets that are traded together, such as the pairs.
+	Groups [][]string
+
+	// MultiAssetStrategies is the list of multi-asset strategies to apply to the groups of assets.
+	MultiAssetStrategies []strategy.MultiAssetStrategy
+
 	// Workers is the number of concurrent workers.
 	Workers int
 
@@ -70,6 +77,7 @@ func NewBacktest(repository asset.Repository, report Report) *Backtest {
 		report:     report,
 		Names:      []string{},
 		Strategies: []strategy.Strategy{},
+		Groups:     [][]string{},
 		Workers:    DefaultBacktestWorkers,
 		LastDays:   DefaultLastDays,
 		Logger:     slog.Default(),
@@ -98,24 +106,47 @@ func (b *Backtest) Run() error {
 		}
 	}
 
+	// When multi-asset strategies are absent, considers all arbitrage strategies for the groups.
+	if len(b.Groups) > 0 && len(b.MultiAssetStrategies) == 0 {
+		b.MultiAssetStrategies = arbitrage.AllStrategies()
+	}
+
+	names := make([]string, 0, len(b.Names)+len(b.Groups))
+	names = append(names, b.Names...)
+
+	for _, group := range b.Groups {
+		names = append(names, strategy.MultiAssetName(group))
+	}
+
 	// Begin report.
-	err := b.report.Begin(b.Names, b.Strategies)
+	err := b.report.Begin(names, b.Strategies)
 	if err != nil {
 		return fmt.Errorf("unable to begin report: %w", err)
 	}
 
 	// Run the backtest workers.
-	names := helper.SliceToChan(b.Names)
 	wg := &sync.WaitGroup{}
+	jobs := helper.SliceToChan(b.Names)
 
 	for i := 0; i < b.Workers; i++ {
 		wg.Add(1)
-		go b.worker(names, wg)
+		go b.worker(jobs, wg)
 	}
 
 	// Wait for all workers to finish.
 	wg.Wait()
 
+	// Run the backtest workers for the groups.
+	groups := helper.SliceToChan(b.Groups)
+
+	for i := 0; i < b.Workers; i++ {
+		wg.Add(1)
+		go b.groupWorker(groups, wg)
+	}
+
+	// Wait for all group workers to finish.
+	wg.Wait()
+
 	// End report.
 	err = b.report.End()
 	if err != nil {
@@ -151,12 +182,18 @@ func (b *Backtest) worker(names <-chan string, wg *sync.WaitGroup) {
 			continue
 		}
 
-		// Backtest strategies on the given asset.
+		// Backtest strategies on the given asset. The snapshots are already materialized, so the
+		// batch form is used for the strategies that support it.
 		for _, currentStrategy := range b.Strategies {
-			snapshotsSplice := helper.Duplicate(helper.SliceToChan(snapshotsSlice), 2)
+			actions, outcomes := strategy.ComputeSliceWithOutcome(currentStrategy, snapshotsSlice)
 
-			actions, outcomes := strategy.ComputeWithOutcome(currentStrategy, snapshotsSplice[0])
-			err = b.report.Write(name, currentStrategy, snapshotsSplice[1], actions, outcomes)
+			err = b.report.Write(
+				name,
+				currentStrategy,
+				helper.SliceToChan(snapshotsSlice),
+				helper.SliceToChan(actions),
+				helper.SliceToChan(outcomes),
+			)
 			if err != nil {
 				b.Logger.Error("Unable to write report.", "asset", name, "error", err)
 			}
@@ -169,3 +206,81 @@ func (b *Backtest) worker(names <-chan string, wg *sync.WaitGroup) {
 		}
 	}
 }
+
+// groupWorker is a backtesting worker that concurrently executes backtests for the groups of assets
+// traded together. It receives the groups from the provided channel, and performs backtests using the
+// given multi-asset strategies on the date aligned snapshots of the assets.
+func (b *Backtest) groupWorker(groups <-chan []string, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	since := time.Now().AddDate(0, 0, -b.LastDays)
+
+	report, ok := b.report.(MultiAssetReport)
+	if !ok {
+		for group := range groups {
+			b.Logger.Error("Report does not support multi-asset strategies.", "group", strategy.MultiAssetName(group))
+		}
+
+		return
+	}
+
+	for group := range groups {
+		name := strategy.MultiAssetName(group)
+		b.Logger.Info("Backtesting started.", "group", name)
+
+		// We don't expect the snapshots to be a stream during backtesting.
+		snapshotsSlices := make([][]*asset.Snapshot, len(group))
+		failed := false
+
+		for i, assetName := range group {
+			snapshots, err := b.repository.GetSince(assetName, since)
+			if err != nil {
+				b.Logger.Error("Unable to retrieve snapshots.", "asset", assetName, "error", err)
+				failed = true
+				break
+			}
+
+			snapshotsSlices[i] = helper.ChanToSlice(snapshots)
+		}
+
+		if failed {
+			continue
+		}
+
+		// Backtesting group has begun.
+		err := b.report.AssetBegin(name, nil)
+		if err != nil {
+			b.Logger.Error("Unable to begin group.", "group", name, "error", err)
+			continue
+		}
+
+		// Backtest multi-asset strategies on the given group.
+		for _, currentStrategy := range b.MultiAssetStrategies {
+			legs := make([]<-chan *asset.Snapshot, len(group))
+			for i, snapshotsSlice := range snapshotsSlices {
+				legs[i] = helper.SliceToChan(snapshotsSlice)
+			}
+
+			computeLegs := make([]<-chan *asset.Snapshot, len(group))
+			reportLegs := make([]<-chan *asset.Snapshot, len(group))
+
+			for i, leg := range asset.Align(legs...) {
+				legsSplice := helper.Duplicate(leg, 2)
+				computeLegs[i] = legsSplice[0]
+				reportLegs[i] = legsSplice[1]
+			}
+
+			positions, outcomes := strategy.ComputeMultiAssetWithOutcome(currentStrategy, computeLegs)
+			err = report.WriteMultiAsset(name, currentStrategy, reportLegs, positions, outcomes)
+			if err != nil {
+				b.Logger.Error("Unable to write report.", "group", name, "error", err)
+			}
+		}
+
+		// Backtesting group had ended
+		err = b.report.AssetEnd(name)
+		if err != nil {
+			b.Logger.Error("Unable to end group.", "group", name, "error", err)
+		}
+	}
+}
@@ -7,10 +7,12 @@ package backtest_test
 import (
 	"github.com/cinar/indicator/v2/helper"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/cinar/indicator/v2/asset"
 	"github.com/cinar/indicator/v2/backtest"
+	"github.com/cinar/indicator/v2/strategy/arbitrage"
 	"github.com/cinar/indicator/v2/strategy/trend"
 )
 
@@ -73,3 +75,64 @@ func TestBacktestNonExistingAsset(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestBacktestGroups(t *testing.T) {
+	repository := asset.NewFileSystemRepository("testdata/repository")
+
+	outputDir, err := os.MkdirTemp("", "bt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer helper.RemoveAll(t, outputDir)
+
+	htmlReport := backtest.NewHTMLReport(outputDir)
+	bt := backtest.NewBacktest(repository, htmlReport)
+	bt.Names = append(bt.Names, "brk-b")
+	bt.Groups = append(bt.Groups, []string{"brk-b", "pair"})
+	bt.LastDays = 365 * 10
+
+	err = bt.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = os.Stat(filepath.Join(outputDir, "brk-b+pair.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBacktestGroupsDataReport(t *testing.T) {
+	repository := asset.NewFileSystemRepository("testdata/repository")
+
+	dataReport := backtest.NewDataReport()
+	bt := backtest.NewBacktest(repository, dataReport)
+	bt.Names = append(bt.Names, "brk-b")
+	bt.Groups = append(bt.Groups, []string{"brk-b", "pair"})
+	bt.LastDays = 365 * 10
+
+	err := bt.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, ok := dataReport.MultiAssetResults["brk-b+pair"]
+	if !ok {
+		t.Fatal("group result not found")
+	}
+
+	if len(results) != len(arbitrage.AllStrategies()) {
+		t.Fatalf("results count and strategies count are not the same, %d %d", len(results), len(arbitrage.AllStrategies()))
+	}
+
+	for _, result := range results {
+		if len(result.Positions) != 2 {
+			t.Fatalf("positions count is not two, %d", len(result.Positions))
+		}
+
+		if len(result.Positions[0]) == 0 || len(result.Positions[0]) != len(result.Positions[1]) {
+			t.Fatalf("positions lengths are not valid, %d %d", len(result.Positions[0]), len(result.Positions[1]))
+		}
+	}
+}
@@ -5,6 +5,8 @@
 package backtest
 
 import (
+	"sync"
+
 	"github.com/cinar/indicator/v2/asset"
 	"github.com/cinar/indicator/v2/helper"
 	"github.com/cinar/indicator/v2/strategy"
@@ -28,16 +30,35 @@ type DataStrategyResult struct {
 	Transactions []strategy.Action
 }
 
+// DataMultiAssetStrategyResult is the multi-asset strategy result.
+type DataMultiAssetStrategyResult struct {
+	// Group is the name of the group of assets.
+	Group string
+
+	// Strategy is the multi-asset strategy instance.
+	Strategy strategy.MultiAssetStrategy
+
+	// Outcome is the strategy outcome.
+	Outcome float64
+
+	// Positions are the positions for each asset in the group.
+	Positions [][]float64
+}
+
 // DataReport is the bactest data report enablign programmatic access to the backtest results.
 type DataReport struct {
 	// Results are the backtest results for the assets.
 	Results map[string][]*DataStrategyResult
+
+	// MultiAssetResults are the backtest results for the groups of assets.
+	MultiAssetResults map[string][]*DataMultiAssetStrategyResult
 }
 
 // NewDataReport initializes a new data report instance.
 func NewDataReport() *DataReport {
 	return &DataReport{
-		Results: make(map[string][]*DataStrategyResult),
+		Results:           make(map[string][]*DataStrategyResult),
+		MultiAssetResults: make(map[string][]*DataMultiAssetStrategyResult),
 	}
 }
 
@@ -75,6 +96,41 @@ func (d *DataReport) Write(assetName string, currentStrategy strategy.Strategy,
 	return nil
 }
 
+// WriteMultiAsset writes the given multi-asset strategy positions and outcomes to the report.
+func (d *DataReport) WriteMultiAsset(groupName string, currentStrategy strategy.MultiAssetStrategy, snapshots []<-chan *asset.Snapshot, positions []<-chan float64, outcomes <-chan float64) error {
+	for _, c := range snapshots {
+		go helper.Drain(c)
+	}
+
+	lastOutcome := helper.Last(outcomes, 1)
+
+	// The positions are collected concurrently, as the legs advance together.
+	result := &DataMultiAssetStrategyResult{
+		Group:     groupName,
+		Strategy:  currentStrategy,
+		Positions: make([][]float64, len(positions)),
+	}
+
+	wg := &sync.WaitGroup{}
+
+	for i, c := range positions {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			result.Positions[i] = helper.ChanToSlice(c)
+		}()
+	}
+
+	wg.Wait()
+
+	result.Outcome = <-lastOutcome
+
+	d.MultiAssetResults[groupName] = append(d.MultiAssetResults[groupName], result)
+
+	return nil
+}
+
 // AssetEnd is called when backtesting for the given asset ends.
 func (*DataReport) AssetEnd(_ string) error {
 	return nil
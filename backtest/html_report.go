@@ -24,6 +24,14 @@ import (
 const (
 	// DefaultWriteStrategyReports is the default state of writing individual strategy reports.
 	DefaultWriteStrategyReports = true
+
+	// DefaultWriteTradeReports is the default state of writing the trade reports as the individual
+	// strategy reports.
+	DefaultWriteTradeReports = false
+
+	// DefaultSelfContainedReports is the default state of embedding the styles and the scripts in
+	// the reports.
+	DefaultSelfContainedReports = false
 )
 
 //go:embed "html_report.tmpl"
@@ -48,6 +56,15 @@ type HTMLReport struct {
 	// WriteStrategyReports indicates whether the individual strategy reports should be generated.
 	WriteStrategyReports bool
 
+	// WriteTradeReports indicates whether the individual strategy reports should be the trade
+	// reports, with the candlesticks, the volumes, the buy and sell markers, and the shaded trades,
+	// instead of the reports of the strategies.
+	WriteTradeReports bool
+
+	// SelfContainedReports indicates whether the reports should embed their styles and scripts,
+	// so they render without any network access.
+	SelfContainedReports bool
+
 	// DateFormat is the date format that is used in the reports.
 	DateFormat string
 
@@ -82,6 +99,8 @@ func NewHTMLReport(outputDir string) *HTMLReport {
 		outputDir:            outputDir,
 		assetResults:         make(map[string][]*htmlReportResult),
 		WriteStrategyReports: DefaultWriteStrategyReports,
+		WriteTradeReports:    DefaultWriteTradeReports,
+		SelfContainedReports: DefaultSelfContainedReports,
 		DateFormat:           helper.DefaultReportDateFormat,
 		Logger:               slog.Default(),
 	}
@@ -123,8 +142,16 @@ func (h *HTMLReport) Write(assetName string, currentStrategy strategy.Strategy,
 
 	// Generate inidividual strategy report.
 	if h.WriteStrategyReports {
-		report := currentStrategy.Report(snapshots)
+		var report *helper.Report
+
+		if h.WriteTradeReports {
+			report = strategy.TradeReport(currentStrategy, snapshots)
+		} else {
+			report = currentStrategy.Report(snapshots)
+		}
+
 		report.DateFormat = h.DateFormat
+		report.SelfContained = h.SelfContainedReports
 
 		reportFile := h.strategyReportFileName(assetName, currentStrategy.Name())
 
@@ -155,6 +182,56 @@ func (h *HTMLReport) Write(assetName string, currentStrategy strategy.Strategy,
 	return nil
 }
 
+// WriteMultiAsset writes the given multi-asset strategy positions and outcomes to the report. The actions
+// are derived from the positions of the first asset in the group.
+func (h *HTMLReport) WriteMultiAsset(groupName string, currentStrategy strategy.MultiAssetStrategy, snapshots []<-chan *asset.Snapshot, positions []<-chan float64, outcomes <-chan float64) error {
+	for _, c := range positions[1:] {
+		go helper.Drain(c)
+	}
+
+	actionsSplice := helper.Duplicate(strategy.PositionsToActions(positions[0]), 3)
+
+	actions := helper.Last(actionsSplice[0], 1)
+	sinces := helper.Last(helper.Since[strategy.Action, int](actionsSplice[1]), 1)
+	outcomes = helper.Last(outcomes, 1)
+	transactions := helper.Last(strategy.CountTransactions(actionsSplice[2]), 1)
+
+	// Generate inidividual strategy report.
+	if h.WriteStrategyReports {
+		report := currentStrategy.Report(snapshots)
+		report.DateFormat = h.DateFormat
+
+		reportFile := h.strategyReportFileName(groupName, currentStrategy.Name())
+
+		err := report.WriteToFile(path.Join(h.outputDir, reportFile))
+		if err != nil {
+			return fmt.Errorf("unable to write report for %s (%v)", groupName, err)
+		}
+	} else {
+		for _, c := range snapshots {
+			go helper.Drain(c)
+		}
+	}
+
+	// Get group strategy results.
+	results, ok := h.assetResults[groupName]
+	if !ok {
+		return fmt.Errorf("asset has not begun: %s", groupName)
+	}
+
+	// Append current strategy result for the group.
+	h.assetResults[groupName] = append(results, &htmlReportResult{
+		AssetName:    groupName,
+		StrategyName: currentStrategy.Name(),
+		Action:       <-actions,
+		Since:        <-sinces,
+		Outcome:      <-outcomes * 100,
+		Transactions: <-transactions,
+	})
+
+	return nil
+}
+
 // AssetEnd is called when backtesting for the given asset ends.
 func (h *HTMLReport) AssetEnd(name string) error {
 	results, ok := h.assetResults[name]
@@ -202,15 +279,17 @@ func (*HTMLReport) strategyReportFileName(assetName, strategyName string) string
 // writeAssetReport generates a detailed report for the asset, summarizing the backtest results.
 func (h *HTMLReport) writeAssetReport(name string, results []*htmlReportResult) error {
 	type Model struct {
-		AssetName   string
-		Results     []*htmlReportResult
-		GeneratedOn string
+		AssetName     string
+		Results       []*htmlReportResult
+		GeneratedOn   string
+		SelfContained bool
 	}
 
 	model := Model{
-		AssetName:   name,
-		Results:     results,
-		GeneratedOn: time.Now().String(),
+		AssetName:     name,
+		Results:       results,
+		GeneratedOn:   time.Now().String(),
+		SelfContained: h.SelfContainedReports,
 	}
 
 	file, err := os.Create(filepath.Join(h.outputDir, fmt.Sprintf("%s.html", name)))
@@ -220,7 +299,7 @@ func (h *HTMLReport) writeAssetReport(name string, results []*htmlReportResult)
 
 	defer helper.CloseAndLogError(file, "unable to close asset report file")
 
-	tmpl := template.Must(template.New("report").Parse(htmlAssetReportTmpl))
+	tmpl := template.Must(newHTMLReportTemplate().Parse(htmlAssetReportTmpl))
 
 	err = tmpl.Execute(file, model)
 	if err != nil {
@@ -233,13 +312,15 @@ func (h *HTMLReport) writeAssetReport(name string, results []*htmlReportResult)
 // writeReport generates a detailed report for the best results for all the assets.
 func (h *HTMLReport) writeReport() error {
 	type Model struct {
-		Results     []*htmlReportResult
-		GeneratedOn string
+		Results       []*htmlReportResult
+		GeneratedOn   string
+		SelfContained bool
 	}
 
 	model := Model{
-		Results:     h.bestResults,
-		GeneratedOn: time.Now().String(),
+		Results:       h.bestResults,
+		GeneratedOn:   time.Now().String(),
+		SelfContained: h.SelfContainedReports,
 	}
 
 	file, err := os.Create(filepath.Join(h.outputDir, "index.html"))
@@ -249,7 +330,7 @@ func (h *HTMLReport) writeReport() error {
 
 	defer helper.CloseAndLogError(file, "unable to close main report file")
 
-	tmpl := template.Must(template.New("report").Parse(htmlReportTmpl))
+	tmpl := template.Must(newHTMLReportTemplate().Parse(htmlReportTmpl))
 
 	err = tmpl.Execute(file, model)
 	if err != nil {
@@ -258,3 +339,11 @@ func (h *HTMLReport) writeReport() error {
 
 	return nil
 }
+
+// newHTMLReportTemplate returns a new template for the HTML reports, with the styles of the
+// self-contained strategy reports.
+func newHTMLReportTemplate() *template.Template {
+	return template.New("report").Funcs(template.FuncMap{
+		"style": helper.ReportStyle,
+	})
+}
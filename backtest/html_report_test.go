@@ -0,0 +1,115 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package backtest_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/backtest"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy/trend"
+)
+
+func TestHTMLReportSelfContainedTradeReports(t *testing.T) {
+	repository := asset.NewFileSystemRepository("testdata/repository")
+
+	outputDir, err := os.MkdirTemp("", "bt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer helper.RemoveAll(t, outputDir)
+
+	htmlReport := backtest.NewHTMLReport(outputDir)
+	htmlReport.WriteTradeReports = true
+	htmlReport.SelfContainedReports = true
+
+	bt := backtest.NewBacktest(repository, htmlReport)
+	bt.Names = append(bt.Names, "brk-b")
+	bt.Strategies = append(bt.Strategies, trend.NewMacdStrategy())
+
+	err = bt.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fileNames := []string{
+		"index.html",
+		"brk-b.html",
+		"brk-b - " + trend.NewMacdStrategy().Name() + ".html",
+	}
+
+	for _, fileName := range fileNames {
+		content, err := os.ReadFile(filepath.Join(outputDir, fileName))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		html := string(content)
+
+		// The reports should not load any assets over the network.
+		if strings.Contains(html, "<link") || strings.Contains(html, "cdn.jsdelivr.net") || strings.Contains(html, "gstatic.com") {
+			t.Fatalf("report %s loads external assets", fileName)
+		}
+
+		if !strings.Contains(html, helper.ReportStyle()) {
+			t.Fatalf("report %s does not embed the styles", fileName)
+		}
+	}
+
+	content, err := os.ReadFile(filepath.Join(outputDir, fileNames[2]))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(content), `"type": "candlestick"`) {
+		t.Fatal("trade report does not have the candlesticks")
+	}
+}
+
+func TestHTMLReportTradeReports(t *testing.T) {
+	repository := asset.NewFileSystemRepository("testdata/repository")
+
+	outputDir, err := os.MkdirTemp("", "bt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer helper.RemoveAll(t, outputDir)
+
+	htmlReport := backtest.NewHTMLReport(outputDir)
+	htmlReport.WriteTradeReports = true
+
+	bt := backtest.NewBacktest(repository, htmlReport)
+	bt.Names = append(bt.Names, "brk-b")
+	bt.Strategies = append(bt.Strategies, trend.NewMacdStrategy())
+
+	err = bt.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	content, err := os.ReadFile(filepath.Join(outputDir, "brk-b - "+trend.NewMacdStrategy().Name()+".html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	html := string(content)
+
+	// The reports load their styles and the charts library over the network by default.
+	for _, expected := range []string{"cdn.jsdelivr.net", "gstatic.com", `"type": "candlestick",`, `"role": "marker",`, `"role": "shading",`} {
+		if !strings.Contains(html, expected) {
+			t.Fatalf("trade report does not contain %s", expected)
+		}
+	}
+
+	if strings.Contains(html, helper.ReportStyle()) {
+		t.Fatal("trade report embeds the styles")
+	}
+}
@@ -26,3 +26,10 @@ type Report interface {
 	// End is called when the backtest ends.
 	End() error
 }
+
+// MultiAssetReport is the backtest report interface for the multi-asset strategies. The reports that
+// implement it also receive the results of the multi-asset strategies on the groups of assets.
+type MultiAssetReport interface {
+	// WriteMultiAsset writes the given multi-asset strategy positions and outcomes to the report.
+	WriteMultiAsset(groupName string, currentStrategy strategy.MultiAssetStrategy, snapshots []<-chan *asset.Snapshot, positions []<-chan float64, outcomes <-chan float64) error
+}
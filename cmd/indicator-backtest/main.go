@@ -10,12 +10,15 @@ import (
 	"log"
 	"log/slog"
 	"os"
+	"strings"
 
 	"github.com/cinar/indicator/v2/asset"
 	"github.com/cinar/indicator/v2/backtest"
 	"github.com/cinar/indicator/v2/strategy"
 	"github.com/cinar/indicator/v2/strategy/compound"
 	"github.com/cinar/indicator/v2/strategy/momentum"
+	"github.com/cinar/indicator/v2/strategy/pattern"
+	"github.com/cinar/indicator/v2/strategy/spec"
 	"github.com/cinar/indicator/v2/strategy/trend"
 	"github.com/cinar/indicator/v2/strategy/volatility"
 	"github.com/cinar/indicator/v2/strategy/volume"
@@ -30,6 +33,10 @@ func main() {
 	var lastDays int
 	var addSplits bool
 	var addAnds bool
+	var strategiesFile string
+	var pairs string
+	var selfContained bool
+	var tradeReports bool
 
 	stdErr := log.New(os.Stderr, "", 0)
 	stdErr.Println("Indicator Backtest")
@@ -46,6 +53,10 @@ func main() {
 	flag.IntVar(&lastDays, "last", backtest.DefaultLastDays, "number of days to do backtest")
 	flag.BoolVar(&addSplits, "splits", false, "add the split strategies")
 	flag.BoolVar(&addAnds, "ands", false, "add the and strategies")
+	flag.StringVar(&strategiesFile, "strategies", "", "YAML or JSON strategies file to use instead of the built-in strategies")
+	flag.StringVar(&pairs, "pairs", "", "comma separated pairs of assets to backtest together, such as a+b,c+d")
+	flag.BoolVar(&selfContained, "self-contained", backtest.DefaultSelfContainedReports, "embed the styles and the scripts in the html reports")
+	flag.BoolVar(&tradeReports, "trade-reports", backtest.DefaultWriteTradeReports, "write the trade reports as the html strategy reports")
 	flag.Parse()
 
 	logger := slog.Default()
@@ -62,17 +73,40 @@ func main() {
 		os.Exit(1)
 	}
 
+	if htmlReport, ok := report.(*backtest.HTMLReport); ok {
+		htmlReport.SelfContainedReports = selfContained
+		htmlReport.WriteTradeReports = tradeReports
+	}
+
 	backtester := backtest.NewBacktest(source, report)
 	backtester.Workers = workers
 	backtester.LastDays = lastDays
 	backtester.Logger = logger
 	backtester.Names = append(backtester.Names, flag.Args()...)
-	backtester.Strategies = append(backtester.Strategies, compound.AllStrategies()...)
-	backtester.Strategies = append(backtester.Strategies, momentum.AllStrategies()...)
-	backtester.Strategies = append(backtester.Strategies, strategy.AllStrategies()...)
-	backtester.Strategies = append(backtester.Strategies, trend.AllStrategies()...)
-	backtester.Strategies = append(backtester.Strategies, volatility.AllStrategies()...)
-	backtester.Strategies = append(backtester.Strategies, volume.AllStrategies()...)
+
+	if pairs != "" {
+		for _, pair := range strings.Split(pairs, ",") {
+			backtester.Groups = append(backtester.Groups, strings.Split(pair, "+"))
+		}
+	}
+
+	if strategiesFile != "" {
+		strategies, err := spec.ReadFile(strategiesFile)
+		if err != nil {
+			logger.Error("Unable to read strategies.", "error", err)
+			os.Exit(1)
+		}
+
+		backtester.Strategies = append(backtester.Strategies, strategies...)
+	} else {
+		backtester.Strategies = append(backtester.Strategies, compound.AllStrategies()...)
+		backtester.Strategies = append(backtester.Strategies, momentum.AllStrategies()...)
+		backtester.Strategies = append(backtester.Strategies, pattern.AllStrategies()...)
+		backtester.Strategies = append(backtester.Strategies, strategy.AllStrategies()...)
+		backtester.Strategies = append(backtester.Strategies, trend.AllStrategies()...)
+		backtester.Strategies = append(backtester.Strategies, volatility.AllStrategies()...)
+		backtester.Strategies = append(backtester.Strategies, volume.AllStrategies()...)
+	}
 
 	if addSplits {
 		backtester.Strategies = append(backtester.Strategies, strategy.AllSplitStrategies(backtester.Strategies)...)
@@ -0,0 +1,84 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+// main is the indicator live command line program.
+package main
+
+import (
+	"flag"
+	"log"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/live"
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/strategy/compound"
+	"github.com/cinar/indicator/v2/strategy/momentum"
+	"github.com/cinar/indicator/v2/strategy/trend"
+	"github.com/cinar/indicator/v2/strategy/volatility"
+	"github.com/cinar/indicator/v2/strategy/volume"
+)
+
+func main() {
+	var repositoryName string
+	var repositoryConfig string
+	var sinkName string
+	var sinkConfig string
+	var sinceDays int
+	var speed float64
+	var warmup int
+
+	stdErr := log.New(os.Stderr, "", 0)
+	stdErr.Println("Indicator Live")
+	stdErr.Println("Copyright (c) 2021-2024 Onur Cinar.")
+	stdErr.Println("The source code is provided under GNU AGPLv3 License.")
+	stdErr.Println("https://github.com/cinar/indicator")
+	stdErr.Println()
+
+	flag.StringVar(&repositoryName, "repository-name", "filesystem", "repository name")
+	flag.StringVar(&repositoryConfig, "repository-config", "", "repository config")
+	flag.StringVar(&sinkName, "sink-name", live.LogSinkBuilderName, "sink name")
+	flag.StringVar(&sinkConfig, "sink-config", "", "sink config")
+	flag.IntVar(&sinceDays, "since", 30, "number of days to replay")
+	flag.Float64Var(&speed, "speed", live.DefaultReplayFeedSpeed, "replay speed")
+	flag.IntVar(&warmup, "warmup", live.DefaultWarmupPeriod, "number of snapshots to warm up")
+	flag.Parse()
+
+	logger := slog.Default()
+
+	repository, err := asset.NewRepository(repositoryName, repositoryConfig)
+	if err != nil {
+		logger.Error("Unable to initialize repository.", "error", err)
+		os.Exit(1)
+	}
+
+	sink, err := live.NewSink(sinkName, sinkConfig)
+	if err != nil {
+		logger.Error("Unable to initialize sink.", "error", err)
+		os.Exit(1)
+	}
+
+	feed := live.NewReplayFeed(repository)
+	feed.Speed = speed
+
+	engine := live.NewEngine(repository, feed)
+	engine.WarmupPeriod = warmup
+	engine.Logger = logger
+	engine.Names = append(engine.Names, flag.Args()...)
+	engine.Sinks = append(engine.Sinks, sink)
+	engine.Strategies = append(engine.Strategies, compound.AllStrategies()...)
+	engine.Strategies = append(engine.Strategies, momentum.AllStrategies()...)
+	engine.Strategies = append(engine.Strategies, strategy.AllStrategies()...)
+	engine.Strategies = append(engine.Strategies, trend.AllStrategies()...)
+	engine.Strategies = append(engine.Strategies, volatility.AllStrategies()...)
+	engine.Strategies = append(engine.Strategies, volume.AllStrategies()...)
+
+	err = engine.Run(time.Now().AddDate(0, 0, -sinceDays))
+	if err != nil {
+		logger.Error("Unable to run live engine.", "error", err)
+		os.Exit(1)
+	}
+}
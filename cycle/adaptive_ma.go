@@ -0,0 +1,130 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package cycle
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+// AdaptiveMa represents the parameters for calculating a moving average whose period follows the dominant
+// cycle period. Since the moving averages have fixed periods, a moving average is kept for each period
+// between the minimum and the maximum periods, and all of them are updated with every value. At each value,
+// the one with the current dominant cycle period is taken, so the recursive moving averages, such as EMA,
+// carry their state over the whole series.
+//
+// Example:
+//
+//	adaptiveMa := cycle.NewAdaptiveMa(func(period int) trend.Ma[float64] {
+//		return trend.NewSmaWithPeriod[float64](period)
+//	})
+//	result := adaptiveMa.Compute(c)
+type AdaptiveMa[T helper.Number] struct {
+	// DominantCycle is the dominant cycle instance providing the periods.
+	DominantCycle *DominantCycle[T]
+
+	// NewMa initializes a new moving average instance with the given period.
+	NewMa func(period int) trend.Ma[T]
+
+	// MinPeriod is the shortest period.
+	MinPeriod int
+
+	// MaxPeriod is the longest period.
+	MaxPeriod int
+}
+
+// NewAdaptiveMa function initializes a new adaptive moving average instance with the given moving average
+// initializer, limiting the periods to the range of the dominant cycle.
+func NewAdaptiveMa[T helper.Number](newMa func(period int) trend.Ma[T]) *AdaptiveMa[T] {
+	return NewAdaptiveMaWith(newMa, hilbertMinPeriod, hilbertMaxPeriod)
+}
+
+// NewAdaptiveMaWith function initializes a new adaptive moving average instance with the given moving
+// average initializer and the period limits.
+func NewAdaptiveMaWith[T helper.Number](newMa func(period int) trend.Ma[T], minPeriod, maxPeriod int) *AdaptiveMa[T] {
+	return &AdaptiveMa[T]{
+		DominantCycle: NewDominantCycle[T](),
+		NewMa:         newMa,
+		MinPeriod:     minPeriod,
+		MaxPeriod:     maxPeriod,
+	}
+}
+
+// Compute function takes a channel of numbers and computes the adaptive moving average.
+func (a *AdaptiveMa[T]) Compute(c <-chan T) <-chan T {
+	cs := helper.Duplicate(c, 2)
+
+	// The values are aligned with the dominant cycle periods once they settle.
+	values := helper.Skip(cs[0], a.DominantCycle.IdlePeriod())
+	periods := a.DominantCycle.Compute(cs[1])
+
+	return a.ComputeWithPeriods(values, periods)
+}
+
+// ComputeWithPeriods function takes a channel of numbers and a channel of periods, such as the dominant
+// cycle periods, and computes the moving average with the period given for each value. The values are
+// skipped until the moving average with the maximum period yields results.
+func (a *AdaptiveMa[T]) ComputeWithPeriods(values, periods <-chan T) <-chan T {
+	result := make(chan T, cap(values))
+
+	go func() {
+		defer close(result)
+		defer helper.Drain(values)
+		defer helper.Drain(periods)
+
+		mas := make([]trend.Ma[T], a.MaxPeriod-a.MinPeriod+1)
+		for i := range mas {
+			mas[i] = a.NewMa(a.MinPeriod + i)
+		}
+
+		idlePeriod := a.maIdlePeriod()
+		outputs := make([]T, len(mas))
+
+		for i := 0; ; i++ {
+			value, ok := <-values
+			if !ok {
+				break
+			}
+
+			period, ok := <-periods
+			if !ok {
+				break
+			}
+
+			for j, ma := range mas {
+				outputs[j], _ = ma.Update(value)
+			}
+
+			if i < idlePeriod {
+				continue
+			}
+
+			length := int(math.Round(float64(period)))
+			length = min(max(length, a.MinPeriod), a.MaxPeriod)
+
+			result <- outputs[length-a.MinPeriod]
+		}
+	}()
+
+	return result
+}
+
+// IdlePeriod is the initial period that the adaptive moving average won't yield any results.
+func (a *AdaptiveMa[T]) IdlePeriod() int {
+	return a.DominantCycle.IdlePeriod() + a.maIdlePeriod()
+}
+
+// maIdlePeriod is the initial period that the moving average with the maximum period won't yield any results.
+func (a *AdaptiveMa[T]) maIdlePeriod() int {
+	return a.NewMa(a.MaxPeriod).IdlePeriod()
+}
+
+// String is the string representation of the adaptive moving average.
+func (a *AdaptiveMa[T]) String() string {
+	return fmt.Sprintf("ADAPTIVE(%s)", a.NewMa(a.MaxPeriod))
+}
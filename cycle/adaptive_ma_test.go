@@ -0,0 +1,103 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package cycle_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/cycle"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+func TestAdaptiveMa(t *testing.T) {
+	type Data struct {
+		Close float64
+		Sma   float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/adaptive_ma.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := helper.Duplicate(input, 2)
+	closings := helper.Map(inputs[0], func(d *Data) float64 { return d.Close })
+	expected := helper.Map(inputs[1], func(d *Data) float64 { return d.Sma })
+
+	adaptiveMa := cycle.NewAdaptiveMa(func(period int) trend.Ma[float64] {
+		return trend.NewSmaWithPeriod[float64](period)
+	})
+	actual := adaptiveMa.Compute(closings)
+
+	actual = helper.RoundDigits(actual, 2)
+	expected = helper.Skip(expected, adaptiveMa.IdlePeriod())
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAdaptiveMaKeepsState(t *testing.T) {
+	type Data struct {
+		Close float64
+		Sma   float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/adaptive_ma.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	closings := helper.ChanToSlice(helper.Map(input, func(d *Data) float64 { return d.Close }))
+
+	periods := make([]float64, len(closings))
+	for i := range periods {
+		periods[i] = 10
+	}
+
+	adaptiveMa := cycle.NewAdaptiveMa(func(period int) trend.Ma[float64] {
+		return trend.NewEmaWithPeriod[float64](period)
+	})
+
+	// With a fixed period, the adaptive EMA follows the EMA over the whole series.
+	ema := trend.NewEmaWithPeriod[float64](10)
+
+	actual := adaptiveMa.ComputeWithPeriods(helper.SliceToChan(closings), helper.SliceToChan(periods))
+	expected := helper.Skip(
+		ema.Compute(helper.SliceToChan(closings)),
+		adaptiveMa.NewMa(adaptiveMa.MaxPeriod).IdlePeriod()-ema.IdlePeriod(),
+	)
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAdaptiveMaIdlePeriod(t *testing.T) {
+	adaptiveMa := cycle.NewAdaptiveMa(func(period int) trend.Ma[float64] {
+		return trend.NewSmaWithPeriod[float64](period)
+	})
+
+	expected := adaptiveMa.DominantCycle.IdlePeriod() + 49
+	actual := adaptiveMa.IdlePeriod()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestAdaptiveMaString(t *testing.T) {
+	expected := "ADAPTIVE(WMA(20))"
+	actual := cycle.NewAdaptiveMaWith(func(period int) trend.Ma[float64] {
+		return trend.NewWmaWith[float64](period)
+	}, 5, 20).String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
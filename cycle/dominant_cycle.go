@@ -0,0 +1,63 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package cycle
+
+import (
+	"github.com/cinar/indicator/v2/helper"
+)
+
+// DominantCycle represents the parameters for calculating the Hilbert Transform Dominant Cycle Period
+// developed by John Ehlers. It measures the period of the dominant market cycle with a homodyne
+// discriminator, between 6 and 50 bars. The periods can be used to set the periods of other indicators
+// dynamically, such as through the AdaptiveMa.
+//
+//	Smooth = (4 * Value + 3 * Value[1] + 2 * Value[2] + Value[3]) / 10
+//	Detrender = Hilbert Transform(Smooth)
+//	Q1 = Hilbert Transform(Detrender), I1 = Detrender[3]
+//	I2 = I1 - Hilbert Transform(Q1), Q2 = Q1 + Hilbert Transform(I1)
+//	Re = I2 * I2[1] + Q2 * Q2[1], Im = I2 * Q2[1] - Q2 * I2[1]
+//	Period = 360 / ArcTan(Im / Re)
+//
+// The components and the period are smoothed at each step. The periods for the first 32 values are
+// skipped, as they need these values to settle.
+//
+// Example:
+//
+//	dominantCycle := cycle.NewDominantCycle[float64]()
+//	periods := dominantCycle.Compute(c)
+type DominantCycle[T helper.Number] struct{}
+
+// NewDominantCycle function initializes a new Dominant Cycle instance.
+func NewDominantCycle[T helper.Number]() *DominantCycle[T] {
+	return &DominantCycle[T]{}
+}
+
+// Compute function takes a channel of numbers and computes the dominant cycle periods.
+func (d *DominantCycle[T]) Compute(c <-chan T) <-chan T {
+	result := make(chan T, cap(c))
+
+	go func() {
+		defer close(result)
+
+		var h hilbert
+
+		for n := range c {
+			h.update(float64(n))
+			result <- T(h.smoothPeriod)
+		}
+	}()
+
+	return helper.Skip(result, d.IdlePeriod())
+}
+
+// IdlePeriod is the initial period that Dominant Cycle won't yield any results.
+func (*DominantCycle[T]) IdlePeriod() int {
+	return hilbertIdlePeriod
+}
+
+// String is the string representation of the Dominant Cycle.
+func (*DominantCycle[T]) String() string {
+	return "DCPERIOD"
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package cycle_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/cycle"
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestDominantCycle(t *testing.T) {
+	type Data struct {
+		Close  float64
+		Period float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/dominant_cycle.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := helper.Duplicate(input, 2)
+	closings := helper.Map(inputs[0], func(d *Data) float64 { return d.Close })
+	expected := helper.Map(inputs[1], func(d *Data) float64 { return d.Period })
+
+	dominantCycle := cycle.NewDominantCycle[float64]()
+	actual := dominantCycle.Compute(closings)
+
+	actual = helper.RoundDigits(actual, 4)
+	expected = helper.Skip(expected, dominantCycle.IdlePeriod())
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDominantCycleString(t *testing.T) {
+	expected := "DCPERIOD"
+	actual := cycle.NewDominantCycle[float64]().String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package cycle
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultFisherTransformPeriod is the default Fisher Transform period of 10.
+	DefaultFisherTransformPeriod = 10
+)
+
+// FisherTransform represents the parameters for calculating the Fisher Transform developed by John Ehlers.
+// It normalizes the values to the range of -1 to 1 over the period, and converts them to a nearly Gaussian
+// distribution, making the turning points sharper. The trigger is the previous Fisher Transform. It is
+// usually computed with the median prices.
+//
+//	Value = 0.66 * ((Value - Min) / (Max - Min) - 0.5) + 0.67 * Previous Value, limited to 0.999
+//	Fisher = 0.5 * Ln((1 + Value) / (1 - Value)) + 0.5 * Previous Fisher
+//	Trigger = Previous Fisher
+//
+// Example:
+//
+//	fisherTransform := cycle.NewFisherTransform[float64]()
+//	fishers, triggers := fisherTransform.Compute(c)
+type FisherTransform[T helper.Number] struct {
+	// Period is the time period.
+	Period int
+}
+
+// NewFisherTransform function initializes a new Fisher Transform instance with the default parameters.
+func NewFisherTransform[T helper.Number]() *FisherTransform[T] {
+	return NewFisherTransformWithPeriod[T](DefaultFisherTransformPeriod)
+}
+
+// NewFisherTransformWithPeriod function initializes a new Fisher Transform instance with the given period.
+func NewFisherTransformWithPeriod[T helper.Number](period int) *FisherTransform[T] {
+	return &FisherTransform[T]{
+		Period: period,
+	}
+}
+
+// Compute function takes a channel of numbers and computes the Fisher Transform and its trigger.
+func (f *FisherTransform[T]) Compute(c <-chan T) (<-chan T, <-chan T) {
+	fishers := make(chan T, cap(c))
+	triggers := make(chan T, cap(c))
+
+	go func() {
+		defer close(fishers)
+		defer close(triggers)
+
+		window := make([]float64, 0, f.Period)
+		var value, fisher float64
+
+		for n := range c {
+			if len(window) == f.Period {
+				window = append(window[:0], window[1:]...)
+			}
+
+			window = append(window, float64(n))
+			if len(window) < f.Period {
+				continue
+			}
+
+			lowest := slices.Min(window)
+			highest := slices.Max(window)
+
+			normalized := 0.5
+			if highest > lowest {
+				normalized = (float64(n) - lowest) / (highest - lowest)
+			}
+
+			value = 0.66*(normalized-0.5) + 0.67*value
+			value = math.Min(math.Max(value, -0.999), 0.999)
+
+			trigger := fisher
+			fisher = 0.5*math.Log((1+value)/(1-value)) + 0.5*fisher
+
+			fishers <- T(fisher)
+			triggers <- T(trigger)
+		}
+	}()
+
+	return fishers, triggers
+}
+
+// IdlePeriod is the initial period that Fisher Transform won't yield any results.
+func (f *FisherTransform[T]) IdlePeriod() int {
+	return f.Period - 1
+}
+
+// String is the string representation of the Fisher Transform.
+func (f *FisherTransform[T]) String() string {
+	return fmt.Sprintf("FISHER(%d)", f.Period)
+}
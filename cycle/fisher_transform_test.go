@@ -0,0 +1,53 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package cycle_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/cycle"
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestFisherTransform(t *testing.T) {
+	type Data struct {
+		Close   float64
+		Fisher  float64
+		Trigger float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/fisher_transform.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := helper.Duplicate(input, 3)
+	closings := helper.Map(inputs[0], func(d *Data) float64 { return d.Close })
+	expectedFisher := helper.Map(inputs[1], func(d *Data) float64 { return d.Fisher })
+	expectedTrigger := helper.Map(inputs[2], func(d *Data) float64 { return d.Trigger })
+
+	fisherTransform := cycle.NewFisherTransform[float64]()
+	actualFisher, actualTrigger := fisherTransform.Compute(closings)
+
+	actualFisher = helper.RoundDigits(actualFisher, 4)
+	actualTrigger = helper.RoundDigits(actualTrigger, 4)
+
+	expectedFisher = helper.Skip(expectedFisher, fisherTransform.IdlePeriod())
+	expectedTrigger = helper.Skip(expectedTrigger, fisherTransform.IdlePeriod())
+
+	err = helper.CheckEquals(actualFisher, expectedFisher, actualTrigger, expectedTrigger)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFisherTransformString(t *testing.T) {
+	expected := "FISHER(5)"
+	actual := cycle.NewFisherTransformWithPeriod[float64](5).String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
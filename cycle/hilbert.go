@@ -0,0 +1,133 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package cycle
+
+import (
+	"math"
+)
+
+const (
+	// hilbertMinPeriod is the shortest cycle period measured by the Hilbert transform.
+	hilbertMinPeriod = 6
+
+	// hilbertMaxPeriod is the longest cycle period measured by the Hilbert transform.
+	hilbertMaxPeriod = 50
+
+	// hilbertTaps is the number of values kept for the Hilbert transform.
+	hilbertTaps = 7
+
+	// hilbertIdlePeriod is the number of values that the smoothed cycle period needs to settle.
+	hilbertIdlePeriod = 32
+)
+
+// hilbert is the state of the Hilbert transform homodyne discriminator developed by John Ehlers, which
+// measures the dominant cycle period of the values. It is shared by the indicators based on the dominant
+// cycle. The histories keep the latest value first.
+type hilbert struct {
+	// prices are the latest values used for smoothing.
+	prices [4]float64
+
+	// smooths are the smoothed values.
+	smooths [hilbertTaps]float64
+
+	// detrenders are the detrended smoothed values.
+	detrenders [hilbertTaps]float64
+
+	// i1s are the in-phase components.
+	i1s [hilbertTaps]float64
+
+	// q1s are the quadrature components.
+	q1s [hilbertTaps]float64
+
+	// i2 and q2 are the smoothed phasor components.
+	i2, q2 float64
+
+	// re and im are the smoothed homodyne discriminator components.
+	re, im float64
+
+	// period is the measured cycle period.
+	period float64
+
+	// smoothPeriod is the smoothed cycle period.
+	smoothPeriod float64
+
+	// started indicates whether the first value is received.
+	started bool
+}
+
+// update takes the next value and updates the Hilbert transform state.
+func (h *hilbert) update(value float64) {
+	// The prices start with the first value to reduce the initial distortion.
+	if !h.started {
+		h.started = true
+		h.prices = [4]float64{value, value, value, value}
+	}
+
+	shift(h.prices[:], value)
+
+	adjustment := 0.075*h.period + 0.54
+
+	smooth := (4*h.prices[0] + 3*h.prices[1] + 2*h.prices[2] + h.prices[3]) / 10
+	shift(h.smooths[:], smooth)
+
+	shift(h.detrenders[:], hilbertTransform(&h.smooths, adjustment))
+
+	// Compute the in-phase and quadrature components.
+	shift(h.q1s[:], hilbertTransform(&h.detrenders, adjustment))
+	shift(h.i1s[:], h.detrenders[3])
+
+	// Advance the phase of the components by 90 degrees.
+	jI := hilbertTransform(&h.i1s, adjustment)
+	jQ := hilbertTransform(&h.q1s, adjustment)
+
+	// Phasor addition for 3 bar averaging.
+	i2 := h.i1s[0] - jQ
+	q2 := h.q1s[0] + jI
+
+	// Smooth the components before applying the discriminator.
+	i2 = 0.2*i2 + 0.8*h.i2
+	q2 = 0.2*q2 + 0.8*h.q2
+
+	// Homodyne discriminator.
+	re := i2*h.i2 + q2*h.q2
+	im := i2*h.q2 - q2*h.i2
+
+	h.i2, h.q2 = i2, q2
+	h.re = 0.2*re + 0.8*h.re
+	h.im = 0.2*im + 0.8*h.im
+
+	period := h.period
+	if h.im != 0 && h.re != 0 {
+		period = 360 / toDegrees(math.Atan(h.im/h.re))
+	}
+
+	period = math.Min(period, 1.5*h.period)
+	period = math.Max(period, 0.67*h.period)
+	period = math.Min(math.Max(period, hilbertMinPeriod), hilbertMaxPeriod)
+
+	h.period = 0.2*period + 0.8*h.period
+	h.smoothPeriod = 0.33*h.period + 0.67*h.smoothPeriod
+}
+
+// hilbertTransform applies the Hilbert transform to the given history.
+func hilbertTransform(history *[hilbertTaps]float64, adjustment float64) float64 {
+	return (0.0962*history[0] + 0.5769*history[2] - 0.5769*history[4] - 0.0962*history[6]) * adjustment
+}
+
+// shift adds the given value to the beginning of the given history, dropping the oldest value.
+func shift(history []float64, value float64) {
+	copy(history[1:], history)
+	history[0] = value
+}
+
+// toDegrees converts the given radians to degrees.
+func toDegrees(radians float64) float64 {
+	return radians * 180 / math.Pi
+}
+
+// toRadians converts the given degrees to radians.
+func toRadians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package cycle
+
+import (
+	"github.com/cinar/indicator/v2/helper"
+)
+
+// InstantaneousTrendline represents the parameters for calculating the Hilbert Transform Instantaneous
+// Trendline developed by John Ehlers. It removes the dominant cycle from the values by averaging them over
+// the dominant cycle period, and smooths the result with a weighted moving average.
+//
+//	Trend = Average(Value, Period)
+//	Trendline = (4 * Trend + 3 * Trend[1] + 2 * Trend[2] + Trend[3]) / 10
+//
+// Example:
+//
+//	trendline := cycle.NewInstantaneousTrendline[float64]()
+//	result := trendline.Compute(c)
+type InstantaneousTrendline[T helper.Number] struct{}
+
+// NewInstantaneousTrendline function initializes a new Instantaneous Trendline instance.
+func NewInstantaneousTrendline[T helper.Number]() *InstantaneousTrendline[T] {
+	return &InstantaneousTrendline[T]{}
+}
+
+// Compute function takes a channel of numbers and computes the Instantaneous Trendline.
+func (*InstantaneousTrendline[T]) Compute(c <-chan T) <-chan T {
+	result := make(chan T, cap(c))
+
+	go func() {
+		defer close(result)
+
+		var h hilbert
+		var values [hilbertMaxPeriod]float64
+		var trends [4]float64
+
+		for n := range c {
+			value := float64(n)
+
+			// The values and the trends start with the first value to reduce the initial distortion.
+			if !h.started {
+				for i := range values {
+					values[i] = value
+				}
+
+				trends = [4]float64{value, value, value, value}
+			}
+
+			h.update(value)
+			shift(values[:], value)
+
+			period := int(h.smoothPeriod + 0.5)
+
+			trend := 0.0
+			for i := 0; i < period; i++ {
+				trend += values[i]
+			}
+
+			if period > 0 {
+				trend /= float64(period)
+			}
+
+			shift(trends[:], trend)
+
+			result <- T((4*trends[0] + 3*trends[1] + 2*trends[2] + trends[3]) / 10)
+		}
+	}()
+
+	return result
+}
+
+// IdlePeriod is the initial period that Instantaneous Trendline won't yield any results.
+func (*InstantaneousTrendline[T]) IdlePeriod() int {
+	return 0
+}
+
+// String is the string representation of the Instantaneous Trendline.
+func (*InstantaneousTrendline[T]) String() string {
+	return "HT_TRENDLINE"
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package cycle_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/cycle"
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestInstantaneousTrendline(t *testing.T) {
+	type Data struct {
+		Close     float64
+		Trendline float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/instantaneous_trendline.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := helper.Duplicate(input, 2)
+	closings := helper.Map(inputs[0], func(d *Data) float64 { return d.Close })
+	expected := helper.Map(inputs[1], func(d *Data) float64 { return d.Trendline })
+
+	trendline := cycle.NewInstantaneousTrendline[float64]()
+	actual := trendline.Compute(closings)
+
+	actual = helper.RoundDigits(actual, 2)
+	expected = helper.Skip(expected, trendline.IdlePeriod())
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestInstantaneousTrendlineString(t *testing.T) {
+	expected := "HT_TRENDLINE"
+	actual := cycle.NewInstantaneousTrendline[float64]().String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
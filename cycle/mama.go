@@ -0,0 +1,110 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package cycle
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultMamaFastLimit is the default MAMA fast limit of 0.5.
+	DefaultMamaFastLimit = 0.5
+
+	// DefaultMamaSlowLimit is the default MAMA slow limit of 0.05.
+	DefaultMamaSlowLimit = 0.05
+)
+
+// Mama represents the parameters for calculating the MESA Adaptive Moving Average (MAMA) and the Following
+// Adaptive Moving Average (FAMA) developed by John Ehlers. It adapts the smoothing factor to the rate of
+// change of the phase measured by the Hilbert transform, between the slow limit and the fast limit. The
+// FAMA follows the MAMA at half of its smoothing factor, and their crossovers are used as signals.
+//
+//	Phase = ArcTan(Q1 / I1)
+//	Delta Phase = Max(Previous Phase - Phase, 1)
+//	Alpha = Max(Fast Limit / Delta Phase, Slow Limit)
+//	MAMA = Alpha * Value + (1 - Alpha) * Previous MAMA
+//	FAMA = 0.5 * Alpha * MAMA + (1 - 0.5 * Alpha) * Previous FAMA
+//
+// The MAMA and the FAMA start with the first value.
+//
+// Example:
+//
+//	mama := cycle.NewMama[float64]()
+//	mamas, famas := mama.Compute(c)
+type Mama[T helper.Number] struct {
+	// FastLimit is the highest smoothing factor.
+	FastLimit float64
+
+	// SlowLimit is the lowest smoothing factor.
+	SlowLimit float64
+}
+
+// NewMama function initializes a new MAMA instance with the default parameters.
+func NewMama[T helper.Number]() *Mama[T] {
+	return NewMamaWith[T](DefaultMamaFastLimit, DefaultMamaSlowLimit)
+}
+
+// NewMamaWith function initializes a new MAMA instance with the given limits.
+func NewMamaWith[T helper.Number](fastLimit, slowLimit float64) *Mama[T] {
+	return &Mama[T]{
+		FastLimit: fastLimit,
+		SlowLimit: slowLimit,
+	}
+}
+
+// Compute function takes a channel of numbers and computes the MAMA and the FAMA.
+func (m *Mama[T]) Compute(c <-chan T) (<-chan T, <-chan T) {
+	mamas := make(chan T, cap(c))
+	famas := make(chan T, cap(c))
+
+	go func() {
+		defer close(mamas)
+		defer close(famas)
+
+		var h hilbert
+		var mama, fama, previousPhase float64
+
+		for n := range c {
+			value := float64(n)
+
+			if !h.started {
+				mama, fama = value, value
+			}
+
+			h.update(value)
+
+			phase := 0.0
+			if h.i1s[0] != 0 {
+				phase = toDegrees(math.Atan(h.q1s[0] / h.i1s[0]))
+			}
+
+			deltaPhase := math.Max(previousPhase-phase, 1)
+			previousPhase = phase
+
+			alpha := math.Max(m.FastLimit/deltaPhase, m.SlowLimit)
+
+			mama = alpha*value + (1-alpha)*mama
+			fama = 0.5*alpha*mama + (1-0.5*alpha)*fama
+
+			mamas <- T(mama)
+			famas <- T(fama)
+		}
+	}()
+
+	return mamas, famas
+}
+
+// IdlePeriod is the initial period that MAMA won't yield any results.
+func (*Mama[T]) IdlePeriod() int {
+	return 0
+}
+
+// String is the string representation of the MAMA.
+func (m *Mama[T]) String() string {
+	return fmt.Sprintf("MAMA(%g,%g)", m.FastLimit, m.SlowLimit)
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package cycle
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultRoofingFilterHighPassPeriod is the default Roofing Filter high pass period of 48.
+	DefaultRoofingFilterHighPassPeriod = 48
+
+	// DefaultRoofingFilterLowPassPeriod is the default Roofing Filter low pass period of 10.
+	DefaultRoofingFilterLowPassPeriod = 10
+)
+
+// RoofingFilter represents the parameters for calculating the Roofing Filter developed by John Ehlers. It is
+// a band pass filter that removes the trend components longer than the high pass period with a two pole high
+// pass filter, and then removes the noise shorter than the low pass period with a Super Smoother filter. The
+// result oscillates around zero.
+//
+//	A1 = (Cos(0.707 * 2 * Pi / High Pass) + Sin(0.707 * 2 * Pi / High Pass) - 1) / Cos(0.707 * 2 * Pi / High Pass)
+//	HP = (1 - A1 / 2)^2 * (Value - 2 * Previous Value + Second Previous Value)
+//	   + 2 * (1 - A1) * Previous HP - (1 - A1)^2 * Second Previous HP
+//	Roofing Filter = Super Smoother(Low Pass, HP)
+//
+// The high pass filter is zero for the first two values.
+//
+// Example:
+//
+//	roofingFilter := cycle.NewRoofingFilter[float64]()
+//	result := roofingFilter.Compute(c)
+type RoofingFilter[T helper.Number] struct {
+	// HighPassPeriod is the high pass period.
+	HighPassPeriod int
+
+	// LowPassPeriod is the low pass period.
+	LowPassPeriod int
+}
+
+// NewRoofingFilter function initializes a new Roofing Filter instance with the default parameters.
+func NewRoofingFilter[T helper.Number]() *RoofingFilter[T] {
+	return NewRoofingFilterWith[T](DefaultRoofingFilterHighPassPeriod, DefaultRoofingFilterLowPassPeriod)
+}
+
+// NewRoofingFilterWith function initializes a new Roofing Filter instance with the given periods.
+func NewRoofingFilterWith[T helper.Number](highPassPeriod, lowPassPeriod int) *RoofingFilter[T] {
+	return &RoofingFilter[T]{
+		HighPassPeriod: highPassPeriod,
+		LowPassPeriod:  lowPassPeriod,
+	}
+}
+
+// Compute function takes a channel of numbers and computes the Roofing Filter.
+func (r *RoofingFilter[T]) Compute(c <-chan T) <-chan T {
+	result := make(chan T, cap(c))
+
+	go func() {
+		defer close(result)
+
+		angle := 0.707 * 2 * math.Pi / float64(r.HighPassPeriod)
+		a1 := (math.Cos(angle) + math.Sin(angle) - 1) / math.Cos(angle)
+
+		lowPass := newSuperSmootherFilter(r.LowPassPeriod)
+
+		var values [2]float64
+		var highPasses [2]float64
+		count := 0
+
+		for n := range c {
+			value := float64(n)
+
+			highPass := 0.0
+			if count >= 2 {
+				highPass = (1-a1/2)*(1-a1/2)*(value-2*values[0]+values[1]) +
+					2*(1-a1)*highPasses[0] - (1-a1)*(1-a1)*highPasses[1]
+			} else {
+				count++
+			}
+
+			values[1], values[0] = values[0], value
+			highPasses[1], highPasses[0] = highPasses[0], highPass
+
+			result <- T(lowPass.update(highPass))
+		}
+	}()
+
+	return result
+}
+
+// IdlePeriod is the initial period that Roofing Filter won't yield any results.
+func (*RoofingFilter[T]) IdlePeriod() int {
+	return 0
+}
+
+// String is the string representation of the Roofing Filter.
+func (r *RoofingFilter[T]) String() string {
+	return fmt.Sprintf("ROOFING(%d,%d)", r.HighPassPeriod, r.LowPassPeriod)
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package cycle_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/cycle"
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestRoofingFilter(t *testing.T) {
+	type Data struct {
+		Close         float64
+		RoofingFilter float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/roofing_filter.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := helper.Duplicate(input, 2)
+	closings := helper.Map(inputs[0], func(d *Data) float64 { return d.Close })
+	expected := helper.Map(inputs[1], func(d *Data) float64 { return d.RoofingFilter })
+
+	roofingFilter := cycle.NewRoofingFilter[float64]()
+	actual := roofingFilter.Compute(closings)
+
+	actual = helper.RoundDigits(actual, 4)
+	expected = helper.Skip(expected, roofingFilter.IdlePeriod())
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRoofingFilterString(t *testing.T) {
+	expected := "ROOFING(40,8)"
+	actual := cycle.NewRoofingFilterWith[float64](40, 8).String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package cycle
+
+import (
+	"math"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+// SineWave represents the parameters for calculating the Hilbert Transform Sine Wave developed by John
+// Ehlers. It computes the phase of the dominant cycle by correlating the smoothed values with a sine and
+// a cosine over the dominant cycle period. The sine and the lead sine, which is advanced by 45 degrees,
+// cross each other at the cycle turning points, and stay apart while the market is trending.
+//
+//	Real = Sum(Sin(360 * i / Period) * Smooth[i])
+//	Imaginary = Sum(Cos(360 * i / Period) * Smooth[i])
+//	Phase = ArcTan(Real / Imaginary) + 90 + 360 / Period, plus 180 if Imaginary is negative
+//	Sine = Sin(Phase)
+//	Lead Sine = Sin(Phase + 45)
+//
+// Example:
+//
+//	sineWave := cycle.NewSineWave[float64]()
+//	sines, leadSines := sineWave.Compute(c)
+type SineWave[T helper.Number] struct{}
+
+// NewSineWave function initializes a new Sine Wave instance.
+func NewSineWave[T helper.Number]() *SineWave[T] {
+	return &SineWave[T]{}
+}
+
+// Compute function takes a channel of numbers and computes the sine and the lead sine.
+func (*SineWave[T]) Compute(c <-chan T) (<-chan T, <-chan T) {
+	sines := make(chan T, cap(c))
+	leadSines := make(chan T, cap(c))
+
+	go func() {
+		defer close(sines)
+		defer close(leadSines)
+
+		var h hilbert
+		var smooths [hilbertMaxPeriod]float64
+
+		for n := range c {
+			started := h.started
+			h.update(float64(n))
+
+			// The smooths start with the first smooth to reduce the initial distortion.
+			if !started {
+				for i := range smooths {
+					smooths[i] = h.smooths[0]
+				}
+			}
+
+			shift(smooths[:], h.smooths[0])
+
+			period := int(h.smoothPeriod + 0.5)
+
+			realPart, imagPart := 0.0, 0.0
+			for i := 0; i < period; i++ {
+				angle := 2 * math.Pi * float64(i) / float64(period)
+				realPart += math.Sin(angle) * smooths[i]
+				imagPart += math.Cos(angle) * smooths[i]
+			}
+
+			phase := 0.0
+			if imagPart != 0 {
+				phase = toDegrees(math.Atan(realPart / imagPart))
+			} else if realPart > 0 {
+				phase = 90
+			} else if realPart < 0 {
+				phase = -90
+			}
+
+			// Compensate for the one bar lag of the smoothing.
+			phase += 90 + 360/h.smoothPeriod
+
+			if imagPart < 0 {
+				phase += 180
+			}
+
+			if phase > 315 {
+				phase -= 360
+			}
+
+			sines <- T(math.Sin(toRadians(phase)))
+			leadSines <- T(math.Sin(toRadians(phase + 45)))
+		}
+	}()
+
+	return sines, leadSines
+}
+
+// IdlePeriod is the initial period that Sine Wave won't yield any results.
+func (*SineWave[T]) IdlePeriod() int {
+	return 0
+}
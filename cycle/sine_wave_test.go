@@ -0,0 +1,44 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package cycle_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/cycle"
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestSineWave(t *testing.T) {
+	type Data struct {
+		Close    float64
+		Sine     float64
+		LeadSine float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/sine_wave.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := helper.Duplicate(input, 3)
+	closings := helper.Map(inputs[0], func(d *Data) float64 { return d.Close })
+	expectedSine := helper.Map(inputs[1], func(d *Data) float64 { return d.Sine })
+	expectedLeadSine := helper.Map(inputs[2], func(d *Data) float64 { return d.LeadSine })
+
+	sineWave := cycle.NewSineWave[float64]()
+	actualSine, actualLeadSine := sineWave.Compute(closings)
+
+	actualSine = helper.RoundDigits(actualSine, 4)
+	actualLeadSine = helper.RoundDigits(actualLeadSine, 4)
+
+	expectedSine = helper.Skip(expectedSine, sineWave.IdlePeriod())
+	expectedLeadSine = helper.Skip(expectedLeadSine, sineWave.IdlePeriod())
+
+	err = helper.CheckEquals(actualSine, expectedSine, actualLeadSine, expectedLeadSine)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
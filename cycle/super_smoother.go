@@ -0,0 +1,114 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package cycle
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultSuperSmootherPeriod is the default Super Smoother critical period of 10.
+	DefaultSuperSmootherPeriod = 10
+)
+
+// SuperSmoother represents the parameters for calculating the Super Smoother filter developed by John Ehlers.
+// It is a two pole Butterworth low pass filter that removes the aliasing noise above the critical period
+// with less lag than the moving averages.
+//
+//	A1 = Exp(-Sqrt(2) * Pi / Period)
+//	C2 = 2 * A1 * Cos(Sqrt(2) * Pi / Period)
+//	C3 = -A1 * A1
+//	C1 = 1 - C2 - C3
+//	Filter = C1 * (Value + Previous Value) / 2 + C2 * Previous Filter + C3 * Second Previous Filter
+//
+// The filter follows the values for the first two values.
+//
+// Example:
+//
+//	superSmoother := cycle.NewSuperSmoother[float64]()
+//	result := superSmoother.Compute(c)
+type SuperSmoother[T helper.Number] struct {
+	// Period is the critical period.
+	Period int
+}
+
+// NewSuperSmoother function initializes a new Super Smoother instance with the default parameters.
+func NewSuperSmoother[T helper.Number]() *SuperSmoother[T] {
+	return NewSuperSmootherWithPeriod[T](DefaultSuperSmootherPeriod)
+}
+
+// NewSuperSmootherWithPeriod function initializes a new Super Smoother instance with the given period.
+func NewSuperSmootherWithPeriod[T helper.Number](period int) *SuperSmoother[T] {
+	return &SuperSmoother[T]{
+		Period: period,
+	}
+}
+
+// Compute function takes a channel of numbers and computes the Super Smoother filter.
+func (s *SuperSmoother[T]) Compute(c <-chan T) <-chan T {
+	result := make(chan T, cap(c))
+
+	go func() {
+		defer close(result)
+
+		filter := newSuperSmootherFilter(s.Period)
+
+		for n := range c {
+			result <- T(filter.update(float64(n)))
+		}
+	}()
+
+	return result
+}
+
+// IdlePeriod is the initial period that Super Smoother won't yield any results.
+func (*SuperSmoother[T]) IdlePeriod() int {
+	return 0
+}
+
+// String is the string representation of the Super Smoother.
+func (s *SuperSmoother[T]) String() string {
+	return fmt.Sprintf("SUPERSMOOTHER(%d)", s.Period)
+}
+
+// superSmootherFilter is the state of a Super Smoother filter.
+type superSmootherFilter struct {
+	c1, c2, c3 float64
+	count      int
+	value      float64
+	filters    [2]float64
+}
+
+// newSuperSmootherFilter initializes a new Super Smoother filter state for the given period.
+func newSuperSmootherFilter(period int) *superSmootherFilter {
+	a1 := math.Exp(-math.Sqrt2 * math.Pi / float64(period))
+	c2 := 2 * a1 * math.Cos(math.Sqrt2*math.Pi/float64(period))
+	c3 := -a1 * a1
+
+	return &superSmootherFilter{
+		c1: 1 - c2 - c3,
+		c2: c2,
+		c3: c3,
+	}
+}
+
+// update takes the next value and returns the filtered value.
+func (f *superSmootherFilter) update(value float64) float64 {
+	filter := value
+	if f.count >= 2 {
+		filter = f.c1*(value+f.value)/2 + f.c2*f.filters[0] + f.c3*f.filters[1]
+	} else {
+		f.count++
+	}
+
+	f.value = value
+	f.filters[1] = f.filters[0]
+	f.filters[0] = filter
+
+	return filter
+}
@@ -1,3 +1,5 @@
 module github.com/cinar/indicator/v2
 
 go 1.22
+
+require gopkg.in/yaml.v3 v3.0.1
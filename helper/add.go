@@ -15,7 +15,7 @@ package helper
 //	actual := helper.ChanToSlice(helper.Add(ac, bc))
 //
 //	fmt.Println(actual) // [2, 4, 6, 8, 10, 12, 14, 16, 18, 20]
-func Add[T Number](ac, bc <-chan T) <-chan T {
+func Add[T Amount](ac, bc <-chan T) <-chan T {
 	return Operate(ac, bc, func(a, b T) T {
 		return a + b
 	})
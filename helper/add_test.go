@@ -24,3 +24,25 @@ func TestAdd(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestAddDecimal(t *testing.T) {
+	ac := helper.SliceToChan([]helper.Decimal{
+		helper.NewDecimal(0.1), helper.NewDecimal(0.7), helper.NewDecimal(4512.25),
+	})
+
+	bc := helper.SliceToChan([]helper.Decimal{
+		helper.NewDecimal(0.2), helper.NewDecimal(0.1), helper.NewDecimal(-0.01),
+	})
+
+	// The float64 sums are 0.30000000000000004 and 0.7999999999999999.
+	expected := helper.SliceToChan([]helper.Decimal{
+		helper.NewDecimal(0.3), helper.NewDecimal(0.8), helper.NewDecimal(4512.24),
+	})
+
+	actual := helper.Add(ac, bc)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,25 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+// Beta calculates the rolling beta of the values from the first input channel against the values from
+// the second input channel, such as the returns of an asset against the returns of a benchmark, over the
+// given period. The beta is zero when the benchmark is flat.
+//
+//	Beta = Covariance(A, B) / Variance(B)
+//
+// Example:
+//
+//	betas := helper.Beta(assetReturns, benchmarkReturns, 60)
+func Beta[T Number](ac, bc <-chan T, period int) <-chan T {
+	return OperateWindow(ac, bc, period, func(as, bs []T) T {
+		variance := covariance(bs, bs)
+		if variance == 0 {
+			return 0
+		}
+
+		return T(covariance(as, bs) / variance)
+	})
+}
@@ -0,0 +1,24 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestBeta(t *testing.T) {
+	a := helper.SliceToChan([]float64{10, 12, 11, 13, 15, 14, 16, 18, 17, 19, 21, 20})
+	b := helper.SliceToChan([]float64{20, 21, 21, 22, 24, 23, 23, 25, 26, 25, 27, 28})
+	expected := helper.SliceToChan([]float64{1.5, 1.1667, 1.3, 1, 1.3636, 0.8519, 0.5263, 1, 0.7})
+
+	actual := helper.RoundDigits(helper.Beta(a, b, 4), 4)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
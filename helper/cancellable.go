@@ -0,0 +1,67 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import "context"
+
+// Cancellable forwards the values from the given channel until either the channel is closed or the
+// given context is cancelled. Once cancelled, it closes the output channel, and drains the input
+// channel so that the goroutines feeding it are released. Wrapping both the input and the output
+// of a pipeline built with the other helper functions releases all of its goroutines on
+// cancellation, provided that the source of the input channel ends or observes the same context.
+//
+// Example:
+//
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//
+//	closings := helper.Cancellable(ctx, closings)
+//	smas := helper.Cancellable(ctx, sma.Compute(closings))
+func Cancellable[T any](ctx context.Context, c <-chan T) <-chan T {
+	result := make(chan T, cap(c))
+
+	go func() {
+		defer Drain(c)
+		defer close(result)
+
+		for {
+			n, ok := receive(ctx, c)
+			if !ok {
+				return
+			}
+
+			if !send(ctx, result, n) {
+				return
+			}
+		}
+	}()
+
+	return result
+}
+
+// receive receives a value from the given channel. It returns false if the channel is
+// closed or the given context is cancelled.
+func receive[T any](ctx context.Context, c <-chan T) (T, bool) {
+	select {
+	case n, ok := <-c:
+		return n, ok
+
+	case <-ctx.Done():
+		var zero T
+		return zero, false
+	}
+}
+
+// send sends the given value to the given channel. It returns false if the given context
+// is cancelled before the value is sent.
+func send[T any](ctx context.Context, c chan<- T, n T) bool {
+	select {
+	case c <- n:
+		return true
+
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestCancellable(t *testing.T) {
+	input := helper.SliceToChan([]int{2, 4, 6, 8})
+	expected := helper.SliceToChan([]int{2, 4, 6, 8})
+
+	actual := helper.Cancellable(context.Background(), input)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCancellableCancel(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// The pipeline in between is not aware of the context.
+	input := helper.Cancellable(ctx, helper.SliceToChan(make([]int, 1000)))
+	inputs := helper.Duplicate(input, 2)
+	output := helper.Cancellable(ctx, helper.Add(helper.Map(inputs[0], func(n int) int { return n + 1 }), inputs[1]))
+
+	<-output
+	<-output
+
+	cancel()
+
+	err := helper.CheckGoroutines(before, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// endless returns a channel that sends the given value until the given context is cancelled.
+func endless(ctx context.Context, n int) <-chan int {
+	c := make(chan int)
+
+	go func() {
+		defer close(c)
+
+		for {
+			select {
+			case c <- n:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return c
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import "fmt"
+
+// candlestickReportColumn is the candlestick report column struct.
+type candlestickReportColumn[T Number] struct {
+	ReportColumn
+	name   string
+	opens  <-chan T
+	highs  <-chan T
+	lows   <-chan T
+	closes <-chan T
+}
+
+// NewCandlestickReportColumn returns a new instance of a candlestick data column for a report,
+// drawing the opening, high, low, and closing values of each bar as a candlestick.
+func NewCandlestickReportColumn[T Number](name string, opens, highs, lows, closes <-chan T) ReportColumn {
+	return &candlestickReportColumn[T]{
+		name:   name,
+		opens:  opens,
+		highs:  highs,
+		lows:   lows,
+		closes: closes,
+	}
+}
+
+// Name returns the name of the report column.
+func (c *candlestickReportColumn[T]) Name() string {
+	return c.name
+}
+
+// Type returns candlestick as the data type.
+func (*candlestickReportColumn[T]) Type() string {
+	return "candlestick"
+}
+
+// Role returns the role of the report column.
+func (*candlestickReportColumn[T]) Role() string {
+	return "data"
+}
+
+// Value returns the next data value for the report column.
+func (c *candlestickReportColumn[T]) Value() string {
+	return fmt.Sprintf("[%s, %s, %s, %s]",
+		reportNumber(<-c.opens),
+		reportNumber(<-c.highs),
+		reportNumber(<-c.lows),
+		reportNumber(<-c.closes),
+	)
+}
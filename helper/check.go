@@ -8,6 +8,8 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"runtime"
+	"time"
 )
 
 // CheckEquals determines whether the two channels are equal.
@@ -39,3 +41,22 @@ func CheckEquals[T comparable](inputs ...<-chan T) error {
 		i++
 	}
 }
+
+// CheckGoroutines waits for the number of goroutines to drop to the expected count within the
+// given timeout. It is used to verify that the goroutines behind a pipeline are released.
+func CheckGoroutines(expected int, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		actual := runtime.NumGoroutine()
+		if actual <= expected {
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("goroutines actual %d expected %d", actual, expected)
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+}
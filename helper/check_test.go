@@ -5,7 +5,9 @@
 package helper_test
 
 import (
+	"runtime"
 	"testing"
+	"time"
 
 	"github.com/cinar/indicator/v2/helper"
 )
@@ -48,3 +50,24 @@ func TestCheckEquals(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestCheckGoroutines(t *testing.T) {
+	expected := runtime.NumGoroutine()
+
+	done := make(chan struct{})
+	go func() {
+		<-done
+	}()
+
+	err := helper.CheckGoroutines(expected, 20*time.Millisecond)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	close(done)
+
+	err = helper.CheckGoroutines(expected, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrNotStateful indicates that the value does not support exporting its state, such as a moving
+// average that is provided by the caller.
+var ErrNotStateful = errors.New("state not supported")
+
+// Stateful defines the interface for the indicators and the strategies that can export their state,
+// such as their ring buffers, running sums, and last values, to a serializable snapshot, and restore
+// it. Each of them defines its state explicitly, so that the snapshot format does not change with
+// the internal fields.
+//
+// Every indicator with an Update function implements it, and so does every strategy implementing
+// the strategy.UpdateStrategy interface. The strategies that only compute over channels do not keep
+// a state between the calls, so they do not implement it.
+type Stateful interface {
+	// MarshalState returns the state as JSON.
+	MarshalState() ([]byte, error)
+
+	// UnmarshalState restores the state from the given JSON that is returned by MarshalState.
+	UnmarshalState(data []byte) error
+}
+
+// checkpoint is the serialized form of the state of an indicator or a strategy.
+type checkpoint struct {
+	// Type is the type of the indicator or the strategy.
+	Type string `json:"type"`
+
+	// State is the state of the indicator or the strategy.
+	State json.RawMessage `json:"state"`
+}
+
+// Checkpoint returns the state of the given indicator or strategy as a JSON snapshot, along with its
+// type. A process can persist it on shutdown, and resume exactly where it left off by restoring it
+// with the Restore function, instead of warming up the indicator again.
+//
+// Example:
+//
+//	sma := trend.NewSmaWithPeriod[float64](200)
+//	...
+//	data, err := helper.Checkpoint(sma)
+//	...
+//	restored := trend.NewSmaWithPeriod[float64](200)
+//	err = helper.Restore(restored, data)
+func Checkpoint(s Stateful) ([]byte, error) {
+	state, err := s.MarshalState()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&checkpoint{
+		Type:  fmt.Sprintf("%T", s),
+		State: state,
+	})
+}
+
+// Restore restores the state of the given indicator or strategy from the given snapshot that is
+// returned by the Checkpoint function. The indicator or the strategy should be initialized with the
+// same constructor as the one that the snapshot is taken from, as its configuration is not part of
+// the state.
+func Restore(s Stateful, data []byte) error {
+	var c checkpoint
+
+	err := json.Unmarshal(data, &c)
+	if err != nil {
+		return err
+	}
+
+	if c.Type != fmt.Sprintf("%T", s) {
+		return fmt.Errorf("checkpoint type %s not %T", c.Type, s)
+	}
+
+	return s.UnmarshalState(c.State)
+}
+
+// MarshalStateOf returns the state of the given stateful value, or null if the value is nil, as in
+// the states that are not yet initialized.
+func MarshalStateOf[P interface {
+	*E
+	Stateful
+}, E any](p P) (json.RawMessage, error) {
+	if p == nil {
+		return json.RawMessage("null"), nil
+	}
+
+	return p.MarshalState()
+}
+
+// UnmarshalStateOf restores the state of the given stateful value from the given JSON that is
+// returned by MarshalStateOf. The value is set to nil if the state is null, and it is initialized
+// with the given function first if it is nil.
+func UnmarshalStateOf[P interface {
+	*E
+	Stateful
+}, E any](p *P, data json.RawMessage, init func() P) error {
+	if isNullState(data) {
+		*p = nil
+		return nil
+	}
+
+	if *p == nil {
+		*p = init()
+	}
+
+	return (*p).UnmarshalState(data)
+}
+
+// MarshalInterfaceState returns the state of the given interface value, such as a moving average,
+// or null if the value is nil. It returns ErrNotStateful if the value does not implement the
+// Stateful interface.
+func MarshalInterfaceState(v any) (json.RawMessage, error) {
+	if v == nil {
+		return json.RawMessage("null"), nil
+	}
+
+	s, ok := v.(Stateful)
+	if !ok {
+		return nil, fmt.Errorf("%T: %w", v, ErrNotStateful)
+	}
+
+	return s.MarshalState()
+}
+
+// UnmarshalInterfaceState restores the state of the given interface value from the given JSON that
+// is returned by MarshalInterfaceState. The value is set to nil if the state is null, and it is
+// initialized with the given function first if it is nil. It returns ErrNotStateful if the value
+// does not implement the Stateful interface.
+func UnmarshalInterfaceState[I any](v *I, data json.RawMessage, init func() I) error {
+	if isNullState(data) {
+		var zero I
+		*v = zero
+		return nil
+	}
+
+	if any(*v) == nil {
+		*v = init()
+	}
+
+	s, ok := any(*v).(Stateful)
+	if !ok {
+		return fmt.Errorf("%T: %w", *v, ErrNotStateful)
+	}
+
+	return s.UnmarshalState(data)
+}
+
+// isNullState checks if the given state is missing or null.
+func isNullState(data json.RawMessage) bool {
+	return len(data) == 0 || bytes.Equal(bytes.TrimSpace(data), []byte("null"))
+}
@@ -0,0 +1,259 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+// checkpointState is a stateful value with a lazily created ring, a ring that is shared between two
+// fields, and an interface-typed field.
+type checkpointState struct {
+	Size   int
+	ring   *helper.Ring[float64]
+	shared *helper.Ring[float64]
+	view   *helper.Ring[float64]
+	source any
+}
+
+type checkpointStateJSON struct {
+	Ring   json.RawMessage `json:"ring"`
+	Shared json.RawMessage `json:"shared"`
+	Source json.RawMessage `json:"source"`
+}
+
+func newCheckpointState() *checkpointState {
+	shared := helper.NewRing[float64](2)
+
+	return &checkpointState{
+		Size:   3,
+		shared: shared,
+		view:   shared,
+		source: helper.NewRing[float64](4),
+	}
+}
+
+func (c *checkpointState) put(value float64) {
+	if c.ring == nil {
+		c.ring = helper.NewRing[float64](c.Size)
+	}
+
+	c.ring.Put(value)
+	c.shared.Put(value * 2)
+	c.source.(*helper.Ring[float64]).Put(value * 3)
+}
+
+func (c *checkpointState) MarshalState() ([]byte, error) {
+	var err error
+
+	state := checkpointStateJSON{}
+
+	state.Ring, err = helper.MarshalStateOf(c.ring)
+	if err != nil {
+		return nil, err
+	}
+
+	// The view shares the ring with the shared field, so it is not part of the state.
+	state.Shared, err = c.shared.MarshalState()
+	if err != nil {
+		return nil, err
+	}
+
+	state.Source, err = helper.MarshalInterfaceState(c.source)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+func (c *checkpointState) UnmarshalState(data []byte) error {
+	var state checkpointStateJSON
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&c.ring, state.Ring, func() *helper.Ring[float64] {
+		return helper.NewRing[float64](c.Size)
+	})
+	if err != nil {
+		return err
+	}
+
+	err = c.shared.UnmarshalState(state.Shared)
+	if err != nil {
+		return err
+	}
+
+	return helper.UnmarshalInterfaceState(&c.source, state.Source, func() any {
+		return helper.NewRing[float64](4)
+	})
+}
+
+func TestCheckpoint(t *testing.T) {
+	input := []float64{1, 2, 3, 4, 5, 6, 7}
+
+	expected := newCheckpointState()
+	actual := newCheckpointState()
+
+	for _, value := range input[:4] {
+		expected.put(value)
+	}
+
+	data, err := helper.Checkpoint(expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = helper.Restore(actual, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if actual.view != actual.shared {
+		t.Fatal("shared ring not kept")
+	}
+
+	for _, value := range input[4:] {
+		expected.put(value)
+		actual.put(value)
+	}
+
+	checkRing(t, actual.ring, expected.ring)
+	checkRing(t, actual.view, expected.view)
+	checkRing(t, actual.source.(*helper.Ring[float64]), expected.source.(*helper.Ring[float64]))
+}
+
+func TestCheckpointNotInitialized(t *testing.T) {
+	data, err := helper.Checkpoint(newCheckpointState())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual := newCheckpointState()
+	actual.put(1)
+	actual.source = nil
+
+	err = helper.Restore(actual, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if actual.ring != nil {
+		t.Fatal("ring not reset")
+	}
+
+	if actual.shared.Len() != 0 {
+		t.Fatalf("shared length %d expected 0", actual.shared.Len())
+	}
+
+	if actual.source == nil {
+		t.Fatal("source not initialized")
+	}
+}
+
+func TestCheckpointNotStateful(t *testing.T) {
+	state := newCheckpointState()
+	state.source = func() {}
+
+	_, err := helper.Checkpoint(state)
+	if !errors.Is(err, helper.ErrNotStateful) {
+		t.Fatalf("actual %v expected %v", err, helper.ErrNotStateful)
+	}
+
+	data, err := helper.Checkpoint(newCheckpointState())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = helper.Restore(state, data)
+	if !errors.Is(err, helper.ErrNotStateful) {
+		t.Fatalf("actual %v expected %v", err, helper.ErrNotStateful)
+	}
+}
+
+func TestRestoreTypeMismatch(t *testing.T) {
+	data, err := helper.Checkpoint(newCheckpointState())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = helper.Restore(helper.NewRing[float64](3), data)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestRestoreInvalid(t *testing.T) {
+	err := helper.Restore(newCheckpointState(), []byte("invalid"))
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	err = helper.Restore(newCheckpointState(), []byte(`{"type":"*helper_test.checkpointState","state":{"shared":"abc"}}`))
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestRingState(t *testing.T) {
+	expected := helper.NewRing[int](3)
+	for i := 1; i <= 5; i++ {
+		expected.Put(i)
+	}
+
+	data, err := expected.MarshalState()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != `{"size":3,"values":[3,4,5]}` {
+		t.Fatalf("actual %s", data)
+	}
+
+	actual := helper.NewRing[int](1)
+
+	err = actual.UnmarshalState(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 6; i <= 7; i++ {
+		if actual.Put(i) != expected.Put(i) {
+			t.Fatalf("ring %d not restored", i)
+		}
+	}
+}
+
+func TestRingStateInvalid(t *testing.T) {
+	ring := helper.NewRing[int](3)
+
+	for _, data := range []string{`{"size":0,"values":[]}`, `{"size":1,"values":[1,2]}`, `invalid`} {
+		err := ring.UnmarshalState([]byte(data))
+		if err == nil {
+			t.Fatalf("expected error for %s", data)
+		}
+	}
+}
+
+func checkRing(t *testing.T, actual, expected *helper.Ring[float64]) {
+	t.Helper()
+
+	if actual.Len() != expected.Len() {
+		t.Fatalf("actual length %d expected %d", actual.Len(), expected.Len())
+	}
+
+	for i := 0; i < expected.Len(); i++ {
+		if actual.At(i) != expected.At(i) {
+			t.Fatalf("at %d actual %v expected %v", i, actual.At(i), expected.At(i))
+		}
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+// Compute feeds each value from the input channel to the given update function of a stateful
+// indicator, and sends the results that the update function marks as ready to the output
+// channel. It is used to build the channel based computations on top of the incremental ones.
+//
+// Example:
+//
+//	sma := trend.NewSmaWithPeriod[float64](10)
+//	smas := helper.Compute(c, sma.Update)
+func Compute[T any, R any](c <-chan T, update func(T) (R, bool)) <-chan R {
+	rc := make(chan R, cap(c))
+
+	go func() {
+		defer close(rc)
+
+		for n := range c {
+			r, ok := update(n)
+			if ok {
+				rc <- r
+			}
+		}
+	}()
+
+	return rc
+}
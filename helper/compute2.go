@@ -0,0 +1,43 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+// Compute2 feeds the corresponding values from two input channels to the given update function
+// of a stateful indicator, and sends the results that the update function marks as ready to the
+// output channel.
+//
+// Example:
+//
+//	vwma := trend.NewVwma[float64]()
+//	vwmas := helper.Compute2(closings, volumes, vwma.Update)
+func Compute2[A any, B any, R any](ac <-chan A, bc <-chan B, update func(A, B) (R, bool)) <-chan R {
+	rc := make(chan R, cap(ac))
+
+	go func() {
+		defer close(rc)
+
+		for {
+			an, ok := <-ac
+			if !ok {
+				break
+			}
+
+			bn, ok := <-bc
+			if !ok {
+				break
+			}
+
+			r, ok := update(an, bn)
+			if ok {
+				rc <- r
+			}
+		}
+
+		Drain(ac)
+		Drain(bc)
+	}()
+
+	return rc
+}
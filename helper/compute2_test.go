@@ -0,0 +1,27 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestCompute2(t *testing.T) {
+	ac := helper.SliceToChan([]int{1, 2, 3, 4, 5, 6})
+	bc := helper.SliceToChan([]int{1, 2, 3, 4, 5})
+
+	expected := helper.SliceToChan([]int{4, 8})
+
+	actual := helper.Compute2(ac, bc, func(a, b int) (int, bool) {
+		return a + b, a%2 == 0
+	})
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
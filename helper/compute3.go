@@ -0,0 +1,49 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+// Compute3 feeds the corresponding values from three input channels to the given update function
+// of a stateful indicator, and sends the results that the update function marks as ready to the
+// output channel.
+//
+// Example:
+//
+//	atr := volatility.NewAtr[float64]()
+//	atrs := helper.Compute3(highs, lows, closings, atr.Update)
+func Compute3[A any, B any, C any, R any](ac <-chan A, bc <-chan B, cc <-chan C, update func(A, B, C) (R, bool)) <-chan R {
+	rc := make(chan R, cap(ac))
+
+	go func() {
+		defer close(rc)
+
+		for {
+			an, ok := <-ac
+			if !ok {
+				break
+			}
+
+			bn, ok := <-bc
+			if !ok {
+				break
+			}
+
+			cn, ok := <-cc
+			if !ok {
+				break
+			}
+
+			r, ok := update(an, bn, cn)
+			if ok {
+				rc <- r
+			}
+		}
+
+		Drain(ac)
+		Drain(bc)
+		Drain(cc)
+	}()
+
+	return rc
+}
@@ -0,0 +1,28 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestCompute3(t *testing.T) {
+	ac := helper.SliceToChan([]int{1, 2, 3, 4, 5, 6})
+	bc := helper.SliceToChan([]int{1, 2, 3, 4, 5, 6})
+	cc := helper.SliceToChan([]int{1, 2, 3, 4})
+
+	expected := helper.SliceToChan([]int{6, 12})
+
+	actual := helper.Compute3(ac, bc, cc, func(a, b, c int) (int, bool) {
+		return a + b + c, a%2 == 0
+	})
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
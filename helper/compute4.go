@@ -0,0 +1,55 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+// Compute4 feeds the corresponding values from four input channels to the given update function
+// of a stateful indicator, and sends the results that the update function marks as ready to the
+// output channel.
+//
+// Example:
+//
+//	cmf := volume.NewCmf[float64]()
+//	cmfs := helper.Compute4(highs, lows, closings, volumes, cmf.Update)
+func Compute4[A any, B any, C any, D any, R any](ac <-chan A, bc <-chan B, cc <-chan C, dc <-chan D, update func(A, B, C, D) (R, bool)) <-chan R {
+	rc := make(chan R, cap(ac))
+
+	go func() {
+		defer close(rc)
+
+		for {
+			an, ok := <-ac
+			if !ok {
+				break
+			}
+
+			bn, ok := <-bc
+			if !ok {
+				break
+			}
+
+			cn, ok := <-cc
+			if !ok {
+				break
+			}
+
+			dn, ok := <-dc
+			if !ok {
+				break
+			}
+
+			r, ok := update(an, bn, cn, dn)
+			if ok {
+				rc <- r
+			}
+		}
+
+		Drain(ac)
+		Drain(bc)
+		Drain(cc)
+		Drain(dc)
+	}()
+
+	return rc
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+// ComputeSeries feeds the valid values of the input series to the given update function of a
+// stateful indicator, and returns a series with one point for each input point, keeping its time.
+// The points that the update function does not mark as ready, and the points that are not valid
+// in the input, are not valid in the result and carry the zero value.
+//
+// Example:
+//
+//	sma := trend.NewSma[float64]()
+//	smas := helper.ComputeSeries(closings, sma.Update)
+func ComputeSeries[T any, R any](series Series[T], update func(T) (R, bool)) Series[R] {
+	return Map(series, func(p SeriesPoint[T]) SeriesPoint[R] {
+		result := SeriesPoint[R]{
+			Time: p.Time,
+		}
+
+		if p.Valid {
+			value, ok := update(p.Value)
+			if ok {
+				result.Value = value
+				result.Valid = true
+			}
+		}
+
+		return result
+	})
+}
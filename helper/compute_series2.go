@@ -0,0 +1,33 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+// ComputeSeries2 joins the two input series by time, feeds the values of the points where both
+// series are valid to the given update function of a stateful indicator, and returns a series with
+// one point for each joined time.
+//
+// Example:
+//
+//	vwma := trend.NewVwma[float64]()
+//	vwmas := helper.ComputeSeries2(closings, volumes, vwma.Update)
+func ComputeSeries2[T any, R any](as, bs Series[T], update func(T, T) (R, bool)) Series[R] {
+	joined := JoinSeries(as, bs)
+
+	return Operate(joined[0], joined[1], func(a, b SeriesPoint[T]) SeriesPoint[R] {
+		result := SeriesPoint[R]{
+			Time: a.Time,
+		}
+
+		if a.Valid && b.Valid {
+			value, ok := update(a.Value, b.Value)
+			if ok {
+				result.Value = value
+				result.Valid = true
+			}
+		}
+
+		return result
+	})
+}
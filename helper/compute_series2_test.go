@@ -0,0 +1,34 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestComputeSeries2(t *testing.T) {
+	as := helper.SliceToChan([]helper.SeriesPoint[int]{
+		point(1, 1), point(2, 2), point(3, 3), point(4, 4),
+	})
+
+	bs := helper.SliceToChan([]helper.SeriesPoint[int]{
+		point(2, 20), missing(3), point(4, 40), point(5, 50),
+	})
+
+	expected := helper.SliceToChan([]helper.SeriesPoint[int]{
+		point(2, 22), missing(3), point(4, 44),
+	})
+
+	actual := helper.ComputeSeries2(as, bs, func(a, b int) (int, bool) {
+		return a + b, true
+	})
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+// ComputeSeries3 joins the three input series by time, feeds the values of the points where all
+// series are valid to the given update function of a stateful indicator, and returns a series with
+// one point for each joined time.
+//
+// Example:
+//
+//	atr := volatility.NewAtr[float64]()
+//	atrs := helper.ComputeSeries3(highs, lows, closings, atr.Update)
+func ComputeSeries3[T any, R any](as, bs, cs Series[T], update func(T, T, T) (R, bool)) Series[R] {
+	joined := JoinSeries(as, bs, cs)
+
+	return Operate3(joined[0], joined[1], joined[2], func(a, b, c SeriesPoint[T]) SeriesPoint[R] {
+		result := SeriesPoint[R]{
+			Time: a.Time,
+		}
+
+		if a.Valid && b.Valid && c.Valid {
+			value, ok := update(a.Value, b.Value, c.Value)
+			if ok {
+				result.Value = value
+				result.Valid = true
+			}
+		}
+
+		return result
+	})
+}
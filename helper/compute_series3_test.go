@@ -0,0 +1,38 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestComputeSeries3(t *testing.T) {
+	as := helper.SliceToChan([]helper.SeriesPoint[int]{
+		point(1, 1), point(2, 2), point(3, 3),
+	})
+
+	bs := helper.SliceToChan([]helper.SeriesPoint[int]{
+		point(1, 10), point(2, 20), point(3, 30),
+	})
+
+	cs := helper.SliceToChan([]helper.SeriesPoint[int]{
+		point(2, 200), point(3, 300),
+	})
+
+	expected := helper.SliceToChan([]helper.SeriesPoint[int]{
+		point(2, 222), point(3, 333),
+	})
+
+	actual := helper.ComputeSeries3(as, bs, cs, func(a, b, c int) (int, bool) {
+		return a + b + c, true
+	})
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+// ComputeSeries4 joins the four input series by time, feeds the values of the points where all
+// series are valid to the given update function of a stateful indicator, and returns a series with
+// one point for each joined time.
+//
+// Example:
+//
+//	cmf := volume.NewCmf[float64]()
+//	cmfs := helper.ComputeSeries4(highs, lows, closings, volumes, cmf.Update)
+func ComputeSeries4[T any, R any](as, bs, cs, ds Series[T], update func(T, T, T, T) (R, bool)) Series[R] {
+	joined := JoinSeries(as, bs, cs, ds)
+
+	return Compute4(joined[0], joined[1], joined[2], joined[3], func(a, b, c, d SeriesPoint[T]) (SeriesPoint[R], bool) {
+		result := SeriesPoint[R]{
+			Time: a.Time,
+		}
+
+		if a.Valid && b.Valid && c.Valid && d.Valid {
+			value, ok := update(a.Value, b.Value, c.Value, d.Value)
+			if ok {
+				result.Value = value
+				result.Valid = true
+			}
+		}
+
+		return result, true
+	})
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestComputeSeries4(t *testing.T) {
+	as := helper.SliceToChan([]helper.SeriesPoint[int]{
+		point(1, 1), point(2, 2),
+	})
+
+	bs := helper.SliceToChan([]helper.SeriesPoint[int]{
+		point(1, 10), point(2, 20),
+	})
+
+	cs := helper.SliceToChan([]helper.SeriesPoint[int]{
+		point(1, 100), point(2, 200),
+	})
+
+	ds := helper.SliceToChan([]helper.SeriesPoint[int]{
+		point(1, 1000), point(2, 2000),
+	})
+
+	expected := helper.SliceToChan([]helper.SeriesPoint[int]{
+		missing(1), point(2, 2222),
+	})
+
+	actual := helper.ComputeSeries4(as, bs, cs, ds, func(a, b, c, d int) (int, bool) {
+		return a + b + c + d, a > 1
+	})
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestComputeSeries(t *testing.T) {
+	series := helper.SliceToChan([]helper.SeriesPoint[int]{
+		point(1, 1), point(2, 2), missing(3), point(4, 4), point(5, 5),
+	})
+
+	expected := helper.SliceToChan([]helper.SeriesPoint[int]{
+		missing(1), point(2, 3), missing(3), point(4, 6), point(5, 9),
+	})
+
+	previous := 0
+	started := false
+
+	// Sum of the last two valid values.
+	actual := helper.ComputeSeries(series, func(n int) (int, bool) {
+		sum := previous + n
+		previous = n
+
+		ready := started
+		started = true
+
+		return sum, ready
+	})
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+// ComputeSlice feeds the values from the input slice to the given update function of a stateful
+// indicator, and returns the results that the update function marks as ready. It is the batch
+// form of Compute for values that are already materialized, without the goroutine and the
+// channel overhead.
+//
+// Example:
+//
+//	sma := trend.NewSma[float64]()
+//	smas := helper.ComputeSlice(closings, sma.Update)
+func ComputeSlice[T any, R any](values []T, update func(T) (R, bool)) []R {
+	result := make([]R, 0, len(values))
+
+	for _, n := range values {
+		r, ok := update(n)
+		if ok {
+			result = append(result, r)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,27 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+// ComputeSlice2 feeds the corresponding values from two input slices to the given update function
+// of a stateful indicator, and returns the results that the update function marks as ready. The
+// values beyond the length of the shortest slice are ignored.
+//
+// Example:
+//
+//	vwma := trend.NewVwma[float64]()
+//	vwmas := helper.ComputeSlice2(closings, volumes, vwma.Update)
+func ComputeSlice2[A any, B any, R any](as []A, bs []B, update func(A, B) (R, bool)) []R {
+	n := min(len(as), len(bs))
+	result := make([]R, 0, n)
+
+	for i := 0; i < n; i++ {
+		r, ok := update(as[i], bs[i])
+		if ok {
+			result = append(result, r)
+		}
+	}
+
+	return result
+}
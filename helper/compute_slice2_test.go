@@ -0,0 +1,26 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestComputeSlice2(t *testing.T) {
+	as := []int{1, 2, 3, 4}
+	bs := []int{5, 6, 7}
+	expected := []int{8, 10}
+
+	actual := helper.ComputeSlice2(as, bs, func(a, b int) (int, bool) {
+		return a + b, a > 1
+	})
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
@@ -0,0 +1,27 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+// ComputeSlice3 feeds the corresponding values from three input slices to the given update function
+// of a stateful indicator, and returns the results that the update function marks as ready. The
+// values beyond the length of the shortest slice are ignored.
+//
+// Example:
+//
+//	atr := volatility.NewAtr[float64]()
+//	atrs := helper.ComputeSlice3(highs, lows, closings, atr.Update)
+func ComputeSlice3[A any, B any, C any, R any](as []A, bs []B, cs []C, update func(A, B, C) (R, bool)) []R {
+	n := min(len(as), len(bs), len(cs))
+	result := make([]R, 0, n)
+
+	for i := 0; i < n; i++ {
+		r, ok := update(as[i], bs[i], cs[i])
+		if ok {
+			result = append(result, r)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,27 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestComputeSlice3(t *testing.T) {
+	as := []int{1, 2, 3, 4}
+	bs := []int{5, 6, 7, 8}
+	cs := []int{9, 10, 11}
+	expected := []int{18, 21}
+
+	actual := helper.ComputeSlice3(as, bs, cs, func(a, b, c int) (int, bool) {
+		return a + b + c, a > 1
+	})
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
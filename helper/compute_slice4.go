@@ -0,0 +1,27 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+// ComputeSlice4 feeds the corresponding values from four input slices to the given update function
+// of a stateful indicator, and returns the results that the update function marks as ready. The
+// values beyond the length of the shortest slice are ignored.
+//
+// Example:
+//
+//	cmf := volume.NewCmf[float64]()
+//	cmfs := helper.ComputeSlice4(highs, lows, closings, volumes, cmf.Update)
+func ComputeSlice4[A any, B any, C any, D any, R any](as []A, bs []B, cs []C, ds []D, update func(A, B, C, D) (R, bool)) []R {
+	n := min(len(as), len(bs), len(cs), len(ds))
+	result := make([]R, 0, n)
+
+	for i := 0; i < n; i++ {
+		r, ok := update(as[i], bs[i], cs[i], ds[i])
+		if ok {
+			result = append(result, r)
+		}
+	}
+
+	return result
+}
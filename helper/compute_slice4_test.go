@@ -0,0 +1,28 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestComputeSlice4(t *testing.T) {
+	as := []int{1, 2, 3}
+	bs := []int{4, 5, 6}
+	cs := []int{7, 8, 9}
+	ds := []int{10, 11, 12, 13}
+	expected := []int{26, 30}
+
+	actual := helper.ComputeSlice4(as, bs, cs, ds, func(a, b, c, d int) (int, bool) {
+		return a + b + c + d, a > 1
+	})
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
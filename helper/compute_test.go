@@ -0,0 +1,34 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestCompute(t *testing.T) {
+	input := helper.SliceToChan([]int{1, 2, 3, 4, 5, 6})
+	expected := helper.SliceToChan([]int{3, 5, 7, 9, 11})
+
+	previous := 0
+	started := false
+
+	actual := helper.Compute(input, func(n int) (int, bool) {
+		sum := previous + n
+		previous = n
+
+		ready := started
+		started = true
+
+		return sum, ready
+	})
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
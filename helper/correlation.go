@@ -0,0 +1,31 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import "math"
+
+// Correlation calculates the rolling Pearson correlation coefficient of the values from two input
+// channels over the given period. The correlation is zero when either of the values is flat.
+//
+//	Correlation = Covariance(A, B) / (Std(A) * Std(B))
+//
+// Example:
+//
+//	correlations := helper.Correlation(ac, bc, 20)
+func Correlation[T Number](ac, bc <-chan T, period int) <-chan T {
+	return OperateWindow(ac, bc, period, func(as, bs []T) T {
+		return T(correlation(as, bs))
+	})
+}
+
+// correlation calculates the Pearson correlation coefficient of the given values.
+func correlation[T Number](as, bs []T) float64 {
+	divisor := math.Sqrt(covariance(as, as) * covariance(bs, bs))
+	if divisor == 0 {
+		return 0
+	}
+
+	return covariance(as, bs) / divisor
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestCorrelation(t *testing.T) {
+	a := helper.SliceToChan([]float64{10, 12, 11, 13, 15, 14, 16, 18, 17, 19, 21, 20})
+	b := helper.SliceToChan([]float64{20, 21, 21, 22, 24, 23, 23, 25, 26, 25, 27, 28})
+	expected := helper.SliceToChan([]float64{0.9487, 0.9661, 0.9827, 0.6325, 0.7645, 0.7482, 0.513, 0.5606, 0.5292})
+
+	actual := helper.RoundDigits(helper.Correlation(a, b, 4), 4)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCorrelationFlat(t *testing.T) {
+	a := helper.SliceToChan([]float64{1, 2, 3, 4})
+	b := helper.SliceToChan([]float64{5, 5, 5, 5})
+	expected := helper.SliceToChan([]float64{0, 0})
+
+	actual := helper.Correlation(a, b, 3)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+// Covariance calculates the rolling population covariance of the values from two input channels over
+// the given period.
+//
+//	Covariance = Sum((A - Mean(A)) * (B - Mean(B))) / Period
+//
+// Example:
+//
+//	covariances := helper.Covariance(ac, bc, 20)
+func Covariance[T Number](ac, bc <-chan T, period int) <-chan T {
+	return OperateWindow(ac, bc, period, func(as, bs []T) T {
+		return T(covariance(as, bs))
+	})
+}
+
+// mean calculates the mean of the given values.
+func mean[T Number](values []T) float64 {
+	sum := 0.0
+	for _, value := range values {
+		sum += float64(value)
+	}
+
+	return sum / float64(len(values))
+}
+
+// covariance calculates the population covariance of the given values.
+func covariance[T Number](as, bs []T) float64 {
+	meanA := mean(as)
+	meanB := mean(bs)
+
+	sum := 0.0
+	for i := range as {
+		sum += (float64(as[i]) - meanA) * (float64(bs[i]) - meanB)
+	}
+
+	return sum / float64(len(as))
+}
@@ -0,0 +1,257 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DecimalDigits is the number of fractional digits kept by the decimal.
+	DecimalDigits = 8
+
+	// DecimalScale is the number of units in one for the decimal.
+	DecimalScale = 100_000_000
+)
+
+// ErrDecimalOverflow indicates that the result does not fit in the range of the decimal.
+var ErrDecimalOverflow = errors.New("decimal overflow")
+
+// Decimal is a fixed-point number with eight fractional digits, stored as an integer count of
+// units of 1e-8. The prices and the quantities that are multiples of a tick size, such as 0.01 or
+// 0.25, are represented exactly, and adding, subtracting, and comparing them with the regular
+// operators is exact, so the profits and losses do not accumulate the floating point error.
+//
+// Multiplying and dividing two decimals must use the Mul and Div methods, as the regular operators
+// do not account for the scale. For this reason, the decimal is not a Number, and unlike float64,
+// it can not be used as the type parameter of the generic indicators, which multiply and divide
+// their values by constants. It is an Amount instead, for the accounting functions that only add
+// and subtract, such as the Add, the Subtract, and the strategy.ProfitAndLoss functions. The
+// indicators are not exact over the decimals. They are computed by converting the decimals to
+// float64 values with the DecimalToFloat function, and back with the FloatToDecimal function.
+//
+// Example:
+//
+//	price, err := helper.ParseDecimal("4512.25")
+//	total := price.Mul(helper.NewDecimalFromInt(3))
+type Decimal int64
+
+// NewDecimal function returns the decimal nearest to the given float value. It panics if the
+// value is not a number, or if it does not fit in the range of the decimal.
+func NewDecimal(f float64) Decimal {
+	units := math.Round(f * DecimalScale)
+
+	// The float value of math.MaxInt64 rounds up to 2^63, which is out of range.
+	if math.IsNaN(units) || units < math.MinInt64 || units >= math.MaxInt64 {
+		panic(ErrDecimalOverflow)
+	}
+
+	return Decimal(units)
+}
+
+// NewDecimalFromInt function returns the decimal for the given integer value. It panics if the
+// value does not fit in the range of the decimal.
+func NewDecimalFromInt(n int64) Decimal {
+	if n > math.MaxInt64/DecimalScale || n < math.MinInt64/DecimalScale {
+		panic(ErrDecimalOverflow)
+	}
+
+	return Decimal(n * DecimalScale)
+}
+
+// ParseDecimal function parses the given string as a decimal without any rounding. It returns an
+// error if the string has more than eight fractional digits, or if it does not fit in the range of
+// the decimal.
+func ParseDecimal(s string) (Decimal, error) {
+	text := strings.TrimSpace(s)
+
+	negative := strings.HasPrefix(text, "-")
+	if negative || strings.HasPrefix(text, "+") {
+		text = text[1:]
+	}
+
+	whole, fraction, _ := strings.Cut(text, ".")
+
+	if whole == "" && fraction == "" {
+		return 0, fmt.Errorf("invalid decimal %q", s)
+	}
+
+	if len(fraction) > DecimalDigits {
+		return 0, fmt.Errorf("decimal %q has more than %d fractional digits", s, DecimalDigits)
+	}
+
+	var units uint64
+
+	for _, digits := range []string{whole, fraction + strings.Repeat("0", DecimalDigits-len(fraction))} {
+		for _, c := range digits {
+			if c < '0' || c > '9' {
+				return 0, fmt.Errorf("invalid decimal %q", s)
+			}
+
+			hi, lo := bits.Mul64(units, 10)
+			lo, carry := bits.Add64(lo, uint64(c-'0'), 0)
+
+			if hi != 0 || carry != 0 {
+				return 0, ErrDecimalOverflow
+			}
+
+			units = lo
+		}
+	}
+
+	return newDecimalFromUnits(units, negative)
+}
+
+// Float64 returns the float value nearest to the decimal.
+func (d Decimal) Float64() float64 {
+	return float64(d) / DecimalScale
+}
+
+// String returns the decimal without the trailing zeros of its fractional digits.
+func (d Decimal) String() string {
+	units, negative := d.units()
+
+	text := strconv.FormatUint(units/DecimalScale, 10)
+
+	if fraction := units % DecimalScale; fraction != 0 {
+		digits := strconv.FormatUint(fraction+DecimalScale, 10)[1:]
+		text += "." + strings.TrimRight(digits, "0")
+	}
+
+	if negative {
+		text = "-" + text
+	}
+
+	return text
+}
+
+// MarshalText returns the decimal as text, as in the String method.
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText parses the given text as a decimal, as in the ParseDecimal function.
+func (d *Decimal) UnmarshalText(text []byte) error {
+	value, err := ParseDecimal(string(text))
+	if err == nil {
+		*d = value
+	}
+
+	return err
+}
+
+// Mul returns the product of the decimal and the given decimal, rounded half away from zero to
+// eight fractional digits. It panics if the product does not fit in the range of the decimal.
+func (d Decimal) Mul(o Decimal) Decimal {
+	a, aNegative := d.units()
+	b, bNegative := o.units()
+
+	hi, lo := bits.Mul64(a, b)
+	if hi >= DecimalScale {
+		panic(ErrDecimalOverflow)
+	}
+
+	quo, rem := bits.Div64(hi, lo, DecimalScale)
+	if rem*2 >= DecimalScale {
+		quo++
+	}
+
+	return mustDecimalFromUnits(quo, aNegative != bNegative)
+}
+
+// Div returns the quotient of the decimal and the given decimal, rounded half away from zero to
+// eight fractional digits. It panics if the given decimal is zero, or if the quotient does not
+// fit in the range of the decimal.
+func (d Decimal) Div(o Decimal) Decimal {
+	a, aNegative := d.units()
+	b, bNegative := o.units()
+
+	if b == 0 {
+		panic("decimal division by zero")
+	}
+
+	hi, lo := bits.Mul64(a, DecimalScale)
+	if hi >= b {
+		panic(ErrDecimalOverflow)
+	}
+
+	quo, rem := bits.Div64(hi, lo, b)
+	if rem >= b-rem {
+		quo++
+	}
+
+	return mustDecimalFromUnits(quo, aNegative != bNegative)
+}
+
+// Round returns the decimal rounded half away from zero to the nearest multiple of the given
+// tick size.
+func (d Decimal) Round(tick Decimal) Decimal {
+	if tick == 0 {
+		return d
+	}
+
+	if tick < 0 {
+		tick = -tick
+	}
+
+	quo := d / tick
+	rem := d % tick
+
+	if rem < 0 {
+		rem = -rem
+	}
+
+	if rem >= tick-rem {
+		if d < 0 {
+			quo--
+		} else {
+			quo++
+		}
+	}
+
+	return quo * tick
+}
+
+// units returns the absolute count of units of the decimal, and whether it is negative.
+func (d Decimal) units() (uint64, bool) {
+	if d < 0 {
+		return uint64(-d), true
+	}
+
+	return uint64(d), false
+}
+
+// newDecimalFromUnits returns the decimal with the given absolute count of units and sign.
+func newDecimalFromUnits(units uint64, negative bool) (Decimal, error) {
+	if negative {
+		if units > 1<<63 {
+			return 0, ErrDecimalOverflow
+		}
+
+		return Decimal(-units), nil
+	}
+
+	if units > math.MaxInt64 {
+		return 0, ErrDecimalOverflow
+	}
+
+	return Decimal(units), nil
+}
+
+// mustDecimalFromUnits returns the decimal with the given absolute count of units and sign, and
+// panics if it does not fit in the range of the decimal.
+func mustDecimalFromUnits(units uint64, negative bool) Decimal {
+	d, err := newDecimalFromUnits(units, negative)
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
@@ -0,0 +1,278 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestParseDecimal(t *testing.T) {
+	tests := map[string]helper.Decimal{
+		"0":                     0,
+		"1":                     100000000,
+		"-1":                    -100000000,
+		"+4512.25":              451225000000,
+		"0.01":                  1000000,
+		".5":                    50000000,
+		"-0.00000001":           -1,
+		"92233720368.54775807":  math.MaxInt64,
+		"-92233720368.54775808": math.MinInt64,
+	}
+
+	for input, expected := range tests {
+		actual, err := helper.ParseDecimal(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if actual != expected {
+			t.Fatalf("input %s actual %d expected %d", input, actual, expected)
+		}
+	}
+}
+
+func TestParseDecimalInvalid(t *testing.T) {
+	inputs := []string{
+		"", "-", ".", "abc", "1.2.3", "1e5", "--1", "0.000000001",
+	}
+
+	for _, input := range inputs {
+		_, err := helper.ParseDecimal(input)
+		if err == nil {
+			t.Fatalf("input %s expected error", input)
+		}
+	}
+}
+
+func TestParseDecimalOverflow(t *testing.T) {
+	inputs := []string{
+		"92233720368.54775808", "-92233720368.54775809", "100000000000000000000",
+	}
+
+	for _, input := range inputs {
+		_, err := helper.ParseDecimal(input)
+		if !errors.Is(err, helper.ErrDecimalOverflow) {
+			t.Fatalf("input %s actual %v expected overflow", input, err)
+		}
+	}
+}
+
+func TestDecimalString(t *testing.T) {
+	tests := map[helper.Decimal]string{
+		0:                       "0",
+		helper.NewDecimal(1):    "1",
+		helper.NewDecimal(-1.5): "-1.5",
+		helper.NewDecimal(0.01): "0.01",
+		-1:                      "-0.00000001",
+		math.MinInt64:           "-92233720368.54775808",
+	}
+
+	for input, expected := range tests {
+		actual := input.String()
+		if actual != expected {
+			t.Fatalf("actual %s expected %s", actual, expected)
+		}
+
+		parsed, err := helper.ParseDecimal(actual)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if parsed != input {
+			t.Fatalf("actual %d expected %d", parsed, input)
+		}
+	}
+}
+
+func TestDecimalMul(t *testing.T) {
+	a := helper.NewDecimal(4512.25)
+	b := helper.NewDecimal(-0.1)
+	expected := helper.NewDecimal(-451.225)
+
+	actual := a.Mul(b)
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+
+	// 0.00000001 * 0.5 rounds half away from zero.
+	actual = helper.Decimal(1).Mul(helper.NewDecimal(0.5))
+	if actual != 1 {
+		t.Fatalf("actual %v expected %v", actual, helper.Decimal(1))
+	}
+}
+
+func TestDecimalMulOverflow(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic")
+		}
+	}()
+
+	helper.NewDecimal(1e10).Mul(helper.NewDecimal(1e10))
+}
+
+func TestDecimalDiv(t *testing.T) {
+	a := helper.NewDecimal(1)
+	b := helper.NewDecimal(3)
+	expected := helper.Decimal(33333333)
+
+	actual := a.Div(b)
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+
+	expected = helper.Decimal(-66666667)
+
+	actual = helper.NewDecimal(-2).Div(b)
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestDecimalDivByZero(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic")
+		}
+	}()
+
+	helper.NewDecimal(1).Div(0)
+}
+
+func TestDecimalRound(t *testing.T) {
+	tick := helper.NewDecimal(0.25)
+
+	tests := map[float64]float64{
+		4512.12:   4512,
+		4512.125:  4512.25,
+		4512.37:   4512.25,
+		-4512.125: -4512.25,
+		-4512.1:   -4512,
+	}
+
+	for input, expected := range tests {
+		actual := helper.NewDecimal(input).Round(tick)
+		if actual != helper.NewDecimal(expected) {
+			t.Fatalf("input %v actual %v expected %v", input, actual, expected)
+		}
+	}
+}
+
+func TestNewDecimalFromInt(t *testing.T) {
+	if helper.NewDecimalFromInt(3) != helper.NewDecimal(3) {
+		t.Fatalf("actual %v expected 3", helper.NewDecimalFromInt(3))
+	}
+}
+
+func TestNewDecimalFromIntOverflow(t *testing.T) {
+	for _, input := range []int64{math.MaxInt64 / helper.DecimalScale * 2, math.MinInt64 / helper.DecimalScale * 2} {
+		func() {
+			defer func() {
+				if recover() != helper.ErrDecimalOverflow {
+					t.Fatalf("expected overflow for %d", input)
+				}
+			}()
+
+			helper.NewDecimalFromInt(input)
+		}()
+	}
+}
+
+func TestNewDecimalOverflow(t *testing.T) {
+	for _, input := range []float64{1e11, -1e11, math.NaN()} {
+		func() {
+			defer func() {
+				if recover() != helper.ErrDecimalOverflow {
+					t.Fatalf("expected overflow for %v", input)
+				}
+			}()
+
+			helper.NewDecimal(input)
+		}()
+	}
+}
+
+func TestDecimalToFloat(t *testing.T) {
+	input := helper.SliceToChan([]helper.Decimal{helper.NewDecimal(4512.25), helper.NewDecimal(-0.5)})
+	expected := helper.SliceToChan([]float64{4512.25, -0.5})
+
+	actual := helper.DecimalToFloat(input)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFloatToDecimal(t *testing.T) {
+	input := helper.SliceToChan([]float64{4512.25, 0.1})
+	expected := helper.SliceToChan([]helper.Decimal{helper.NewDecimal(4512.25), 10_000_000})
+
+	actual := helper.FloatToDecimal(input)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDecimalFloat64(t *testing.T) {
+	input := helper.NewDecimal(4512.25)
+
+	if input.Float64() != 4512.25 {
+		t.Fatalf("actual %v expected %v", input.Float64(), 4512.25)
+	}
+}
+
+func TestDecimalSum(t *testing.T) {
+	// Summing the ticks of 0.1 accumulates the floating point error, but not the decimal one.
+	floatSum := 0.0
+	decimalSum := helper.Decimal(0)
+
+	for i := 0; i < 10; i++ {
+		floatSum += 0.1
+		decimalSum += helper.NewDecimal(0.1)
+	}
+
+	if floatSum == 1 {
+		t.Fatal("expected floating point error")
+	}
+
+	if decimalSum != helper.NewDecimal(1) {
+		t.Fatalf("actual %v expected %v", decimalSum, helper.NewDecimal(1))
+	}
+}
+
+func TestMultiplyDecimal(t *testing.T) {
+	ac := helper.SliceToChan([]helper.Decimal{helper.NewDecimal(1.5), helper.NewDecimal(0.25)})
+	bc := helper.SliceToChan([]helper.Decimal{helper.NewDecimal(2), helper.NewDecimal(4)})
+
+	expected := helper.SliceToChan([]helper.Decimal{helper.NewDecimal(3), helper.NewDecimal(1)})
+
+	actual := helper.MultiplyDecimal(ac, bc)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDivideDecimal(t *testing.T) {
+	ac := helper.SliceToChan([]helper.Decimal{helper.NewDecimal(3), helper.NewDecimal(1)})
+	bc := helper.SliceToChan([]helper.Decimal{helper.NewDecimal(2), helper.NewDecimal(0.25)})
+
+	expected := helper.SliceToChan([]helper.Decimal{helper.NewDecimal(1.5), helper.NewDecimal(4)})
+
+	actual := helper.DivideDecimal(ac, bc)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,17 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+// DecimalToFloat takes a channel of decimals and converts them to the
+// nearest float64 values, so that the generic indicators can be computed
+// over them.
+//
+// Example:
+//
+//	closings := helper.SliceToChan([]helper.Decimal{helper.NewDecimal(4512.25)})
+//	emas := trend.NewEma[float64]().Compute(helper.DecimalToFloat(closings))
+func DecimalToFloat(c <-chan Decimal) <-chan float64 {
+	return Map(c, Decimal.Float64)
+}
@@ -0,0 +1,22 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+// DivideDecimal takes two channels of decimals and divides the values
+// from the first channel with the values from the second channel, as in
+// the Decimal Div method. It returns a new channel containing the results
+// of the division.
+//
+// Example:
+//
+//	ac := helper.SliceToChan([]helper.Decimal{helper.NewDecimal(3), helper.NewDecimal(1)})
+//	bc := helper.SliceToChan([]helper.Decimal{helper.NewDecimal(2), helper.NewDecimal(0.25)})
+//
+//	division := helper.DivideDecimal(ac, bc)
+//
+//	fmt.Println(helper.ChanToSlice(division)) // [1.5, 4]
+func DivideDecimal(ac, bc <-chan Decimal) <-chan Decimal {
+	return Operate(ac, bc, Decimal.Div)
+}
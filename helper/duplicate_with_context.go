@@ -0,0 +1,49 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import "context"
+
+// DuplicateWithContext duplicates a given receive-only channel by reading each value coming out of
+// that channel and sending them on requested number of new output channels, like Duplicate. It
+// stops when the given context is cancelled, closes the output channels, and drains the input
+// channel. Unlike Duplicate, a consumer that stops reading one of the outputs does not block the
+// others forever once the context is cancelled.
+//
+// Example:
+//
+//	outputs := helper.DuplicateWithContext(ctx, c, 2)
+func DuplicateWithContext[T any](ctx context.Context, input <-chan T, count int) []<-chan T {
+	outputs := make([]chan T, count)
+	result := make([]<-chan T, count)
+
+	for i := range outputs {
+		outputs[i] = make(chan T, cap(input))
+		result[i] = outputs[i]
+	}
+
+	go func() {
+		defer Drain(input)
+
+		for _, output := range outputs {
+			defer close(output)
+		}
+
+		for {
+			n, ok := receive(ctx, input)
+			if !ok {
+				return
+			}
+
+			for _, output := range outputs {
+				if !send(ctx, output, n) {
+					return
+				}
+			}
+		}
+	}()
+
+	return result
+}
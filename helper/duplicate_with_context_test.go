@@ -0,0 +1,41 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestDuplicateWithContext(t *testing.T) {
+	expected := []float64{-10, 20, -4, -5}
+	outputs := helper.DuplicateWithContext(context.Background(), helper.SliceToChan(expected), 2)
+
+	err := helper.CheckEquals(outputs[0], helper.SliceToChan(expected), outputs[1], helper.SliceToChan(expected))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDuplicateWithContextCancel(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	outputs := helper.DuplicateWithContext(ctx, endless(ctx, 1), 2)
+
+	// Only the first output is read.
+	<-outputs[0]
+	cancel()
+
+	err := helper.CheckGoroutines(before, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+// FloatToDecimal takes a channel of float64 values and converts them to
+// the nearest decimals, such as the results of the generic indicators
+// computed over the decimals.
+//
+// Example:
+//
+//	c := helper.SliceToChan([]float64{4512.25, 4512.375})
+//	decimals := helper.FloatToDecimal(c)
+//	fmt.Println(helper.ChanToSlice(decimals)) // [4512.25, 4512.375]
+func FloatToDecimal(c <-chan float64) <-chan Decimal {
+	return Map(c, NewDecimal)
+}
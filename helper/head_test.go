@@ -33,3 +33,15 @@ func TestHeadEarly(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestHeadLeavesRest(t *testing.T) {
+	input := helper.SliceToChan([]int{2, 4, 6, 8})
+	expected := helper.SliceToChan([]int{6, 8})
+
+	helper.Drain(helper.Head(input, 2))
+
+	err := helper.CheckEquals(input, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
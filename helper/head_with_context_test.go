@@ -0,0 +1,53 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestHeadWithContext(t *testing.T) {
+	input := helper.SliceToChan([]int{2, 4, 6, 8})
+	expected := helper.SliceToChan([]int{2, 4})
+
+	actual := helper.HeadWithContext(context.Background(), input, 2)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHeadWithContextReleasesInput(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	helper.Drain(helper.HeadWithContext(context.Background(), helper.SliceToChan(make([]int, 1000)), 2))
+
+	err := helper.CheckGoroutines(before, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHeadWithContextCancel(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	actual := helper.HeadWithContext(ctx, endless(ctx, 1), 1000)
+
+	<-actual
+	cancel()
+
+	err := helper.CheckGoroutines(before, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -32,3 +32,9 @@ type Float interface {
 type Number interface {
 	Integer | Float
 }
+
+// Amount refers to any numeric type, and the Decimal. The amounts are only added, subtracted, and
+// compared, which the regular operators do exactly for the Decimal as well.
+type Amount interface {
+	Number | Decimal
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+// JoinSeries joins the given series by time, keeping only the times that are present in all of
+// them. It returns one series for each input series, all having the same times in the same order,
+// so they can be consumed together. Each series is expected to be in ascending time order.
+//
+// Example:
+//
+//	joined := helper.JoinSeries(closings, volumes)
+//	closings, volumes = joined[0], joined[1]
+func JoinSeries[T any](series ...Series[T]) []Series[T] {
+	return joinSeries(series, false)
+}
+
+// OuterJoinSeries joins the given series by time, keeping all the times that are present in any
+// of them. It returns one series for each input series, all having the same times in the same
+// order, where the points of the times that are missing in a series are not valid. Each series is
+// expected to be in ascending time order.
+//
+// Example:
+//
+//	joined := helper.OuterJoinSeries(firsts, seconds)
+//	firsts, seconds = joined[0], joined[1]
+func OuterJoinSeries[T any](series ...Series[T]) []Series[T] {
+	return joinSeries(series, true)
+}
+
+// joinSeries merges the given series by time, either as an inner join or as an outer join.
+func joinSeries[T any](series []Series[T], outer bool) []Series[T] {
+	outputs := make([]chan SeriesPoint[T], len(series))
+	result := make([]Series[T], len(series))
+
+	for i := range outputs {
+		outputs[i] = make(chan SeriesPoint[T], max(cap(series[i]), 1))
+		result[i] = outputs[i]
+	}
+
+	go func() {
+		for _, output := range outputs {
+			defer close(output)
+		}
+
+		// The inputs may be fed in lockstep, so they are drained concurrently.
+		defer func() {
+			for _, s := range series {
+				go Drain(s)
+			}
+		}()
+
+		heads := make([]SeriesPoint[T], len(series))
+		ok := make([]bool, len(series))
+
+		for i, s := range series {
+			heads[i], ok[i] = <-s
+		}
+
+		for {
+			first := -1
+			all := true
+
+			for i := range heads {
+				if !ok[i] {
+					all = false
+					continue
+				}
+
+				if first == -1 || heads[i].Time.Before(heads[first].Time) {
+					first = i
+				}
+			}
+
+			if first == -1 || (!outer && !all) {
+				return
+			}
+
+			t := heads[first].Time
+
+			matched := true
+			for i := range heads {
+				if !ok[i] || !heads[i].Time.Equal(t) {
+					matched = false
+				}
+			}
+
+			if outer || matched {
+				for i, output := range outputs {
+					point := SeriesPoint[T]{
+						Time: t,
+					}
+
+					if ok[i] && heads[i].Time.Equal(t) {
+						point = heads[i]
+					}
+
+					output <- point
+				}
+			}
+
+			for i, s := range series {
+				if ok[i] && heads[i].Time.Equal(t) {
+					heads[i], ok[i] = <-s
+				}
+			}
+		}
+	}()
+
+	return result
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestJoinSeries(t *testing.T) {
+	as := helper.SliceToChan([]helper.SeriesPoint[int]{
+		point(1, 1), point(2, 2), point(4, 4), point(5, 5),
+	})
+
+	bs := helper.SliceToChan([]helper.SeriesPoint[int]{
+		point(2, 20), point(3, 30), point(4, 40),
+	})
+
+	expectedAs := helper.SliceToChan([]helper.SeriesPoint[int]{
+		point(2, 2), point(4, 4),
+	})
+
+	expectedBs := helper.SliceToChan([]helper.SeriesPoint[int]{
+		point(2, 20), point(4, 40),
+	})
+
+	actual := helper.JoinSeries(as, bs)
+
+	err := helper.CheckEquals(actual[0], expectedAs, actual[1], expectedBs)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOuterJoinSeries(t *testing.T) {
+	as := helper.SliceToChan([]helper.SeriesPoint[int]{
+		point(1, 1), point(2, 2), point(4, 4), point(5, 5),
+	})
+
+	bs := helper.SliceToChan([]helper.SeriesPoint[int]{
+		point(2, 20), point(3, 30), point(4, 40),
+	})
+
+	expectedAs := helper.SliceToChan([]helper.SeriesPoint[int]{
+		point(1, 1), point(2, 2), missing(3), point(4, 4), point(5, 5),
+	})
+
+	expectedBs := helper.SliceToChan([]helper.SeriesPoint[int]{
+		missing(1), point(2, 20), point(3, 30), point(4, 40), missing(5),
+	})
+
+	actual := helper.OuterJoinSeries(as, bs)
+
+	err := helper.CheckEquals(actual[0], expectedAs, actual[1], expectedBs)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestJoinSeriesLockstep(t *testing.T) {
+	series := helper.SliceToChan([]helper.SeriesPoint[int]{
+		point(1, 1), point(2, 2), point(3, 3),
+	})
+
+	// The inputs are fed in lockstep, and the join ends early.
+	inputs := helper.Duplicate(series, 2)
+
+	expected := helper.SliceToChan([]helper.SeriesPoint[int]{
+		point(1, 1), point(2, 2), point(3, 3),
+	})
+
+	actual := helper.JoinSeries(helper.Series[int](inputs[0]), helper.Series[int](inputs[1]))
+	go helper.Drain(actual[1])
+
+	err := helper.CheckEquals(actual[0], expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
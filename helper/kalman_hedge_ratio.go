@@ -0,0 +1,108 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+const (
+	// DefaultKalmanHedgeRatioDelta is the default rate that the Kalman filter lets the hedge ratio change.
+	DefaultKalmanHedgeRatioDelta = 0.0001
+
+	// DefaultKalmanHedgeRatioObservationVariance is the default variance of the Kalman filter measurement noise.
+	DefaultKalmanHedgeRatioObservationVariance = 0.001
+)
+
+// KalmanHedgeRatio estimates the dynamic hedge ratio and the intercept between the values from two input
+// channels with a Kalman filter, modeling the first values as a linear function of the second values whose
+// coefficients follow a random walk. The delta sets how fast the coefficients can change, and the
+// observation variance sets the measurement noise. Unlike the rolling regression, it yields a result for
+// every value. Returns hedge ratios and intercepts. Both outputs must be consumed, such as by draining
+// the unused one with the Drain function, as they are produced together.
+//
+//	A = Hedge Ratio * B + Intercept + Noise
+//	Spread = A - Hedge Ratio * B - Intercept
+//
+// Example:
+//
+//	ratios, intercepts := helper.KalmanHedgeRatio(ac, bc, helper.DefaultKalmanHedgeRatioDelta,
+//	  helper.DefaultKalmanHedgeRatioObservationVariance)
+func KalmanHedgeRatio[T Number](ac, bc <-chan T, delta, observationVariance float64) (<-chan T, <-chan T) {
+	estimates := Duplicate(kalmanHedgeRatio(ac, bc, delta, observationVariance), 2)
+
+	ratios := Map(estimates[0], func(e kalmanHedgeEstimate[T]) T { return e.ratio })
+	intercepts := Map(estimates[1], func(e kalmanHedgeEstimate[T]) T { return e.intercept })
+
+	return ratios, intercepts
+}
+
+// kalmanHedgeEstimate is the hedge ratio and the intercept estimated for a pair of values.
+type kalmanHedgeEstimate[T Number] struct {
+	ratio     T
+	intercept T
+}
+
+// kalmanHedgeRatio estimates the hedge ratio and the intercept for each pair of values from the two
+// input channels with a Kalman filter.
+func kalmanHedgeRatio[T Number](ac, bc <-chan T, delta, observationVariance float64) <-chan kalmanHedgeEstimate[T] {
+	estimates := make(chan kalmanHedgeEstimate[T])
+
+	go func() {
+		defer close(estimates)
+
+		// State is the hedge ratio and the intercept, and P is its covariance.
+		var state [2]float64
+		var p [2][2]float64
+
+		transition := delta / (1 - delta)
+
+		for {
+			an, ok := <-ac
+			if !ok {
+				Drain(bc)
+				break
+			}
+
+			bn, ok := <-bc
+			if !ok {
+				Drain(ac)
+				break
+			}
+
+			x := [2]float64{float64(bn), 1}
+
+			// Predict the covariance with the random walk noise.
+			r := p
+			r[0][0] += transition
+			r[1][1] += transition
+
+			// R * x
+			rx := [2]float64{
+				r[0][0]*x[0] + r[0][1]*x[1],
+				r[1][0]*x[0] + r[1][1]*x[1],
+			}
+
+			variance := x[0]*rx[0] + x[1]*rx[1] + observationVariance
+			err := float64(an) - (x[0]*state[0] + x[1]*state[1])
+
+			// Kalman gain.
+			gain := [2]float64{rx[0] / variance, rx[1] / variance}
+
+			state[0] += gain[0] * err
+			state[1] += gain[1] * err
+
+			// P = R - K * x' * R
+			for i := 0; i < 2; i++ {
+				for j := 0; j < 2; j++ {
+					p[i][j] = r[i][j] - gain[i]*rx[j]
+				}
+			}
+
+			estimates <- kalmanHedgeEstimate[T]{
+				ratio:     T(state[0]),
+				intercept: T(state[1]),
+			}
+		}
+	}()
+
+	return estimates
+}
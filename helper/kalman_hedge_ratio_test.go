@@ -0,0 +1,64 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestKalmanHedgeRatio(t *testing.T) {
+	a := helper.SliceToChan([]float64{10, 12, 11, 13, 15, 14, 16, 18, 17, 19, 21, 20})
+	b := helper.SliceToChan([]float64{20, 21, 21, 22, 24, 23, 23, 25, 26, 25, 27, 28})
+
+	expectedRatios := helper.SliceToChan([]float64{
+		0.4866, 0.5683, 0.5236, 0.5883, 0.6232, 0.6077, 0.6927, 0.7183, 0.6536, 0.7569, 0.7762, 0.7138,
+	})
+
+	expectedIntercepts := helper.SliceToChan([]float64{
+		0.0243, 0.028, 0.0259, 0.0284, 0.0294, 0.0286, 0.0323, 0.0327, 0.031, 0.0365, 0.0367, 0.0354,
+	})
+
+	ratios, intercepts := helper.KalmanHedgeRatio(a, b, helper.DefaultKalmanHedgeRatioDelta,
+		helper.DefaultKalmanHedgeRatioObservationVariance)
+
+	ratios = helper.RoundDigits(ratios, 4)
+	intercepts = helper.RoundDigits(intercepts, 4)
+
+	err := helper.CheckEquals(ratios, expectedRatios, intercepts, expectedIntercepts)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestKalmanHedgeRatioSingleOutput(t *testing.T) {
+	a := helper.SliceToChan([]float64{10, 12, 11, 13, 15, 14, 16, 18, 17, 19, 21, 20})
+	b := helper.SliceToChan([]float64{20, 21, 21, 22, 24, 23, 23, 25, 26, 25, 27, 28})
+
+	expected := helper.SliceToChan([]float64{
+		0.4866, 0.5683, 0.5236, 0.5883, 0.6232, 0.6077, 0.6927, 0.7183, 0.6536, 0.7569, 0.7762, 0.7138,
+	})
+
+	ratios, intercepts := helper.KalmanHedgeRatio(a, b, helper.DefaultKalmanHedgeRatioDelta,
+		helper.DefaultKalmanHedgeRatioObservationVariance)
+
+	// Only the ratios are used.
+	go helper.Drain(intercepts)
+
+	err := helper.CheckEquals(helper.RoundDigits(ratios, 4), expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The inputs are fully consumed.
+	if _, ok := <-a; ok {
+		t.Fatal("first input not consumed")
+	}
+
+	if _, ok := <-b; ok {
+		t.Fatal("second input not consumed")
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import "context"
+
+// MapWithContext applies the given transformation function to each element in the input channel
+// and returns a new channel containing the transformed values, like Map. It stops when the given
+// context is cancelled, closes the output channel, and drains the input channel.
+//
+// Example:
+//
+//	timesTwo := helper.MapWithContext(ctx, c, func(n int) int {
+//		return n * 2
+//	})
+func MapWithContext[F, T any](ctx context.Context, c <-chan F, f func(F) T) <-chan T {
+	mc := make(chan T)
+
+	go func() {
+		defer Drain(c)
+		defer close(mc)
+
+		for {
+			n, ok := receive(ctx, c)
+			if !ok {
+				return
+			}
+
+			if !send(ctx, mc, f(n)) {
+				return
+			}
+		}
+	}()
+
+	return mc
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestMapWithContext(t *testing.T) {
+	input := helper.SliceToChan([]int{2, 4, 6, 8})
+	expected := helper.SliceToChan([]int{4, 8, 12, 16})
+
+	actual := helper.MapWithContext(context.Background(), input, func(n int) int {
+		return n * 2
+	})
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMapWithContextCancel(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	actual := helper.MapWithContext(ctx, endless(ctx, 1), func(n int) int {
+		return n * 2
+	})
+
+	<-actual
+	cancel()
+
+	err := helper.CheckGoroutines(before, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import "strconv"
+
+// ReportMarker is a trade marker drawn on a report chart.
+type ReportMarker int
+
+const (
+	// ReportMarkerNone indicates no marker.
+	ReportMarkerNone ReportMarker = 0
+
+	// ReportMarkerBuy is the buy marker, drawn as an upward triangle below the bar.
+	ReportMarkerBuy ReportMarker = 1
+
+	// ReportMarkerSell is the sell marker, drawn as a downward triangle above the bar.
+	ReportMarkerSell ReportMarker = -1
+)
+
+// markerReportColumn is the marker report column struct.
+type markerReportColumn struct {
+	ReportColumn
+	markers <-chan ReportMarker
+}
+
+// NewMarkerReportColumn returns a new instance of a marker column for a report. The markers are
+// drawn on the data column added before them to the same chart.
+func NewMarkerReportColumn(markers <-chan ReportMarker) ReportColumn {
+	return &markerReportColumn{
+		markers: markers,
+	}
+}
+
+// Name returns the name of the report column.
+func (*markerReportColumn) Name() string {
+	return ""
+}
+
+// Type returns number as the data type.
+func (*markerReportColumn) Type() string {
+	return "number"
+}
+
+// Role returns the role of the report column.
+func (*markerReportColumn) Role() string {
+	return "marker"
+}
+
+// Value returns the next data value for the report column.
+func (c *markerReportColumn) Value() string {
+	marker := <-c.markers
+
+	if marker != ReportMarkerNone {
+		return strconv.Itoa(int(marker))
+	}
+
+	return "null"
+}
@@ -0,0 +1,23 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+// MultiplyDecimal takes two channels of decimals and multiplies the values
+// from the first channel with the values from the second channel, as in
+// the Decimal Mul method. It returns a new channel containing the results
+// of the multiplication. The decimals can be added and subtracted with the
+// Add and Subtract functions directly, as they are amounts.
+//
+// Example:
+//
+//	ac := helper.SliceToChan([]helper.Decimal{helper.NewDecimal(1.5), helper.NewDecimal(0.25)})
+//	bc := helper.SliceToChan([]helper.Decimal{helper.NewDecimal(2), helper.NewDecimal(4)})
+//
+//	multiplication := helper.MultiplyDecimal(ac, bc)
+//
+//	fmt.Println(helper.ChanToSlice(multiplication)) // [3, 1]
+func MultiplyDecimal(ac, bc <-chan Decimal) <-chan Decimal {
+	return Operate(ac, bc, Decimal.Mul)
+}
@@ -4,7 +4,10 @@
 
 package helper
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+)
 
 // numericReportColumn is the number report column struct.
 type numericReportColumn[T Number] struct {
@@ -38,5 +41,16 @@ func (*numericReportColumn[T]) Role() string {
 
 // Value returns the next data value for the report column.
 func (c *numericReportColumn[T]) Value() string {
-	return fmt.Sprintf("%v", <-c.values)
+	return reportNumber(<-c.values)
+}
+
+// reportNumber returns the given value as a script literal, with the values that are not finite
+// as null.
+func reportNumber[T Number](value T) string {
+	f := float64(value)
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return "null"
+	}
+
+	return fmt.Sprintf("%v", value)
 }
@@ -0,0 +1,54 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import "context"
+
+// Operate3WithContext applies the provided operate function to corresponding values from three
+// input channels and sends the resulting values to an output channel, like Operate3. It stops when
+// the given context is cancelled, closes the output channel, and drains the input channels.
+//
+// Example:
+//
+//	add := helper.Operate3WithContext(ctx, ac, bc, cc, func(a, b, c int) int {
+//	  return a + b + c
+//	})
+func Operate3WithContext[A any, B any, C any, R any](ctx context.Context, ac <-chan A, bc <-chan B, cc <-chan C, o func(A, B, C) R) <-chan R {
+	rc := make(chan R)
+
+	go func() {
+		// The inputs may be fed in lockstep, so they are drained concurrently.
+		defer func() {
+			go Drain(ac)
+			go Drain(bc)
+			go Drain(cc)
+		}()
+
+		defer close(rc)
+
+		for {
+			an, ok := receive(ctx, ac)
+			if !ok {
+				return
+			}
+
+			bn, ok := receive(ctx, bc)
+			if !ok {
+				return
+			}
+
+			cn, ok := receive(ctx, cc)
+			if !ok {
+				return
+			}
+
+			if !send(ctx, rc, o(an, bn, cn)) {
+				return
+			}
+		}
+	}()
+
+	return rc
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestOperate3WithContext(t *testing.T) {
+	ac := helper.SliceToChan([]int{2, 4, 6, 8})
+	bc := helper.SliceToChan([]int{1, 2, 3, 4})
+	cc := helper.SliceToChan([]int{1, 1, 1, 1})
+	expected := helper.SliceToChan([]int{4, 7, 10, 13})
+
+	actual := helper.Operate3WithContext(context.Background(), ac, bc, cc, func(a, b, c int) int {
+		return a + b + c
+	})
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOperate3WithContextCancel(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// The inputs are fed in lockstep.
+	inputs := helper.Duplicate(helper.SliceToChan(make([]int, 1000)), 3)
+
+	actual := helper.Operate3WithContext(ctx, inputs[0], inputs[1], inputs[2], func(a, b, c int) int {
+		return a + b + c
+	})
+
+	<-actual
+	cancel()
+
+	err := helper.CheckGoroutines(before, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestOperate4(t *testing.T) {
+	ac := helper.SliceToChan([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+	bc := helper.SliceToChan([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+	cc := helper.SliceToChan([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+	dc := helper.SliceToChan([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+
+	expected := helper.SliceToChan([]int{4, 8, 12, 16, 20, 24, 28, 32, 36, 40})
+
+	actual := helper.Operate4(ac, bc, cc, dc, func(a, b, c, d int) int {
+		return a + b + c + d
+	})
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOperate4FourthEnds(t *testing.T) {
+	ac := helper.SliceToChan([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+	bc := helper.SliceToChan([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+	cc := helper.SliceToChan([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+	dc := helper.SliceToChan([]int{1, 2, 3, 4, 5, 6, 7, 8})
+
+	expected := helper.SliceToChan([]int{4, 8, 12, 16, 20, 24, 28, 32})
+
+	actual := helper.Operate4(ac, bc, cc, dc, func(a, b, c, d int) int {
+		return a + b + c + d
+	})
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+// OperateWindow applies the provided operate function to the windows of the last period values from two
+// input channels, and sends the resulting values to an output channel. The windows are ordered from the
+// oldest value to the newest value, and they are reused between the calls. The first result is sent once
+// both windows are full.
+//
+// Example:
+//
+//	spreads := helper.OperateWindow(ac, bc, 3, func(as, bs []float64) float64 {
+//	  return as[2] - bs[2]
+//	})
+func OperateWindow[A any, B any, R any](ac <-chan A, bc <-chan B, period int, o func([]A, []B) R) <-chan R {
+	oc := make(chan R)
+
+	go func() {
+		defer close(oc)
+
+		as := make([]A, 0, period)
+		bs := make([]B, 0, period)
+
+		for {
+			an, ok := <-ac
+			if !ok {
+				Drain(bc)
+				break
+			}
+
+			bn, ok := <-bc
+			if !ok {
+				Drain(ac)
+				break
+			}
+
+			if len(as) == period {
+				as = append(as[:0], as[1:]...)
+				bs = append(bs[:0], bs[1:]...)
+			}
+
+			as = append(as, an)
+			bs = append(bs, bn)
+
+			if len(as) == period {
+				oc <- o(as, bs)
+			}
+		}
+	}()
+
+	return oc
+}
@@ -0,0 +1,31 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestOperateWindow(t *testing.T) {
+	a := helper.SliceToChan([]int{1, 2, 3, 4, 5})
+	b := helper.SliceToChan([]int{5, 4, 3, 2, 1, 0})
+	expected := helper.SliceToChan([]int{18, 18, 18})
+
+	actual := helper.OperateWindow(a, b, 3, func(as, bs []int) int {
+		sum := 0
+		for i := range as {
+			sum += as[i] + bs[i]
+		}
+
+		return sum
+	})
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import "context"
+
+// OperateWithContext applies the provided operate function to corresponding values from two input
+// channels and sends the resulting values to an output channel, like Operate. It stops when the
+// given context is cancelled, closes the output channel, and drains the input channels.
+//
+// Example:
+//
+//	add := helper.OperateWithContext(ctx, ac, bc, func(a, b int) int {
+//	  return a + b
+//	})
+func OperateWithContext[A any, B any, R any](ctx context.Context, ac <-chan A, bc <-chan B, o func(A, B) R) <-chan R {
+	oc := make(chan R)
+
+	go func() {
+		// The inputs may be fed in lockstep, so they are drained concurrently.
+		defer func() {
+			go Drain(ac)
+			go Drain(bc)
+		}()
+
+		defer close(oc)
+
+		for {
+			an, ok := receive(ctx, ac)
+			if !ok {
+				return
+			}
+
+			bn, ok := receive(ctx, bc)
+			if !ok {
+				return
+			}
+
+			if !send(ctx, oc, o(an, bn)) {
+				return
+			}
+		}
+	}()
+
+	return oc
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestOperateWithContext(t *testing.T) {
+	ac := helper.SliceToChan([]int{2, 4, 6, 8})
+	bc := helper.SliceToChan([]int{1, 2, 3, 4})
+	expected := helper.SliceToChan([]int{3, 6, 9, 12})
+
+	actual := helper.OperateWithContext(context.Background(), ac, bc, func(a, b int) int {
+		return a + b
+	})
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestOperateWithContextCancel(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// The inputs are fed in lockstep.
+	inputs := helper.Duplicate(helper.SliceToChan(make([]int, 1000)), 2)
+
+	actual := helper.OperateWithContext(ctx, inputs[0], inputs[1], func(a, b int) int {
+		return a + b
+	})
+
+	<-actual
+	cancel()
+
+	err := helper.CheckGoroutines(before, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
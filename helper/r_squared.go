@@ -0,0 +1,19 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+// RSquared calculates the rolling coefficient of determination (R²) of the linear regression between
+// the values from two input channels over the given period. It is the square of their Pearson
+// correlation, and it measures how much of the variance of one is explained by the other.
+//
+// Example:
+//
+//	rSquareds := helper.RSquared(ac, bc, 20)
+func RSquared[T Number](ac, bc <-chan T, period int) <-chan T {
+	return OperateWindow(ac, bc, period, func(as, bs []T) T {
+		r := correlation(as, bs)
+		return T(r * r)
+	})
+}
@@ -27,6 +27,10 @@ var kindToBits = map[reflect.Kind]int{
 	reflect.Float64: 64,
 }
 
+// decimalType is the reflect type of the decimal, which is parsed from its text form rather than as
+// an integer.
+var decimalType = reflect.TypeOf(Decimal(0))
+
 // setReflectValueFromBool assigns the parsed boolean value to the specified variable.
 func setReflectValueFromBool(value reflect.Value, stringValue string) error {
 	actualValue, err := strconv.ParseBool(stringValue)
@@ -79,6 +83,10 @@ func setReflectValueFromTime(value reflect.Value, stringValue, format string) er
 
 // setReflectValue assigns the parsed value to the specified variable.
 func setReflectValue(value reflect.Value, stringValue, format string) error {
+	if value.Type() == decimalType {
+		return value.Addr().Interface().(*Decimal).UnmarshalText([]byte(stringValue))
+	}
+
 	kind := value.Kind()
 
 	switch kind {
@@ -116,6 +124,10 @@ func setReflectValue(value reflect.Value, stringValue, format string) error {
 
 // getReflectValue returns the string representation of the given value.
 func getReflectValue(value reflect.Value, format string) (string, error) {
+	if value.Type() == decimalType {
+		return value.Interface().(Decimal).String(), nil
+	}
+
 	kind := value.Kind()
 
 	switch kind {
@@ -300,6 +300,31 @@ func TestSetReflectValueFromNotUint64(t *testing.T) {
 	}
 }
 
+func TestSetReflectValueFromDecimal(t *testing.T) {
+	actual := Decimal(0)
+	value := reflect.ValueOf(&actual).Elem()
+	expected := Decimal(1025000000)
+
+	err := setReflectValue(value, "10.25", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestSetReflectValueFromNotDecimal(t *testing.T) {
+	actual := Decimal(0)
+	value := reflect.ValueOf(&actual).Elem()
+
+	err := setReflectValue(value, "abcd", "")
+	if err == nil {
+		t.Fatalf("actual %v expected error", actual)
+	}
+}
+
 func TestSetReflectValueFromFloat32(t *testing.T) {
 	actual := float32(0)
 	value := reflect.ValueOf(&actual).Elem()
@@ -606,6 +631,21 @@ func TestGetReflectValueFromFloat64(t *testing.T) {
 	}
 }
 
+func TestGetReflectValueFromDecimal(t *testing.T) {
+	input := NewDecimal(10.25)
+	value := reflect.ValueOf(&input).Elem()
+	expected := "10.25"
+
+	actual, err := getReflectValue(value, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
 func TestGetReflectValueFromTime(t *testing.T) {
 	input := time.Date(2023, 11, 28, 19, 14, 0, 0, time.UTC)
 	value := reflect.ValueOf(&input).Elem()
@@ -0,0 +1,39 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+// RelativeStrength calculates the Mansfield relative strength of the values from the first input channel
+// versus the values from the second input channel, such as the closings of an asset versus the closings of
+// a benchmark, over the given period. It compares the ratio of the values with its moving average, and it
+// is positive when the asset outperforms the benchmark. The relative strength is zero when the benchmark
+// is zero.
+//
+//	Ratio = A / B
+//	Relative Strength = 100 * (Ratio / SMA(Ratio, Period) - 1)
+//
+// Example:
+//
+//	relativeStrengths := helper.RelativeStrength(assetClosings, benchmarkClosings, 52)
+func RelativeStrength[T Number](ac, bc <-chan T, period int) <-chan T {
+	return OperateWindow(ac, bc, period, func(as, bs []T) T {
+		sum := 0.0
+		for i := range as {
+			if bs[i] == 0 {
+				return 0
+			}
+
+			sum += float64(as[i]) / float64(bs[i])
+		}
+
+		average := sum / float64(period)
+		ratio := float64(as[period-1]) / float64(bs[period-1])
+
+		if average == 0 {
+			return 0
+		}
+
+		return T(100 * (ratio/average - 1))
+	})
+}
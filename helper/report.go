@@ -17,6 +17,15 @@ import (
 //go:embed "report.tmpl"
 var reportTmpl string
 
+//go:embed "report_self_contained.tmpl"
+var reportSelfContainedTmpl string
+
+//go:embed "report.css"
+var reportCSS string
+
+//go:embed "report.js"
+var reportJS string
+
 const (
 	// DefaultReportDateFormat is the default date format used in the report.
 	DefaultReportDateFormat = "2006-01-02"
@@ -39,19 +48,47 @@ type ReportColumn interface {
 	Value() string
 }
 
+// ReportHistogramBin is a single bin of a report histogram.
+type ReportHistogramBin struct {
+	// Label is the label of the bin, such as its price.
+	Label string
+
+	// Value is the value of the bin, such as its volume.
+	Value float64
+}
+
+// ReportHistogram is a horizontal histogram drawn below the charts of the report, such as a
+// volume profile. The bins are drawn from top to bottom in the order they are received.
+type ReportHistogram struct {
+	// Title is the title of the histogram.
+	Title string
+
+	// Bins is the bins of the histogram.
+	Bins <-chan ReportHistogramBin
+}
+
 // Report generates an HTML file containing an interactive chart that
 // visually represents the provided data and annotations.
 //
 // The generated HTML file can be opened in a web browser to explore
 // the data visually, interact with the chart elements, and view
-// the associated annotations.
+// the associated annotations. By default, it loads its styles and
+// the Google Charts library over the network. If SelfContained is
+// set, it embeds its styles and scripts instead, so it renders
+// without any network access.
+//
+// Besides the numeric columns drawn as lines, the report supports
+// the candlestick and the volume columns, and the marker and the
+// shading columns to show the trades.
 type Report struct {
-	Title       string
-	Date        <-chan time.Time
-	Columns     []ReportColumn
-	Views       [][]int
-	DateFormat  string
-	GeneratedOn string
+	Title         string
+	Date          <-chan time.Time
+	Columns       []ReportColumn
+	Views         [][]int
+	Histograms    []*ReportHistogram
+	DateFormat    string
+	GeneratedOn   string
+	SelfContained bool
 }
 
 // NewReport takes a channel of time as the time axis and returns a new
@@ -65,6 +102,7 @@ func NewReport(title string, date <-chan time.Time) *Report {
 		Views: [][]int{
 			{},
 		},
+		Histograms:  []*ReportHistogram{},
 		DateFormat:  DefaultReportDateFormat,
 		GeneratedOn: time.Now().String(),
 	}
@@ -93,11 +131,29 @@ func (r *Report) AddColumn(column ReportColumn, charts ...int) {
 	}
 }
 
+// AddHistogram adds a new horizontal histogram to the report. The bins are read only after all
+// the rows of the report are read, therefore they can be derived from the same data streams,
+// such as the final state of an indicator.
+func (r *Report) AddHistogram(title string, bins <-chan ReportHistogramBin) {
+	r.Histograms = append(r.Histograms, &ReportHistogram{
+		Title: title,
+		Bins:  bins,
+	})
+}
+
 // WriteToWriter writes the report content to the provided io.Writer.
 // This allows the report to be sent to various destinations, such
 // as a file, a network socket, or even the standard output.
 func (r *Report) WriteToWriter(writer io.Writer) error {
-	tmpl, err := template.New("report").Parse(reportTmpl)
+	text := reportTmpl
+	if r.SelfContained {
+		text = reportSelfContainedTmpl
+	}
+
+	tmpl, err := template.New("report").Funcs(template.FuncMap{
+		"style":  ReportStyle,
+		"script": reportScript,
+	}).Parse(text)
 	if err != nil {
 		return err
 	}
@@ -121,3 +177,14 @@ func (r *Report) WriteToFile(fileName string) error {
 
 	return file.Close()
 }
+
+// ReportStyle returns the CSS styles of the self-contained reports, so that the other HTML reports
+// can share them without loading any assets over the network.
+func ReportStyle() string {
+	return reportCSS
+}
+
+// reportScript returns the self-contained script that draws the charts of the report.
+func reportScript() string {
+	return reportJS
+}
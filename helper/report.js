@@ -0,0 +1,679 @@
+/*
+ * Copyright (c) 2021-2024 Onur Cinar.
+ * The source code is provided under GNU AGPLv3 License.
+ * https://github.com/cinar/indicator
+ *
+ * Self-contained report renderer. It draws the report data defined in the report variable on
+ * canvases, without loading any assets over the network.
+ *
+ * The columns with the data role are drawn as lines, or as candlesticks when their type is
+ * candlestick, and the columns with the volume role are drawn as bars. The annotation and the
+ * marker columns are drawn on the data column preceding them in the view, and the shading
+ * columns shade the bars where their values are true.
+ */
+(function () {
+    "use strict";
+
+    var COLORS = [
+        "#3366cc", "#dc3912", "#ff9900", "#109618", "#990099",
+        "#0099c6", "#dd4477", "#66aa00", "#b82e2e", "#316395",
+    ];
+
+    var UP_COLOR = "#48c78e";
+    var DOWN_COLOR = "#f14668";
+    var SHADING_COLOR = "rgba(72, 199, 142, 0.15)";
+    var GRID_COLOR = "#ededed";
+    var TEXT_COLOR = "#7a7a7a";
+    var CROSSHAIR_COLOR = "#b5b5b5";
+    var FONT = "11px sans-serif";
+    var PADDING = { top: 12, right: 64, bottom: 24, left: 8 };
+
+    var rows = report.rows;
+    var range = { start: 0, end: rows.length - 1 };
+    var hover = -1;
+    var charts = [];
+
+    function isNumber(value) {
+        return typeof value === "number" && isFinite(value);
+    }
+
+    function isCandlestick(value) {
+        return Array.isArray(value) && value.length === 4 && value.every(isNumber);
+    }
+
+    // formatNumber formats the given number for the axis and the tooltip.
+    function formatNumber(value) {
+        if (!isNumber(value)) {
+            return "";
+        }
+
+        var abs = Math.abs(value);
+        if (abs >= 1e9) {
+            return (value / 1e9).toFixed(2) + "B";
+        }
+
+        if (abs >= 1e6) {
+            return (value / 1e6).toFixed(2) + "M";
+        }
+
+        if (abs >= 1e4) {
+            return (value / 1e3).toFixed(1) + "K";
+        }
+
+        return String(Math.round(value * 100) / 100);
+    }
+
+    // niceStep returns a round step size close to the given raw step size.
+    function niceStep(step) {
+        var magnitude = Math.pow(10, Math.floor(Math.log10(step)));
+        var ratio = step / magnitude;
+
+        if (ratio >= 5) {
+            return 5 * magnitude;
+        }
+
+        if (ratio >= 2) {
+            return 2 * magnitude;
+        }
+
+        return magnitude;
+    }
+
+    // buildSeries returns the drawable series of the given view, attaching the annotations and the
+    // markers to the data series preceding them.
+    function buildSeries(view) {
+        var series = [];
+        var shadings = [];
+        var last = null;
+
+        view.forEach(function (id) {
+            var column = report.columns[id];
+
+            if (column.role === "annotation" || column.role === "marker") {
+                if (last !== null) {
+                    last[column.role + "s"].push(id);
+                }
+
+                return;
+            }
+
+            if (column.role === "shading") {
+                shadings.push(id);
+                return;
+            }
+
+            last = {
+                id: id,
+                name: column.name,
+                type: column.type,
+                role: column.role,
+                color: COLORS[(id - 1) % COLORS.length],
+                annotations: [],
+                markers: [],
+            };
+
+            series.push(last);
+        });
+
+        return { series: series, shadings: shadings };
+    }
+
+    // low returns the lowest value of the series at the given row.
+    function low(series, row) {
+        var value = rows[row][series.id];
+
+        if (series.role === "volume") {
+            return isNumber(value) ? Math.min(0, value) : null;
+        }
+
+        if (series.type === "candlestick") {
+            return isCandlestick(value) ? value[2] : null;
+        }
+
+        return isNumber(value) ? value : null;
+    }
+
+    // high returns the highest value of the series at the given row.
+    function high(series, row) {
+        var value = rows[row][series.id];
+
+        if (series.role === "volume") {
+            return isNumber(value) ? Math.max(0, value) : null;
+        }
+
+        if (series.type === "candlestick") {
+            return isCandlestick(value) ? value[1] : null;
+        }
+
+        return isNumber(value) ? value : null;
+    }
+
+    // point returns the value of the series at the given row that the annotations and the markers
+    // are drawn at.
+    function point(series, row, above) {
+        return above ? high(series, row) : low(series, row);
+    }
+
+    // Chart draws a single view of the report.
+    function Chart(element, view) {
+        var built = buildSeries(view);
+
+        this.element = element;
+        this.series = built.series;
+        this.shadings = built.shadings;
+        this.height = Number(element.getAttribute("data-height"));
+
+        var legend = document.createElement("div");
+        legend.className = "legend";
+
+        this.series.forEach(function (series) {
+            if (series.name === "") {
+                return;
+            }
+
+            var item = document.createElement("span");
+            item.textContent = series.name;
+            item.style.setProperty("--color", series.type === "candlestick" ? UP_COLOR : series.color);
+            legend.appendChild(item);
+        });
+
+        this.canvas = document.createElement("canvas");
+        this.tooltip = document.createElement("div");
+        this.tooltip.className = "tooltip";
+        this.tooltip.style.display = "none";
+
+        element.appendChild(legend);
+        element.appendChild(this.canvas);
+        element.appendChild(this.tooltip);
+
+        this.canvas.addEventListener("mousemove", this.onMouseMove.bind(this));
+        this.canvas.addEventListener("mouseleave", this.onMouseLeave.bind(this));
+    }
+
+    // layout computes the size of the canvas and the plot area.
+    Chart.prototype.layout = function () {
+        var ratio = window.devicePixelRatio || 1;
+
+        this.width = this.canvas.clientWidth;
+        this.canvas.width = Math.round(this.width * ratio);
+        this.canvas.height = Math.round(this.height * ratio);
+        this.canvas.style.height = this.height + "px";
+
+        this.context = this.canvas.getContext("2d");
+        this.context.setTransform(ratio, 0, 0, ratio, 0, 0);
+
+        this.plot = {
+            left: PADDING.left,
+            top: PADDING.top,
+            width: Math.max(1, this.width - PADDING.left - PADDING.right),
+            height: Math.max(1, this.height - PADDING.top - PADDING.bottom),
+        };
+    };
+
+    // x returns the horizontal position of the given row.
+    Chart.prototype.x = function (row) {
+        return this.plot.left + (row - range.start + 0.5) * this.step;
+    };
+
+    // y returns the vertical position of the given value.
+    Chart.prototype.y = function (value) {
+        return this.plot.top + (this.max - value) / (this.max - this.min) * this.plot.height;
+    };
+
+    // row returns the row at the given horizontal position.
+    Chart.prototype.row = function (x) {
+        var row = range.start + Math.floor((x - this.plot.left) / this.step);
+        return Math.max(range.start, Math.min(range.end, row));
+    };
+
+    // scale computes the value range of the visible rows.
+    Chart.prototype.scale = function () {
+        var min = Infinity;
+        var max = -Infinity;
+
+        for (var row = range.start; row <= range.end; row++) {
+            this.series.forEach(function (series) {
+                var l = low(series, row);
+                var h = high(series, row);
+
+                if (l !== null) {
+                    min = Math.min(min, l);
+                }
+
+                if (h !== null) {
+                    max = Math.max(max, h);
+                }
+            });
+        }
+
+        if (!isFinite(min) || !isFinite(max)) {
+            min = 0;
+            max = 1;
+        }
+
+        if (min === max) {
+            min -= 1;
+            max += 1;
+        }
+
+        var margin = (max - min) * 0.05;
+
+        this.min = min - margin;
+        this.max = max + margin;
+        this.step = this.plot.width / (range.end - range.start + 1);
+    };
+
+    Chart.prototype.draw = function () {
+        this.layout();
+        this.scale();
+
+        var context = this.context;
+        context.clearRect(0, 0, this.width, this.height);
+
+        this.drawShadings();
+        this.drawGrid();
+
+        this.series.forEach(function (series) {
+            if (series.role === "volume") {
+                this.drawBars(series);
+            } else if (series.type === "candlestick") {
+                this.drawCandlesticks(series);
+            } else {
+                this.drawLine(series);
+            }
+        }, this);
+
+        this.series.forEach(function (series) {
+            this.drawAnnotations(series);
+            this.drawMarkers(series);
+        }, this);
+
+        this.drawCrosshair();
+    };
+
+    Chart.prototype.drawShadings = function () {
+        var context = this.context;
+        context.fillStyle = SHADING_COLOR;
+
+        this.shadings.forEach(function (id) {
+            var begin = -1;
+
+            for (var row = range.start; row <= range.end + 1; row++) {
+                var shaded = row <= range.end && rows[row][id] === true;
+
+                if (shaded && begin === -1) {
+                    begin = row;
+                } else if (!shaded && begin !== -1) {
+                    var left = this.x(begin) - this.step / 2;
+                    context.fillRect(left, this.plot.top, (row - begin) * this.step, this.plot.height);
+                    begin = -1;
+                }
+            }
+        }, this);
+    };
+
+    Chart.prototype.drawGrid = function () {
+        var context = this.context;
+        var step = niceStep((this.max - this.min) / 5);
+
+        context.font = FONT;
+        context.lineWidth = 1;
+        context.strokeStyle = GRID_COLOR;
+        context.fillStyle = TEXT_COLOR;
+        context.textAlign = "left";
+        context.textBaseline = "middle";
+
+        for (var value = Math.ceil(this.min / step) * step; value <= this.max; value += step) {
+            var y = Math.round(this.y(value)) + 0.5;
+
+            context.beginPath();
+            context.moveTo(this.plot.left, y);
+            context.lineTo(this.plot.left + this.plot.width, y);
+            context.stroke();
+
+            context.fillText(formatNumber(value), this.plot.left + this.plot.width + 6, y);
+        }
+
+        var count = range.end - range.start + 1;
+        var every = Math.max(1, Math.ceil(count / Math.max(1, Math.floor(this.plot.width / 100))));
+
+        context.textAlign = "center";
+        context.textBaseline = "top";
+
+        for (var row = range.start; row <= range.end; row += every) {
+            context.fillText(rows[row][0], this.x(row), this.plot.top + this.plot.height + 6);
+        }
+    };
+
+    Chart.prototype.drawLine = function (series) {
+        var context = this.context;
+        var drawing = false;
+
+        context.lineWidth = 1.5;
+        context.strokeStyle = series.color;
+        context.beginPath();
+
+        for (var row = range.start; row <= range.end; row++) {
+            var value = rows[row][series.id];
+
+            if (!isNumber(value)) {
+                drawing = false;
+                continue;
+            }
+
+            if (drawing) {
+                context.lineTo(this.x(row), this.y(value));
+            } else {
+                context.moveTo(this.x(row), this.y(value));
+                drawing = true;
+            }
+        }
+
+        context.stroke();
+    };
+
+    Chart.prototype.drawCandlesticks = function (series) {
+        var context = this.context;
+        var width = Math.max(1, this.step * 0.7);
+
+        context.lineWidth = 1;
+
+        for (var row = range.start; row <= range.end; row++) {
+            var value = rows[row][series.id];
+            if (!isCandlestick(value)) {
+                continue;
+            }
+
+            var x = this.x(row);
+            var open = this.y(value[0]);
+            var close = this.y(value[3]);
+            var color = value[3] >= value[0] ? UP_COLOR : DOWN_COLOR;
+
+            context.strokeStyle = color;
+            context.fillStyle = color;
+
+            context.beginPath();
+            context.moveTo(Math.round(x) + 0.5, this.y(value[1]));
+            context.lineTo(Math.round(x) + 0.5, this.y(value[2]));
+            context.stroke();
+
+            context.fillRect(x - width / 2, Math.min(open, close), width, Math.max(1, Math.abs(close - open)));
+        }
+    };
+
+    Chart.prototype.drawBars = function (series) {
+        var context = this.context;
+        var width = Math.max(1, this.step * 0.7);
+        var zero = this.y(0);
+
+        context.fillStyle = series.color;
+        context.globalAlpha = 0.6;
+
+        for (var row = range.start; row <= range.end; row++) {
+            var value = rows[row][series.id];
+            if (!isNumber(value)) {
+                continue;
+            }
+
+            var y = this.y(value);
+            context.fillRect(this.x(row) - width / 2, Math.min(y, zero), width, Math.max(1, Math.abs(zero - y)));
+        }
+
+        context.globalAlpha = 1;
+    };
+
+    Chart.prototype.drawAnnotations = function (series) {
+        var context = this.context;
+
+        context.font = FONT;
+        context.fillStyle = series.color;
+        context.textAlign = "center";
+        context.textBaseline = "bottom";
+
+        series.annotations.forEach(function (id) {
+            for (var row = range.start; row <= range.end; row++) {
+                var text = rows[row][id];
+                var value = point(series, row, true);
+
+                if (typeof text === "string" && text !== "" && value !== null) {
+                    context.fillText(text, this.x(row), this.y(value) - 4);
+                }
+            }
+        }, this);
+    };
+
+    Chart.prototype.drawMarkers = function (series) {
+        var context = this.context;
+        var size = Math.max(4, Math.min(8, this.step * 0.8));
+
+        series.markers.forEach(function (id) {
+            for (var row = range.start; row <= range.end; row++) {
+                var marker = rows[row][id];
+                if (marker !== 1 && marker !== -1) {
+                    continue;
+                }
+
+                // The buy markers point up below the bar, and the sell markers point down above it.
+                var buy = marker === 1;
+                var value = point(series, row, !buy);
+                if (value === null) {
+                    continue;
+                }
+
+                var x = this.x(row);
+                var tip = this.y(value) + (buy ? 4 : -4);
+                var base = buy ? tip + size * 1.5 : tip - size * 1.5;
+
+                context.fillStyle = buy ? UP_COLOR : DOWN_COLOR;
+                context.beginPath();
+                context.moveTo(x, tip);
+                context.lineTo(x - size, base);
+                context.lineTo(x + size, base);
+                context.closePath();
+                context.fill();
+            }
+        }, this);
+    };
+
+    Chart.prototype.drawCrosshair = function () {
+        if (hover < range.start || hover > range.end) {
+            return;
+        }
+
+        var context = this.context;
+        var x = Math.round(this.x(hover)) + 0.5;
+
+        context.lineWidth = 1;
+        context.strokeStyle = CROSSHAIR_COLOR;
+        context.beginPath();
+        context.moveTo(x, this.plot.top);
+        context.lineTo(x, this.plot.top + this.plot.height);
+        context.stroke();
+    };
+
+    Chart.prototype.onMouseMove = function (event) {
+        var bounds = this.canvas.getBoundingClientRect();
+        var x = event.clientX - bounds.left;
+
+        hover = this.row(x);
+        drawAll();
+
+        var lines = [rows[hover][0]];
+
+        this.series.forEach(function (series) {
+            var value = rows[hover][series.id];
+            var text;
+
+            if (series.type === "candlestick") {
+                text = isCandlestick(value) ?
+                    "O " + formatNumber(value[0]) + " H " + formatNumber(value[1]) +
+                    " L " + formatNumber(value[2]) + " C " + formatNumber(value[3]) : "";
+            } else {
+                text = formatNumber(value);
+            }
+
+            if (text !== "") {
+                lines.push((series.name !== "" ? series.name + ": " : "") + text);
+            }
+        });
+
+        this.tooltip.textContent = "";
+
+        lines.forEach(function (line) {
+            var div = document.createElement("div");
+            div.textContent = line;
+            this.tooltip.appendChild(div);
+        }, this);
+
+        this.tooltip.style.display = "block";
+
+        var left = x + 12;
+        if (left + this.tooltip.offsetWidth > this.width) {
+            left = x - 12 - this.tooltip.offsetWidth;
+        }
+
+        this.tooltip.style.left = Math.max(0, left) + "px";
+        this.tooltip.style.top = (this.canvas.offsetTop + PADDING.top) + "px";
+    };
+
+    Chart.prototype.onMouseLeave = function () {
+        hover = -1;
+        this.tooltip.style.display = "none";
+        drawAll();
+    };
+
+    // drawHistogram draws the given histogram as horizontal bars.
+    function drawHistogram(element, histogram) {
+        var title = document.createElement("div");
+        title.className = "legend";
+        title.textContent = histogram.title;
+
+        var canvas = document.createElement("canvas");
+        element.appendChild(title);
+        element.appendChild(canvas);
+
+        var barHeight = 16;
+        var height = Math.max(200, histogram.bins.length * barHeight + PADDING.top + PADDING.bottom);
+
+        return function () {
+            var ratio = window.devicePixelRatio || 1;
+            var width = canvas.clientWidth;
+
+            canvas.width = Math.round(width * ratio);
+            canvas.height = Math.round(height * ratio);
+            canvas.style.height = height + "px";
+
+            var context = canvas.getContext("2d");
+            context.setTransform(ratio, 0, 0, ratio, 0, 0);
+            context.font = FONT;
+
+            var labelWidth = 0;
+            var max = 0;
+
+            histogram.bins.forEach(function (bin) {
+                labelWidth = Math.max(labelWidth, context.measureText(bin[0]).width);
+                max = Math.max(max, bin[1]);
+            });
+
+            var left = PADDING.left + labelWidth + 8;
+            var plotWidth = Math.max(1, width - left - PADDING.right);
+            var rowHeight = (height - PADDING.top - PADDING.bottom) / Math.max(1, histogram.bins.length);
+
+            context.textBaseline = "middle";
+
+            histogram.bins.forEach(function (bin, i) {
+                var y = PADDING.top + i * rowHeight;
+
+                context.fillStyle = TEXT_COLOR;
+                context.textAlign = "right";
+                context.fillText(bin[0], left - 8, y + rowHeight / 2);
+
+                context.fillStyle = COLORS[0];
+                context.fillRect(left, y + rowHeight * 0.1, max > 0 ? bin[1] / max * plotWidth : 0, rowHeight * 0.8);
+
+                context.fillStyle = TEXT_COLOR;
+                context.textAlign = "left";
+                context.fillText(formatNumber(bin[1]), left + (max > 0 ? bin[1] / max * plotWidth : 0) + 4, y + rowHeight / 2);
+            });
+        };
+    }
+
+    // buildRange adds the range controls that select the visible rows of all the charts.
+    function buildRange(element) {
+        if (element === null || rows.length < 2) {
+            return;
+        }
+
+        var inputs = [range.start, range.end].map(function (value) {
+            var input = document.createElement("input");
+            input.type = "range";
+            input.min = 0;
+            input.max = rows.length - 1;
+            input.value = value;
+            element.appendChild(input);
+
+            return input;
+        });
+
+        function update() {
+            var a = Number(inputs[0].value);
+            var b = Number(inputs[1].value);
+
+            range.start = Math.min(a, b);
+            range.end = Math.max(a, b);
+
+            if (range.start === range.end) {
+                if (range.end < rows.length - 1) {
+                    range.end++;
+                } else {
+                    range.start--;
+                }
+            }
+
+            drawAll();
+        }
+
+        inputs.forEach(function (input) {
+            input.addEventListener("input", update);
+        });
+    }
+
+    var histograms = [];
+
+    function drawAll() {
+        charts.forEach(function (chart) {
+            chart.draw();
+        });
+    }
+
+    function init() {
+        if (rows.length > 0) {
+            report.views.forEach(function (view, i) {
+                charts.push(new Chart(document.getElementById("chart" + i), view));
+            });
+
+            buildRange(document.getElementById("controls"));
+        }
+
+        report.histograms.forEach(function (histogram, i) {
+            histograms.push(drawHistogram(document.getElementById("histogram" + i), histogram));
+        });
+
+        function redraw() {
+            drawAll();
+
+            histograms.forEach(function (draw) {
+                draw();
+            });
+        }
+
+        window.addEventListener("resize", redraw);
+        redraw();
+    }
+
+    if (document.readyState === "loading") {
+        document.addEventListener("DOMContentLoaded", init);
+    } else {
+        init();
+    }
+})();
@@ -5,6 +5,8 @@
 package helper_test
 
 import (
+	"math"
+	"strings"
 	"testing"
 	"time"
 
@@ -40,6 +42,11 @@ func TestReportWriteToFile(t *testing.T) {
 	report.AddColumn(helper.NewNumericReportColumn("Close", closes), 0, 1)
 	report.AddColumn(helper.NewAnnotationReportColumn(annotations), 0, 1)
 
+	report.AddHistogram("Histogram", helper.SliceToChan([]helper.ReportHistogramBin{
+		{Label: "High", Value: 2},
+		{Label: "Low", Value: 1},
+	}))
+
 	fileName := "report.html"
 	defer helper.Remove(t, fileName)
 
@@ -75,3 +82,196 @@ func TestReportWriteToFileFailed(t *testing.T) {
 		t.Fatal("expected error")
 	}
 }
+
+func TestCandlestickReportColumn(t *testing.T) {
+	column := helper.NewCandlestickReportColumn(
+		"Price",
+		helper.SliceToChan([]float64{1.5, 2}),
+		helper.SliceToChan([]float64{3, 4}),
+		helper.SliceToChan([]float64{0.5, math.NaN()}),
+		helper.SliceToChan([]float64{2.5, 3}),
+	)
+
+	if column.Name() != "Price" || column.Type() != "candlestick" || column.Role() != "data" {
+		t.Fatalf("column %s %s %s", column.Name(), column.Type(), column.Role())
+	}
+
+	expected := []string{"[1.5, 3, 0.5, 2.5]", "[2, 4, null, 3]"}
+
+	for _, value := range expected {
+		actual := column.Value()
+		if actual != value {
+			t.Fatalf("actual %v expected %v", actual, value)
+		}
+	}
+}
+
+func TestMarkerReportColumn(t *testing.T) {
+	column := helper.NewMarkerReportColumn(helper.SliceToChan([]helper.ReportMarker{
+		helper.ReportMarkerBuy,
+		helper.ReportMarkerNone,
+		helper.ReportMarkerSell,
+	}))
+
+	if column.Type() != "number" || column.Role() != "marker" {
+		t.Fatalf("column %s %s", column.Type(), column.Role())
+	}
+
+	expected := []string{"1", "null", "-1"}
+
+	for _, value := range expected {
+		actual := column.Value()
+		if actual != value {
+			t.Fatalf("actual %v expected %v", actual, value)
+		}
+	}
+}
+
+func TestShadingReportColumn(t *testing.T) {
+	column := helper.NewShadingReportColumn(helper.SliceToChan([]bool{true, false}))
+
+	if column.Type() != "boolean" || column.Role() != "shading" {
+		t.Fatalf("column %s %s", column.Type(), column.Role())
+	}
+
+	expected := []string{"true", "false"}
+
+	for _, value := range expected {
+		actual := column.Value()
+		if actual != value {
+			t.Fatalf("actual %v expected %v", actual, value)
+		}
+	}
+}
+
+func TestReportTrades(t *testing.T) {
+	var html strings.Builder
+
+	err := newTradesReport(t).WriteToWriter(&html)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The default report draws the charts with the Google Charts library.
+	expected := []string{
+		`<script type="text/javascript" src="https://www.gstatic.com/charts/loader.js"></script>`,
+		`"type": "candlestick",`,
+		`"role": "marker",`,
+		`"role": "shading",`,
+		`"role": "volume",`,
+		`"series": series(columns, [1, 2, 3, ]),`,
+		`"series": series(columns, [4, ]),`,
+	}
+
+	for _, content := range expected {
+		if !strings.Contains(html.String(), content) {
+			t.Fatalf("report does not contain %s", content)
+		}
+	}
+
+	// The values of the row with the buy marker.
+	row := []string{
+		`new Date("2023-02-09"),`,
+		`true,`,
+		`[310.170013, 311.420013, 306.98999, 307.209991],`,
+		`1,`,
+		`3.4612e+06,`,
+	}
+
+	rest := html.String()[strings.Index(html.String(), row[0]):]
+
+	for _, value := range row {
+		index := strings.Index(rest, value)
+		if index == -1 {
+			t.Fatalf("row does not contain %s", value)
+		}
+
+		rest = rest[index+len(value):]
+	}
+}
+
+func TestReportSelfContained(t *testing.T) {
+	report := newTradesReport(t)
+	report.SelfContained = true
+
+	var html strings.Builder
+
+	err := report.WriteToWriter(&html)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The report should not load any assets over the network.
+	for _, external := range []string{"<link", "<script src", "<script type=\"text/javascript\" src"} {
+		if strings.Contains(html.String(), external) {
+			t.Fatalf("report loads external asset %s", external)
+		}
+	}
+
+	expected := []string{
+		helper.ReportStyle(),
+		`{ "name": "Price", "type": "candlestick", "role": "data" }`,
+		`{ "name": "", "type": "number", "role": "marker" }`,
+		`{ "name": "", "type": "boolean", "role": "shading" }`,
+		`{ "name": "Volume", "type": "number", "role": "volume" }`,
+		`["2022-11-30", false, [315.130005, 318.600006, 308.700012, 318.600006], null, 7.9197e+06]`,
+		`["2023-02-09", true, [310.170013, 311.420013, 306.98999, 307.209991], 1, 3.4612e+06]`,
+		`["2023-04-24", false, [324.429993, 326.399994, 324.299988, 326.049988], -1, 2.2619e+06]`,
+	}
+
+	for _, content := range expected {
+		if !strings.Contains(html.String(), content) {
+			t.Fatalf("report does not contain %s", content)
+		}
+	}
+}
+
+// newTradesReport returns a report with the shading, the candlestick, the marker, and the volume
+// columns, where the trades are shaded at the buy markers.
+func newTradesReport(t *testing.T) *helper.Report {
+	type Row struct {
+		Date       time.Time `format:"2006-01-02"`
+		Open       float64
+		High       float64
+		Low        float64
+		Close      float64
+		Volume     float64
+		Annotation string
+	}
+
+	input, err := helper.ReadFromCsvFile[Row]("testdata/report.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := helper.Duplicate(input, 8)
+	dates := helper.Map(inputs[0], func(row *Row) time.Time { return row.Date })
+	opens := helper.Map(inputs[1], func(row *Row) float64 { return row.Open })
+	highs := helper.Map(inputs[2], func(row *Row) float64 { return row.High })
+	lows := helper.Map(inputs[3], func(row *Row) float64 { return row.Low })
+	closes := helper.Map(inputs[4], func(row *Row) float64 { return row.Close })
+	volumes := helper.Map(inputs[5], func(row *Row) float64 { return row.Volume })
+	markers := helper.Map(inputs[6], func(row *Row) helper.ReportMarker {
+		switch row.Annotation {
+		case "B":
+			return helper.ReportMarkerBuy
+
+		case "S":
+			return helper.ReportMarkerSell
+
+		default:
+			return helper.ReportMarkerNone
+		}
+	})
+	trades := helper.Map(inputs[7], func(row *Row) bool { return row.Annotation == "B" })
+
+	report := helper.NewReport("Test Report", dates)
+	volumePane := report.AddChart()
+
+	report.AddColumn(helper.NewShadingReportColumn(trades))
+	report.AddColumn(helper.NewCandlestickReportColumn("Price", opens, highs, lows, closes))
+	report.AddColumn(helper.NewMarkerReportColumn(markers))
+	report.AddColumn(helper.NewVolumeReportColumn("Volume", volumes), volumePane)
+
+	return report
+}
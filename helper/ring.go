@@ -4,6 +4,11 @@
 
 package helper
 
+import (
+	"encoding/json"
+	"fmt"
+)
+
 // Ring represents a ring structure that can be instantiated
 // using the NewRing function.
 //
@@ -72,6 +77,19 @@ func (r *Ring[T]) At(index int) T {
 	return r.buffer[(r.begin+index)%len(r.buffer)]
 }
 
+// Len returns the number of values in the ring buffer.
+func (r *Ring[T]) Len() int {
+	if r.empty {
+		return 0
+	}
+
+	if r.end > r.begin {
+		return r.end - r.begin
+	}
+
+	return len(r.buffer) - r.begin + r.end
+}
+
 // IsEmpty checks if the current ring buffer is empty.
 func (r *Ring[T]) IsEmpty() bool {
 	return r.empty
@@ -82,6 +100,51 @@ func (r *Ring[T]) IsFull() bool {
 	return !r.empty && (r.end == r.begin)
 }
 
+// ringState is the state of the ring.
+type ringState[T any] struct {
+	// Size is the size of the ring.
+	Size int `json:"size"`
+
+	// Values are the values in the ring, starting with the oldest one.
+	Values []T `json:"values"`
+}
+
+// MarshalState returns the state of the ring as JSON.
+func (r *Ring[T]) MarshalState() ([]byte, error) {
+	state := ringState[T]{
+		Size:   len(r.buffer),
+		Values: make([]T, r.Len()),
+	}
+
+	for i := range state.Values {
+		state.Values[i] = r.At(i)
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState restores the state of the ring from the given JSON.
+func (r *Ring[T]) UnmarshalState(data []byte) error {
+	var state ringState[T]
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	if state.Size <= 0 || len(state.Values) > state.Size {
+		return fmt.Errorf("invalid ring state with %d values of size %d", len(state.Values), state.Size)
+	}
+
+	*r = *NewRing[T](state.Size)
+
+	for _, value := range state.Values {
+		r.Put(value)
+	}
+
+	return nil
+}
+
 // nextIndex returns the next index in a ring buffer, wrapping
 // around if it reaches the capacity.
 func (r *Ring[T]) nextIndex(i int) int {
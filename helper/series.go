@@ -0,0 +1,72 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import "time"
+
+// SeriesPoint is a single point of a series, carrying the time, the value, and whether the
+// value is valid. A point is not valid when, for example, the indicator computing it has not
+// passed its idle period yet, or the series has no value at that time after an outer join.
+type SeriesPoint[T any] struct {
+	// Time is the time of the point.
+	Time time.Time
+
+	// Value is the value of the point.
+	Value T
+
+	// Valid indicates whether the value is valid.
+	Valid bool
+}
+
+// Series is a stream of timestamped points in ascending time order. Unlike the bare value
+// channels, a series computed from another series keeps one point for each input point, so the
+// values stay aligned with their times without skipping or shifting them manually.
+//
+// Example:
+//
+//	closings := helper.NewSeries(dates, values)
+//	smas := helper.ComputeSeries(closings, trend.NewSma[float64]().Update)
+type Series[T any] <-chan SeriesPoint[T]
+
+// NewSeries function takes a channel of times and a channel of values, and returns a series of
+// valid points pairing them.
+func NewSeries[T any](times <-chan time.Time, values <-chan T) Series[T] {
+	return Operate(times, values, func(t time.Time, value T) SeriesPoint[T] {
+		return SeriesPoint[T]{
+			Time:  t,
+			Value: value,
+			Valid: true,
+		}
+	})
+}
+
+// SeriesAsTimes extracts the time of each point of the given series.
+func SeriesAsTimes[T any](series Series[T]) <-chan time.Time {
+	return Map(series, func(p SeriesPoint[T]) time.Time {
+		return p.Time
+	})
+}
+
+// SeriesAsValues extracts the value of each point of the given series, using the given fill
+// value for the points that are not valid. The result stays aligned with the times of the series.
+func SeriesAsValues[T any](series Series[T], fill T) <-chan T {
+	return Map(series, func(p SeriesPoint[T]) T {
+		if !p.Valid {
+			return fill
+		}
+
+		return p.Value
+	})
+}
+
+// SeriesAsValidValues extracts the value of each valid point of the given series, dropping the
+// points that are not valid.
+func SeriesAsValidValues[T any](series Series[T]) <-chan T {
+	return Map(Filter(series, func(p SeriesPoint[T]) bool {
+		return p.Valid
+	}), func(p SeriesPoint[T]) T {
+		return p.Value
+	})
+}
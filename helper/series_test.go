@@ -0,0 +1,85 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+// day returns the time of the given day in January 2024.
+func day(d int) time.Time {
+	return time.Date(2024, 1, d, 0, 0, 0, 0, time.UTC)
+}
+
+// point returns a valid series point for the given day and value.
+func point(d, value int) helper.SeriesPoint[int] {
+	return helper.SeriesPoint[int]{Time: day(d), Value: value, Valid: true}
+}
+
+// missing returns a series point for the given day that is not valid.
+func missing(d int) helper.SeriesPoint[int] {
+	return helper.SeriesPoint[int]{Time: day(d)}
+}
+
+func TestNewSeries(t *testing.T) {
+	times := helper.SliceToChan([]time.Time{day(1), day(2), day(3)})
+	values := helper.SliceToChan([]int{10, 20, 30})
+
+	expected := helper.SliceToChan([]helper.SeriesPoint[int]{
+		point(1, 10), point(2, 20), point(3, 30),
+	})
+
+	actual := helper.NewSeries(times, values)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSeriesAsTimes(t *testing.T) {
+	series := helper.SliceToChan([]helper.SeriesPoint[int]{
+		point(1, 10), missing(2), point(3, 30),
+	})
+
+	expected := helper.SliceToChan([]time.Time{day(1), day(2), day(3)})
+	actual := helper.SeriesAsTimes(series)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSeriesAsValues(t *testing.T) {
+	series := helper.SliceToChan([]helper.SeriesPoint[int]{
+		point(1, 10), missing(2), point(3, 30),
+	})
+
+	expected := helper.SliceToChan([]int{10, -1, 30})
+	actual := helper.SeriesAsValues(series, -1)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSeriesAsValidValues(t *testing.T) {
+	series := helper.SliceToChan([]helper.SeriesPoint[int]{
+		point(1, 10), missing(2), point(3, 30),
+	})
+
+	expected := helper.SliceToChan([]int{10, 30})
+	actual := helper.SeriesAsValidValues(series)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
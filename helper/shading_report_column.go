@@ -0,0 +1,41 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import "strconv"
+
+// shadingReportColumn is the shading report column struct.
+type shadingReportColumn struct {
+	ReportColumn
+	values <-chan bool
+}
+
+// NewShadingReportColumn returns a new instance of a shading column for a report. The background
+// of the bars where the value is true is shaded, such as to show the open trades.
+func NewShadingReportColumn(values <-chan bool) ReportColumn {
+	return &shadingReportColumn{
+		values: values,
+	}
+}
+
+// Name returns the name of the report column.
+func (*shadingReportColumn) Name() string {
+	return ""
+}
+
+// Type returns boolean as the data type.
+func (*shadingReportColumn) Type() string {
+	return "boolean"
+}
+
+// Role returns the role of the report column.
+func (*shadingReportColumn) Role() string {
+	return "shading"
+}
+
+// Value returns the next data value for the report column.
+func (c *shadingReportColumn) Value() string {
+	return strconv.FormatBool(<-c.values)
+}
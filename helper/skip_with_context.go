@@ -0,0 +1,45 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import "context"
+
+// SkipWithContext skips the specified number of elements from the given channel, like Skip. It
+// stops when the given context is cancelled, closes the output channel, and drains the input
+// channel.
+//
+// Example:
+//
+//	c := helper.SliceToChan([]int{2, 4, 6, 8})
+//	actual := helper.SkipWithContext(ctx, c, 2)
+//	fmt.Println(helper.ChanToSlice(actual)) // [6, 8]
+func SkipWithContext[T any](ctx context.Context, c <-chan T, count int) <-chan T {
+	result := make(chan T, cap(c))
+
+	go func() {
+		defer Drain(c)
+		defer close(result)
+
+		for i := 0; i < count; i++ {
+			_, ok := receive(ctx, c)
+			if !ok {
+				return
+			}
+		}
+
+		for {
+			n, ok := receive(ctx, c)
+			if !ok {
+				return
+			}
+
+			if !send(ctx, result, n) {
+				return
+			}
+		}
+	}()
+
+	return result
+}
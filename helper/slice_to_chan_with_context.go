@@ -0,0 +1,30 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import "context"
+
+// SliceToChanWithContext converts a slice of values to a channel, like SliceToChan. It stops
+// sending the values and closes the channel when the given context is cancelled.
+//
+// Example:
+//
+//	slice := []float64{2, 4, 6, 8}
+//	c := helper.SliceToChanWithContext(ctx, slice)
+func SliceToChanWithContext[T any](ctx context.Context, slice []T) <-chan T {
+	c := make(chan T)
+
+	go func() {
+		defer close(c)
+
+		for _, n := range slice {
+			if !send(ctx, c, n) {
+				return
+			}
+		}
+	}()
+
+	return c
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestSliceToChanWithContext(t *testing.T) {
+	expected := []int{2, 4, 6, 8}
+	actual := helper.SliceToChanWithContext(context.Background(), expected)
+
+	err := helper.CheckEquals(actual, helper.SliceToChan(expected))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSliceToChanWithContextCancel(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	actual := helper.SliceToChanWithContext(ctx, make([]int, 1000))
+
+	<-actual
+	cancel()
+
+	err := helper.CheckGoroutines(before, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
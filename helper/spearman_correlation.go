@@ -0,0 +1,53 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import "sort"
+
+// SpearmanCorrelation calculates the rolling Spearman rank correlation coefficient of the values from two
+// input channels over the given period. It is the Pearson correlation of the ranks of the values, where
+// the tied values get the average of their ranks. It measures how monotonic the relationship is, and it is
+// less sensitive to the outliers.
+//
+// Example:
+//
+//	correlations := helper.SpearmanCorrelation(ac, bc, 20)
+func SpearmanCorrelation[T Number](ac, bc <-chan T, period int) <-chan T {
+	rankA := make([]float64, period)
+	rankB := make([]float64, period)
+	indexes := make([]int, period)
+
+	return OperateWindow(ac, bc, period, func(as, bs []T) T {
+		rank(as, rankA, indexes)
+		rank(bs, rankB, indexes)
+
+		return T(correlation(rankA, rankB))
+	})
+}
+
+// rank computes the ranks of the given values starting from one, using the average rank for the ties.
+func rank[T Number](values []T, ranks []float64, indexes []int) {
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	sort.SliceStable(indexes, func(i, j int) bool {
+		return values[indexes[i]] < values[indexes[j]]
+	})
+
+	for i := 0; i < len(indexes); {
+		j := i
+		for j+1 < len(indexes) && values[indexes[j+1]] == values[indexes[i]] {
+			j++
+		}
+
+		average := float64(i+j)/2 + 1
+		for k := i; k <= j; k++ {
+			ranks[indexes[k]] = average
+		}
+
+		i = j + 1
+	}
+}
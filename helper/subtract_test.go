@@ -23,3 +23,25 @@ func TestSubstract(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestSubtractDecimal(t *testing.T) {
+	ac := helper.SliceToChan([]helper.Decimal{
+		helper.NewDecimal(0.3), helper.NewDecimal(4512.5), helper.NewDecimal(71.27),
+	})
+
+	bc := helper.SliceToChan([]helper.Decimal{
+		helper.NewDecimal(0.1), helper.NewDecimal(4512.25), helper.NewDecimal(71.57),
+	})
+
+	// The float64 differences are 0.19999999999999998 and -0.29999999999999716.
+	expected := helper.SliceToChan([]helper.Decimal{
+		helper.NewDecimal(0.2), helper.NewDecimal(0.25), helper.NewDecimal(-0.3),
+	})
+
+	actual := helper.Subtract(ac, bc)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
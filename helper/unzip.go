@@ -0,0 +1,40 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+// Unzip takes a channel of fixed size slices, and returns the given number of channels, where
+// each channel receives the values at the corresponding index of the slices. It is used to split
+// the results of the stateful indicators that yield multiple values at once. Each returned channel
+// buffers at least one value, so the values of the same slice can be consumed in any order, but the
+// returned channels advance together, so they should be consumed concurrently.
+//
+// Example:
+//
+//	values := helper.SliceToChan([][]int{{1, 2}, {3, 4}})
+//	results := helper.Unzip(values, 2)
+//	sums := helper.Add(results[0], results[1]) // [3, 7]
+func Unzip[T any](c <-chan []T, count int) []<-chan T {
+	outputs := make([]chan T, count)
+	result := make([]<-chan T, count)
+
+	for i := range outputs {
+		outputs[i] = make(chan T, max(cap(c), 1))
+		result[i] = outputs[i]
+	}
+
+	go func() {
+		for _, output := range outputs {
+			defer close(output)
+		}
+
+		for values := range c {
+			for i, output := range outputs {
+				output <- values[i]
+			}
+		}
+	}()
+
+	return result
+}
@@ -0,0 +1,30 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+// UnzipSlice splits the given slice of value slices into the given number of slices, where the i-th
+// slice holds the i-th value of each input value slice. It is the batch form of Unzip.
+//
+// Example:
+//
+//	input := [][]int{{1, 2}, {3, 4}, {5, 6}}
+//	outputs := helper.UnzipSlice(input, 2)
+//	fmt.Println(outputs[0]) // [1 3 5]
+//	fmt.Println(outputs[1]) // [2 4 6]
+func UnzipSlice[T any](values [][]T, count int) [][]T {
+	result := make([][]T, count)
+
+	for i := range result {
+		result[i] = make([]T, len(values))
+	}
+
+	for j, value := range values {
+		for i := range result {
+			result[i][j] = value[i]
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,23 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestUnzipSlice(t *testing.T) {
+	input := [][]int{{1, 2}, {3, 4}, {5, 6}}
+	expected := [][]int{{1, 3, 5}, {2, 4, 6}}
+
+	actual := helper.UnzipSlice(input, 2)
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestUnzip(t *testing.T) {
+	input := helper.SliceToChan([][]int{{1, 2}, {3, 4}, {5, 6}})
+
+	expected := helper.SliceToChan([]int{-1, -1, -1})
+
+	results := helper.Unzip(input, 2)
+	actual := helper.Subtract(results[0], results[1])
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUnzipOutOfOrder(t *testing.T) {
+	input := make(chan []int)
+
+	go func() {
+		defer close(input)
+
+		for _, values := range [][]int{{1, 2}, {3, 4}, {5, 6}} {
+			input <- values
+		}
+	}()
+
+	expected := helper.SliceToChan([]int{1, 1, 1})
+
+	results := helper.Unzip(input, 2)
+	actual := helper.Subtract(results[1], results[0])
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
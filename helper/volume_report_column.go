@@ -0,0 +1,46 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+// volumeReportColumn is the volume report column struct.
+type volumeReportColumn[T Number] struct {
+	ReportColumn
+	name    string
+	volumes <-chan T
+}
+
+// NewVolumeReportColumn returns a new instance of a volume data column for a report, drawing
+// the values as bars. It is typically added to its own chart as a volume pane.
+//
+// Example:
+//
+//	volumePane := report.AddChart()
+//	report.AddColumn(helper.NewVolumeReportColumn("Volume", volumes), volumePane)
+func NewVolumeReportColumn[T Number](name string, volumes <-chan T) ReportColumn {
+	return &volumeReportColumn[T]{
+		name:    name,
+		volumes: volumes,
+	}
+}
+
+// Name returns the name of the report column.
+func (c *volumeReportColumn[T]) Name() string {
+	return c.name
+}
+
+// Type returns number as the data type.
+func (*volumeReportColumn[T]) Type() string {
+	return "number"
+}
+
+// Role returns the role of the report column.
+func (*volumeReportColumn[T]) Role() string {
+	return "volume"
+}
+
+// Value returns the next data value for the report column.
+func (c *volumeReportColumn[T]) Value() string {
+	return reportNumber(<-c.volumes)
+}
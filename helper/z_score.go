@@ -0,0 +1,60 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper
+
+import "math"
+
+// ZScore calculates the rolling z-score of the values from the input channel over the given period,
+// which is the number of standard deviations that the value is away from the mean. The z-score is
+// zero when the values are flat.
+//
+//	Z-Score = (Value - Mean) / Std
+//
+// Example:
+//
+//	zScores := helper.ZScore(c, 20)
+func ZScore[T Number](c <-chan T, period int) <-chan T {
+	result := make(chan T, cap(c))
+
+	go func() {
+		defer close(result)
+
+		window := make([]T, 0, period)
+
+		for n := range c {
+			if len(window) == period {
+				window = append(window[:0], window[1:]...)
+			}
+
+			window = append(window, n)
+			if len(window) < period {
+				continue
+			}
+
+			std := math.Sqrt(covariance(window, window))
+			if std == 0 {
+				result <- 0
+				continue
+			}
+
+			result <- T((float64(n) - mean(window)) / std)
+		}
+	}()
+
+	return result
+}
+
+// SpreadZScore calculates the rolling z-score of the spread between the values from two input channels
+// over the given period. The second values can be multiplied with a hedge ratio before.
+//
+//	Spread = A - B
+//	Z-Score = (Spread - Mean(Spread)) / Std(Spread)
+//
+// Example:
+//
+//	zScores := helper.SpreadZScore(ac, bc, 20)
+func SpreadZScore[T Number](ac, bc <-chan T, period int) <-chan T {
+	return ZScore(Subtract(ac, bc), period)
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package helper_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+func TestZScore(t *testing.T) {
+	input := helper.SliceToChan([]float64{10, 12, 11, 13, 15, 14, 16, 18, 17, 19, 21, 20})
+	expected := helper.SliceToChan([]float64{1.3416, 1.5213, 0.5071, 1.3416, 1.5213, 0.5071, 1.3416, 1.5213, 0.5071})
+
+	actual := helper.RoundDigits(helper.ZScore(input, 4), 4)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSpreadZScore(t *testing.T) {
+	a := helper.SliceToChan([]float64{10, 12, 11, 13, 15, 14, 16, 18, 17, 19, 21, 20})
+	b := helper.SliceToChan([]float64{20, 21, 21, 22, 24, 23, 23, 25, 26, 25, 27, 28})
+	expected := helper.SliceToChan([]float64{1, 0.5774, 0.5774, 1.7321, 1, -1, 1.1471, 0.8165, -0.5774})
+
+	actual := helper.RoundDigits(helper.SpreadZScore(a, b, 4), 4)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,313 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package levels
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+)
+
+// PivotPointMethod is the method used for calculating the pivot point levels.
+type PivotPointMethod int
+
+const (
+	// PivotPointClassic is the classic (floor) pivot points.
+	//
+	//	P = (High + Low + Close) / 3
+	//	R1 = 2 * P - Low, S1 = 2 * P - High
+	//	R2 = P + (High - Low), S2 = P - (High - Low)
+	//	R3 = High + 2 * (P - Low), S3 = Low - 2 * (High - P)
+	PivotPointClassic PivotPointMethod = iota
+
+	// PivotPointFibonacci is the Fibonacci pivot points.
+	//
+	//	P = (High + Low + Close) / 3
+	//	R1 = P + 0.382 * (High - Low), S1 = P - 0.382 * (High - Low)
+	//	R2 = P + 0.618 * (High - Low), S2 = P - 0.618 * (High - Low)
+	//	R3 = P + (High - Low), S3 = P - (High - Low)
+	PivotPointFibonacci
+
+	// PivotPointCamarilla is the Camarilla pivot points.
+	//
+	//	P = (High + Low + Close) / 3
+	//	R1 = Close + 1.1 * (High - Low) / 12, S1 = Close - 1.1 * (High - Low) / 12
+	//	R2 = Close + 1.1 * (High - Low) / 6, S2 = Close - 1.1 * (High - Low) / 6
+	//	R3 = Close + 1.1 * (High - Low) / 4, S3 = Close - 1.1 * (High - Low) / 4
+	PivotPointCamarilla
+
+	// PivotPointWoodie is the Woodie pivot points, which weight the closing more.
+	//
+	//	P = (High + Low + 2 * Close) / 4
+	//	R1 = 2 * P - Low, S1 = 2 * P - High
+	//	R2 = P + (High - Low), S2 = P - (High - Low)
+	//	R3 = High + 2 * (P - Low), S3 = Low - 2 * (High - P)
+	PivotPointWoodie
+
+	// PivotPointDemark is the DeMark pivot points, which only define the first levels.
+	//
+	//	X = High + 2 * Low + Close, if Close < Open
+	//	X = 2 * High + Low + Close, if Close > Open
+	//	X = High + Low + 2 * Close, if Close = Open
+	//	P = X / 4
+	//	R1 = X / 2 - Low, S1 = X / 2 - High
+	PivotPointDemark
+)
+
+const (
+	// DefaultPivotPointMethod is the default pivot point method.
+	DefaultPivotPointMethod = PivotPointClassic
+)
+
+// pivotPointMethodNames is the names of the pivot point methods.
+var pivotPointMethodNames = []string{
+	"Classic",
+	"Fibonacci",
+	"Camarilla",
+	"Woodie",
+	"DeMark",
+}
+
+// String returns the name of the pivot point method.
+func (m PivotPointMethod) String() string {
+	if m < 0 || int(m) >= len(pivotPointMethodNames) {
+		return fmt.Sprintf("PivotPointMethod(%d)", int(m))
+	}
+
+	return pivotPointMethodNames[m]
+}
+
+// PivotPoint is the pivot point levels for a bar. The levels that are not defined by the method
+// are zero.
+type PivotPoint struct {
+	// Pivot is the pivot point.
+	Pivot float64
+
+	// R1 is the first resistance level.
+	R1 float64
+
+	// R2 is the second resistance level.
+	R2 float64
+
+	// R3 is the third resistance level.
+	R3 float64
+
+	// S1 is the first support level.
+	S1 float64
+
+	// S2 is the second support level.
+	S2 float64
+
+	// S3 is the third support level.
+	S3 float64
+}
+
+// PivotPoints represents the configuration parameters for calculating the pivot points. The
+// levels for each bar are computed from the open, high, low, and close of the prior period, where
+// the periods are determined by the timeframe. Intraday bars are resampled into the timeframe,
+// such as daily, to compute the levels from the prior day.
+//
+// Example:
+//
+//	pivotPoints := levels.NewPivotPointsWith(levels.PivotPointFibonacci, asset.Daily)
+//	pivots := pivotPoints.Compute(snapshots)
+type PivotPoints struct {
+	// Method is the pivot point method.
+	Method PivotPointMethod
+
+	// Timeframe is the timeframe of the periods.
+	Timeframe asset.Timeframe
+}
+
+// NewPivotPoints function initializes a new pivot points instance with the default parameters.
+func NewPivotPoints() *PivotPoints {
+	return NewPivotPointsWith(DefaultPivotPointMethod, asset.Daily)
+}
+
+// NewPivotPointsWith function initializes a new pivot points instance with the given parameters.
+func NewPivotPointsWith(method PivotPointMethod, timeframe asset.Timeframe) *PivotPoints {
+	return &PivotPoints{
+		Method:    method,
+		Timeframe: timeframe,
+	}
+}
+
+// Compute function takes a channel of snapshots and computes the pivot point levels from the
+// prior period. It yields one pivot point per snapshot, starting with the second period, as the
+// bars in the first period have no prior period.
+func (p *PivotPoints) Compute(snapshots <-chan *asset.Snapshot) <-chan *PivotPoint {
+	return helper.Map(p.computeBars(snapshots), func(b *pivotBar) *PivotPoint {
+		return b.levels
+	})
+}
+
+// Report processes the provided asset snapshots and generates a report with the pivot point
+// levels drawn as lines along with the closings. The bars in the first period are left out, as
+// they have no levels.
+func (p *PivotPoints) Report(c <-chan *asset.Snapshot) *helper.Report {
+	//
+	// bars[0] -> dates
+	// bars[1] -> closings
+	// bars[2] -> levels
+	//
+	names := []string{"P", "R1", "R2", "R3", "S1", "S2", "S3"}
+	fields := []func(*PivotPoint) float64{
+		func(l *PivotPoint) float64 { return l.Pivot },
+		func(l *PivotPoint) float64 { return l.R1 },
+		func(l *PivotPoint) float64 { return l.R2 },
+		func(l *PivotPoint) float64 { return l.R3 },
+		func(l *PivotPoint) float64 { return l.S1 },
+		func(l *PivotPoint) float64 { return l.S2 },
+		func(l *PivotPoint) float64 { return l.S3 },
+	}
+
+	// DeMark only defines the first levels.
+	if p.Method == PivotPointDemark {
+		names = []string{"P", "R1", "S1"}
+		fields = []func(*PivotPoint) float64{fields[0], fields[1], fields[4]}
+	}
+
+	bars := helper.Duplicate(p.computeBars(c), 2+len(fields))
+
+	dates := helper.Map(bars[0], func(b *pivotBar) time.Time { return b.snapshot.Date })
+	closings := helper.Map(bars[1], func(b *pivotBar) float64 { return b.snapshot.Close })
+
+	report := helper.NewReport(fmt.Sprintf("%s Pivot Points", p.Method), dates)
+	report.AddColumn(helper.NewNumericReportColumn("Close", closings))
+
+	for i, field := range fields {
+		report.AddColumn(helper.NewNumericReportColumn(names[i], helper.Map(bars[2+i], func(b *pivotBar) float64 {
+			return field(b.levels)
+		})))
+	}
+
+	return report
+}
+
+// pivotBar is a snapshot with the pivot point levels from its prior period.
+type pivotBar struct {
+	// snapshot is the snapshot.
+	snapshot *asset.Snapshot
+
+	// levels is the pivot point levels from the prior period.
+	levels *PivotPoint
+}
+
+// computeBars takes a channel of snapshots, and yields the snapshots starting with the second
+// period along with their pivot point levels from the prior period.
+func (p *PivotPoints) computeBars(snapshots <-chan *asset.Snapshot) <-chan *pivotBar {
+	result := make(chan *pivotBar, cap(snapshots))
+
+	go func() {
+		defer close(result)
+
+		var period *asset.Snapshot
+		var levels *PivotPoint
+
+		for snapshot := range snapshots {
+			start := p.Timeframe(snapshot.Date)
+
+			if period != nil && period.Date.Equal(start) {
+				period.High = math.Max(period.High, snapshot.High)
+				period.Low = math.Min(period.Low, snapshot.Low)
+				period.Close = snapshot.Close
+			} else {
+				if period != nil {
+					levels = p.levels(period)
+				}
+
+				period = &asset.Snapshot{
+					Date:  start,
+					Open:  snapshot.Open,
+					High:  snapshot.High,
+					Low:   snapshot.Low,
+					Close: snapshot.Close,
+				}
+			}
+
+			if levels != nil {
+				result <- &pivotBar{
+					snapshot: snapshot,
+					levels:   levels,
+				}
+			}
+		}
+	}()
+
+	return result
+}
+
+// levels computes the pivot point levels from the given period.
+func (p *PivotPoints) levels(period *asset.Snapshot) *PivotPoint {
+	high := period.High
+	low := period.Low
+	closing := period.Close
+	span := high - low
+
+	switch p.Method {
+	case PivotPointFibonacci:
+		pivot := (high + low + closing) / 3
+
+		return &PivotPoint{
+			Pivot: pivot,
+			R1:    pivot + 0.382*span,
+			R2:    pivot + 0.618*span,
+			R3:    pivot + span,
+			S1:    pivot - 0.382*span,
+			S2:    pivot - 0.618*span,
+			S3:    pivot - span,
+		}
+
+	case PivotPointCamarilla:
+		return &PivotPoint{
+			Pivot: (high + low + closing) / 3,
+			R1:    closing + 1.1*span/12,
+			R2:    closing + 1.1*span/6,
+			R3:    closing + 1.1*span/4,
+			S1:    closing - 1.1*span/12,
+			S2:    closing - 1.1*span/6,
+			S3:    closing - 1.1*span/4,
+		}
+
+	case PivotPointWoodie:
+		return floorLevels((high+low+2*closing)/4, high, low)
+
+	case PivotPointDemark:
+		var x float64
+
+		if closing < period.Open {
+			x = high + 2*low + closing
+		} else if closing > period.Open {
+			x = 2*high + low + closing
+		} else {
+			x = high + low + 2*closing
+		}
+
+		return &PivotPoint{
+			Pivot: x / 4,
+			R1:    x/2 - low,
+			S1:    x/2 - high,
+		}
+
+	default:
+		return floorLevels((high+low+closing)/3, high, low)
+	}
+}
+
+// floorLevels computes the floor support and resistance levels around the given pivot.
+func floorLevels(pivot, high, low float64) *PivotPoint {
+	return &PivotPoint{
+		Pivot: pivot,
+		R1:    2*pivot - low,
+		R2:    pivot + (high - low),
+		R3:    high + 2*(pivot-low),
+		S1:    2*pivot - high,
+		S2:    pivot - (high - low),
+		S3:    low - 2*(high-pivot),
+	}
+}
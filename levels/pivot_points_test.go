@@ -0,0 +1,148 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package levels_test
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/levels"
+)
+
+// intradaySnapshots returns hourly snapshots over two days, where the first day opens at 95,
+// spans from 90 to 110, and closes at 104.
+func intradaySnapshots() []*asset.Snapshot {
+	day := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	return []*asset.Snapshot{
+		{Date: day, Open: 95, High: 100, Low: 90, Close: 98},
+		{Date: day.Add(time.Hour), Open: 98, High: 110, Low: 97, Close: 101},
+		{Date: day.Add(2 * time.Hour), Open: 101, High: 105, Low: 100, Close: 104},
+		{Date: day.AddDate(0, 0, 1), Open: 104, High: 108, Low: 103, Close: 107},
+		{Date: day.AddDate(0, 0, 1).Add(time.Hour), Open: 107, High: 109, Low: 99, Close: 100},
+	}
+}
+
+// roundPivotPoint rounds the levels of the given pivot point to two digits.
+func roundPivotPoint(p *levels.PivotPoint) levels.PivotPoint {
+	round := func(n float64) float64 {
+		return math.Round(n*100) / 100
+	}
+
+	return levels.PivotPoint{
+		Pivot: round(p.Pivot),
+		R1:    round(p.R1),
+		R2:    round(p.R2),
+		R3:    round(p.R3),
+		S1:    round(p.S1),
+		S2:    round(p.S2),
+		S3:    round(p.S3),
+	}
+}
+
+func TestPivotPoints(t *testing.T) {
+	tests := []struct {
+		method   levels.PivotPointMethod
+		expected levels.PivotPoint
+	}{
+		{
+			levels.PivotPointClassic,
+			levels.PivotPoint{Pivot: 101.33, R1: 112.67, R2: 121.33, R3: 132.67, S1: 92.67, S2: 81.33, S3: 72.67},
+		},
+		{
+			levels.PivotPointFibonacci,
+			levels.PivotPoint{Pivot: 101.33, R1: 108.97, R2: 113.69, R3: 121.33, S1: 93.69, S2: 88.97, S3: 81.33},
+		},
+		{
+			levels.PivotPointCamarilla,
+			levels.PivotPoint{Pivot: 101.33, R1: 105.83, R2: 107.67, R3: 109.5, S1: 102.17, S2: 100.33, S3: 98.5},
+		},
+		{
+			levels.PivotPointWoodie,
+			levels.PivotPoint{Pivot: 102, R1: 114, R2: 122, R3: 134, S1: 94, S2: 82, S3: 74},
+		},
+		{
+			levels.PivotPointDemark,
+			levels.PivotPoint{Pivot: 103.5, R1: 117, S1: 97},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.method.String(), func(t *testing.T) {
+			pivotPoints := levels.NewPivotPointsWith(test.method, asset.Daily)
+
+			actual := helper.ChanToSlice(pivotPoints.Compute(helper.SliceToChan(intradaySnapshots())))
+
+			// The bars in the first day have no prior day, and are skipped.
+			if len(actual) != 2 {
+				t.Fatalf("actual %d expected 2", len(actual))
+			}
+
+			for i := range actual {
+				if !reflect.DeepEqual(roundPivotPoint(actual[i]), test.expected) {
+					t.Fatalf("bar %d actual %v expected %v", i, actual[i], test.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestPivotPointsDefault(t *testing.T) {
+	pivotPoints := levels.NewPivotPoints()
+
+	if pivotPoints.Method != levels.PivotPointClassic {
+		t.Fatalf("actual %v expected %v", pivotPoints.Method, levels.PivotPointClassic)
+	}
+
+	if levels.PivotPointMethod(10).String() != "PivotPointMethod(10)" {
+		t.Fatalf("actual %s", levels.PivotPointMethod(10))
+	}
+}
+
+func TestPivotPointsReport(t *testing.T) {
+	for _, method := range []levels.PivotPointMethod{levels.PivotPointClassic, levels.PivotPointDemark} {
+		pivotPoints := levels.NewPivotPointsWith(method, asset.Daily)
+
+		report := pivotPoints.Report(helper.SliceToChan(intradaySnapshots()))
+
+		fileName := "pivot_points_" + method.String() + ".html"
+		defer helper.Remove(t, fileName)
+
+		err := report.WriteToFile(fileName)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestPivotPointsReportSkipsFirstPeriod(t *testing.T) {
+	pivotPoints := levels.NewPivotPoints()
+
+	report := pivotPoints.Report(helper.SliceToChan(intradaySnapshots()))
+
+	var buffer bytes.Buffer
+
+	err := report.WriteToWriter(&buffer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	html := buffer.String()
+
+	// The first day has no levels, and neither its dates nor zero levels are in the report.
+	if strings.Contains(html, "2024-01-01") {
+		t.Fatal("report includes the first period")
+	}
+
+	if !strings.Contains(html, "2024-01-02") {
+		t.Fatal("report does not include the second period")
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package levels
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/volatility"
+)
+
+const (
+	// DefaultZigZagPercentage is the default reversal percentage for the ZigZag.
+	DefaultZigZagPercentage = 5
+
+	// DefaultZigZagAtrMultiplier is the default ATR multiplier for the ZigZag reversal distance.
+	DefaultZigZagAtrMultiplier = 3
+)
+
+// ZigZag represents the configuration parameters for detecting the swing highs and lows, and
+// deriving the support and resistance levels from them. A swing high is confirmed once the low
+// falls from the highest high of the up leg by the reversal distance, and a swing low is confirmed
+// once the high rises from the lowest low of the down leg by the reversal distance. The last
+// confirmed swing low is the support, and the last confirmed swing high is the resistance.
+//
+// The reversal distance is either a percentage of the swing extreme, or a multiple of the Average
+// True Range (ATR) at each bar, so that it adapts to the volatility.
+//
+// Example:
+//
+//	zigzag := levels.NewZigZag()
+//	supports, resistances := zigzag.Compute(snapshots)
+type ZigZag struct {
+	// Percentage is the reversal percentage, used when the ATR is not set.
+	Percentage float64
+
+	// Atr is the ATR used for the reversal distance.
+	Atr *volatility.Atr[float64]
+
+	// Multiplier is the ATR multiplier for the reversal distance.
+	Multiplier float64
+}
+
+// NewZigZag function initializes a new ZigZag instance with the default parameters.
+func NewZigZag() *ZigZag {
+	return NewZigZagWithPercentage(DefaultZigZagPercentage)
+}
+
+// NewZigZagWithPercentage function initializes a new ZigZag instance with the given reversal percentage.
+func NewZigZagWithPercentage(percentage float64) *ZigZag {
+	return &ZigZag{
+		Percentage: percentage,
+	}
+}
+
+// NewZigZagWithAtr function initializes a new ZigZag instance with the given ATR and multiplier.
+func NewZigZagWithAtr(atr *volatility.Atr[float64], multiplier float64) *ZigZag {
+	return &ZigZag{
+		Atr:        atr,
+		Multiplier: multiplier,
+	}
+}
+
+// Compute function takes a channel of snapshots and computes the support and resistance levels
+// from the confirmed swings. It yields one support and one resistance per snapshot. The levels are
+// zero until the first swing of each kind is confirmed. In ATR mode, no swings are confirmed in the
+// ATR idle period.
+func (z *ZigZag) Compute(c <-chan *asset.Snapshot) (<-chan float64, <-chan float64) {
+	if z.Atr == nil {
+		return z.zigzag(c, nil)
+	}
+
+	snapshots := helper.Duplicate(c, 4)
+
+	highs := asset.SnapshotsAsHighs(snapshots[0])
+	lows := asset.SnapshotsAsLows(snapshots[1])
+	closings := asset.SnapshotsAsClosings(snapshots[2])
+
+	distances := helper.MultiplyBy(z.Atr.Compute(highs, lows, closings), z.Multiplier)
+	distances = helper.Shift(distances, z.Atr.IdlePeriod(), 0)
+
+	return z.zigzag(snapshots[3], distances)
+}
+
+// Report processes the provided asset snapshots and generates a report with the support and
+// resistance levels drawn as lines along with the closings.
+func (z *ZigZag) Report(c <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> closings
+	// snapshots[2] -> supports, resistances
+	//
+	snapshots := helper.Duplicate(c, 3)
+
+	dates := asset.SnapshotsAsDates(snapshots[0])
+	closings := asset.SnapshotsAsClosings(snapshots[1])
+	supports, resistances := z.Compute(snapshots[2])
+
+	report := helper.NewReport(z.String(), dates)
+	report.AddColumn(helper.NewNumericReportColumn("Close", closings))
+	report.AddColumn(helper.NewNumericReportColumn("Support", supports))
+	report.AddColumn(helper.NewNumericReportColumn("Resistance", resistances))
+
+	return report
+}
+
+// String returns the name of the ZigZag.
+func (z *ZigZag) String() string {
+	if z.Atr == nil {
+		return fmt.Sprintf("ZigZag (%.2f%%)", z.Percentage)
+	}
+
+	return fmt.Sprintf("ZigZag (%.2f ATR)", z.Multiplier)
+}
+
+// zigzag computes the support and resistance levels using the reversal distance given for each
+// snapshot, or the reversal percentage if no distances are given.
+func (z *ZigZag) zigzag(snapshots <-chan *asset.Snapshot, distances <-chan float64) (<-chan float64, <-chan float64) {
+	supports := make(chan float64, cap(snapshots))
+	resistances := make(chan float64, cap(snapshots))
+
+	go func() {
+		defer close(supports)
+		defer close(resistances)
+		defer helper.Drain(snapshots)
+
+		if distances != nil {
+			defer helper.Drain(distances)
+		}
+
+		// Direction of the current leg, 1 for up, -1 for down, and 0 before the first swing.
+		direction := 0
+		highest := math.Inf(-1)
+		lowest := math.Inf(1)
+		support := 0.0
+		resistance := 0.0
+
+		// Reversal distance from the given extreme.
+		distance := func(extreme float64) float64 {
+			return extreme * z.Percentage / 100
+		}
+
+		for snapshot := range snapshots {
+			if distances != nil {
+				size, ok := <-distances
+				if !ok {
+					break
+				}
+
+				distance = func(float64) float64 {
+					return size
+				}
+			}
+
+			highest = math.Max(highest, snapshot.High)
+			lowest = math.Min(lowest, snapshot.Low)
+
+			switch direction {
+			case 1:
+				if reversal(highest, snapshot.Low, distance(highest)) {
+					resistance = highest
+					direction = -1
+					lowest = snapshot.Low
+				}
+
+			case -1:
+				if reversal(snapshot.High, lowest, distance(lowest)) {
+					support = lowest
+					direction = 1
+					highest = snapshot.High
+				}
+
+			default:
+				if reversal(snapshot.High, lowest, distance(lowest)) {
+					support = lowest
+					direction = 1
+					highest = snapshot.High
+				} else if reversal(highest, snapshot.Low, distance(highest)) {
+					resistance = highest
+					direction = -1
+					lowest = snapshot.Low
+				}
+			}
+
+			supports <- support
+			resistances <- resistance
+		}
+	}()
+
+	return supports, resistances
+}
+
+// reversal checks if the move from low to high covers the given non-zero reversal distance.
+func reversal(high, low, distance float64) bool {
+	return distance > 0 && high-low >= distance
+}
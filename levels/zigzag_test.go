@@ -0,0 +1,86 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package levels_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/levels"
+	"github.com/cinar/indicator/v2/volatility"
+)
+
+// rangeSnapshots returns daily snapshots with the given closings, and a range of the given
+// spread around the closing.
+func rangeSnapshots(spread float64, closings ...float64) []*asset.Snapshot {
+	date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	snapshots := make([]*asset.Snapshot, len(closings))
+
+	for i, closing := range closings {
+		snapshots[i] = &asset.Snapshot{
+			Date:  date.AddDate(0, 0, i),
+			Open:  closing,
+			High:  closing + spread,
+			Low:   closing - spread,
+			Close: closing,
+		}
+	}
+
+	return snapshots
+}
+
+func TestZigZag(t *testing.T) {
+	snapshots := rangeSnapshots(0, 100, 105, 112, 108, 100, 95, 90, 96, 101)
+
+	expectedSupports := []float64{0, 0, 100, 100, 100, 100, 100, 100, 90}
+	expectedResistances := []float64{0, 0, 0, 0, 112, 112, 112, 112, 112}
+
+	zigzag := levels.NewZigZagWithPercentage(10)
+	supports, resistances := zigzag.Compute(helper.SliceToChan(snapshots))
+
+	err := helper.CheckEquals(supports, helper.SliceToChan(expectedSupports),
+		resistances, helper.SliceToChan(expectedResistances))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestZigZagWithAtr(t *testing.T) {
+	// The true range of each snapshot is one.
+	snapshots := rangeSnapshots(0.5, 10, 10.5, 11, 11.5, 12, 11.5, 11, 10.5, 11, 11.5)
+
+	expectedSupports := []float64{0, 0, 9.5, 9.5, 9.5, 9.5, 9.5, 9.5, 9.5, 10}
+	expectedResistances := []float64{0, 0, 0, 0, 0, 0, 12.5, 12.5, 12.5, 12.5}
+
+	zigzag := levels.NewZigZagWithAtr(volatility.NewAtrWithPeriod[float64](1), 2)
+	supports, resistances := zigzag.Compute(helper.SliceToChan(snapshots))
+
+	err := helper.CheckEquals(supports, helper.SliceToChan(expectedSupports),
+		resistances, helper.SliceToChan(expectedResistances))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestZigZagReport(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	zigzag := levels.NewZigZag()
+
+	report := zigzag.Report(snapshots)
+
+	fileName := "zigzag.html"
+	defer helper.Remove(t, fileName)
+
+	err = report.WriteToFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package live
+
+import (
+	"errors"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+)
+
+const (
+	// DefaultWarmupPeriod is the default number of historical snapshots used to warm up the strategies.
+	DefaultWarmupPeriod = 365
+)
+
+// idlePeriodStrategy is implemented by the strategies that report their idle period.
+type idlePeriodStrategy interface {
+	// IdlePeriod is the initial period that strategy won't yield any results.
+	IdlePeriod() int
+}
+
+// Engine keeps the strategies warm for each asset, feeds them the new snapshots
+// as they arrive from the feed, and sends the action changes to the sinks.
+//
+// Example:
+//
+//	repository := asset.NewFileSystemRepository("testdata/repository")
+//
+//	engine := live.NewEngine(repository, live.NewReplayFeed(repository))
+//	engine.Strategies = append(engine.Strategies, trend.NewMacdStrategy())
+//	engine.Sinks = append(engine.Sinks, live.NewLogSink())
+//
+//	err := engine.Run(since)
+type Engine struct {
+	// repository is the repository to retrieve the historical snapshots from.
+	repository asset.Repository
+
+	// feed is the feed to receive the new snapshots from.
+	feed Feed
+
+	// Names is the names of the assets to follow.
+	Names []string
+
+	// Strategies is the list of strategies to apply.
+	Strategies []strategy.Strategy
+
+	// Sinks is the list of sinks to send the signals to.
+	Sinks []Sink
+
+	// WarmupPeriod is the minimum number of historical snapshots used to warm
+	// up the strategies. Strategies reporting a longer idle period extend it.
+	WarmupPeriod int
+
+	// Logger is the slog logger instance.
+	Logger *slog.Logger
+}
+
+// NewEngine function initializes a new live engine instance.
+func NewEngine(repository asset.Repository, feed Feed) *Engine {
+	return &Engine{
+		repository:   repository,
+		feed:         feed,
+		Names:        []string{},
+		Strategies:   []strategy.Strategy{},
+		Sinks:        []Sink{},
+		WarmupPeriod: DefaultWarmupPeriod,
+		Logger:       slog.Default(),
+	}
+}
+
+// Run warms up the strategies with the historical snapshots prior to the given
+// date, and follows the feed starting with the given date until the feed closes
+// for all assets. In the absence of explicitly defined assets, encompasses all
+// assets within the repository.
+func (e *Engine) Run(since time.Time) error {
+	names := e.Names
+
+	if len(names) == 0 {
+		assets, err := e.repository.Assets()
+		if err != nil {
+			return err
+		}
+
+		names = assets
+	}
+
+	if len(e.Strategies) == 0 {
+		return errors.New("no strategies")
+	}
+
+	var hasErrors atomic.Bool
+	wg := &sync.WaitGroup{}
+
+	for _, name := range names {
+		wg.Add(1)
+
+		go func(name string) {
+			defer wg.Done()
+
+			err := e.follow(name, since)
+			if err != nil {
+				e.Logger.Error("Unable to follow asset.", "asset", name, "error", err)
+				hasErrors.Store(true)
+			}
+		}(name)
+	}
+
+	wg.Wait()
+
+	if hasErrors.Load() {
+		return errors.New("has errors")
+	}
+
+	return nil
+}
+
+// warmupPeriod returns the number of historical snapshots required to warm up the strategies.
+func (e *Engine) warmupPeriod() int {
+	period := e.WarmupPeriod
+
+	for _, s := range e.Strategies {
+		if ip, ok := s.(idlePeriodStrategy); ok && ip.IdlePeriod()+1 > period {
+			period = ip.IdlePeriod() + 1
+		}
+	}
+
+	return period
+}
+
+// follow warms up a pipeline for the asset with the given name, and feeds it the new snapshots.
+func (e *Engine) follow(name string, since time.Time) error {
+	history, err := e.repository.Get(name)
+	if err != nil {
+		return err
+	}
+
+	history = helper.Last(
+		helper.Filter(history, func(s *asset.Snapshot) bool {
+			return s.Date.Before(since)
+		}),
+		e.warmupPeriod(),
+	)
+
+	snapshots, err := e.feed.Subscribe(name, since)
+	if err != nil {
+		helper.Drain(history)
+		return err
+	}
+
+	// The strategy actions for the historical snapshots only warm up the
+	// pipeline, and the changes are sent starting with the live snapshots.
+	lasts := make([]strategy.Action, len(e.Strategies))
+
+	p := newPipeline(e.Strategies, func(i int, snapshot *asset.Snapshot, action strategy.Action) {
+		if action == strategy.Hold || action == lasts[i] {
+			return
+		}
+
+		lasts[i] = action
+
+		if snapshot.Date.Before(since) {
+			return
+		}
+
+		e.send(&Signal{
+			Asset:    name,
+			Strategy: e.Strategies[i].Name(),
+			Date:     snapshot.Date,
+			Close:    snapshot.Close,
+			Action:   action,
+		})
+	})
+
+	warmup := 0
+	for snapshot := range history {
+		p.Push(snapshot)
+		warmup++
+	}
+
+	e.Logger.Info("Live engine warmed up.", "asset", name, "snapshots", warmup)
+
+	for snapshot := range snapshots {
+		p.Push(snapshot)
+	}
+
+	p.Close()
+
+	return nil
+}
+
+// send delivers the given signal to all sinks.
+func (e *Engine) send(signal *Signal) {
+	for _, sink := range e.Sinks {
+		err := sink.Send(signal)
+		if err != nil {
+			e.Logger.Error("Unable to send signal.", "asset", signal.Asset, "strategy", signal.Strategy, "error", err)
+		}
+	}
+}
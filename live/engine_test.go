@@ -0,0 +1,169 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package live_test
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/live"
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/strategy/compound"
+	"github.com/cinar/indicator/v2/strategy/momentum"
+	"github.com/cinar/indicator/v2/strategy/trend"
+	"github.com/cinar/indicator/v2/strategy/volatility"
+	"github.com/cinar/indicator/v2/strategy/volume"
+)
+
+var repositoryBase = "testdata/repository"
+
+// memorySink collects the signals in memory.
+type memorySink struct {
+	mu      sync.Mutex
+	signals []*live.Signal
+}
+
+func (m *memorySink) Send(signal *live.Signal) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.signals = append(m.signals, signal)
+	return nil
+}
+
+// expectedSignals computes the signals that the engine should generate from the given
+// strategy over the entire history, starting with the given date.
+func expectedSignals(t *testing.T, repository asset.Repository, name string, s strategy.Strategy, since time.Time) []*live.Signal {
+	snapshots, err := repository.Get(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshotsSlice := helper.ChanToSlice(snapshots)
+	actions := helper.ChanToSlice(s.Compute(helper.SliceToChan(snapshotsSlice)))
+
+	var signals []*live.Signal
+	last := strategy.Hold
+
+	for i, action := range actions {
+		if action == strategy.Hold || action == last {
+			continue
+		}
+
+		last = action
+
+		if snapshotsSlice[i].Date.Before(since) {
+			continue
+		}
+
+		signals = append(signals, &live.Signal{
+			Asset:    name,
+			Strategy: s.Name(),
+			Date:     snapshotsSlice[i].Date,
+			Close:    snapshotsSlice[i].Close,
+			Action:   action,
+		})
+	}
+
+	return signals
+}
+
+func TestEngine(t *testing.T) {
+	repository := asset.NewFileSystemRepository(repositoryBase)
+	since := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	var strategies []strategy.Strategy
+	strategies = append(strategies, compound.AllStrategies()...)
+	strategies = append(strategies, momentum.AllStrategies()...)
+	strategies = append(strategies, strategy.AllStrategies()...)
+	strategies = append(strategies, trend.AllStrategies()...)
+	strategies = append(strategies, volatility.AllStrategies()...)
+	strategies = append(strategies, volume.AllStrategies()...)
+
+	sink := &memorySink{}
+
+	engine := live.NewEngine(repository, live.NewReplayFeed(repository))
+	engine.Names = append(engine.Names, "brk-b")
+	engine.Strategies = strategies
+	engine.Sinks = append(engine.Sinks, sink)
+
+	err := engine.Run(since)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, s := range strategies {
+		expected := expectedSignals(t, repository, "brk-b", s, since)
+
+		var actual []*live.Signal
+		for _, signal := range sink.signals {
+			if signal.Strategy == s.Name() {
+				actual = append(actual, signal)
+			}
+		}
+
+		if !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("strategy %s actual %v expected %v", s.Name(), actual, expected)
+		}
+	}
+}
+
+func TestEngineAllAssets(t *testing.T) {
+	repository := asset.NewFileSystemRepository(repositoryBase)
+	since := time.Date(2023, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	engine := live.NewEngine(repository, live.NewReplayFeed(repository))
+	engine.Strategies = append(engine.Strategies, trend.NewMacdStrategy())
+
+	err := engine.Run(since)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The assets of the repository should not be kept in the configuration.
+	if len(engine.Names) != 0 {
+		t.Fatalf("names %v expected none", engine.Names)
+	}
+}
+
+func TestEngineNoStrategies(t *testing.T) {
+	repository := asset.NewFileSystemRepository(repositoryBase)
+
+	engine := live.NewEngine(repository, live.NewReplayFeed(repository))
+
+	err := engine.Run(time.Now())
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestEngineNonExistingAsset(t *testing.T) {
+	repository := asset.NewFileSystemRepository(repositoryBase)
+
+	engine := live.NewEngine(repository, live.NewReplayFeed(repository))
+	engine.Names = append(engine.Names, "non_existing")
+	engine.Strategies = append(engine.Strategies, trend.NewMacdStrategy())
+
+	err := engine.Run(time.Now())
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestEngineNonExistingRepository(t *testing.T) {
+	repository := asset.NewFileSystemRepository("testdata/non_existing")
+
+	engine := live.NewEngine(repository, live.NewReplayFeed(repository))
+	engine.Strategies = append(engine.Strategies, trend.NewMacdStrategy())
+
+	err := engine.Run(time.Now())
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
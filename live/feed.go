@@ -0,0 +1,19 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package live
+
+import (
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+)
+
+// Feed is the source of the new snapshots as they arrive.
+type Feed interface {
+	// Subscribe returns a channel of snapshots for the asset with the given
+	// name starting with the given date. The channel is closed when the feed
+	// has no more snapshots for the asset.
+	Subscribe(name string, since time.Time) (<-chan *asset.Snapshot, error)
+}
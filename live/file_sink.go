@@ -0,0 +1,53 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package live
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// FileSink appends the signals to a file as JSON lines.
+type FileSink struct {
+	// file is the file that the signals are appended to.
+	file *os.File
+
+	// encoder is the JSON encoder for the file.
+	encoder *json.Encoder
+
+	// mu serializes the writes to the file.
+	mu sync.Mutex
+}
+
+// NewFileSink function opens the given file for appending and initializes a new file sink instance.
+func NewFileSink(fileName string) (*FileSink, error) {
+	file, err := os.OpenFile(filepath.Clean(fileName), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileSink{
+		file:    file,
+		encoder: json.NewEncoder(file),
+	}, nil
+}
+
+// Send delivers the given signal to the sink.
+func (f *FileSink) Send(signal *Signal) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.encoder.Encode(signal)
+}
+
+// Close closes the underlying file.
+func (f *FileSink) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.file.Close()
+}
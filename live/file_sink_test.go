@@ -0,0 +1,75 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package live_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/live"
+	"github.com/cinar/indicator/v2/strategy"
+)
+
+func TestFileSink(t *testing.T) {
+	outputDir, err := os.MkdirTemp("", "live")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer helper.RemoveAll(t, outputDir)
+
+	fileName := filepath.Join(outputDir, "signals.json")
+
+	sink, err := live.NewFileSink(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &live.Signal{
+		Asset:    "brk-b",
+		Strategy: "Test Strategy",
+		Date:     time.Date(2023, 11, 29, 0, 0, 0, 0, time.UTC),
+		Close:    358.69,
+		Action:   strategy.Buy,
+	}
+
+	err = sink.Send(expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = sink.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual := &live.Signal{}
+
+	err = json.Unmarshal(data, actual)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestFileSinkNonExisting(t *testing.T) {
+	_, err := live.NewFileSink("testdata/non_existing/signals.json")
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package live
+
+import (
+	"log/slog"
+)
+
+// LogSink writes the signals to a slog logger.
+type LogSink struct {
+	// Logger is the slog logger instance.
+	Logger *slog.Logger
+}
+
+// NewLogSink function initializes a new log sink instance using the default logger.
+func NewLogSink() *LogSink {
+	return &LogSink{
+		Logger: slog.Default(),
+	}
+}
+
+// Send delivers the given signal to the sink.
+func (l *LogSink) Send(signal *Signal) error {
+	l.Logger.Info("Action changed.",
+		"asset", signal.Asset,
+		"strategy", signal.Strategy,
+		"date", signal.Date,
+		"close", signal.Close,
+		"action", signal.Action.Annotation(),
+	)
+
+	return nil
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package live
+
+import (
+	"sync"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+)
+
+// pipelineFunc is called with the index of the strategy, the snapshot, and the
+// action that the strategy recommended for that snapshot.
+type pipelineFunc func(i int, snapshot *asset.Snapshot, action strategy.Action)
+
+// pipeline keeps the channel based strategies for a single asset running, and
+// feeds them one snapshot at a time. Some strategies yield the action for a
+// snapshot only after receiving the next one, so the actions are read
+// independently from the snapshots being pushed.
+type pipeline struct {
+	// input is the channel that the snapshots are pushed to.
+	input chan *asset.Snapshot
+
+	// pending are the snapshots that are waiting for an action, one queue for each strategy.
+	pending [][]*asset.Snapshot
+
+	// mu guards the pending queues.
+	mu sync.Mutex
+
+	// cond signals the arrival of new snapshots to the action readers.
+	cond *sync.Cond
+
+	// closed indicates that no more snapshots will be pushed.
+	closed bool
+
+	// wg waits for the action readers.
+	wg sync.WaitGroup
+}
+
+// newPipeline function initializes a new pipeline for the given strategies.
+func newPipeline(strategies []strategy.Strategy, f pipelineFunc) *pipeline {
+	p := &pipeline{
+		input:   make(chan *asset.Snapshot),
+		pending: make([][]*asset.Snapshot, len(strategies)),
+	}
+
+	p.cond = sync.NewCond(&p.mu)
+
+	snapshots := helper.Duplicate[*asset.Snapshot](p.input, len(strategies))
+
+	for i, s := range strategies {
+		p.wg.Add(1)
+
+		go func(i int, actions <-chan strategy.Action) {
+			defer p.wg.Done()
+
+			for action := range actions {
+				snapshot := p.next(i)
+				if snapshot != nil {
+					f(i, snapshot, action)
+				}
+			}
+		}(i, s.Compute(snapshots[i]))
+	}
+
+	return p
+}
+
+// Push feeds the given snapshot to the strategies.
+func (p *pipeline) Push(snapshot *asset.Snapshot) {
+	p.mu.Lock()
+	for i := range p.pending {
+		p.pending[i] = append(p.pending[i], snapshot)
+	}
+	p.cond.Broadcast()
+	p.mu.Unlock()
+
+	p.input <- snapshot
+}
+
+// Close stops the pipeline and waits for the remaining actions.
+func (p *pipeline) Close() {
+	p.mu.Lock()
+	p.closed = true
+	p.cond.Broadcast()
+	p.mu.Unlock()
+
+	close(p.input)
+	p.wg.Wait()
+}
+
+// next removes and returns the oldest snapshot waiting for an action from the strategy at the given
+// index. Strategies may yield the idle period actions before the snapshots are pushed, so it waits
+// for the snapshot to arrive. It returns nil if the pipeline is closed and no snapshots are left.
+func (p *pipeline) next(i int) *asset.Snapshot {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for len(p.pending[i]) == 0 {
+		if p.closed {
+			return nil
+		}
+
+		p.cond.Wait()
+	}
+
+	snapshot := p.pending[i][0]
+	p.pending[i] = p.pending[i][1:]
+
+	return snapshot
+}
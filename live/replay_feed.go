@@ -0,0 +1,76 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package live
+
+import (
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+)
+
+const (
+	// DefaultReplayFeedSpeed is the default replay feed speed.
+	DefaultReplayFeedSpeed = 0
+)
+
+// ReplayFeed replays the snapshots stored in a repository as a live feed. It
+// is primarily used for testing the live engine offline.
+//
+// Example:
+//
+//	repository := asset.NewFileSystemRepository("testdata/repository")
+//
+//	feed := live.NewReplayFeed(repository)
+//	feed.Speed = 3600
+//
+//	snapshots, err := feed.Subscribe("brk-b", since)
+type ReplayFeed struct {
+	// repository is the repository to replay the snapshots from.
+	repository asset.Repository
+
+	// Speed is the replay speed relative to the time elapsed between the
+	// snapshots. A speed of 3600 replays an hour of data in a second. A speed
+	// of zero replays the snapshots without any delay.
+	Speed float64
+}
+
+// NewReplayFeed function initializes a new replay feed instance for the given repository.
+func NewReplayFeed(repository asset.Repository) *ReplayFeed {
+	return &ReplayFeed{
+		repository: repository,
+		Speed:      DefaultReplayFeedSpeed,
+	}
+}
+
+// Subscribe returns a channel of snapshots for the asset with the given name starting with the given date.
+func (r *ReplayFeed) Subscribe(name string, since time.Time) (<-chan *asset.Snapshot, error) {
+	snapshots, err := r.repository.GetSince(name, since)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Speed <= 0 {
+		return snapshots, nil
+	}
+
+	result := make(chan *asset.Snapshot)
+
+	go func() {
+		defer close(result)
+
+		var last time.Time
+
+		for snapshot := range snapshots {
+			if !last.IsZero() {
+				time.Sleep(time.Duration(float64(snapshot.Date.Sub(last)) / r.Speed))
+			}
+
+			last = snapshot.Date
+			result <- snapshot
+		}
+	}()
+
+	return result, nil
+}
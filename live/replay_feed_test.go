@@ -0,0 +1,74 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package live_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/live"
+)
+
+func TestReplayFeed(t *testing.T) {
+	repository := asset.NewFileSystemRepository(repositoryBase)
+	since := time.Date(2023, 11, 20, 0, 0, 0, 0, time.UTC)
+
+	expected, err := repository.GetSince("brk-b", since)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feed := live.NewReplayFeed(repository)
+
+	actual, err := feed.Subscribe("brk-b", since)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = helper.CheckEquals(
+		asset.SnapshotsAsDates(actual),
+		asset.SnapshotsAsDates(expected),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReplayFeedSpeed(t *testing.T) {
+	repository := asset.NewFileSystemRepository(repositoryBase)
+	since := time.Date(2023, 11, 27, 0, 0, 0, 0, time.UTC)
+
+	feed := live.NewReplayFeed(repository)
+	feed.Speed = float64(24 * time.Hour / (10 * time.Millisecond))
+
+	snapshots, err := feed.Subscribe("brk-b", since)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	count := len(helper.ChanToSlice(snapshots))
+	elapsed := time.Since(start)
+
+	if count != 3 {
+		t.Fatalf("actual %d expected 3", count)
+	}
+
+	if elapsed < 20*time.Millisecond {
+		t.Fatalf("replay is too fast %v", elapsed)
+	}
+}
+
+func TestReplayFeedNonExisting(t *testing.T) {
+	repository := asset.NewFileSystemRepository("testdata/non_existing")
+	feed := live.NewReplayFeed(repository)
+
+	_, err := feed.Subscribe("brk-b", time.Now())
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package live
+
+import (
+	"time"
+
+	"github.com/cinar/indicator/v2/strategy"
+)
+
+// Signal represents a change in the action recommended by a strategy for an asset.
+type Signal struct {
+	// Asset is the name of the asset.
+	Asset string `json:"asset"`
+
+	// Strategy is the name of the strategy.
+	Strategy string `json:"strategy"`
+
+	// Date is the date of the snapshot that triggered the signal.
+	Date time.Time `json:"date"`
+
+	// Close is the closing price of the snapshot that triggered the signal.
+	Close float64 `json:"close"`
+
+	// Action is the newly recommended action.
+	Action strategy.Action `json:"action"`
+}
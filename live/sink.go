@@ -0,0 +1,13 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package live
+
+// Sink is the destination for the signals generated by the live engine. The
+// engine processes the assets concurrently, so sinks must be safe for
+// concurrent use.
+type Sink interface {
+	// Send delivers the given signal to the sink.
+	Send(signal *Signal) error
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package live
+
+import (
+	"fmt"
+)
+
+const (
+	// LogSinkBuilderName is the name for the log sink builder.
+	LogSinkBuilderName = "log"
+
+	// FileSinkBuilderName is the name for the file sink builder.
+	FileSinkBuilderName = "file"
+
+	// WebhookSinkBuilderName is the name for the webhook sink builder.
+	WebhookSinkBuilderName = "webhook"
+)
+
+// SinkBuilderFunc defines a function to build a new sink using the given configuration parameter.
+type SinkBuilderFunc func(config string) (Sink, error)
+
+// sinkBuilders provides mapping for the sink builders.
+var sinkBuilders = map[string]SinkBuilderFunc{
+	LogSinkBuilderName:     logSinkBuilder,
+	FileSinkBuilderName:    fileSinkBuilder,
+	WebhookSinkBuilderName: webhookSinkBuilder,
+}
+
+// RegisterSinkBuilder registers the given builder.
+func RegisterSinkBuilder(name string, builder SinkBuilderFunc) {
+	sinkBuilders[name] = builder
+}
+
+// NewSink builds a new sink by the given name type and the configuration.
+func NewSink(name, config string) (Sink, error) {
+	builder, ok := sinkBuilders[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown sink: %s", name)
+	}
+
+	return builder(config)
+}
+
+// logSinkBuilder builds a new log sink instance.
+func logSinkBuilder(_ string) (Sink, error) {
+	return NewLogSink(), nil
+}
+
+// fileSinkBuilder builds a new file sink instance.
+func fileSinkBuilder(config string) (Sink, error) {
+	return NewFileSink(config)
+}
+
+// webhookSinkBuilder builds a new webhook sink instance.
+func webhookSinkBuilder(config string) (Sink, error) {
+	return NewWebhookSink(config), nil
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package live_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/live"
+)
+
+func TestNewSinkUnknown(t *testing.T) {
+	sink, err := live.NewSink("unknown", "")
+	if err == nil {
+		t.Fatalf("unknown sink: %T", sink)
+	}
+}
+
+func TestRegisterSinkBuilder(t *testing.T) {
+	builderName := "testbuilder"
+
+	sink, err := live.NewSink(builderName, "")
+	if err == nil {
+		t.Fatalf("testbuilder is: %T", sink)
+	}
+
+	live.RegisterSinkBuilder(builderName, func(_ string) (live.Sink, error) {
+		return live.NewLogSink(), nil
+	})
+
+	sink, err = live.NewSink(builderName, "")
+	if err != nil {
+		t.Fatalf("testbuilder is not found: %v", err)
+	}
+
+	_, ok := sink.(*live.LogSink)
+	if !ok {
+		t.Fatalf("testbuilder is: %T", sink)
+	}
+}
+
+func TestNewSinkLog(t *testing.T) {
+	sink, err := live.NewSink(live.LogSinkBuilderName, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, ok := sink.(*live.LogSink)
+	if !ok {
+		t.Fatalf("sink not correct type: %T", sink)
+	}
+
+	err = sink.Send(&live.Signal{})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNewSinkWebhook(t *testing.T) {
+	sink, err := live.NewSink(live.WebhookSinkBuilderName, "http://localhost/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, ok := sink.(*live.WebhookSink)
+	if !ok {
+		t.Fatalf("sink not correct type: %T", sink)
+	}
+}
+
+func TestNewSinkFile(t *testing.T) {
+	_, err := live.NewSink(live.FileSinkBuilderName, "testdata/non_existing/signals.json")
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package live
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	// DefaultWebhookSinkTimeout is the default timeout for the webhook requests.
+	DefaultWebhookSinkTimeout = 10 * time.Second
+)
+
+// WebhookSink posts the signals as JSON to the given URL.
+type WebhookSink struct {
+	// URL is the webhook URL.
+	URL string
+
+	// client is the HTTP client.
+	client *http.Client
+}
+
+// NewWebhookSink function initializes a new webhook sink instance with the given URL.
+func NewWebhookSink(url string) *WebhookSink {
+	return &WebhookSink{
+		URL: url,
+		client: &http.Client{
+			Timeout: DefaultWebhookSinkTimeout,
+		},
+	}
+}
+
+// Send delivers the given signal to the sink.
+func (w *WebhookSink) Send(signal *Signal) error {
+	body, err := json.Marshal(signal)
+	if err != nil {
+		return err
+	}
+
+	res, err := w.client.Post(w.URL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	err = res.Body.Close()
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("webhook status %d %s", res.StatusCode, res.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package live_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cinar/indicator/v2/live"
+	"github.com/cinar/indicator/v2/strategy"
+)
+
+func TestWebhookSink(t *testing.T) {
+	var actual live.Signal
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		err := json.NewDecoder(r.Body).Decode(&actual)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	sink := live.NewWebhookSink(server.URL)
+
+	err := sink.Send(&live.Signal{
+		Asset:  "brk-b",
+		Action: strategy.Sell,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if actual.Asset != "brk-b" || actual.Action != strategy.Sell {
+		t.Fatalf("actual %v", actual)
+	}
+}
+
+func TestWebhookSinkStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	sink := live.NewWebhookSink(server.URL)
+
+	err := sink.Send(&live.Signal{})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
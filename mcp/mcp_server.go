@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/strategy/spec"
 	"github.com/mark3labs/mcp-go/mcp"
 	"github.com/mark3labs/mcp-go/server"
 )
@@ -122,44 +123,8 @@ func handleBacktest(_ context.Context, _ mcp.CallToolRequest, args StrategyReque
 }
 
 // GetAllStrategyTypes returns a slice of all available strategy types as strings.
-// This list includes base, trend, momentum, and volume strategies, providing a
-// comprehensive set of options for backtesting.
+// The types are the ones registered in the strategy spec registry, in sorted
+// order, providing the same set of options as the backend.
 func GetAllStrategyTypes() []string {
-	return []string{
-		// Base strategies
-		string(StrategyBuyAndHold),
-
-		// Trend strategies
-		string(StrategyAlligator),
-		string(StrategyAroon),
-		string(StrategyApo),
-		string(StrategyBop),
-		string(StrategyCci),
-		string(StrategyDema),
-		string(StrategyGoldenCross),
-		string(StrategyKama),
-		string(StrategyKdj),
-		string(StrategyMACD),
-		string(StrategyQstick),
-		string(StrategySmma),
-		string(StrategyTrima),
-		string(StrategyTripleMaCrossover),
-		string(StrategyTsi),
-		string(StrategyVwma),
-		string(StrategyWeightedClose),
-
-		// Momentum strategies
-		string(StrategyAwesomeOscillator),
-		string(StrategyRsi),
-		string(StrategyStochasticRsi),
-		string(StrategyTripleRsi),
-
-		// Volume strategies
-		string(StrategyChaikinMoneyFlow),
-		string(StrategyEaseOfMovement),
-		string(StrategyForceIndex),
-		string(StrategyMoneyFlowIndex),
-		string(StrategyNegativeVolumeIndex),
-		string(StrategyWeightedAveragePrice),
-	}
+	return spec.Types()
 }
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestCreateStrategy(t *testing.T) {
+	for _, name := range []string{"macd", "vwap_ema", "donchian_breakout"} {
+		s, err := CreateStrategy(StrategyType(name))
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+
+		if s.Name() == "" {
+			t.Fatalf("%s: empty name", name)
+		}
+	}
+
+	if _, err := CreateStrategy("unknown"); err == nil {
+		t.Fatal("expected error for unknown strategy")
+	}
+}
@@ -5,6 +5,8 @@
 package momentum
 
 import (
+	"encoding/json"
+
 	"github.com/cinar/indicator/v2/helper"
 	"github.com/cinar/indicator/v2/trend"
 )
@@ -36,6 +38,12 @@ type AwesomeOscillator[T helper.Number] struct {
 
 	// LongSma is the SMA for the long period.
 	LongSma *trend.Sma[T]
+
+	// shortSma is the short SMA state.
+	shortSma *trend.Sma[T]
+
+	// longSma is the long SMA state.
+	longSma *trend.Sma[T]
 }
 
 // NewAwesomeOscillator function initializes a new Awesome Oscillator instance.
@@ -48,23 +56,97 @@ func NewAwesomeOscillator[T helper.Number]() *AwesomeOscillator[T] {
 
 // Compute function takes a channel of numbers and computes the AwesomeOscillator.
 func (a *AwesomeOscillator[T]) Compute(highs, lows <-chan T) <-chan T {
-	medianSplice := helper.Duplicate(
-		helper.DivideBy(
-			helper.Add(highs, lows),
-			2,
-		),
-		2,
-	)
-
-	shortSma := a.ShortSma.Compute(medianSplice[0])
-	longSma := a.LongSma.Compute(medianSplice[1])
-
-	shortSma = helper.Skip(shortSma, a.LongSma.IdlePeriod()-a.ShortSma.IdlePeriod())
-
-	return helper.Subtract(
-		shortSma,
-		longSma,
-	)
+	return helper.Compute2(highs, lows, a.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the AwesomeOscillator.
+func (a *AwesomeOscillator[T]) ComputeSlice(highs, lows []T) []T {
+	return helper.ComputeSlice2(highs, lows, a.Clone().Update)
+}
+
+// Update function takes a high and a low value, and updates the Awesome Oscillator. It returns the
+// Awesome Oscillator value, and whether the Awesome Oscillator has passed its idle period and the
+// value is ready.
+func (a *AwesomeOscillator[T]) Update(high, low T) (T, bool) {
+	if a.shortSma == nil {
+		a.shortSma = a.ShortSma.Clone()
+		a.longSma = a.LongSma.Clone()
+	}
+
+	median := (high + low) / 2
+
+	shortSma, _ := a.shortSma.Update(median)
+
+	longSma, ok := a.longSma.Update(median)
+	if !ok {
+		return 0, false
+	}
+
+	return shortSma - longSma, true
+}
+
+// Reset function resets the state of the Awesome Oscillator.
+func (a *AwesomeOscillator[T]) Reset() {
+	a.shortSma = nil
+	a.longSma = nil
+}
+
+// Clone function returns a new Awesome Oscillator instance with the same configuration and a fresh state.
+func (a *AwesomeOscillator[T]) Clone() *AwesomeOscillator[T] {
+	return &AwesomeOscillator[T]{
+		ShortSma: a.ShortSma.Clone(),
+		LongSma:  a.LongSma.Clone(),
+	}
+}
+
+// awesomeOscillatorState is the state of the Awesome Oscillator.
+type awesomeOscillatorState struct {
+	// ShortSma is the short SMA state.
+	ShortSma json.RawMessage `json:"shortSma"`
+
+	// LongSma is the long SMA state.
+	LongSma json.RawMessage `json:"longSma"`
+}
+
+// MarshalState function returns the state of the Awesome Oscillator as JSON.
+func (a *AwesomeOscillator[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := awesomeOscillatorState{}
+
+	state.ShortSma, err = helper.MarshalStateOf(a.shortSma)
+	if err != nil {
+		return nil, err
+	}
+
+	state.LongSma, err = helper.MarshalStateOf(a.longSma)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the Awesome Oscillator from the given JSON.
+func (a *AwesomeOscillator[T]) UnmarshalState(data []byte) error {
+	var state awesomeOscillatorState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&a.shortSma, state.ShortSma, a.ShortSma.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&a.longSma, state.LongSma, a.LongSma.Clone)
+	if err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // IdlePeriod is the initial period that Awesome Oscillator won't yield any results.
@@ -5,6 +5,8 @@
 package momentum
 
 import (
+	"encoding/json"
+
 	"github.com/cinar/indicator/v2/helper"
 	"github.com/cinar/indicator/v2/trend"
 	"github.com/cinar/indicator/v2/volume"
@@ -38,6 +40,15 @@ type ChaikinOscillator[T helper.Number] struct {
 
 	// LongEma is the SMA for the long period.
 	LongEma *trend.Ema[T]
+
+	// ad is the A/D state.
+	ad *volume.Ad[T]
+
+	// shortEma is the short EMA state.
+	shortEma *trend.Ema[T]
+
+	// longEma is the long EMA state.
+	longEma *trend.Ema[T]
 }
 
 // NewChaikinOscillator function initializes a new Chaikin Oscillator instance.
@@ -51,20 +62,125 @@ func NewChaikinOscillator[T helper.Number]() *ChaikinOscillator[T] {
 
 // Compute function takes a channel of numbers and computes the Chaikin Oscillator.
 func (c *ChaikinOscillator[T]) Compute(highs, lows, closings, volumes <-chan T) (<-chan T, <-chan T) {
-	adSplice := helper.Duplicate(
-		c.Ad.Compute(highs, lows, closings, volumes),
-		3,
-	)
+	chaikinOscillator := c.Clone()
+
+	values := helper.Compute4(highs, lows, closings, volumes, func(high, low, closing, volume T) ([]T, bool) {
+		co, ad, ok := chaikinOscillator.Update(high, low, closing, volume)
+		return []T{co, ad}, ok
+	})
+
+	outputs := helper.Unzip(values, 2)
+
+	return outputs[0], outputs[1]
+}
+
+// ComputeSlice function takes a slice of numbers and computes the Chaikin Oscillator.
+func (c *ChaikinOscillator[T]) ComputeSlice(highs, lows, closings, volumes []T) ([]T, []T) {
+	chaikinOscillator := c.Clone()
+
+	values := helper.ComputeSlice4(highs, lows, closings, volumes, func(high, low, closing, volume T) ([]T, bool) {
+		co, ad, ok := chaikinOscillator.Update(high, low, closing, volume)
+		return []T{co, ad}, ok
+	})
+
+	outputs := helper.UnzipSlice(values, 2)
+
+	return outputs[0], outputs[1]
+}
+
+// Update function takes a high, a low, a closing, and a volume value, and updates the Chaikin
+// Oscillator. It returns the Chaikin Oscillator and the A/D values, and whether the Chaikin Oscillator
+// has passed its idle period and the values are ready.
+func (c *ChaikinOscillator[T]) Update(high, low, closing, volume T) (T, T, bool) {
+	if c.ad == nil {
+		c.ad = c.Ad.Clone()
+		c.shortEma = c.ShortEma.Clone()
+		c.longEma = c.LongEma.Clone()
+	}
+
+	ad, _ := c.ad.Update(high, low, closing, volume)
+	shortEma, _ := c.shortEma.Update(ad)
+
+	longEma, ok := c.longEma.Update(ad)
+	if !ok {
+		return 0, 0, false
+	}
+
+	return shortEma - longEma, ad, true
+}
+
+// Reset function resets the state of the Chaikin Oscillator.
+func (c *ChaikinOscillator[T]) Reset() {
+	c.ad = nil
+	c.shortEma = nil
+	c.longEma = nil
+}
 
-	shortEma := c.ShortEma.Compute(adSplice[0])
-	longEma := c.LongEma.Compute(adSplice[1])
+// Clone function returns a new Chaikin Oscillator instance with the same configuration and a fresh state.
+func (c *ChaikinOscillator[T]) Clone() *ChaikinOscillator[T] {
+	return &ChaikinOscillator[T]{
+		Ad:       c.Ad.Clone(),
+		ShortEma: c.ShortEma.Clone(),
+		LongEma:  c.LongEma.Clone(),
+	}
+}
+
+// chaikinOscillatorState is the state of the Chaikin Oscillator.
+type chaikinOscillatorState struct {
+	// Ad is the A/D state.
+	Ad json.RawMessage `json:"ad"`
+
+	// ShortEma is the short EMA state.
+	ShortEma json.RawMessage `json:"shortEma"`
+
+	// LongEma is the long EMA state.
+	LongEma json.RawMessage `json:"longEma"`
+}
+
+// MarshalState function returns the state of the Chaikin Oscillator as JSON.
+func (c *ChaikinOscillator[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := chaikinOscillatorState{}
+
+	state.Ad, err = helper.MarshalStateOf(c.ad)
+	if err != nil {
+		return nil, err
+	}
 
-	shortEma = helper.Skip(shortEma, c.LongEma.IdlePeriod()-c.ShortEma.IdlePeriod())
+	state.ShortEma, err = helper.MarshalStateOf(c.shortEma)
+	if err != nil {
+		return nil, err
+	}
+
+	state.LongEma, err = helper.MarshalStateOf(c.longEma)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the Chaikin Oscillator from the given JSON.
+func (c *ChaikinOscillator[T]) UnmarshalState(data []byte) error {
+	var state chaikinOscillatorState
 
-	co := helper.Subtract(shortEma, longEma)
-	adSplice[2] = helper.Skip(adSplice[2], c.LongEma.IdlePeriod())
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&c.ad, state.Ad, c.Ad.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&c.shortEma, state.ShortEma, c.ShortEma.Clone)
+	if err != nil {
+		return err
+	}
 
-	return co, adSplice[2]
+	return helper.UnmarshalStateOf(&c.longEma, state.LongEma, c.LongEma.Clone)
 }
 
 // IdlePeriod is the initial period that Chaikin Oscillator won't yield any results.
@@ -0,0 +1,199 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package momentum_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/momentum"
+)
+
+// checkpointBar is a bar of the synthetic input of the checkpoint tests.
+type checkpointBar struct {
+	Open   float64
+	High   float64
+	Low    float64
+	Close  float64
+	Volume float64
+}
+
+// checkpointCase is an indicator that is checkpointed and restored.
+type checkpointCase struct {
+	// New returns a new instance of the indicator.
+	New func() helper.Stateful
+
+	// Update updates the given indicator with the given bar.
+	Update func(s helper.Stateful, bar checkpointBar) ([]float64, bool)
+}
+
+func checkpointBars(n int) []checkpointBar {
+	bars := make([]checkpointBar, n)
+
+	for i := range bars {
+		x := float64(i)
+		closing := 100 + 10*math.Sin(x/9) + 3*math.Sin(x/2.3) + 0.1*x
+
+		bars[i] = checkpointBar{
+			Open:   closing - 0.5*math.Cos(x),
+			High:   closing + 1 + 0.5*math.Abs(math.Sin(x/1.7)),
+			Low:    closing - 1 - 0.5*math.Abs(math.Cos(x/1.3)),
+			Close:  closing,
+			Volume: 1000 + 300*math.Sin(x/5),
+		}
+	}
+
+	return bars
+}
+
+func values1(value float64, ok bool) ([]float64, bool) {
+	return []float64{value}, ok
+}
+
+func values2(value1, value2 float64, ok bool) ([]float64, bool) {
+	return []float64{value1, value2}, ok
+}
+
+func values3(value1, value2, value3 float64, ok bool) ([]float64, bool) {
+	return []float64{value1, value2, value3}, ok
+}
+
+func values5(value1, value2, value3, value4, value5 float64, ok bool) ([]float64, bool) {
+	return []float64{value1, value2, value3, value4, value5}, ok
+}
+
+func TestCheckpointRestore(t *testing.T) {
+	cases := map[string]checkpointCase{
+		"AwesomeOscillator": {
+			New: func() helper.Stateful { return momentum.NewAwesomeOscillator[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*momentum.AwesomeOscillator[float64]).Update(b.High, b.Low))
+			},
+		},
+		"ChaikinOscillator": {
+			New: func() helper.Stateful { return momentum.NewChaikinOscillator[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values2(s.(*momentum.ChaikinOscillator[float64]).Update(b.High, b.Low, b.Close, b.Volume))
+			},
+		},
+		"Divergence": {
+			New: func() helper.Stateful { return momentum.NewDivergenceWith[float64](2, 30) },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				divergence, ok := s.(*momentum.Divergence[float64]).Update(b.Close, b.Volume)
+				return []float64{float64(divergence)}, ok
+			},
+		},
+		"IchimokuCloud": {
+			New: func() helper.Stateful { return momentum.NewIchimokuCloud[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values5(s.(*momentum.IchimokuCloud[float64]).Update(b.High, b.Low, b.Close))
+			},
+		},
+		"Ppo": {
+			New: func() helper.Stateful { return momentum.NewPpo[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values3(s.(*momentum.Ppo[float64]).Update(b.Close))
+			},
+		},
+		"Pvo": {
+			New: func() helper.Stateful { return momentum.NewPvo[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values3(s.(*momentum.Pvo[float64]).Update(b.Volume))
+			},
+		},
+		"Qstick": {
+			New: func() helper.Stateful { return momentum.NewQstick[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*momentum.Qstick[float64]).Update(b.Open, b.Close))
+			},
+		},
+		"Rsi": {
+			New: func() helper.Stateful { return momentum.NewRsi[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*momentum.Rsi[float64]).Update(b.Close))
+			},
+		},
+		"StochasticOscillator": {
+			New: func() helper.Stateful { return momentum.NewStochasticOscillator[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values2(s.(*momentum.StochasticOscillator[float64]).Update(b.High, b.Low, b.Close))
+			},
+		},
+		"StochasticRsi": {
+			New: func() helper.Stateful { return momentum.NewStochasticRsi[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*momentum.StochasticRsi[float64]).Update(b.Close))
+			},
+		},
+		"WilliamsR": {
+			New: func() helper.Stateful { return momentum.NewWilliamsR[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*momentum.WilliamsR[float64]).Update(b.High, b.Low, b.Close))
+			},
+		},
+	}
+
+	bars := checkpointBars(120)
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			checkCheckpointRestore(t, c, bars)
+		})
+	}
+}
+
+// checkCheckpointRestore checks that the indicator restored from a checkpoint taken after each bar
+// produces the same output as the uninterrupted one.
+func checkCheckpointRestore(t *testing.T, c checkpointCase, bars []checkpointBar) {
+	t.Helper()
+
+	type output struct {
+		Values []float64
+		Ok     bool
+	}
+
+	expected := make([]output, len(bars))
+
+	uninterrupted := c.New()
+	for i, bar := range bars {
+		expected[i].Values, expected[i].Ok = c.Update(uninterrupted, bar)
+	}
+
+	for split := 0; split <= len(bars); split++ {
+		actual := make([]output, len(bars))
+
+		before := c.New()
+		for i, bar := range bars[:split] {
+			actual[i].Values, actual[i].Ok = c.Update(before, bar)
+		}
+
+		data, err := helper.Checkpoint(before)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		after := c.New()
+
+		// The restored state replaces any state the indicator has.
+		for _, bar := range bars[:3] {
+			c.Update(after, bar)
+		}
+
+		err = helper.Restore(after, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i, bar := range bars[split:] {
+			actual[split+i].Values, actual[split+i].Ok = c.Update(after, bar)
+		}
+
+		if !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("split %d actual %v expected %v", split, actual, expected)
+		}
+	}
+}
@@ -0,0 +1,366 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package momentum
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultDivergenceLookback is the default number of values on each side of a swing pivot.
+	DefaultDivergenceLookback = 5
+
+	// DefaultDivergenceMaxRange is the default maximum number of values between the two compared pivots.
+	DefaultDivergenceMaxRange = 60
+)
+
+// DivergenceType represents the type of the divergence between the price and the oscillator.
+type DivergenceType int
+
+const (
+	// NoDivergence indicates that no divergence is detected.
+	NoDivergence DivergenceType = iota
+
+	// RegularBullishDivergence indicates a lower low in the price with a higher low in the oscillator,
+	// signaling a possible reversal to the upside.
+	RegularBullishDivergence
+
+	// HiddenBullishDivergence indicates a higher low in the price with a lower low in the oscillator,
+	// signaling a possible continuation of the uptrend.
+	HiddenBullishDivergence
+
+	// RegularBearishDivergence indicates a higher high in the price with a lower high in the oscillator,
+	// signaling a possible reversal to the downside.
+	RegularBearishDivergence
+
+	// HiddenBearishDivergence indicates a lower high in the price with a higher high in the oscillator,
+	// signaling a possible continuation of the downtrend.
+	HiddenBearishDivergence
+)
+
+// IsBullish checks if the divergence is a regular or a hidden bullish divergence.
+func (d DivergenceType) IsBullish() bool {
+	return d == RegularBullishDivergence || d == HiddenBullishDivergence
+}
+
+// IsBearish checks if the divergence is a regular or a hidden bearish divergence.
+func (d DivergenceType) IsBearish() bool {
+	return d == RegularBearishDivergence || d == HiddenBearishDivergence
+}
+
+// String returns the name of the divergence type.
+func (d DivergenceType) String() string {
+	switch d {
+	case RegularBullishDivergence:
+		return "Regular Bullish"
+
+	case HiddenBullishDivergence:
+		return "Hidden Bullish"
+
+	case RegularBearishDivergence:
+		return "Regular Bearish"
+
+	case HiddenBearishDivergence:
+		return "Hidden Bearish"
+
+	default:
+		return ""
+	}
+}
+
+// Divergence represents the configuration parameters for detecting the divergences between a price and
+// an oscillator, such as the RSI, MACD, OBV, or the Stochastic Oscillator. A swing low pivot is a price
+// that is lower than the Lookback prices on each side of it, and a swing high pivot is a price that is
+// higher than them. Each new pivot is compared with the previous pivot of the same kind that is at most
+// MaxRange values before it, along with the oscillator values at the both pivots.
+//
+//	Regular Bullish = Price Lower Low and Oscillator Higher Low.
+//	Hidden Bullish = Price Higher Low and Oscillator Lower Low.
+//	Regular Bearish = Price Higher High and Oscillator Lower High.
+//	Hidden Bearish = Price Lower High and Oscillator Higher High.
+//
+// A pivot is only confirmed after the Lookback values that follow it, so the divergence is reported at
+// the value that confirms the pivot, not at the pivot itself.
+//
+// Example:
+//
+//	divergence := momentum.NewDivergence[float64]()
+//	divergences := divergence.Compute(closings, rsi)
+type Divergence[T helper.Number] struct {
+	// Lookback is the number of values on each side of a swing pivot.
+	Lookback int
+
+	// MaxRange is the maximum number of values between the two compared pivots.
+	MaxRange int
+
+	// points is the prices and the oscillator values in the pivot window.
+	points *helper.Ring[divergencePoint[T]]
+
+	// low is the last swing low pivot.
+	low *divergencePivot[T]
+
+	// high is the last swing high pivot.
+	high *divergencePivot[T]
+
+	// index is the number of values processed.
+	index int
+}
+
+// divergencePoint is a price and the oscillator value for the same period.
+type divergencePoint[T helper.Number] struct {
+	price      T
+	oscillator T
+}
+
+// divergencePivot is a swing pivot and its index.
+type divergencePivot[T helper.Number] struct {
+	index      int
+	price      T
+	oscillator T
+}
+
+// NewDivergence function initializes a new divergence instance with the default parameters.
+func NewDivergence[T helper.Number]() *Divergence[T] {
+	return NewDivergenceWith[T](
+		DefaultDivergenceLookback,
+		DefaultDivergenceMaxRange,
+	)
+}
+
+// NewDivergenceWith function initializes a new divergence instance with the given parameters.
+func NewDivergenceWith[T helper.Number](lookback, maxRange int) *Divergence[T] {
+	return &Divergence[T]{
+		Lookback: lookback,
+		MaxRange: maxRange,
+	}
+}
+
+// Compute function takes a channel of prices and a channel of the oscillator values for the same
+// periods, and detects the divergences between them. It yields one divergence type per value.
+func (d *Divergence[T]) Compute(prices, oscillators <-chan T) <-chan DivergenceType {
+	return helper.Compute2(prices, oscillators, d.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of prices and a slice of the oscillator values for the same
+// periods, and detects the divergences between them. It yields one divergence type per value.
+func (d *Divergence[T]) ComputeSlice(prices, oscillators []T) []DivergenceType {
+	return helper.ComputeSlice2(prices, oscillators, d.Clone().Update)
+}
+
+// Update function takes a price and the oscillator value for the same period, and updates the
+// divergence. It returns the detected divergence type, and whether the value is ready. The
+// divergence yields one value per period, so the value is always ready.
+func (d *Divergence[T]) Update(price, oscillator T) (DivergenceType, bool) {
+	window := 2*d.Lookback + 1
+
+	if d.points == nil {
+		d.points = helper.NewRing[divergencePoint[T]](window)
+	}
+
+	d.points.Put(divergencePoint[T]{
+		price:      price,
+		oscillator: oscillator,
+	})
+
+	d.index++
+
+	if !d.points.IsFull() {
+		return NoDivergence, true
+	}
+
+	// The candidate pivot is at the middle of the window.
+	candidate := d.points.At(d.Lookback)
+	current := &divergencePivot[T]{
+		index:      d.index - d.Lookback,
+		price:      candidate.price,
+		oscillator: candidate.oscillator,
+	}
+
+	isLow := true
+	isHigh := true
+
+	for i := 0; i < window; i++ {
+		if i == d.Lookback {
+			continue
+		}
+
+		other := d.points.At(i).price
+		isLow = isLow && current.price < other
+		isHigh = isHigh && current.price > other
+	}
+
+	divergence := NoDivergence
+
+	if isLow {
+		if d.low != nil && current.index-d.low.index <= d.MaxRange {
+			if current.price < d.low.price && current.oscillator > d.low.oscillator {
+				divergence = RegularBullishDivergence
+			} else if current.price > d.low.price && current.oscillator < d.low.oscillator {
+				divergence = HiddenBullishDivergence
+			}
+		}
+
+		d.low = current
+	}
+
+	if isHigh {
+		if d.high != nil && current.index-d.high.index <= d.MaxRange {
+			if current.price > d.high.price && current.oscillator < d.high.oscillator {
+				divergence = RegularBearishDivergence
+			} else if current.price < d.high.price && current.oscillator > d.high.oscillator {
+				divergence = HiddenBearishDivergence
+			}
+		}
+
+		d.high = current
+	}
+
+	return divergence, true
+}
+
+// Reset function resets the state of the divergence.
+func (d *Divergence[T]) Reset() {
+	d.points = nil
+	d.low = nil
+	d.high = nil
+	d.index = 0
+}
+
+// Clone function returns a new divergence instance with the same configuration and a fresh state.
+func (d *Divergence[T]) Clone() *Divergence[T] {
+	return NewDivergenceWith[T](d.Lookback, d.MaxRange)
+}
+
+// divergencePointState is the state of a divergence point.
+type divergencePointState[T helper.Number] struct {
+	// Price is the price.
+	Price T `json:"price"`
+
+	// Oscillator is the oscillator value.
+	Oscillator T `json:"oscillator"`
+}
+
+// divergencePivotState is the state of a divergence pivot.
+type divergencePivotState[T helper.Number] struct {
+	// Index is the index of the pivot.
+	Index int `json:"index"`
+
+	// Price is the price at the pivot.
+	Price T `json:"price"`
+
+	// Oscillator is the oscillator value at the pivot.
+	Oscillator T `json:"oscillator"`
+}
+
+// divergenceState is the state of the Divergence.
+type divergenceState[T helper.Number] struct {
+	// Points is the prices and the oscillator values in the pivot window.
+	Points []divergencePointState[T] `json:"points"`
+
+	// Low is the last swing low pivot.
+	Low *divergencePivotState[T] `json:"low"`
+
+	// High is the last swing high pivot.
+	High *divergencePivotState[T] `json:"high"`
+
+	// Index is the number of values processed.
+	Index int `json:"index"`
+}
+
+// MarshalState function returns the state of the Divergence as JSON.
+func (d *Divergence[T]) MarshalState() ([]byte, error) {
+	state := divergenceState[T]{
+		Index: d.index,
+	}
+
+	if d.points != nil {
+		state.Points = make([]divergencePointState[T], d.points.Len())
+
+		for i := range state.Points {
+			point := d.points.At(i)
+			state.Points[i] = divergencePointState[T]{
+				Price:      point.price,
+				Oscillator: point.oscillator,
+			}
+		}
+	}
+
+	if d.low != nil {
+		state.Low = &divergencePivotState[T]{
+			Index:      d.low.index,
+			Price:      d.low.price,
+			Oscillator: d.low.oscillator,
+		}
+	}
+
+	if d.high != nil {
+		state.High = &divergencePivotState[T]{
+			Index:      d.high.index,
+			Price:      d.high.price,
+			Oscillator: d.high.oscillator,
+		}
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the Divergence from the given JSON.
+func (d *Divergence[T]) UnmarshalState(data []byte) error {
+	var state divergenceState[T]
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	window := 2*d.Lookback + 1
+
+	if len(state.Points) > window {
+		return fmt.Errorf("invalid divergence state with %d points of window %d", len(state.Points), window)
+	}
+
+	d.points = nil
+
+	if state.Points != nil {
+		d.points = helper.NewRing[divergencePoint[T]](window)
+
+		for _, point := range state.Points {
+			d.points.Put(divergencePoint[T]{
+				price:      point.Price,
+				oscillator: point.Oscillator,
+			})
+		}
+	}
+
+	d.low = nil
+	if state.Low != nil {
+		d.low = &divergencePivot[T]{
+			index:      state.Low.Index,
+			price:      state.Low.Price,
+			oscillator: state.Low.Oscillator,
+		}
+	}
+
+	d.high = nil
+	if state.High != nil {
+		d.high = &divergencePivot[T]{
+			index:      state.High.Index,
+			price:      state.High.Price,
+			oscillator: state.High.Oscillator,
+		}
+	}
+
+	d.index = state.Index
+
+	return nil
+}
+
+// String is the string representation of the divergence.
+func (d *Divergence[T]) String() string {
+	return fmt.Sprintf("Divergence(%d,%d)", d.Lookback, d.MaxRange)
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package momentum_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/momentum"
+)
+
+func TestDivergence(t *testing.T) {
+	prices := helper.SliceToChan([]float64{5, 3, 5, 2, 5, 6, 8, 6, 9, 5, 7, 6, 7})
+	oscillators := helper.SliceToChan([]float64{50, 30, 50, 35, 50, 60, 80, 60, 70, 60, 75, 20, 65})
+
+	expected := helper.SliceToChan([]momentum.DivergenceType{
+		momentum.NoDivergence,
+		momentum.NoDivergence,
+		momentum.NoDivergence,
+		momentum.NoDivergence,
+		momentum.RegularBullishDivergence,
+		momentum.NoDivergence,
+		momentum.NoDivergence,
+		momentum.NoDivergence,
+		momentum.NoDivergence,
+		momentum.RegularBearishDivergence,
+		momentum.NoDivergence,
+		momentum.HiddenBearishDivergence,
+		momentum.HiddenBullishDivergence,
+	})
+
+	divergence := momentum.NewDivergenceWith[float64](1, 10)
+	actual := divergence.Compute(prices, oscillators)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDivergenceMaxRange(t *testing.T) {
+	prices := helper.SliceToChan([]float64{5, 3, 5, 2, 5, 6, 8, 6, 9, 5, 7, 6, 7})
+	oscillators := helper.SliceToChan([]float64{50, 30, 50, 35, 50, 60, 80, 60, 70, 60, 75, 20, 65})
+
+	divergence := momentum.NewDivergenceWith[float64](1, 1)
+	actual := divergence.Compute(prices, oscillators)
+
+	for d := range actual {
+		if d != momentum.NoDivergence {
+			t.Fatalf("actual %v expected no divergence", d)
+		}
+	}
+}
+
+func TestDivergenceType(t *testing.T) {
+	if !momentum.RegularBullishDivergence.IsBullish() || !momentum.HiddenBullishDivergence.IsBullish() {
+		t.Fatal("bullish divergences are not bullish")
+	}
+
+	if !momentum.RegularBearishDivergence.IsBearish() || !momentum.HiddenBearishDivergence.IsBearish() {
+		t.Fatal("bearish divergences are not bearish")
+	}
+
+	if momentum.NoDivergence.IsBullish() || momentum.NoDivergence.IsBearish() {
+		t.Fatal("no divergence is bullish or bearish")
+	}
+
+	if momentum.HiddenBearishDivergence.String() != "Hidden Bearish" {
+		t.Fatalf("actual %s expected Hidden Bearish", momentum.HiddenBearishDivergence.String())
+	}
+}
+
+func TestDivergenceString(t *testing.T) {
+	expected := "Divergence(5,60)"
+	actual := momentum.NewDivergence[float64]().String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
@@ -5,6 +5,8 @@
 package momentum
 
 import (
+	"encoding/json"
+
 	"github.com/cinar/indicator/v2/helper"
 	"github.com/cinar/indicator/v2/trend"
 )
@@ -58,6 +60,27 @@ type IchimokuCloud[T helper.Number] struct {
 
 	// LaggingPeriod is the lagging period.
 	LaggingPeriod int
+
+	// conversionMax is the conversion Moving Max state.
+	conversionMax *trend.MovingMax[T]
+
+	// conversionMin is the conversion Moving Min state.
+	conversionMin *trend.MovingMin[T]
+
+	// baseMax is the base Moving Max state.
+	baseMax *trend.MovingMax[T]
+
+	// baseMin is the base Moving Min state.
+	baseMin *trend.MovingMin[T]
+
+	// leadingMax is the leading Moving Max state.
+	leadingMax *trend.MovingMax[T]
+
+	// leadingMin is the leading Moving Min state.
+	leadingMin *trend.MovingMin[T]
+
+	// closings is the closings in the lagging period.
+	closings *helper.Ring[T]
 }
 
 // NewIchimokuCloud function initializes a new Ichimoku Cloud instance.
@@ -76,63 +99,219 @@ func NewIchimokuCloud[T helper.Number]() *IchimokuCloud[T] {
 // Compute function takes a channel of numbers and computes the Ichimoku Cloud.
 // Returns conversionLine, baseLine, leadingSpanA, leadingSpanB, laggingSpan
 func (i *IchimokuCloud[T]) Compute(highs, lows, closings <-chan T) (<-chan T, <-chan T, <-chan T, <-chan T, <-chan T) {
-	highsSplice := helper.Duplicate(highs, 3)
-	lowsSplice := helper.Duplicate(lows, 3)
+	ichimokuCloud := i.Clone()
+
+	values := helper.Compute3(highs, lows, closings, func(high, low, closing T) ([]T, bool) {
+		conversionLine, baseLine, leadingSpanA, leadingSpanB, laggingLine, ok := ichimokuCloud.Update(high, low, closing)
+		return []T{conversionLine, baseLine, leadingSpanA, leadingSpanB, laggingLine}, ok
+	})
+
+	outputs := helper.Unzip(values, 5)
+
+	return outputs[0], outputs[1], outputs[2], outputs[3], outputs[4]
+}
+
+// ComputeSlice function takes a slice of numbers and computes the Ichimoku Cloud.
+// Returns conversionLine, baseLine, leadingSpanA, leadingSpanB, laggingSpan
+func (i *IchimokuCloud[T]) ComputeSlice(highs, lows, closings []T) ([]T, []T, []T, []T, []T) {
+	ichimokuCloud := i.Clone()
+
+	values := helper.ComputeSlice3(highs, lows, closings, func(high, low, closing T) ([]T, bool) {
+		conversionLine, baseLine, leadingSpanA, leadingSpanB, laggingLine, ok := ichimokuCloud.Update(high, low, closing)
+		return []T{conversionLine, baseLine, leadingSpanA, leadingSpanB, laggingLine}, ok
+	})
+
+	outputs := helper.UnzipSlice(values, 5)
+
+	return outputs[0], outputs[1], outputs[2], outputs[3], outputs[4]
+}
+
+// Update function takes a high, a low, and a closing value, and updates the Ichimoku Cloud. It returns
+// the conversion line, base line, leading span A, leading span B, and lagging span values, and whether
+// the Ichimoku Cloud has passed its idle period and the values are ready.
+func (i *IchimokuCloud[T]) Update(high, low, closing T) (T, T, T, T, T, bool) {
+	if i.conversionMax == nil {
+		i.conversionMax = i.ConversionMax.Clone()
+		i.conversionMin = i.ConversionMin.Clone()
+		i.baseMax = i.BaseMax.Clone()
+		i.baseMin = i.BaseMin.Clone()
+		i.leadingMax = i.LeadingMax.Clone()
+		i.leadingMin = i.LeadingMin.Clone()
+
+		if i.LaggingPeriod > 0 {
+			i.closings = helper.NewRing[T](i.LaggingPeriod)
+		}
+	}
+
+	//	Chikou Span (Lagging Span) = Closing plotted 26 days in the past.
+	laggingLine := closing
+	if i.closings != nil {
+		laggingLine = i.closings.Put(closing)
+	}
+
+	conversionMax, _ := i.conversionMax.Update(high)
+	conversionMin, _ := i.conversionMin.Update(low)
+	baseMax, _ := i.baseMax.Update(high)
+	baseMin, _ := i.baseMin.Update(low)
+	leadingMax, _ := i.leadingMax.Update(high)
+
+	leadingMin, ok := i.leadingMin.Update(low)
+	if !ok {
+		return 0, 0, 0, 0, 0, false
+	}
 
 	//	Tenkan-sen (Conversion Line) = (9-Period High + 9-Period Low) / 2
-	conversionLineSplice := helper.Duplicate(
-		helper.DivideBy(
-			helper.Add(
-				i.ConversionMax.Compute(highsSplice[0]),
-				i.ConversionMin.Compute(lowsSplice[0]),
-			),
-			2,
-		),
-		2,
-	)
+	conversionLine := (conversionMax + conversionMin) / 2
 
 	//	Kijun-sen (Base Line) = (26-Period High + 26-Period Low) / 2
-	baseLineSplice := helper.Duplicate(
-		helper.DivideBy(
-			helper.Add(
-				i.BaseMax.Compute(highsSplice[1]),
-				i.BaseMin.Compute(lowsSplice[1]),
-			),
-			2,
-		),
-		2,
-	)
-
-	conversionLineSplice[0] = helper.Skip(conversionLineSplice[0], i.BaseMax.IdlePeriod()-i.ConversionMax.IdlePeriod())
-	conversionLineSplice[1] = helper.Skip(conversionLineSplice[1], i.BaseMax.IdlePeriod()-i.ConversionMax.IdlePeriod())
+	baseLine := (baseMax + baseMin) / 2
 
 	//	Senkou Span A (Leading Span A) = (Conversion Line + Base Line) / 2
-	leadingSpanA := helper.DivideBy(
-		helper.Add(
-			conversionLineSplice[0],
-			baseLineSplice[0],
-		),
-		2,
-	)
+	leadingSpanA := (conversionLine + baseLine) / 2
 
 	//	Senkou Span B (Leading Span B) = (52-Period High + 52-Period Low) / 2
-	leadingSpanB := helper.DivideBy(
-		helper.Add(
-			i.LeadingMax.Compute(highsSplice[2]),
-			i.LeadingMin.Compute(lowsSplice[2]),
-		),
-		2,
-	)
-
-	leadingSpanA = helper.Skip(leadingSpanA, i.LeadingMax.IdlePeriod()-i.BaseMax.IdlePeriod())
-	conversionLineSplice[1] = helper.Skip(conversionLineSplice[1], i.LeadingMax.IdlePeriod()-i.BaseMax.IdlePeriod())
-	baseLineSplice[1] = helper.Skip(baseLineSplice[1], i.LeadingMax.IdlePeriod()-i.BaseMax.IdlePeriod())
+	leadingSpanB := (leadingMax + leadingMin) / 2
 
-	//	Chikou Span (Lagging Span) = Closing plotted 26 days in the past.
-	laggingLine := helper.Shift(closings, i.LaggingPeriod, 0)
-	laggingLine = helper.Skip(laggingLine, i.LeadingMax.IdlePeriod())
+	return conversionLine, baseLine, leadingSpanA, leadingSpanB, laggingLine, true
+}
+
+// Reset function resets the state of the Ichimoku Cloud.
+func (i *IchimokuCloud[T]) Reset() {
+	i.conversionMax = nil
+	i.conversionMin = nil
+	i.baseMax = nil
+	i.baseMin = nil
+	i.leadingMax = nil
+	i.leadingMin = nil
+	i.closings = nil
+}
+
+// Clone function returns a new Ichimoku Cloud instance with the same configuration and a fresh state.
+func (i *IchimokuCloud[T]) Clone() *IchimokuCloud[T] {
+	return &IchimokuCloud[T]{
+		ConversionMax: i.ConversionMax.Clone(),
+		ConversionMin: i.ConversionMin.Clone(),
+		BaseMax:       i.BaseMax.Clone(),
+		BaseMin:       i.BaseMin.Clone(),
+		LeadingMax:    i.LeadingMax.Clone(),
+		LeadingMin:    i.LeadingMin.Clone(),
+		LaggingPeriod: i.LaggingPeriod,
+	}
+}
+
+// ichimokuCloudState is the state of the Ichimoku Cloud.
+type ichimokuCloudState struct {
+	// ConversionMax is the conversion Moving Max state.
+	ConversionMax json.RawMessage `json:"conversionMax"`
+
+	// ConversionMin is the conversion Moving Min state.
+	ConversionMin json.RawMessage `json:"conversionMin"`
+
+	// BaseMax is the base Moving Max state.
+	BaseMax json.RawMessage `json:"baseMax"`
+
+	// BaseMin is the base Moving Min state.
+	BaseMin json.RawMessage `json:"baseMin"`
+
+	// LeadingMax is the leading Moving Max state.
+	LeadingMax json.RawMessage `json:"leadingMax"`
+
+	// LeadingMin is the leading Moving Min state.
+	LeadingMin json.RawMessage `json:"leadingMin"`
+
+	// Closings is the closings in the lagging period.
+	Closings json.RawMessage `json:"closings"`
+}
+
+// MarshalState function returns the state of the Ichimoku Cloud as JSON.
+func (i *IchimokuCloud[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := ichimokuCloudState{}
+
+	state.ConversionMax, err = helper.MarshalStateOf(i.conversionMax)
+	if err != nil {
+		return nil, err
+	}
+
+	state.ConversionMin, err = helper.MarshalStateOf(i.conversionMin)
+	if err != nil {
+		return nil, err
+	}
+
+	state.BaseMax, err = helper.MarshalStateOf(i.baseMax)
+	if err != nil {
+		return nil, err
+	}
+
+	state.BaseMin, err = helper.MarshalStateOf(i.baseMin)
+	if err != nil {
+		return nil, err
+	}
+
+	state.LeadingMax, err = helper.MarshalStateOf(i.leadingMax)
+	if err != nil {
+		return nil, err
+	}
+
+	state.LeadingMin, err = helper.MarshalStateOf(i.leadingMin)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Closings, err = helper.MarshalStateOf(i.closings)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the Ichimoku Cloud from the given JSON.
+func (i *IchimokuCloud[T]) UnmarshalState(data []byte) error {
+	var state ichimokuCloudState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&i.conversionMax, state.ConversionMax, i.ConversionMax.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&i.conversionMin, state.ConversionMin, i.ConversionMin.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&i.baseMax, state.BaseMax, i.BaseMax.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&i.baseMin, state.BaseMin, i.BaseMin.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&i.leadingMax, state.LeadingMax, i.LeadingMax.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&i.leadingMin, state.LeadingMin, i.LeadingMin.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&i.closings, state.Closings, func() *helper.Ring[T] { return helper.NewRing[T](i.LaggingPeriod) })
+	if err != nil {
+		return err
+	}
 
-	return conversionLineSplice[1], baseLineSplice[1], leadingSpanA, leadingSpanB, laggingLine
+	return nil
 }
 
 // IdlePeriod is the initial period that Ichimoku Cloud won't yield any results.
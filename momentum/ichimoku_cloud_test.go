@@ -5,6 +5,7 @@
 package momentum_test
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/cinar/indicator/v2/helper"
@@ -64,3 +65,48 @@ func TestIchimokuCloud(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestIchimokuCloudCheckpoint(t *testing.T) {
+	type Data struct {
+		High  float64
+		Low   float64
+		Close float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/ichimoku_cloud.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rows := helper.ChanToSlice(input)
+	split := len(rows) / 2
+
+	uninterrupted := momentum.NewIchimokuCloud[float64]()
+	ic := momentum.NewIchimokuCloud[float64]()
+
+	for i, row := range rows {
+		if i == split {
+			data, err := helper.Checkpoint(ic)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ic = momentum.NewIchimokuCloud[float64]()
+
+			err = helper.Restore(ic, data)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		c1, b1, a1, s1, l1, ok1 := uninterrupted.Update(row.High, row.Low, row.Close)
+		c2, b2, a2, s2, l2, ok2 := ic.Update(row.High, row.Low, row.Close)
+
+		expected := []any{c1, b1, a1, s1, l1, ok1}
+		actual := []any{c2, b2, a2, s2, l2, ok2}
+
+		if !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("index %d actual %v expected %v", i, actual, expected)
+		}
+	}
+}
@@ -5,6 +5,8 @@
 package momentum
 
 import (
+	"encoding/json"
+
 	"github.com/cinar/indicator/v2/helper"
 	"github.com/cinar/indicator/v2/trend"
 )
@@ -41,6 +43,15 @@ type Ppo[T helper.Number] struct {
 
 	// SignalEma is the signal EMA instance.
 	SignalEma *trend.Ema[T]
+
+	// shortEma is the short EMA state.
+	shortEma *trend.Ema[T]
+
+	// longEma is the long EMA state.
+	longEma *trend.Ema[T]
+
+	// signalEma is the signal EMA state.
+	signalEma *trend.Ema[T]
 }
 
 // NewPpo function initializes a new Percentage Price Oscillator instance.
@@ -55,45 +66,136 @@ func NewPpo[T helper.Number]() *Ppo[T] {
 // Compute function takes a channel of numbers and computes the Percentage Price Oscillator.
 // Returns ppo, signal, histogram.
 func (p *Ppo[T]) Compute(closings <-chan T) (<-chan T, <-chan T, <-chan T) {
-	closingsSplice := helper.Duplicate(
-		closings,
-		2,
-	)
+	ppo := p.Clone()
+
+	values := helper.Compute(closings, func(closing T) ([]T, bool) {
+		ppoValue, signal, histogram, ok := ppo.Update(closing)
+		return []T{ppoValue, signal, histogram}, ok
+	})
+
+	outputs := helper.Unzip(values, 3)
+
+	return outputs[0], outputs[1], outputs[2]
+}
+
+// ComputeSlice function takes a slice of numbers and computes the Percentage Price Oscillator.
+// Returns ppo, signal, histogram.
+func (p *Ppo[T]) ComputeSlice(closings []T) ([]T, []T, []T) {
+	ppo := p.Clone()
+
+	values := helper.ComputeSlice(closings, func(closing T) ([]T, bool) {
+		ppoValue, signal, histogram, ok := ppo.Update(closing)
+		return []T{ppoValue, signal, histogram}, ok
+	})
+
+	outputs := helper.UnzipSlice(values, 3)
 
-	shortEma := p.ShortEma.Compute(closingsSplice[0])
+	return outputs[0], outputs[1], outputs[2]
+}
+
+// Update function takes a closing value and updates the Percentage Price Oscillator. It returns the ppo, signal,
+// and histogram values, and whether the Percentage Price Oscillator has passed its idle period and the values are ready.
+func (p *Ppo[T]) Update(closing T) (T, T, T, bool) {
+	if p.shortEma == nil {
+		p.shortEma = p.ShortEma.Clone()
+		p.longEma = p.LongEma.Clone()
+		p.signalEma = p.SignalEma.Clone()
+	}
 
-	longEmaSplice := helper.Duplicate(
-		p.LongEma.Compute(closingsSplice[1]),
-		2,
-	)
+	shortEma, _ := p.shortEma.Update(closing)
 
-	shortEma = helper.Skip(shortEma, p.LongEma.IdlePeriod()-p.ShortEma.IdlePeriod())
+	longEma, ok := p.longEma.Update(closing)
+	if !ok {
+		return 0, 0, 0, false
+	}
 
 	//	PPO = ((EMA(shortPeriod, prices) - EMA(longPeriod, prices)) / EMA(longPeriod, prices)) * 100
-	ppoSplice := helper.Duplicate(
-		helper.MultiplyBy(
-			helper.Divide(
-				helper.Subtract(shortEma, longEmaSplice[0]),
-				longEmaSplice[1],
-			),
-			100,
-		),
-		3,
-	)
+	ppo := ((shortEma - longEma) / longEma) * 100
 
 	//	Signal = EMA(9, PPO)
-	signalSplice := helper.Duplicate(
-		p.SignalEma.Compute(ppoSplice[0]),
-		2,
-	)
-
-	ppoSplice[1] = helper.Skip(ppoSplice[1], p.SignalEma.IdlePeriod())
-	ppoSplice[2] = helper.Skip(ppoSplice[2], p.SignalEma.IdlePeriod())
+	signal, ok := p.signalEma.Update(ppo)
+	if !ok {
+		return 0, 0, 0, false
+	}
 
 	//	Histogram = PPO - Signal
-	histogram := helper.Subtract(ppoSplice[1], signalSplice[0])
+	histogram := ppo - signal
+
+	return ppo, signal, histogram, true
+}
+
+// Reset function resets the state of the Percentage Price Oscillator.
+func (p *Ppo[T]) Reset() {
+	p.shortEma = nil
+	p.longEma = nil
+	p.signalEma = nil
+}
+
+// Clone function returns a new Percentage Price Oscillator instance with the same configuration and a fresh state.
+func (p *Ppo[T]) Clone() *Ppo[T] {
+	return &Ppo[T]{
+		ShortEma:  p.ShortEma.Clone(),
+		LongEma:   p.LongEma.Clone(),
+		SignalEma: p.SignalEma.Clone(),
+	}
+}
+
+// ppoState is the state of the PPO.
+type ppoState struct {
+	// ShortEma is the short EMA state.
+	ShortEma json.RawMessage `json:"shortEma"`
+
+	// LongEma is the long EMA state.
+	LongEma json.RawMessage `json:"longEma"`
+
+	// SignalEma is the signal EMA state.
+	SignalEma json.RawMessage `json:"signalEma"`
+}
+
+// MarshalState function returns the state of the PPO as JSON.
+func (p *Ppo[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := ppoState{}
+
+	state.ShortEma, err = helper.MarshalStateOf(p.shortEma)
+	if err != nil {
+		return nil, err
+	}
+
+	state.LongEma, err = helper.MarshalStateOf(p.longEma)
+	if err != nil {
+		return nil, err
+	}
+
+	state.SignalEma, err = helper.MarshalStateOf(p.signalEma)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the PPO from the given JSON.
+func (p *Ppo[T]) UnmarshalState(data []byte) error {
+	var state ppoState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&p.shortEma, state.ShortEma, p.ShortEma.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&p.longEma, state.LongEma, p.LongEma.Clone)
+	if err != nil {
+		return err
+	}
 
-	return ppoSplice[2], signalSplice[1], histogram
+	return helper.UnmarshalStateOf(&p.signalEma, state.SignalEma, p.SignalEma.Clone)
 }
 
 // IdlePeriod is the initial period that Percentage Price Oscillator won't yield any results.
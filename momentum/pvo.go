@@ -5,6 +5,8 @@
 package momentum
 
 import (
+	"encoding/json"
+
 	"github.com/cinar/indicator/v2/helper"
 	"github.com/cinar/indicator/v2/trend"
 )
@@ -41,6 +43,15 @@ type Pvo[T helper.Number] struct {
 
 	// SignalEma is the signal EMA instance.
 	SignalEma *trend.Ema[T]
+
+	// shortEma is the short EMA state.
+	shortEma *trend.Ema[T]
+
+	// longEma is the long EMA state.
+	longEma *trend.Ema[T]
+
+	// signalEma is the signal EMA state.
+	signalEma *trend.Ema[T]
 }
 
 // NewPvo function initializes a new Percentage Volume Oscillator instance.
@@ -55,45 +66,136 @@ func NewPvo[T helper.Number]() *Pvo[T] {
 // Compute function takes a channel of numbers and computes the Percentage Volume Oscillator.
 // Returns pvo, signal, histogram.
 func (p *Pvo[T]) Compute(volumes <-chan T) (<-chan T, <-chan T, <-chan T) {
-	volumesSplice := helper.Duplicate(
-		volumes,
-		2,
-	)
+	pvo := p.Clone()
+
+	values := helper.Compute(volumes, func(volume T) ([]T, bool) {
+		pvoValue, signal, histogram, ok := pvo.Update(volume)
+		return []T{pvoValue, signal, histogram}, ok
+	})
+
+	outputs := helper.Unzip(values, 3)
+
+	return outputs[0], outputs[1], outputs[2]
+}
+
+// ComputeSlice function takes a slice of numbers and computes the Percentage Volume Oscillator.
+// Returns pvo, signal, histogram.
+func (p *Pvo[T]) ComputeSlice(volumes []T) ([]T, []T, []T) {
+	pvo := p.Clone()
+
+	values := helper.ComputeSlice(volumes, func(volume T) ([]T, bool) {
+		pvoValue, signal, histogram, ok := pvo.Update(volume)
+		return []T{pvoValue, signal, histogram}, ok
+	})
+
+	outputs := helper.UnzipSlice(values, 3)
 
-	shortEma := p.ShortEma.Compute(volumesSplice[0])
+	return outputs[0], outputs[1], outputs[2]
+}
+
+// Update function takes a volume value and updates the Percentage Volume Oscillator. It returns the pvo, signal,
+// and histogram values, and whether the Percentage Volume Oscillator has passed its idle period and the values are ready.
+func (p *Pvo[T]) Update(volume T) (T, T, T, bool) {
+	if p.shortEma == nil {
+		p.shortEma = p.ShortEma.Clone()
+		p.longEma = p.LongEma.Clone()
+		p.signalEma = p.SignalEma.Clone()
+	}
 
-	longEmaSplice := helper.Duplicate(
-		p.LongEma.Compute(volumesSplice[1]),
-		2,
-	)
+	shortEma, _ := p.shortEma.Update(volume)
 
-	shortEma = helper.Skip(shortEma, p.LongEma.IdlePeriod()-p.ShortEma.IdlePeriod())
+	longEma, ok := p.longEma.Update(volume)
+	if !ok {
+		return 0, 0, 0, false
+	}
 
 	//	PVO = ((EMA(shortPeriod, prices) - EMA(longPeriod, prices)) / EMA(longPeriod, prices)) * 100
-	pvoSplice := helper.Duplicate(
-		helper.MultiplyBy(
-			helper.Divide(
-				helper.Subtract(shortEma, longEmaSplice[0]),
-				longEmaSplice[1],
-			),
-			100,
-		),
-		3,
-	)
+	pvo := ((shortEma - longEma) / longEma) * 100
 
 	//	Signal = EMA(9, PVO)
-	signalSplice := helper.Duplicate(
-		p.SignalEma.Compute(pvoSplice[0]),
-		2,
-	)
-
-	pvoSplice[1] = helper.Skip(pvoSplice[1], p.SignalEma.IdlePeriod())
-	pvoSplice[2] = helper.Skip(pvoSplice[2], p.SignalEma.IdlePeriod())
+	signal, ok := p.signalEma.Update(pvo)
+	if !ok {
+		return 0, 0, 0, false
+	}
 
 	//	Histogram = PVO - Signal
-	histogram := helper.Subtract(pvoSplice[1], signalSplice[0])
+	histogram := pvo - signal
+
+	return pvo, signal, histogram, true
+}
+
+// Reset function resets the state of the Percentage Volume Oscillator.
+func (p *Pvo[T]) Reset() {
+	p.shortEma = nil
+	p.longEma = nil
+	p.signalEma = nil
+}
+
+// Clone function returns a new Percentage Volume Oscillator instance with the same configuration and a fresh state.
+func (p *Pvo[T]) Clone() *Pvo[T] {
+	return &Pvo[T]{
+		ShortEma:  p.ShortEma.Clone(),
+		LongEma:   p.LongEma.Clone(),
+		SignalEma: p.SignalEma.Clone(),
+	}
+}
+
+// pvoState is the state of the PVO.
+type pvoState struct {
+	// ShortEma is the short EMA state.
+	ShortEma json.RawMessage `json:"shortEma"`
+
+	// LongEma is the long EMA state.
+	LongEma json.RawMessage `json:"longEma"`
+
+	// SignalEma is the signal EMA state.
+	SignalEma json.RawMessage `json:"signalEma"`
+}
+
+// MarshalState function returns the state of the PVO as JSON.
+func (p *Pvo[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := pvoState{}
+
+	state.ShortEma, err = helper.MarshalStateOf(p.shortEma)
+	if err != nil {
+		return nil, err
+	}
+
+	state.LongEma, err = helper.MarshalStateOf(p.longEma)
+	if err != nil {
+		return nil, err
+	}
+
+	state.SignalEma, err = helper.MarshalStateOf(p.signalEma)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the PVO from the given JSON.
+func (p *Pvo[T]) UnmarshalState(data []byte) error {
+	var state pvoState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&p.shortEma, state.ShortEma, p.ShortEma.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&p.longEma, state.LongEma, p.LongEma.Clone)
+	if err != nil {
+		return err
+	}
 
-	return pvoSplice[2], signalSplice[1], histogram
+	return helper.UnmarshalStateOf(&p.signalEma, state.SignalEma, p.SignalEma.Clone)
 }
 
 // IdlePeriod is the initial period that Percentage Volume Oscillator won't yield any results.
@@ -5,6 +5,8 @@
 package momentum
 
 import (
+	"encoding/json"
+
 	"github.com/cinar/indicator/v2/helper"
 	"github.com/cinar/indicator/v2/trend"
 )
@@ -32,6 +34,9 @@ const (
 //	values := qstick.Compute(openings, closings)
 type Qstick[T helper.Number] struct {
 	Sma *trend.Sma[T]
+
+	// sma is the SMA state.
+	sma *trend.Sma[T]
 }
 
 // NewQstick function initializes a new QStick instance.
@@ -47,10 +52,66 @@ func NewQstick[T helper.Number]() *Qstick[T] {
 
 // Compute function takes a channel of numbers and computes the Qstick.
 func (q *Qstick[T]) Compute(openings, closings <-chan T) <-chan T {
-	qstick := helper.Subtract(closings, openings)
-	qstick = q.Sma.Compute(qstick)
+	return helper.Compute2(openings, closings, q.Clone().Update)
+}
 
-	return qstick
+// ComputeSlice function takes a slice of numbers and computes the Qstick.
+func (q *Qstick[T]) ComputeSlice(openings, closings []T) []T {
+	return helper.ComputeSlice2(openings, closings, q.Clone().Update)
+}
+
+// Update function takes an opening and a closing value, and updates the Qstick. It returns the Qstick
+// value, and whether the Qstick has passed its idle period and the value is ready.
+func (q *Qstick[T]) Update(opening, closing T) (T, bool) {
+	if q.sma == nil {
+		q.sma = q.Sma.Clone()
+	}
+
+	return q.sma.Update(closing - opening)
+}
+
+// Reset function resets the state of the Qstick.
+func (q *Qstick[T]) Reset() {
+	q.sma = nil
+}
+
+// Clone function returns a new Qstick instance with the same configuration and a fresh state.
+func (q *Qstick[T]) Clone() *Qstick[T] {
+	return &Qstick[T]{
+		Sma: q.Sma.Clone(),
+	}
+}
+
+// qstickState is the state of the Qstick.
+type qstickState struct {
+	// Sma is the SMA state.
+	Sma json.RawMessage `json:"sma"`
+}
+
+// MarshalState function returns the state of the Qstick as JSON.
+func (q *Qstick[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := qstickState{}
+
+	state.Sma, err = helper.MarshalStateOf(q.sma)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the Qstick from the given JSON.
+func (q *Qstick[T]) UnmarshalState(data []byte) error {
+	var state qstickState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	return helper.UnmarshalStateOf(&q.sma, state.Sma, q.Sma.Clone)
 }
 
 // IdlePeriod is the initial period that Qstick won't yield any results.
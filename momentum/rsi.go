@@ -5,6 +5,9 @@
 package momentum
 
 import (
+	"encoding/json"
+	"math"
+
 	"github.com/cinar/indicator/v2/helper"
 	"github.com/cinar/indicator/v2/trend"
 )
@@ -27,6 +30,15 @@ const (
 type Rsi[T helper.Number] struct {
 	// Rma is the RMA instance.
 	Rma *trend.Rma[T]
+
+	// gains is the average gains RMA state.
+	gains *trend.Rma[T]
+
+	// losses is the average losses RMA state.
+	losses *trend.Rma[T]
+
+	// previous is the previous closing.
+	previous T
 }
 
 // NewRsi function initializes a new Relative Strength Index instance with the default parameters.
@@ -43,47 +55,110 @@ func NewRsiWithPeriod[T helper.Number](period int) *Rsi[T] {
 
 // Compute function takes a channel of closings numbers and computes the Relative Strength Index.
 func (r *Rsi[T]) Compute(closings <-chan T) <-chan T {
-	changesSplice := helper.Duplicate(
-		helper.Change(closings, 1),
-		2,
-	)
-
-	averageGains := r.Rma.Compute(
-		helper.KeepPositives(changesSplice[0]),
-	)
-
-	averageLosses := helper.MultiplyBy(
-		r.Rma.Compute(
-			helper.KeepNegatives(changesSplice[1]),
-		),
-		-1,
-	)
-
-	rs := helper.Divide(
-		averageGains,
-		averageLosses,
-	)
+	return helper.Compute(closings, r.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of closings numbers and computes the Relative Strength Index.
+func (r *Rsi[T]) ComputeSlice(closings []T) []T {
+	return helper.ComputeSlice(closings, r.Clone().Update)
+}
+
+// Update function takes a value and updates the Relative Strength Index. It returns the Relative Strength Index value, and whether the
+// Relative Strength Index has passed its idle period and the value is ready.
+func (r *Rsi[T]) Update(closing T) (T, bool) {
+	if r.gains == nil {
+		r.gains = r.Rma.Clone()
+		r.losses = r.Rma.Clone()
+		r.previous = closing
+
+		return 0, false
+	}
+
+	change := closing - r.previous
+	r.previous = closing
+
+	averageGain, _ := r.gains.Update(max(change, 0))
+
+	averageLoss, ok := r.losses.Update(min(change, 0))
+	if !ok {
+		return 0, false
+	}
+
+	rs := averageGain / (averageLoss * -1)
 
 	// RSI = 100 - (100 / (1 + RS))
-	rsi := helper.IncrementBy(
-		// - (100 / (1 + RS))
-		helper.MultiplyBy(
-			// 100 / (1 + RS)
-			helper.MultiplyBy(
-				// 1 / (1 + RS)
-				helper.Pow(
-					// 1 + RS
-					helper.IncrementBy(rs, 1),
-					-1,
-				),
-				100,
-			),
-			-1,
-		),
-		100,
-	)
-
-	return rsi
+	return (T(math.Pow(float64(rs+1), -1))*100)*-1 + 100, true
+}
+
+// Reset function resets the state of the Relative Strength Index.
+func (r *Rsi[T]) Reset() {
+	r.gains = nil
+	r.losses = nil
+	r.previous = 0
+}
+
+// Clone function returns a new Relative Strength Index instance with the same configuration and a fresh state.
+func (r *Rsi[T]) Clone() *Rsi[T] {
+	return &Rsi[T]{
+		Rma: r.Rma.Clone(),
+	}
+}
+
+// rsiState is the state of the Relative Strength Index.
+type rsiState[T helper.Number] struct {
+	// Gains is the average gains RMA state.
+	Gains json.RawMessage `json:"gains"`
+
+	// Losses is the average losses RMA state.
+	Losses json.RawMessage `json:"losses"`
+
+	// Previous is the previous closing.
+	Previous T `json:"previous"`
+}
+
+// MarshalState function returns the state of the Relative Strength Index as JSON.
+func (r *Rsi[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := rsiState[T]{
+		Previous: r.previous,
+	}
+
+	state.Gains, err = helper.MarshalStateOf(r.gains)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Losses, err = helper.MarshalStateOf(r.losses)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the Relative Strength Index from the given JSON.
+func (r *Rsi[T]) UnmarshalState(data []byte) error {
+	var state rsiState[T]
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&r.gains, state.Gains, r.Rma.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&r.losses, state.Losses, r.Rma.Clone)
+	if err != nil {
+		return err
+	}
+
+	r.previous = state.Previous
+
+	return nil
 }
 
 // IdlePeriod is the initial period that Relative Strength Index won't yield any results.
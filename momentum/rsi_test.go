@@ -37,3 +37,56 @@ func TestRsi(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestRsiUpdate(t *testing.T) {
+	type Data struct {
+		Close float64
+		Rsi   float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/rsi.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := helper.ChanToSlice(input)
+	rsi := momentum.NewRsi[float64]()
+
+	// Feeding a warmed up instance after a reset should yield the same values.
+	for range 2 {
+		for i, d := range data {
+			actual, ok := rsi.Update(d.Close)
+			if ok != (i >= rsi.IdlePeriod()) {
+				t.Fatalf("index %d ready %v", i, ok)
+			}
+
+			if ok && helper.RoundDigit(actual, 2) != d.Rsi {
+				t.Fatalf("index %d actual %v expected %v", i, actual, d.Rsi)
+			}
+		}
+
+		rsi.Reset()
+	}
+}
+
+func TestRsiComputeSlice(t *testing.T) {
+	type Data struct {
+		Close float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/rsi.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	closings := helper.ChanToSlice(helper.Map(input, func(d *Data) float64 { return d.Close }))
+
+	rsi := momentum.NewRsi[float64]()
+	expected := rsi.Compute(helper.SliceToChan(closings))
+	actual := helper.SliceToChan(rsi.ComputeSlice(closings))
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
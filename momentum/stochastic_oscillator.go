@@ -5,6 +5,8 @@
 package momentum
 
 import (
+	"encoding/json"
+
 	"github.com/cinar/indicator/v2/helper"
 	"github.com/cinar/indicator/v2/trend"
 )
@@ -37,6 +39,15 @@ type StochasticOscillator[T helper.Number] struct {
 
 	// Sma is the SMA instance.
 	Sma *trend.Sma[T]
+
+	// max is the Moving Max state.
+	max *trend.MovingMax[T]
+
+	// min is the Moving Min state.
+	min *trend.MovingMin[T]
+
+	// sma is the SMA state.
+	sma *trend.Sma[T]
 }
 
 // NewStochasticOscillator function initializes a new Stochastic Oscillator instance.
@@ -50,32 +61,133 @@ func NewStochasticOscillator[T helper.Number]() *StochasticOscillator[T] {
 
 // Compute function takes a channel of numbers and computes the Stochastic Oscillator. Returns k and d.
 func (s *StochasticOscillator[T]) Compute(highs, lows, closings <-chan T) (<-chan T, <-chan T) {
+	stochasticOscillator := s.Clone()
+
+	values := helper.Compute3(highs, lows, closings, func(high, low, closing T) ([]T, bool) {
+		k, d, ok := stochasticOscillator.Update(high, low, closing)
+		return []T{k, d}, ok
+	})
+
+	outputs := helper.Unzip(values, 2)
+
+	return outputs[0], outputs[1]
+}
+
+// ComputeSlice function takes a slice of numbers and computes the Stochastic Oscillator. Returns k and d.
+func (s *StochasticOscillator[T]) ComputeSlice(highs, lows, closings []T) ([]T, []T) {
+	stochasticOscillator := s.Clone()
+
+	values := helper.ComputeSlice3(highs, lows, closings, func(high, low, closing T) ([]T, bool) {
+		k, d, ok := stochasticOscillator.Update(high, low, closing)
+		return []T{k, d}, ok
+	})
+
+	outputs := helper.UnzipSlice(values, 2)
+
+	return outputs[0], outputs[1]
+}
+
+// Update function takes a high, a low, and a closing value, and updates the Stochastic Oscillator. It
+// returns the k and d values, and whether the Stochastic Oscillator has passed its idle period and the
+// values are ready.
+func (s *StochasticOscillator[T]) Update(high, low, closing T) (T, T, bool) {
+	if s.max == nil {
+		s.max = s.Max.Clone()
+		s.min = s.Min.Clone()
+		s.sma = s.Sma.Clone()
+	}
+
+	highest, _ := s.max.Update(high)
+
+	lowest, ok := s.min.Update(low)
+	if !ok {
+		return 0, 0, false
+	}
+
 	//	K = (Closing - Lowest Low) / (Highest High - Lowest Low) * 100
+	k := ((closing - lowest) / (highest - lowest)) * 100
+
 	//	D = 3-Period SMA of K
-	lowestSplice := helper.Duplicate(
-		s.Min.Compute(lows),
-		2,
-	)
-
-	highest := s.Max.Compute(highs)
-
-	closings = helper.Skip(closings, s.Min.IdlePeriod())
-
-	kSplice := helper.Duplicate(
-		helper.MultiplyBy(
-			helper.Divide(
-				helper.Subtract(closings, lowestSplice[0]),
-				helper.Subtract(highest, lowestSplice[1]),
-			),
-			100,
-		),
-		2,
-	)
-
-	d := s.Sma.Compute(kSplice[0])
-	kSplice[1] = helper.Skip(kSplice[1], s.Sma.IdlePeriod())
-
-	return kSplice[1], d
+	d, ok := s.sma.Update(k)
+	if !ok {
+		return 0, 0, false
+	}
+
+	return k, d, true
+}
+
+// Reset function resets the state of the Stochastic Oscillator.
+func (s *StochasticOscillator[T]) Reset() {
+	s.max = nil
+	s.min = nil
+	s.sma = nil
+}
+
+// Clone function returns a new Stochastic Oscillator instance with the same configuration and a fresh state.
+func (s *StochasticOscillator[T]) Clone() *StochasticOscillator[T] {
+	return &StochasticOscillator[T]{
+		Max: s.Max.Clone(),
+		Min: s.Min.Clone(),
+		Sma: s.Sma.Clone(),
+	}
+}
+
+// stochasticOscillatorState is the state of the Stochastic Oscillator.
+type stochasticOscillatorState struct {
+	// Max is the moving max state.
+	Max json.RawMessage `json:"max"`
+
+	// Min is the moving min state.
+	Min json.RawMessage `json:"min"`
+
+	// Sma is the SMA state.
+	Sma json.RawMessage `json:"sma"`
+}
+
+// MarshalState function returns the state of the Stochastic Oscillator as JSON.
+func (s *StochasticOscillator[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := stochasticOscillatorState{}
+
+	state.Max, err = helper.MarshalStateOf(s.max)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Min, err = helper.MarshalStateOf(s.min)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Sma, err = helper.MarshalStateOf(s.sma)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the Stochastic Oscillator from the given JSON.
+func (s *StochasticOscillator[T]) UnmarshalState(data []byte) error {
+	var state stochasticOscillatorState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&s.max, state.Max, s.Max.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&s.min, state.Min, s.Min.Clone)
+	if err != nil {
+		return err
+	}
+
+	return helper.UnmarshalStateOf(&s.sma, state.Sma, s.Sma.Clone)
 }
 
 // IdlePeriod is the initial period that Stochastic Oscillator won't yield any results.
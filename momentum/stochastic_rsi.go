@@ -5,6 +5,8 @@
 package momentum
 
 import (
+	"encoding/json"
+
 	"github.com/cinar/indicator/v2/helper"
 	"github.com/cinar/indicator/v2/trend"
 )
@@ -35,6 +37,15 @@ type StochasticRsi[T helper.Number] struct {
 
 	// Max is the Moving Max instance.
 	Max *trend.MovingMax[T]
+
+	// rsi is the RSI state.
+	rsi *Rsi[T]
+
+	// min is the Moving Min state.
+	min *trend.MovingMin[T]
+
+	// max is the Moving Max state.
+	max *trend.MovingMax[T]
 }
 
 // NewStochasticRsi function initializes a new Storchastic RSI instance with the default parameters.
@@ -53,32 +64,115 @@ func NewStochasticRsiWithPeriod[T helper.Number](period int) *StochasticRsi[T] {
 
 // Compute function takes a channel of closings numbers and computes the Stochastic RSI.
 func (s *StochasticRsi[T]) Compute(closings <-chan T) <-chan T {
-	rsisSplice := helper.Duplicate(
-		s.Rsi.Compute(closings),
-		3,
-	)
-
-	rsisSplice[0] = helper.Skip(rsisSplice[0], s.Max.IdlePeriod())
-
-	minRsisSplice := helper.Duplicate(
-		s.Min.Compute(rsisSplice[1]),
-		2,
-	)
-
-	maxRsis := s.Max.Compute(rsisSplice[2])
-
-	result := helper.Divide(
-		helper.Subtract(
-			rsisSplice[0],
-			minRsisSplice[0],
-		),
-		helper.Subtract(
-			maxRsis,
-			minRsisSplice[1],
-		),
-	)
-
-	return result
+	return helper.Compute(closings, s.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of closings numbers and computes the Stochastic RSI.
+func (s *StochasticRsi[T]) ComputeSlice(closings []T) []T {
+	return helper.ComputeSlice(closings, s.Clone().Update)
+}
+
+// Update function takes a value and updates the Stochastic RSI. It returns the Stochastic RSI value, and whether the
+// Stochastic RSI has passed its idle period and the value is ready.
+func (s *StochasticRsi[T]) Update(closing T) (T, bool) {
+	if s.rsi == nil {
+		s.rsi = s.Rsi.Clone()
+		s.min = s.Min.Clone()
+		s.max = s.Max.Clone()
+	}
+
+	rsi, ok := s.rsi.Update(closing)
+	if !ok {
+		return 0, false
+	}
+
+	minRsi, _ := s.min.Update(rsi)
+
+	maxRsi, ok := s.max.Update(rsi)
+	if !ok {
+		return 0, false
+	}
+
+	return (rsi - minRsi) / (maxRsi - minRsi), true
+}
+
+// Reset function resets the state of the Stochastic RSI.
+func (s *StochasticRsi[T]) Reset() {
+	s.rsi = nil
+	s.min = nil
+	s.max = nil
+}
+
+// Clone function returns a new Stochastic RSI instance with the same configuration and a fresh state.
+func (s *StochasticRsi[T]) Clone() *StochasticRsi[T] {
+	return &StochasticRsi[T]{
+		Rsi: s.Rsi.Clone(),
+		Min: s.Min.Clone(),
+		Max: s.Max.Clone(),
+	}
+}
+
+// stochasticRsiState is the state of the Stochastic RSI.
+type stochasticRsiState struct {
+	// Rsi is the RSI state.
+	Rsi json.RawMessage `json:"rsi"`
+
+	// Min is the Moving Min state.
+	Min json.RawMessage `json:"min"`
+
+	// Max is the Moving Max state.
+	Max json.RawMessage `json:"max"`
+}
+
+// MarshalState function returns the state of the Stochastic RSI as JSON.
+func (s *StochasticRsi[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := stochasticRsiState{}
+
+	state.Rsi, err = helper.MarshalStateOf(s.rsi)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Min, err = helper.MarshalStateOf(s.min)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Max, err = helper.MarshalStateOf(s.max)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the Stochastic RSI from the given JSON.
+func (s *StochasticRsi[T]) UnmarshalState(data []byte) error {
+	var state stochasticRsiState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&s.rsi, state.Rsi, s.Rsi.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&s.min, state.Min, s.Min.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&s.max, state.Max, s.Max.Clone)
+	if err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // IdlePeriod is the initial period that Stochasic RSI won't yield any results.
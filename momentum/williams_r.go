@@ -5,6 +5,8 @@
 package momentum
 
 import (
+	"encoding/json"
+
 	"github.com/cinar/indicator/v2/helper"
 	"github.com/cinar/indicator/v2/trend"
 )
@@ -32,6 +34,12 @@ type WilliamsR[T helper.Number] struct {
 
 	// Min is the Moving Min instance.
 	Min *trend.MovingMin[T]
+
+	// max is the Moving Max state.
+	max *trend.MovingMax[T]
+
+	// min is the Moving Min state.
+	min *trend.MovingMin[T]
 }
 
 // NewWilliamsR function initializes a new Williams R instance.
@@ -44,22 +52,94 @@ func NewWilliamsR[T helper.Number]() *WilliamsR[T] {
 
 // Compute function takes a channel of numbers and computes the Williams R.
 func (w *WilliamsR[T]) Compute(highs, lows, closings <-chan T) <-chan T {
-	highestSplice := helper.Duplicate(
-		w.Max.Compute(highs),
-		2,
-	)
-
-	lowest := w.Min.Compute(lows)
-
-	closings = helper.Skip(closings, w.Max.IdlePeriod())
-
-	return helper.MultiplyBy(
-		helper.Divide(
-			helper.Subtract(highestSplice[0], closings),
-			helper.Subtract(highestSplice[1], lowest),
-		),
-		-100,
-	)
+	return helper.Compute3(highs, lows, closings, w.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the Williams R.
+func (w *WilliamsR[T]) ComputeSlice(highs, lows, closings []T) []T {
+	return helper.ComputeSlice3(highs, lows, closings, w.Clone().Update)
+}
+
+// Update function takes a high, a low, and a closing value, and updates the Williams R. It returns the
+// Williams R value, and whether the Williams R has passed its idle period and the value is ready.
+func (w *WilliamsR[T]) Update(high, low, closing T) (T, bool) {
+	if w.max == nil {
+		w.max = w.Max.Clone()
+		w.min = w.Min.Clone()
+	}
+
+	highest, _ := w.max.Update(high)
+
+	lowest, ok := w.min.Update(low)
+	if !ok {
+		return 0, false
+	}
+
+	return ((highest - closing) / (highest - lowest)) * -100, true
+}
+
+// Reset function resets the state of the Williams R.
+func (w *WilliamsR[T]) Reset() {
+	w.max = nil
+	w.min = nil
+}
+
+// Clone function returns a new Williams R instance with the same configuration and a fresh state.
+func (w *WilliamsR[T]) Clone() *WilliamsR[T] {
+	return &WilliamsR[T]{
+		Max: w.Max.Clone(),
+		Min: w.Min.Clone(),
+	}
+}
+
+// williamsRState is the state of the Williams R.
+type williamsRState struct {
+	// Max is the Moving Max state.
+	Max json.RawMessage `json:"max"`
+
+	// Min is the Moving Min state.
+	Min json.RawMessage `json:"min"`
+}
+
+// MarshalState function returns the state of the Williams R as JSON.
+func (w *WilliamsR[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := williamsRState{}
+
+	state.Max, err = helper.MarshalStateOf(w.max)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Min, err = helper.MarshalStateOf(w.min)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the Williams R from the given JSON.
+func (w *WilliamsR[T]) UnmarshalState(data []byte) error {
+	var state williamsRState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&w.max, state.Max, w.Max.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&w.min, state.Min, w.Min.Clone)
+	if err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // IdlePeriod is the initial period that Williams R won't yield any results.
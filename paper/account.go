@@ -0,0 +1,155 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package paper
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// DefaultAccountCash is the default initial cash for a new account.
+	DefaultAccountCash = 100000
+
+	// DefaultAccountMarginRatio is the default margin ratio, requiring the full value of the positions.
+	DefaultAccountMarginRatio = 1.0
+)
+
+// Account captures the state of a paper trading account.
+type Account struct {
+	// InitialCash is the cash that the account started with.
+	InitialCash float64 `json:"initialCash"`
+
+	// Cash is the available cash.
+	Cash float64 `json:"cash"`
+
+	// MarginRatio is the fraction of the position exposure that must be
+	// covered by the equity. A ratio of 1 disables leverage, and a ratio of
+	// 0.5 allows positions up to twice the equity.
+	MarginRatio float64 `json:"marginRatio"`
+
+	// RealizedPnL is the total realized profit or loss.
+	RealizedPnL float64 `json:"realizedPnL"`
+
+	// Positions are the open positions keyed by the asset name.
+	Positions map[string]*Position `json:"positions"`
+
+	// Orders are the open orders.
+	Orders []*Order `json:"orders"`
+
+	// Rejected are the orders rejected by the broker.
+	Rejected []*Order `json:"rejected"`
+
+	// Canceled are the orders canceled before they are filled.
+	Canceled []*Order `json:"canceled"`
+
+	// Fills are the history of the executions.
+	Fills []*Fill `json:"fills"`
+
+	// NextOrderID is the identifier for the next submitted order.
+	NextOrderID int `json:"nextOrderId"`
+}
+
+// NewAccount function initializes a new account with the given cash.
+func NewAccount(cash float64) *Account {
+	return &Account{
+		InitialCash: cash,
+		Cash:        cash,
+		MarginRatio: DefaultAccountMarginRatio,
+		Positions:   make(map[string]*Position),
+		Orders:      []*Order{},
+		Rejected:    []*Order{},
+		Canceled:    []*Order{},
+		Fills:       []*Fill{},
+		NextOrderID: 1,
+	}
+}
+
+// LoadAccount reads the account from the given JSON file.
+func LoadAccount(fileName string) (*Account, error) {
+	data, err := os.ReadFile(filepath.Clean(fileName))
+	if err != nil {
+		return nil, err
+	}
+
+	account := NewAccount(0)
+
+	err = json.Unmarshal(data, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// Save writes the account to the given JSON file.
+func (a *Account) Save(fileName string) error {
+	data, err := json.MarshalIndent(a, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first to not corrupt the account on failure.
+	temp := filepath.Clean(fileName) + ".tmp"
+
+	err = os.WriteFile(temp, data, 0600)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(temp, filepath.Clean(fileName))
+}
+
+// Equity returns the cash plus the market value of the positions.
+func (a *Account) Equity() float64 {
+	equity := a.Cash
+
+	for _, position := range a.Positions {
+		equity += position.Value()
+	}
+
+	return equity
+}
+
+// Exposure returns the total absolute market value of the positions.
+func (a *Account) Exposure() float64 {
+	var exposure float64
+
+	for _, position := range a.Positions {
+		exposure += position.Exposure()
+	}
+
+	return exposure
+}
+
+// Margin returns the equity required to carry the open positions.
+func (a *Account) Margin() float64 {
+	return a.Exposure() * a.MarginRatio
+}
+
+// BuyingPower returns the additional exposure that the account can take.
+func (a *Account) BuyingPower() float64 {
+	excess := a.Equity() - a.Margin()
+	if excess <= 0 {
+		return 0
+	}
+
+	return excess / a.MarginRatio
+}
+
+// Position returns the position for the asset with the given name, creating an empty one if needed.
+func (a *Account) Position(name string) *Position {
+	position, ok := a.Positions[name]
+	if !ok {
+		position = &Position{
+			Asset: name,
+		}
+
+		a.Positions[name] = position
+	}
+
+	return position
+}
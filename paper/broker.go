@@ -0,0 +1,379 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package paper
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/live"
+	"github.com/cinar/indicator/v2/strategy"
+)
+
+// ErrOrderNotFound indicates that the given order is not found among the open orders.
+var ErrOrderNotFound = errors.New("order is not found")
+
+// ErrInvalidOrder indicates that the given order is not valid.
+var ErrInvalidOrder = errors.New("invalid order")
+
+// Broker simulates the execution of the orders against the incoming snapshots,
+// and keeps the account state. The broker is safe for concurrent use.
+//
+// Example:
+//
+//	broker, err := paper.OpenBroker("account.json", paper.DefaultAccountCash)
+//	if err != nil {
+//		return err
+//	}
+//
+//	engine := live.NewEngine(repository, feed)
+//	engine.Sinks = append(engine.Sinks, broker)
+type Broker struct {
+	// account is the account state.
+	account *Account
+
+	// fileName is the file that the account is persisted to. The account
+	// is only kept in memory if it is empty.
+	fileName string
+
+	// mu guards the account.
+	mu sync.Mutex
+
+	// Commission is the flat commission charged for each fill.
+	Commission float64
+}
+
+// NewBroker function initializes a new broker with the given account kept in memory.
+func NewBroker(account *Account) *Broker {
+	return &Broker{
+		account: account,
+	}
+}
+
+// OpenBroker function initializes a new broker with the account persisted to
+// the given file. A new account with the given cash is created if the file
+// does not exist yet.
+func OpenBroker(fileName string, cash float64) (*Broker, error) {
+	account, err := LoadAccount(fileName)
+	if errors.Is(err, os.ErrNotExist) {
+		account = NewAccount(cash)
+
+		err = account.Save(fileName)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	broker := NewBroker(account)
+	broker.fileName = fileName
+
+	return broker, nil
+}
+
+// Account returns a copy of the current account state.
+func (b *Broker) Account() *Account {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	account := *b.account
+	account.Positions = make(map[string]*Position, len(b.account.Positions))
+	account.Orders = make([]*Order, len(b.account.Orders))
+	account.Rejected = make([]*Order, len(b.account.Rejected))
+	account.Canceled = make([]*Order, len(b.account.Canceled))
+	account.Fills = make([]*Fill, len(b.account.Fills))
+
+	for name, position := range b.account.Positions {
+		p := *position
+		account.Positions[name] = &p
+	}
+
+	for i, order := range b.account.Orders {
+		o := *order
+		account.Orders[i] = &o
+	}
+
+	for i, order := range b.account.Rejected {
+		o := *order
+		account.Rejected[i] = &o
+	}
+
+	for i, order := range b.account.Canceled {
+		o := *order
+		account.Canceled[i] = &o
+	}
+
+	for i, fill := range b.account.Fills {
+		f := *fill
+		account.Fills[i] = &f
+	}
+
+	return &account
+}
+
+// Submit validates the given order and queues it to be filled by the following snapshots.
+func (b *Broker) Submit(order *Order) (*Order, error) {
+	if order.Action != strategy.Buy && order.Action != strategy.Sell {
+		return nil, fmt.Errorf("%w: action must be buy or sell", ErrInvalidOrder)
+	}
+
+	if order.Quantity <= 0 {
+		return nil, fmt.Errorf("%w: quantity must be positive", ErrInvalidOrder)
+	}
+
+	switch order.Type {
+	case MarketOrder:
+
+	case LimitOrder, StopOrder:
+		if order.Price <= 0 {
+			return nil, fmt.Errorf("%w: price must be positive", ErrInvalidOrder)
+		}
+
+	default:
+		return nil, fmt.Errorf("%w: unknown type %q", ErrInvalidOrder, order.Type)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	submitted := *order
+	submitted.ID = b.account.NextOrderID
+	submitted.Status = OrderOpen
+	b.account.NextOrderID++
+
+	b.account.Orders = append(b.account.Orders, &submitted)
+
+	result := submitted
+	return &result, b.save()
+}
+
+// Cancel cancels the open order with the given identifier, and keeps it in the canceled orders.
+func (b *Broker) Cancel(id int) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for i, order := range b.account.Orders {
+		if order.ID == id {
+			b.account.Orders = append(b.account.Orders[:i], b.account.Orders[i+1:]...)
+
+			order.Status = OrderCanceled
+			b.account.Canceled = append(b.account.Canceled, order)
+
+			return b.save()
+		}
+	}
+
+	return ErrOrderNotFound
+}
+
+// Update fills the open orders for the asset with the given name against the
+// given snapshot, and marks the position to the closing price. Only the orders
+// submitted before the snapshot date are considered.
+func (b *Broker) Update(name string, snapshot *asset.Snapshot) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	orders := b.account.Orders[:0]
+
+	for _, order := range b.account.Orders {
+		if order.Asset != name || !snapshot.Date.After(order.Date) {
+			orders = append(orders, order)
+			continue
+		}
+
+		price, ok := fillPrice(order, snapshot)
+		if !ok {
+			orders = append(orders, order)
+			continue
+		}
+
+		reason := b.check(order, price)
+		if reason != "" {
+			order.Status = OrderRejected
+			order.Reason = reason
+			b.account.Rejected = append(b.account.Rejected, order)
+			continue
+		}
+
+		b.execute(order, price, snapshot.Date)
+	}
+
+	b.account.Orders = orders
+
+	// Mark the position to the closing price once the fills are processed.
+	if position, ok := b.account.Positions[name]; ok {
+		position.LastPrice = snapshot.Close
+	}
+
+	return b.save()
+}
+
+// SizeFunc adjusts the quantity of the order generated for a strategy action
+// given the account state. It returns the new quantity for the order.
+type SizeFunc func(account *Account, order *Order) float64
+
+// Act executes the given strategy action at the closing price of the given
+// snapshot, following the same model as the backtest outcome. A Buy action
+// invests all of the buying power into the asset, and a Sell action closes
+// the position.
+func (b *Broker) Act(name string, snapshot *asset.Snapshot, action strategy.Action) error {
+	return b.ActWithSize(name, snapshot, action, nil)
+}
+
+// ActWithSize executes the given strategy action like Act, with the order
+// quantity adjusted by the given size function. The size function can only
+// reduce the quantity.
+func (b *Broker) ActWithSize(name string, snapshot *asset.Snapshot, action strategy.Action, size SizeFunc) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	position := b.account.Position(name)
+	position.LastPrice = snapshot.Close
+
+	order := &Order{
+		Asset:  name,
+		Action: action,
+		Type:   MarketOrder,
+		Date:   snapshot.Date,
+	}
+
+	switch {
+	case action == strategy.Buy && position.Quantity <= 0:
+		// Covering the short position releases its exposure as buying power.
+		available := b.account.BuyingPower() + position.Exposure() - (b.Commission / b.account.MarginRatio)
+		order.Quantity = -position.Quantity + (math.Max(available, 0) / snapshot.Close)
+
+	case action == strategy.Sell && position.Quantity > 0:
+		order.Quantity = position.Quantity
+	}
+
+	if size != nil && order.Quantity > 0 {
+		order.Quantity = math.Min(order.Quantity, size(b.account, order))
+	}
+
+	if position.Quantity == 0 {
+		delete(b.account.Positions, name)
+	}
+
+	if order.Quantity <= 0 {
+		return nil
+	}
+
+	order.ID = b.account.NextOrderID
+	b.account.NextOrderID++
+
+	b.execute(order, snapshot.Close, snapshot.Date)
+
+	return b.save()
+}
+
+// Send executes the action of the given live signal. It allows the broker to be used as a live engine sink.
+func (b *Broker) Send(signal *live.Signal) error {
+	return b.Act(signal.Asset, &asset.Snapshot{
+		Date:  signal.Date,
+		Open:  signal.Close,
+		High:  signal.Close,
+		Low:   signal.Close,
+		Close: signal.Close,
+	}, signal.Action)
+}
+
+// check returns the reason if the account cannot afford to fill the given order at the given price.
+func (b *Broker) check(order *Order, price float64) string {
+	quantity := order.Quantity
+	if order.Action == strategy.Sell {
+		quantity = -quantity
+	}
+
+	current := 0.0
+	if position, ok := b.account.Positions[order.Asset]; ok {
+		current = position.Quantity
+	}
+
+	additional := (math.Abs(current+quantity) - math.Abs(current)) * price
+	if additional <= 0 {
+		return ""
+	}
+
+	excess := b.account.Equity() - b.account.Margin()
+	required := (additional * b.account.MarginRatio) + b.Commission
+
+	if required > excess {
+		return fmt.Sprintf("insufficient buying power: required %.2f available %.2f", required, excess)
+	}
+
+	return ""
+}
+
+// execute fills the given order at the given price.
+func (b *Broker) execute(order *Order, price float64, date time.Time) {
+	quantity := order.Quantity
+	if order.Action == strategy.Sell {
+		quantity = -quantity
+	}
+
+	position := b.account.Position(order.Asset)
+
+	b.account.RealizedPnL += position.add(quantity, price)
+	b.account.Cash -= (quantity * price) + b.Commission
+
+	if position.Quantity == 0 {
+		delete(b.account.Positions, order.Asset)
+	}
+
+	order.Status = OrderFilled
+
+	b.account.Fills = append(b.account.Fills, &Fill{
+		OrderID:    order.ID,
+		Asset:      order.Asset,
+		Action:     order.Action,
+		Quantity:   order.Quantity,
+		Price:      price,
+		Commission: b.Commission,
+		Date:       date,
+	})
+}
+
+// save persists the account if the broker has a file.
+func (b *Broker) save() error {
+	if b.fileName == "" {
+		return nil
+	}
+
+	return b.account.Save(b.fileName)
+}
+
+// fillPrice returns the price that the given order fills at during the given snapshot.
+func fillPrice(order *Order, snapshot *asset.Snapshot) (float64, bool) {
+	switch order.Type {
+	case MarketOrder:
+		return snapshot.Open, true
+
+	case LimitOrder:
+		if order.Action == strategy.Buy && snapshot.Low <= order.Price {
+			return math.Min(snapshot.Open, order.Price), true
+		}
+
+		if order.Action == strategy.Sell && snapshot.High >= order.Price {
+			return math.Max(snapshot.Open, order.Price), true
+		}
+
+	case StopOrder:
+		if order.Action == strategy.Buy && snapshot.High >= order.Price {
+			return math.Max(snapshot.Open, order.Price), true
+		}
+
+		if order.Action == strategy.Sell && snapshot.Low <= order.Price {
+			return math.Min(snapshot.Open, order.Price), true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,289 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package paper_test
+
+import (
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/live"
+	"github.com/cinar/indicator/v2/paper"
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/strategy/trend"
+)
+
+var repositoryBase = "testdata/repository"
+
+func TestBrokerActMatchesOutcome(t *testing.T) {
+	repository := asset.NewFileSystemRepository(repositoryBase)
+
+	snapshots, err := repository.Get("brk-b")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshotsSlice := helper.ChanToSlice(snapshots)
+	macd := trend.NewMacdStrategy()
+
+	actionsSlice := helper.ChanToSlice(macd.Compute(helper.SliceToChan(snapshotsSlice)))
+	outcomesSlice := helper.ChanToSlice(strategy.Outcome(
+		asset.SnapshotsAsClosings(helper.SliceToChan(snapshotsSlice)),
+		helper.SliceToChan(actionsSlice),
+	))
+
+	broker := paper.NewBroker(paper.NewAccount(1000))
+
+	for i, snapshot := range snapshotsSlice {
+		err = broker.Act("brk-b", snapshot, actionsSlice[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		actual := broker.Account().Equity()/1000 - 1
+		if math.Abs(actual-outcomesSlice[i]) > 1e-9 {
+			t.Fatalf("index %d actual %v expected %v", i, actual, outcomesSlice[i])
+		}
+	}
+
+	if len(broker.Account().Fills) == 0 {
+		t.Fatal("expected fills")
+	}
+}
+
+func TestBrokerSubmitInvalid(t *testing.T) {
+	broker := paper.NewBroker(paper.NewAccount(1000))
+
+	orders := []*paper.Order{
+		{Asset: "a", Action: strategy.Hold, Type: paper.MarketOrder, Quantity: 1},
+		{Asset: "a", Action: strategy.Buy, Type: paper.MarketOrder, Quantity: 0},
+		{Asset: "a", Action: strategy.Buy, Type: paper.LimitOrder, Quantity: 1},
+		{Asset: "a", Action: strategy.Buy, Type: "unknown", Quantity: 1},
+	}
+
+	for _, order := range orders {
+		_, err := broker.Submit(order)
+		if !errors.Is(err, paper.ErrInvalidOrder) {
+			t.Fatalf("order %v error %v", order, err)
+		}
+	}
+}
+
+func TestBrokerOrders(t *testing.T) {
+	broker := paper.NewBroker(paper.NewAccount(1000))
+	broker.Commission = 1
+
+	date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	market, err := broker.Submit(&paper.Order{Asset: "a", Action: strategy.Buy, Type: paper.MarketOrder, Quantity: 5, Date: date})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	limit, err := broker.Submit(&paper.Order{Asset: "a", Action: strategy.Sell, Type: paper.LimitOrder, Quantity: 5, Price: 110, Date: date})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stop, err := broker.Submit(&paper.Order{Asset: "a", Action: strategy.Sell, Type: paper.StopOrder, Quantity: 5, Price: 90, Date: date})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Orders are not filled by the snapshot they were submitted on.
+	err = broker.Update("a", &asset.Snapshot{Date: date, Open: 100, High: 100, Low: 100, Close: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(broker.Account().Orders) != 3 {
+		t.Fatalf("orders %v", broker.Account().Orders)
+	}
+
+	err = broker.Update("a", &asset.Snapshot{Date: date.AddDate(0, 0, 1), Open: 100, High: 105, Low: 95, Close: 102})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	account := broker.Account()
+	if len(account.Fills) != 1 || account.Fills[0].OrderID != market.ID || account.Fills[0].Price != 100 {
+		t.Fatalf("fills %v", account.Fills)
+	}
+
+	if account.Cash != 499 || account.Equity() != 1009 {
+		t.Fatalf("cash %v equity %v", account.Cash, account.Equity())
+	}
+
+	err = broker.Cancel(stop.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = broker.Cancel(stop.ID)
+	if !errors.Is(err, paper.ErrOrderNotFound) {
+		t.Fatalf("error %v", err)
+	}
+
+	canceled := broker.Account().Canceled
+	if len(canceled) != 1 || canceled[0].ID != stop.ID || canceled[0].Status != paper.OrderCanceled {
+		t.Fatalf("canceled %v", canceled)
+	}
+
+	err = broker.Update("a", &asset.Snapshot{Date: date.AddDate(0, 0, 2), Open: 108, High: 112, Low: 107, Close: 111})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	account = broker.Account()
+	if len(account.Fills) != 2 || account.Fills[1].OrderID != limit.ID || account.Fills[1].Price != 110 {
+		t.Fatalf("fills %v", account.Fills)
+	}
+
+	if len(account.Positions) != 0 || len(account.Orders) != 0 {
+		t.Fatalf("positions %v orders %v", account.Positions, account.Orders)
+	}
+
+	if account.Cash != 1048 || account.RealizedPnL != 50 {
+		t.Fatalf("cash %v realized %v", account.Cash, account.RealizedPnL)
+	}
+}
+
+func TestBrokerRejectsInsufficientBuyingPower(t *testing.T) {
+	broker := paper.NewBroker(paper.NewAccount(1000))
+
+	_, err := broker.Submit(&paper.Order{Asset: "a", Action: strategy.Buy, Type: paper.MarketOrder, Quantity: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = broker.Update("a", &asset.Snapshot{Date: time.Now(), Open: 100, High: 100, Low: 100, Close: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	account := broker.Account()
+	if len(account.Fills) != 0 || len(account.Orders) != 0 || len(account.Rejected) != 1 {
+		t.Fatalf("fills %v orders %v rejected %v", account.Fills, account.Orders, account.Rejected)
+	}
+
+	if account.Rejected[0].Status != paper.OrderRejected || account.Rejected[0].Reason == "" {
+		t.Fatalf("rejected %v", account.Rejected[0])
+	}
+}
+
+func TestBrokerMargin(t *testing.T) {
+	account := paper.NewAccount(1000)
+	account.MarginRatio = 0.5
+
+	broker := paper.NewBroker(account)
+
+	_, err := broker.Submit(&paper.Order{Asset: "a", Action: strategy.Sell, Type: paper.MarketOrder, Quantity: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = broker.Update("a", &asset.Snapshot{Date: time.Now(), Open: 100, High: 100, Low: 100, Close: 90})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	account = broker.Account()
+	if account.Positions["a"].Quantity != -20 || account.Equity() != 1200 || account.Margin() != 900 {
+		t.Fatalf("position %v equity %v margin %v", account.Positions["a"], account.Equity(), account.Margin())
+	}
+
+	if account.BuyingPower() != 600 {
+		t.Fatalf("buying power %v", account.BuyingPower())
+	}
+}
+
+func TestBrokerPersistence(t *testing.T) {
+	outputDir, err := os.MkdirTemp("", "paper")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer helper.RemoveAll(t, outputDir)
+
+	fileName := filepath.Join(outputDir, "account.json")
+
+	broker, err := paper.OpenBroker(fileName, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = broker.Act("a", &asset.Snapshot{Date: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Close: 100}, strategy.Buy)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = broker.Submit(&paper.Order{Asset: "a", Action: strategy.Sell, Type: paper.LimitOrder, Quantity: 10, Price: 120})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	restarted, err := paper.OpenBroker(fileName, 5000)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := broker.Account()
+	actual := restarted.Account()
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestOpenBrokerInvalid(t *testing.T) {
+	_, err := paper.OpenBroker("testdata/repository/brk-b.csv", 1000)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestBrokerLiveSink(t *testing.T) {
+	repository := asset.NewFileSystemRepository(repositoryBase)
+	broker := paper.NewBroker(paper.NewAccount(1000))
+
+	engine := live.NewEngine(repository, live.NewReplayFeed(repository))
+	engine.Strategies = append(engine.Strategies, trend.NewMacdStrategy())
+	engine.Sinks = append(engine.Sinks, broker)
+
+	err := engine.Run(time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(broker.Account().Fills) == 0 {
+		t.Fatal("expected fills")
+	}
+}
+
+func TestBrokerActWithSize(t *testing.T) {
+	broker := paper.NewBroker(paper.NewAccount(1000))
+
+	err := broker.ActWithSize("a", &asset.Snapshot{Close: 100}, strategy.Buy, func(account *paper.Account, order *paper.Order) float64 {
+		if account.Cash != 1000 || order.Quantity != 10 {
+			t.Fatalf("cash %v quantity %v", account.Cash, order.Quantity)
+		}
+
+		return 4
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	account := broker.Account()
+	if account.Positions["a"].Quantity != 4 || account.Cash != 600 {
+		t.Fatalf("positions %v cash %v", account.Positions, account.Cash)
+	}
+}
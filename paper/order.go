@@ -0,0 +1,97 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package paper
+
+import (
+	"time"
+
+	"github.com/cinar/indicator/v2/strategy"
+)
+
+// OrderType represents the different order types that the broker accepts.
+type OrderType string
+
+const (
+	// MarketOrder is filled at the next available price.
+	MarketOrder OrderType = "market"
+
+	// LimitOrder is filled at the limit price or better.
+	LimitOrder OrderType = "limit"
+
+	// StopOrder becomes a market order once the stop price is reached.
+	StopOrder OrderType = "stop"
+)
+
+// OrderStatus represents the different states of an order.
+type OrderStatus string
+
+const (
+	// OrderOpen indicates that the order is waiting to be filled.
+	OrderOpen OrderStatus = "open"
+
+	// OrderFilled indicates that the order is filled.
+	OrderFilled OrderStatus = "filled"
+
+	// OrderCanceled indicates that the order is canceled.
+	OrderCanceled OrderStatus = "canceled"
+
+	// OrderRejected indicates that the order is rejected by the broker.
+	OrderRejected OrderStatus = "rejected"
+)
+
+// Order represents an instruction to buy or sell an asset. The order uses the
+// same Buy and Sell actions that the strategies recommend.
+type Order struct {
+	// ID is the unique identifier of the order assigned by the broker.
+	ID int `json:"id"`
+
+	// Asset is the name of the asset.
+	Asset string `json:"asset"`
+
+	// Action is either Buy or Sell.
+	Action strategy.Action `json:"action"`
+
+	// Type is the order type.
+	Type OrderType `json:"type"`
+
+	// Quantity is the number of shares.
+	Quantity float64 `json:"quantity"`
+
+	// Price is the limit price for the limit orders, and the stop price for the stop orders.
+	Price float64 `json:"price,omitempty"`
+
+	// Date is the date the order is submitted.
+	Date time.Time `json:"date"`
+
+	// Status is the current status of the order.
+	Status OrderStatus `json:"status"`
+
+	// Reason is the reason for the order to be rejected.
+	Reason string `json:"reason,omitempty"`
+}
+
+// Fill represents the execution of an order.
+type Fill struct {
+	// OrderID is the identifier of the filled order.
+	OrderID int `json:"orderId"`
+
+	// Asset is the name of the asset.
+	Asset string `json:"asset"`
+
+	// Action is either Buy or Sell.
+	Action strategy.Action `json:"action"`
+
+	// Quantity is the number of shares.
+	Quantity float64 `json:"quantity"`
+
+	// Price is the execution price.
+	Price float64 `json:"price"`
+
+	// Commission is the commission paid for the fill.
+	Commission float64 `json:"commission"`
+
+	// Date is the date of the execution.
+	Date time.Time `json:"date"`
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package paper
+
+import "math"
+
+// Position represents the holdings of an asset. A negative quantity represents a short position.
+type Position struct {
+	// Asset is the name of the asset.
+	Asset string `json:"asset"`
+
+	// Quantity is the number of shares.
+	Quantity float64 `json:"quantity"`
+
+	// AveragePrice is the average price paid for the shares.
+	AveragePrice float64 `json:"averagePrice"`
+
+	// LastPrice is the last known price of the asset.
+	LastPrice float64 `json:"lastPrice"`
+}
+
+// Value returns the market value of the position.
+func (p *Position) Value() float64 {
+	return p.Quantity * p.LastPrice
+}
+
+// Exposure returns the absolute market value of the position.
+func (p *Position) Exposure() float64 {
+	return math.Abs(p.Value())
+}
+
+// UnrealizedPnL returns the profit or loss of the position at the last known price.
+func (p *Position) UnrealizedPnL() float64 {
+	return p.Quantity * (p.LastPrice - p.AveragePrice)
+}
+
+// add adds the given signed quantity at the given price to the position, and returns the realized profit or loss.
+func (p *Position) add(quantity, price float64) float64 {
+	var realized float64
+
+	// Reducing the position realizes the profit or loss for the closed shares.
+	if p.Quantity != 0 && math.Signbit(p.Quantity) != math.Signbit(quantity) {
+		closed := math.Min(math.Abs(quantity), math.Abs(p.Quantity))
+		if p.Quantity < 0 {
+			closed = -closed
+		}
+
+		realized = closed * (price - p.AveragePrice)
+		p.Quantity -= closed
+		quantity += closed
+	}
+
+	if quantity != 0 {
+		p.AveragePrice = ((p.AveragePrice * p.Quantity) + (price * quantity)) / (p.Quantity + quantity)
+		p.Quantity += quantity
+	}
+
+	if p.Quantity == 0 {
+		p.AveragePrice = 0
+	}
+
+	p.LastPrice = price
+
+	return realized
+}
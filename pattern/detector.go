@@ -0,0 +1,303 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package pattern
+
+import (
+	"math"
+
+	"github.com/cinar/indicator/v2/asset"
+)
+
+const (
+	// DefaultDojiBody is the default maximum ratio of the body to the range for a doji.
+	DefaultDojiBody = 0.1
+
+	// DefaultSmallBody is the default maximum ratio of the body to the range for a small body.
+	DefaultSmallBody = 0.3
+
+	// DefaultLongShadow is the default minimum ratio of the shadow to the body for a long shadow.
+	DefaultLongShadow = 2.0
+
+	// DefaultShortShadow is the default maximum ratio of the shadow to the range for a short shadow.
+	DefaultShortShadow = 0.1
+
+	// DefaultTrendPeriod is the default number of bars used to determine the preceding trend.
+	DefaultTrendPeriod = 5
+)
+
+// Detector represents the configuration parameters for recognizing the candlestick patterns. The
+// ratios are tolerances relative to the body and the range of a bar.
+//
+//	Body = Abs(Close - Open)
+//	Range = High - Low
+//	Upper Shadow = High - Max(Open, Close)
+//	Lower Shadow = Min(Open, Close) - Low
+//
+// The hammer shaped patterns are distinguished by the preceding trend, which is determined by
+// comparing the previous closing with the closing trend period bars before it.
+//
+// Example:
+//
+//	detector := pattern.NewDetector()
+//	patterns := detector.Compute(snapshots)
+type Detector struct {
+	// DojiBody is the maximum ratio of the body to the range for a doji.
+	DojiBody float64
+
+	// SmallBody is the maximum ratio of the body to the range for a small body.
+	SmallBody float64
+
+	// LongShadow is the minimum ratio of the shadow to the body for a long shadow.
+	LongShadow float64
+
+	// ShortShadow is the maximum ratio of the shadow to the range for a short shadow.
+	ShortShadow float64
+
+	// TrendPeriod is the number of bars used to determine the preceding trend.
+	TrendPeriod int
+}
+
+// candle is the measurements of a single bar.
+type candle struct {
+	open  float64
+	high  float64
+	low   float64
+	close float64
+}
+
+// NewDetector function initializes a new detector instance with the default parameters.
+func NewDetector() *Detector {
+	return &Detector{
+		DojiBody:    DefaultDojiBody,
+		SmallBody:   DefaultSmallBody,
+		LongShadow:  DefaultLongShadow,
+		ShortShadow: DefaultShortShadow,
+		TrendPeriod: DefaultTrendPeriod,
+	}
+}
+
+// Compute function takes a channel of snapshots and recognizes the candlestick patterns ending at
+// each bar. It yields one pattern bitmask per snapshot.
+func (d *Detector) Compute(snapshots <-chan *asset.Snapshot) <-chan Pattern {
+	result := make(chan Pattern, cap(snapshots))
+
+	go func() {
+		defer close(result)
+
+		size := max(3, d.TrendPeriod+2)
+		candles := make([]candle, 0, size)
+
+		for snapshot := range snapshots {
+			if len(candles) == size {
+				candles = append(candles[:0], candles[1:]...)
+			}
+
+			candles = append(candles, candle{
+				open:  snapshot.Open,
+				high:  snapshot.High,
+				low:   snapshot.Low,
+				close: snapshot.Close,
+			})
+
+			result <- d.detect(candles)
+		}
+	}()
+
+	return result
+}
+
+// IdlePeriod is the initial period that detector won't yield any results.
+func (*Detector) IdlePeriod() int {
+	return 0
+}
+
+// detect recognizes the patterns ending at the last of the given candles.
+func (d *Detector) detect(candles []candle) Pattern {
+	n := len(candles)
+	c := candles[n-1]
+	patterns := None
+
+	if c.isDoji(d) {
+		patterns |= Doji
+	}
+
+	rising, falling := d.trend(candles)
+
+	if c.isHammerShaped(d) {
+		if falling {
+			patterns |= Hammer
+		} else if rising {
+			patterns |= HangingMan
+		}
+	}
+
+	if c.isInvertedHammerShaped(d) {
+		if falling {
+			patterns |= InvertedHammer
+		} else if rising {
+			patterns |= ShootingStar
+		}
+	}
+
+	if n >= 2 {
+		patterns |= d.detectTwo(candles[n-2], c)
+	}
+
+	if n >= 3 {
+		patterns |= d.detectThree(candles[n-3], candles[n-2], c)
+	}
+
+	return patterns
+}
+
+// detectTwo recognizes the two bar patterns.
+func (*Detector) detectTwo(p, c candle) Pattern {
+	patterns := None
+
+	if p.isBearish() && c.isBullish() {
+		if c.open <= p.close && c.close >= p.open && c.body() > p.body() {
+			patterns |= BullishEngulfing
+		}
+
+		if c.open > p.close && c.close < p.open {
+			patterns |= BullishHarami
+		}
+	}
+
+	if p.isBullish() && c.isBearish() {
+		if c.open >= p.close && c.close <= p.open && c.body() > p.body() {
+			patterns |= BearishEngulfing
+		}
+
+		if c.open < p.close && c.close > p.open {
+			patterns |= BearishHarami
+		}
+	}
+
+	return patterns
+}
+
+// detectThree recognizes the three bar patterns.
+func (d *Detector) detectThree(first, second, third candle) Pattern {
+	patterns := None
+
+	if first.isBearish() && first.isLong(d) && second.isSmall(d) && third.isBullish() &&
+		second.bodyTop() <= first.close && third.close > first.middle() {
+		patterns |= MorningStar
+	}
+
+	if first.isBullish() && first.isLong(d) && second.isSmall(d) && third.isBearish() &&
+		second.bodyBottom() >= first.close && third.close < first.middle() {
+		patterns |= EveningStar
+	}
+
+	if first.isBullish() && second.isBullish() && third.isBullish() &&
+		first.isLong(d) && second.isLong(d) && third.isLong(d) &&
+		second.opensWithin(first) && third.opensWithin(second) &&
+		second.close > first.close && third.close > second.close {
+		patterns |= ThreeWhiteSoldiers
+	}
+
+	if first.isBearish() && second.isBearish() && third.isBearish() &&
+		first.isLong(d) && second.isLong(d) && third.isLong(d) &&
+		second.opensWithin(first) && third.opensWithin(second) &&
+		second.close < first.close && third.close < second.close {
+		patterns |= ThreeBlackCrows
+	}
+
+	return patterns
+}
+
+// trend determines whether the candles before the last one are rising or falling.
+func (d *Detector) trend(candles []candle) (bool, bool) {
+	n := len(candles)
+	if d.TrendPeriod <= 0 || n < d.TrendPeriod+2 {
+		return false, false
+	}
+
+	previous := candles[n-2].close
+	before := candles[n-2-d.TrendPeriod].close
+
+	return previous > before, previous < before
+}
+
+// body returns the size of the body.
+func (c candle) body() float64 {
+	return math.Abs(c.close - c.open)
+}
+
+// bodyTop returns the top of the body.
+func (c candle) bodyTop() float64 {
+	return math.Max(c.open, c.close)
+}
+
+// bodyBottom returns the bottom of the body.
+func (c candle) bodyBottom() float64 {
+	return math.Min(c.open, c.close)
+}
+
+// middle returns the middle of the body.
+func (c candle) middle() float64 {
+	return (c.open + c.close) / 2
+}
+
+// span returns the range of the bar.
+func (c candle) span() float64 {
+	return c.high - c.low
+}
+
+// upperShadow returns the size of the upper shadow.
+func (c candle) upperShadow() float64 {
+	return c.high - c.bodyTop()
+}
+
+// lowerShadow returns the size of the lower shadow.
+func (c candle) lowerShadow() float64 {
+	return c.bodyBottom() - c.low
+}
+
+// isBullish checks if the bar closed above its opening.
+func (c candle) isBullish() bool {
+	return c.close > c.open
+}
+
+// isBearish checks if the bar closed below its opening.
+func (c candle) isBearish() bool {
+	return c.close < c.open
+}
+
+// isDoji checks if the bar has a very small body.
+func (c candle) isDoji(d *Detector) bool {
+	return c.span() > 0 && c.body() <= d.DojiBody*c.span()
+}
+
+// isSmall checks if the bar has a small body.
+func (c candle) isSmall(d *Detector) bool {
+	return c.body() <= d.SmallBody*c.span()
+}
+
+// isLong checks if the bar has a body larger than a small body.
+func (c candle) isLong(d *Detector) bool {
+	return c.body() > d.SmallBody*c.span()
+}
+
+// isHammerShaped checks if the bar has a small body with a long lower shadow and a short upper shadow.
+func (c candle) isHammerShaped(d *Detector) bool {
+	return c.span() > 0 && c.isSmall(d) &&
+		c.lowerShadow() >= d.LongShadow*c.body() &&
+		c.upperShadow() <= d.ShortShadow*c.span()
+}
+
+// isInvertedHammerShaped checks if the bar has a small body with a long upper shadow and a short lower shadow.
+func (c candle) isInvertedHammerShaped(d *Detector) bool {
+	return c.span() > 0 && c.isSmall(d) &&
+		c.upperShadow() >= d.LongShadow*c.body() &&
+		c.lowerShadow() <= d.ShortShadow*c.span()
+}
+
+// opensWithin checks if the bar opens within the body of the given bar.
+func (c candle) opensWithin(p candle) bool {
+	return c.open >= p.bodyBottom() && c.open <= p.bodyTop()
+}
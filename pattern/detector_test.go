@@ -0,0 +1,195 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package pattern_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/pattern"
+)
+
+// trendSnapshots returns the given number of bars closing from the given value with the given step.
+func trendSnapshots(count int, from, step float64) []*asset.Snapshot {
+	snapshots := make([]*asset.Snapshot, count)
+
+	for i := range snapshots {
+		closing := from + float64(i)*step
+		open := closing - step/2
+		snapshots[i] = &asset.Snapshot{
+			Open:  open,
+			High:  max(open, closing) + 0.1,
+			Low:   min(open, closing) - 0.1,
+			Close: closing,
+		}
+	}
+
+	return snapshots
+}
+
+func TestDetector(t *testing.T) {
+	type Data struct {
+		Pattern pattern.Pattern
+	}
+
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := helper.ReadFromCsvFile[Data]("testdata/patterns.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := helper.Map(results, func(d *Data) pattern.Pattern { return d.Pattern })
+
+	detector := pattern.NewDetector()
+	actual := detector.Compute(snapshots)
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDetectorPatterns(t *testing.T) {
+	falling := trendSnapshots(6, 20, -1)
+	rising := trendSnapshots(6, 10, 1)
+
+	cases := []struct {
+		name      string
+		snapshots []*asset.Snapshot
+		expected  pattern.Pattern
+	}{
+		{
+			name:      "doji",
+			snapshots: []*asset.Snapshot{{Open: 10, High: 11, Low: 9, Close: 10.05}},
+			expected:  pattern.Doji,
+		},
+		{
+			name:      "hammer",
+			snapshots: append(falling, &asset.Snapshot{Open: 14, High: 14.3, Low: 12, Close: 14.3}),
+			expected:  pattern.Hammer,
+		},
+		{
+			name:      "inverted hammer",
+			snapshots: append(falling, &asset.Snapshot{Open: 14, High: 16, Low: 14, Close: 14.3}),
+			expected:  pattern.InvertedHammer,
+		},
+		{
+			name:      "hanging man",
+			snapshots: append(rising, &asset.Snapshot{Open: 16, High: 16.3, Low: 14, Close: 16.3}),
+			expected:  pattern.HangingMan,
+		},
+		{
+			name:      "shooting star",
+			snapshots: append(rising, &asset.Snapshot{Open: 16.3, High: 18.3, Low: 16, Close: 16}),
+			expected:  pattern.ShootingStar,
+		},
+		{
+			name: "bullish engulfing",
+			snapshots: []*asset.Snapshot{
+				{Open: 10, High: 10.2, Low: 8.8, Close: 9},
+				{Open: 8.9, High: 10.6, Low: 8.8, Close: 10.5},
+			},
+			expected: pattern.BullishEngulfing,
+		},
+		{
+			name: "bearish engulfing",
+			snapshots: []*asset.Snapshot{
+				{Open: 9, High: 10.2, Low: 8.8, Close: 10},
+				{Open: 10.1, High: 10.2, Low: 8.4, Close: 8.5},
+			},
+			expected: pattern.BearishEngulfing,
+		},
+		{
+			name: "bullish harami",
+			snapshots: []*asset.Snapshot{
+				{Open: 12, High: 12.1, Low: 9.9, Close: 10},
+				{Open: 10.5, High: 11.6, Low: 10.4, Close: 11.5},
+			},
+			expected: pattern.BullishHarami,
+		},
+		{
+			name: "bearish harami",
+			snapshots: []*asset.Snapshot{
+				{Open: 10, High: 12.1, Low: 9.9, Close: 12},
+				{Open: 11.5, High: 11.6, Low: 10.4, Close: 10.5},
+			},
+			expected: pattern.BearishHarami,
+		},
+		{
+			name: "morning star",
+			snapshots: []*asset.Snapshot{
+				{Open: 12, High: 12.1, Low: 9.9, Close: 10},
+				{Open: 9.6, High: 9.8, Low: 9.3, Close: 9.5},
+				{Open: 9.7, High: 11.6, Low: 9.6, Close: 11.5},
+			},
+			expected: pattern.MorningStar,
+		},
+		{
+			name: "evening star",
+			snapshots: []*asset.Snapshot{
+				{Open: 10, High: 12.1, Low: 9.9, Close: 12},
+				{Open: 12.4, High: 12.7, Low: 12.2, Close: 12.5},
+				{Open: 12.3, High: 12.4, Low: 10.4, Close: 10.5},
+			},
+			expected: pattern.EveningStar,
+		},
+		{
+			name: "three white soldiers",
+			snapshots: []*asset.Snapshot{
+				{Open: 10, High: 11.1, Low: 9.9, Close: 11},
+				{Open: 10.5, High: 11.6, Low: 10.4, Close: 11.5},
+				{Open: 11, High: 12.1, Low: 10.9, Close: 12},
+			},
+			expected: pattern.ThreeWhiteSoldiers,
+		},
+		{
+			name: "three black crows",
+			snapshots: []*asset.Snapshot{
+				{Open: 12, High: 12.1, Low: 10.9, Close: 11},
+				{Open: 11.5, High: 11.6, Low: 10.4, Close: 10.5},
+				{Open: 11, High: 11.1, Low: 9.9, Close: 10},
+			},
+			expected: pattern.ThreeBlackCrows,
+		},
+	}
+
+	detector := pattern.NewDetector()
+
+	for _, c := range cases {
+		patterns := helper.ChanToSlice(detector.Compute(helper.SliceToChan(c.snapshots)))
+
+		if len(patterns) != len(c.snapshots) {
+			t.Fatalf("%s: actual %d patterns expected %d", c.name, len(patterns), len(c.snapshots))
+		}
+
+		actual := patterns[len(patterns)-1]
+		if actual != c.expected {
+			t.Fatalf("%s: actual %q expected %q", c.name, actual, c.expected)
+		}
+	}
+}
+
+func TestDetectorTolerances(t *testing.T) {
+	snapshot := &asset.Snapshot{Open: 10, High: 11, Low: 9, Close: 10.3}
+
+	detector := pattern.NewDetector()
+
+	actual := <-detector.Compute(helper.SliceToChan([]*asset.Snapshot{snapshot}))
+	if actual.Has(pattern.Doji) {
+		t.Fatalf("actual %q", actual)
+	}
+
+	detector.DojiBody = 0.2
+
+	actual = <-detector.Compute(helper.SliceToChan([]*asset.Snapshot{snapshot}))
+	if !actual.Has(pattern.Doji) {
+		t.Fatalf("actual %q", actual)
+	}
+}
@@ -0,0 +1,141 @@
+// Package pattern contains the candlestick pattern recognition functions.
+//
+// This package belongs to the Indicator project. Indicator is
+// a Golang module that supplies a variety of technical
+// indicators, strategies, and a backtesting framework
+// for analysis.
+//
+// # License
+//
+//	Copyright (c) 2021-2024 Onur Cinar.
+//	The source code is provided under GNU AGPLv3 License.
+//	https://github.com/cinar/indicator
+//
+// # Disclaimer
+//
+// The information provided on this project is strictly for
+// informational purposes and is not to be construed as
+// advice or solicitation to buy or sell any security.
+package pattern
+
+import (
+	"strings"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+// Pattern is a bitmask of the candlestick patterns that are recognized at a bar. Multiple
+// patterns can be recognized at the same bar.
+type Pattern uint32
+
+const (
+	// None indicates that no patterns are recognized.
+	None Pattern = 0
+
+	// Doji is a single bar with a very small body, indicating indecision.
+	Doji Pattern = 1 << (iota - 1)
+
+	// Hammer is a single bar with a small body and a long lower shadow after a downtrend.
+	Hammer
+
+	// InvertedHammer is a single bar with a small body and a long upper shadow after a downtrend.
+	InvertedHammer
+
+	// HangingMan is a single bar with a small body and a long lower shadow after an uptrend.
+	HangingMan
+
+	// ShootingStar is a single bar with a small body and a long upper shadow after an uptrend.
+	ShootingStar
+
+	// BullishEngulfing is a bullish bar whose body engulfs the body of the previous bearish bar.
+	BullishEngulfing
+
+	// BearishEngulfing is a bearish bar whose body engulfs the body of the previous bullish bar.
+	BearishEngulfing
+
+	// BullishHarami is a bullish bar whose body is contained in the body of the previous bearish bar.
+	BullishHarami
+
+	// BearishHarami is a bearish bar whose body is contained in the body of the previous bullish bar.
+	BearishHarami
+
+	// MorningStar is a long bearish bar, followed by a small body below it, followed by a bullish bar
+	// closing above the middle of the first bar.
+	MorningStar
+
+	// EveningStar is a long bullish bar, followed by a small body above it, followed by a bearish bar
+	// closing below the middle of the first bar.
+	EveningStar
+
+	// ThreeWhiteSoldiers is three consecutive long bullish bars, each opening within the previous body
+	// and closing higher.
+	ThreeWhiteSoldiers
+
+	// ThreeBlackCrows is three consecutive long bearish bars, each opening within the previous body
+	// and closing lower.
+	ThreeBlackCrows
+)
+
+const (
+	// Bullish is the bitmask of the patterns that suggest a bullish reversal or continuation.
+	Bullish = Hammer | InvertedHammer | BullishEngulfing | BullishHarami | MorningStar | ThreeWhiteSoldiers
+
+	// Bearish is the bitmask of the patterns that suggest a bearish reversal or continuation.
+	Bearish = HangingMan | ShootingStar | BearishEngulfing | BearishHarami | EveningStar | ThreeBlackCrows
+)
+
+// patternNames is the names of the patterns in the bit order.
+var patternNames = []struct {
+	pattern Pattern
+	name    string
+}{
+	{Doji, "Doji"},
+	{Hammer, "Hammer"},
+	{InvertedHammer, "Inverted Hammer"},
+	{HangingMan, "Hanging Man"},
+	{ShootingStar, "Shooting Star"},
+	{BullishEngulfing, "Bullish Engulfing"},
+	{BearishEngulfing, "Bearish Engulfing"},
+	{BullishHarami, "Bullish Harami"},
+	{BearishHarami, "Bearish Harami"},
+	{MorningStar, "Morning Star"},
+	{EveningStar, "Evening Star"},
+	{ThreeWhiteSoldiers, "Three White Soldiers"},
+	{ThreeBlackCrows, "Three Black Crows"},
+}
+
+// Has checks if any of the given patterns are recognized.
+func (p Pattern) Has(other Pattern) bool {
+	return p&other != 0
+}
+
+// IsBullish checks if any of the bullish patterns are recognized.
+func (p Pattern) IsBullish() bool {
+	return p.Has(Bullish)
+}
+
+// IsBearish checks if any of the bearish patterns are recognized.
+func (p Pattern) IsBearish() bool {
+	return p.Has(Bearish)
+}
+
+// String returns the names of the recognized patterns separated by commas.
+func (p Pattern) String() string {
+	var names []string
+
+	for _, n := range patternNames {
+		if p.Has(n.pattern) {
+			names = append(names, n.name)
+		}
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// PatternsToAnnotations takes a channel of patterns and returns a new channel containing the
+// corresponding annotations for a report.
+func PatternsToAnnotations(pc <-chan Pattern) <-chan string {
+	return helper.Map(pc, func(p Pattern) string {
+		return p.String()
+	})
+}
@@ -0,0 +1,57 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package pattern_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/pattern"
+)
+
+func TestPatternString(t *testing.T) {
+	cases := map[pattern.Pattern]string{
+		pattern.None:                  "",
+		pattern.Doji:                  "Doji",
+		pattern.Doji | pattern.Hammer: "Doji, Hammer",
+		pattern.ThreeBlackCrows:       "Three Black Crows",
+	}
+
+	for p, expected := range cases {
+		if p.String() != expected {
+			t.Fatalf("actual %q expected %q", p.String(), expected)
+		}
+	}
+}
+
+func TestPatternDirection(t *testing.T) {
+	if !pattern.MorningStar.IsBullish() || pattern.MorningStar.IsBearish() {
+		t.Fatal("morning star is bullish")
+	}
+
+	if !pattern.EveningStar.IsBearish() || pattern.EveningStar.IsBullish() {
+		t.Fatal("evening star is bearish")
+	}
+
+	if pattern.Doji.IsBullish() || pattern.Doji.IsBearish() {
+		t.Fatal("doji is neutral")
+	}
+
+	if pattern.Bullish.Has(pattern.Bearish) {
+		t.Fatal("bullish and bearish patterns overlap")
+	}
+}
+
+func TestPatternsToAnnotations(t *testing.T) {
+	input := helper.SliceToChan([]pattern.Pattern{pattern.None, pattern.Doji, pattern.BullishEngulfing})
+	expected := helper.SliceToChan([]string{"", "Doji", "Bullish Engulfing"})
+
+	actual := pattern.PatternsToAnnotations(input)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
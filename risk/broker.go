@@ -0,0 +1,155 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package risk
+
+import (
+	"sync"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/live"
+	"github.com/cinar/indicator/v2/paper"
+	"github.com/cinar/indicator/v2/strategy"
+)
+
+// Broker wraps a paper broker with the risk rules. The orders generated for
+// the strategy actions are sized by the rules, and the positions are closed
+// when a breaker trips. It can be used as a live engine sink.
+type Broker struct {
+	// Broker is the paper broker executing the orders.
+	Broker *paper.Broker
+
+	// Manager is the risk manager.
+	Manager *Manager
+
+	// portfolio is the risk view of the paper account.
+	portfolio *Portfolio
+
+	// mu guards the portfolio.
+	mu sync.Mutex
+}
+
+// NewBroker function initializes a new risk managed broker.
+func NewBroker(broker *paper.Broker, manager *Manager) *Broker {
+	return &Broker{
+		Broker:    broker,
+		Manager:   manager,
+		portfolio: manager.NewPortfolio(),
+	}
+}
+
+// Act executes the given strategy action for the asset with the given name, subject to the risk rules.
+func (b *Broker) Act(name string, snapshot *asset.Snapshot, action strategy.Action) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.portfolio.Observe(name, snapshot.Date, snapshot.Close)
+
+	flattened, err := b.flattenIfTripped(name, snapshot)
+	if err != nil || flattened {
+		return err
+	}
+
+	return b.Broker.ActWithSize(name, snapshot, action, func(account *paper.Account, order *paper.Order) float64 {
+		b.mark(account, snapshot)
+
+		current := 0.0
+		if position, ok := account.Positions[name]; ok {
+			current = position.Quantity
+		}
+
+		// Orders reducing the position are not subject to the rules.
+		if (order.Action == strategy.Sell && current > 0) ||
+			(order.Action == strategy.Buy && current < 0 && order.Quantity <= -current) {
+			return order.Quantity
+		}
+
+		cover := 0.0
+		if order.Action == strategy.Buy && current < 0 {
+			cover = -current
+		}
+
+		return cover + b.Manager.Check(b.portfolio, &Proposal{
+			Asset:    name,
+			Date:     snapshot.Date,
+			Action:   order.Action,
+			Price:    snapshot.Close,
+			Quantity: order.Quantity - cover,
+		})
+	})
+}
+
+// Update fills the open orders against the given snapshot, and closes the position when a breaker trips.
+func (b *Broker) Update(name string, snapshot *asset.Snapshot) error {
+	err := b.Broker.Update(name, snapshot)
+	if err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.portfolio.Observe(name, snapshot.Date, snapshot.Close)
+	_, err = b.flattenIfTripped(name, snapshot)
+
+	return err
+}
+
+// Send executes the action of the given live signal subject to the risk rules. It allows the broker to be
+// used as a live engine sink.
+func (b *Broker) Send(signal *live.Signal) error {
+	return b.Act(signal.Asset, &asset.Snapshot{
+		Date:  signal.Date,
+		Open:  signal.Close,
+		High:  signal.Close,
+		Low:   signal.Close,
+		Close: signal.Close,
+	}, signal.Action)
+}
+
+// Events returns the recorded risk events.
+func (b *Broker) Events() []*Event {
+	return b.Manager.Events()
+}
+
+// flattenIfTripped closes the position in the asset with the given name if a breaker trips. It returns true
+// if the position is closed.
+func (b *Broker) flattenIfTripped(name string, snapshot *asset.Snapshot) (bool, error) {
+	account := b.Broker.Account()
+
+	position, ok := account.Positions[name]
+	if ok {
+		position.LastPrice = snapshot.Close
+	}
+
+	b.mark(account, snapshot)
+
+	if !ok || !b.Manager.Tripped(b.portfolio, name, snapshot.Date, position.Quantity) {
+		return false, nil
+	}
+
+	action := strategy.Sell
+	quantity := position.Quantity
+
+	if quantity < 0 {
+		action = strategy.Buy
+		quantity = -quantity
+	}
+
+	err := b.Broker.ActWithSize(name, snapshot, action, func(_ *paper.Account, _ *paper.Order) float64 {
+		return quantity
+	})
+
+	return err == nil, err
+}
+
+// mark updates the portfolio from the given account at the date of the given snapshot.
+func (b *Broker) mark(account *paper.Account, snapshot *asset.Snapshot) {
+	b.portfolio.Exposures = make(map[string]float64, len(account.Positions))
+	for name, position := range account.Positions {
+		b.portfolio.Exposures[name] = position.Value()
+	}
+
+	b.portfolio.Mark(snapshot.Date, account.Equity())
+}
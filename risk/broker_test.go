@@ -0,0 +1,93 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package risk_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/live"
+	"github.com/cinar/indicator/v2/paper"
+	"github.com/cinar/indicator/v2/risk"
+	"github.com/cinar/indicator/v2/strategy"
+)
+
+func TestBrokerPositionSize(t *testing.T) {
+	broker := risk.NewBroker(
+		paper.NewBroker(paper.NewAccount(1000)),
+		risk.NewManager(risk.NewMaxPositionSize(0.5)),
+	)
+
+	date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	err := broker.Send(&live.Signal{Asset: "a", Date: date, Close: 100, Action: strategy.Buy})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = broker.Send(&live.Signal{Asset: "b", Date: date, Close: 50, Action: strategy.Buy})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	account := broker.Broker.Account()
+	if account.Positions["a"].Quantity != 5 || account.Positions["b"].Quantity != 10 || account.Cash != 0 {
+		t.Fatalf("positions %v cash %v", account.Positions, account.Cash)
+	}
+
+	events := broker.Events()
+	if len(events) != 1 || events[0].Asset != "a" || events[0].Requested != 10 || events[0].Allowed != 5 {
+		t.Fatalf("events %v", events)
+	}
+
+	err = broker.Send(&live.Signal{Asset: "a", Date: date, Close: 100, Action: strategy.Sell})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := broker.Broker.Account().Positions["a"]; ok {
+		t.Fatal("expected position to be closed")
+	}
+}
+
+func TestBrokerBreaker(t *testing.T) {
+	broker := risk.NewBroker(
+		paper.NewBroker(paper.NewAccount(1000)),
+		risk.NewManager(risk.NewMaxDrawdown(0.1)),
+	)
+
+	date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	err := broker.Act("a", &asset.Snapshot{Date: date, Close: 100}, strategy.Buy)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = broker.Update("a", &asset.Snapshot{Date: date.AddDate(0, 0, 1), Open: 95, High: 95, Low: 85, Close: 85})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	account := broker.Broker.Account()
+	if len(account.Positions) != 0 || math.Abs(account.Cash-850) > 1e-9 {
+		t.Fatalf("positions %v cash %v", account.Positions, account.Cash)
+	}
+
+	err = broker.Act("a", &asset.Snapshot{Date: date.AddDate(0, 0, 2), Close: 90}, strategy.Buy)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(broker.Broker.Account().Positions) != 0 {
+		t.Fatal("expected the breaker to block the entry")
+	}
+
+	events := broker.Events()
+	if len(events) != 2 || events[0].Action != strategy.Sell || events[1].Allowed != 0 {
+		t.Fatalf("events %v", events)
+	}
+}
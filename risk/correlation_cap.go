@@ -0,0 +1,109 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package risk
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+const (
+	// DefaultCorrelationCapMax is the default maximum correlation with the held assets.
+	DefaultCorrelationCapMax = 0.8
+)
+
+// CorrelationCap blocks the new positions in an asset whose returns are
+// highly correlated with the returns of an asset that is already held.
+type CorrelationCap struct {
+	// Max is the maximum allowed correlation.
+	Max float64
+}
+
+// NewCorrelationCap function initializes a new correlation cap rule with the given maximum.
+func NewCorrelationCap(maximum float64) *CorrelationCap {
+	return &CorrelationCap{
+		Max: maximum,
+	}
+}
+
+// Name returns the name of the rule.
+func (r *CorrelationCap) Name() string {
+	return fmt.Sprintf("Correlation Cap (%.2f)", r.Max)
+}
+
+// Check returns the quantity of the given proposal that the rule allows for the given portfolio.
+func (r *CorrelationCap) Check(portfolio *Portfolio, proposal *Proposal) (float64, string) {
+	// Visit the held assets in order for the reasons to be reproducible.
+	names := make([]string, 0, len(portfolio.Exposures))
+	for name, exposure := range portfolio.Exposures {
+		if name != proposal.Asset && exposure != 0 {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		correlation := correlation(portfolio.CommonReturns(proposal.Asset, name))
+		if correlation > r.Max {
+			return 0, fmt.Sprintf("correlation %.4f with %s exceeds %.4f", correlation, name, r.Max)
+		}
+	}
+
+	return proposal.Quantity, ""
+}
+
+// correlation returns the Pearson correlation of the given slices of the same length.
+func correlation(x, y []float64) float64 {
+	n := len(x)
+	if n < 2 || n != len(y) {
+		return 0
+	}
+
+	meanX := mean(x)
+	meanY := mean(y)
+
+	var covariance, varianceX, varianceY float64
+
+	for i := 0; i < n; i++ {
+		dx := x[i] - meanX
+		dy := y[i] - meanY
+
+		covariance += dx * dy
+		varianceX += dx * dx
+		varianceY += dy * dy
+	}
+
+	if varianceX == 0 || varianceY == 0 {
+		return 0
+	}
+
+	return covariance / math.Sqrt(varianceX*varianceY)
+}
+
+// mean returns the mean of the given values.
+func mean(values []float64) float64 {
+	var sum float64
+
+	for _, value := range values {
+		sum += value
+	}
+
+	return sum / float64(len(values))
+}
+
+// stdDev returns the sample standard deviation of the given values.
+func stdDev(values []float64) float64 {
+	m := mean(values)
+
+	var sum float64
+
+	for _, value := range values {
+		sum += (value - m) * (value - m)
+	}
+
+	return math.Sqrt(sum / float64(len(values)-1))
+}
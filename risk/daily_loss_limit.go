@@ -0,0 +1,54 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package risk
+
+import (
+	"fmt"
+)
+
+const (
+	// DefaultDailyLossLimitFraction is the default daily loss limit as a fraction of the start of the day equity.
+	DefaultDailyLossLimitFraction = 0.03
+)
+
+// DailyLossLimit halts the trading for the rest of the day once the equity
+// declines by the given fraction since the start of the day. The days follow
+// the session of the manager, which is the local calendar day by default.
+type DailyLossLimit struct {
+	// Fraction is the maximum loss as a fraction of the start of the day equity.
+	Fraction float64
+}
+
+// NewDailyLossLimit function initializes a new daily loss limit rule with the given fraction.
+func NewDailyLossLimit(fraction float64) *DailyLossLimit {
+	return &DailyLossLimit{
+		Fraction: fraction,
+	}
+}
+
+// Name returns the name of the rule.
+func (r *DailyLossLimit) Name() string {
+	return fmt.Sprintf("Daily Loss Limit (%.2f)", r.Fraction)
+}
+
+// Check returns the quantity of the given proposal that the rule allows for the given portfolio.
+func (r *DailyLossLimit) Check(portfolio *Portfolio, proposal *Proposal) (float64, string) {
+	tripped, reason := r.Tripped(portfolio)
+	if tripped {
+		return 0, reason
+	}
+
+	return proposal.Quantity, ""
+}
+
+// Tripped returns true along with the reason if the positions should be closed.
+func (r *DailyLossLimit) Tripped(portfolio *Portfolio) (bool, string) {
+	loss := portfolio.DailyLoss()
+	if loss >= r.Fraction {
+		return true, fmt.Sprintf("daily loss %.4f reached the limit %.4f", loss, r.Fraction)
+	}
+
+	return false, ""
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package risk
+
+import (
+	"time"
+
+	"github.com/cinar/indicator/v2/strategy"
+)
+
+// Event records an action that is blocked, modified, or forced by a risk rule.
+type Event struct {
+	// Asset is the name of the asset.
+	Asset string `json:"asset"`
+
+	// Date is the date of the action.
+	Date time.Time `json:"date"`
+
+	// Rule is the name of the rule.
+	Rule string `json:"rule"`
+
+	// Action is the action that the rule was applied to.
+	Action strategy.Action `json:"action"`
+
+	// Requested is the requested quantity.
+	Requested float64 `json:"requested"`
+
+	// Allowed is the allowed quantity. It is zero when the action is blocked.
+	Allowed float64 `json:"allowed"`
+
+	// Reason is the reason given by the rule.
+	Reason string `json:"reason"`
+}
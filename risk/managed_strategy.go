@@ -0,0 +1,110 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package risk
+
+import (
+	"fmt"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+)
+
+// ManagedStrategy wraps a strategy with the risk rules, allowing it to be used
+// by the backtest and the live engine. It simulates a single asset portfolio
+// following the backtest outcome model, blocks the Buy actions that the rules
+// do not allow, and recommends Sell when a breaker trips. Since the strategy
+// actions carry no quantity, a Buy action reduced by a sizing rule is still
+// recommended, and the simulated portfolio only invests the allowed quantity.
+type ManagedStrategy struct {
+	// InnerStrategy is the inner strategy.
+	InnerStrategy strategy.Strategy
+
+	// Manager is the risk manager.
+	Manager *Manager
+}
+
+// NewManagedStrategy function initializes a new managed strategy instance.
+func NewManagedStrategy(innerStrategy strategy.Strategy, manager *Manager) *ManagedStrategy {
+	return &ManagedStrategy{
+		InnerStrategy: innerStrategy,
+		Manager:       manager,
+	}
+}
+
+// Name returns the name of the strategy.
+func (m *ManagedStrategy) Name() string {
+	return fmt.Sprintf("Risk Managed Strategy (%s)", m.InnerStrategy.Name())
+}
+
+// Compute processes the provided asset snapshots and generates a stream of actionable recommendations.
+func (m *ManagedStrategy) Compute(c <-chan *asset.Snapshot) <-chan strategy.Action {
+	snapshots := helper.Duplicate(c, 2)
+	innerActions := m.InnerStrategy.Compute(snapshots[0])
+
+	name := m.InnerStrategy.Name()
+	portfolio := m.Manager.NewPortfolio()
+
+	cash := 1.0
+	shares := 0.0
+
+	return helper.Operate(snapshots[1], innerActions, func(snapshot *asset.Snapshot, action strategy.Action) strategy.Action {
+		portfolio.Observe(name, snapshot.Date, snapshot.Close)
+		portfolio.Exposures[name] = shares * snapshot.Close
+		portfolio.Mark(snapshot.Date, cash+portfolio.Exposures[name])
+
+		if shares > 0 && (action == strategy.Sell || m.Manager.Tripped(portfolio, name, snapshot.Date, shares)) {
+			cash += shares * snapshot.Close
+			shares = 0
+			return strategy.Sell
+		}
+
+		if action != strategy.Buy || shares > 0 {
+			return strategy.Hold
+		}
+
+		shares = m.Manager.Check(portfolio, &Proposal{
+			Asset:    name,
+			Date:     snapshot.Date,
+			Action:   strategy.Buy,
+			Price:    snapshot.Close,
+			Quantity: cash / snapshot.Close,
+		})
+
+		if shares <= 0 {
+			shares = 0
+			return strategy.Hold
+		}
+
+		cash -= shares * snapshot.Close
+		return strategy.Buy
+	})
+}
+
+// Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
+// The actions are computed with a clone of the manager, so that the events are not recorded again in the
+// manager when the report follows a computation over the same snapshots.
+func (m *ManagedStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
+	snapshots := helper.Duplicate(c, 3)
+
+	dates := asset.SnapshotsAsDates(snapshots[0])
+	closings := asset.SnapshotsAsClosings(snapshots[1])
+
+	managed := NewManagedStrategy(m.InnerStrategy, m.Manager.Clone())
+
+	actions, outcomes := strategy.ComputeWithOutcome(managed, snapshots[2])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(m.Name(), dates)
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closings))
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations))
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 1)
+
+	return report
+}
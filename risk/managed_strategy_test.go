@@ -0,0 +1,110 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package risk_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/risk"
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/strategy/trend"
+)
+
+func TestManagedStrategyWithoutRules(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshotsSplice := helper.Duplicate(snapshots, 2)
+
+	macd := trend.NewMacdStrategy()
+	managed := risk.NewManagedStrategy(macd, risk.NewManager())
+
+	expected := strategy.NormalizeActions(macd.Compute(snapshotsSplice[0]))
+	actual := managed.Compute(snapshotsSplice[1])
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestManagedStrategyBreaker(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	manager := risk.NewManager(risk.NewMaxDrawdown(0.01))
+	managed := risk.NewManagedStrategy(strategy.NewBuyAndHoldStrategy(), manager)
+
+	actions := helper.ChanToSlice(managed.Compute(snapshots))
+
+	buys := 0
+	sells := 0
+
+	for _, action := range actions {
+		switch action {
+		case strategy.Buy:
+			buys++
+
+		case strategy.Sell:
+			sells++
+		}
+	}
+
+	// The breaker closes the position once.
+	if buys != 1 || sells != 1 {
+		t.Fatalf("buys %d sells %d", buys, sells)
+	}
+
+	events := manager.Events()
+	if len(events) != 1 || events[0].Action != strategy.Sell || events[0].Reason == "" {
+		t.Fatalf("events %v", events)
+	}
+}
+
+func TestManagedStrategyReportKeepsEvents(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bars := helper.ChanToSlice(snapshots)
+
+	manager := risk.NewManager(risk.NewMaxDrawdown(0.01))
+	managed := risk.NewManagedStrategy(strategy.NewBuyAndHoldStrategy(), manager)
+
+	helper.Drain(managed.Compute(helper.SliceToChan(bars)))
+	events := len(manager.Events())
+
+	managed.Report(helper.SliceToChan(bars))
+
+	if len(manager.Events()) != events {
+		t.Fatalf("events %d expected %d", len(manager.Events()), events)
+	}
+}
+
+func TestManagedStrategyReport(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	managed := risk.NewManagedStrategy(trend.NewMacdStrategy(), risk.NewManager(risk.NewMaxDrawdown(0.05)))
+
+	report := managed.Report(snapshots)
+
+	fileName := "managed_strategy.html"
+	defer helper.Remove(t, fileName)
+
+	err = report.WriteToFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
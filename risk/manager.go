@@ -0,0 +1,150 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package risk
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/strategy"
+)
+
+// Manager applies the risk rules to the proposed trades and records every
+// blocked, modified, or forced action with its reason. The manager is safe
+// for concurrent use.
+//
+// Example:
+//
+//	manager := risk.NewManager(
+//		risk.NewMaxPositionSize(0.2),
+//		risk.NewMaxDrawdown(0.1),
+//	)
+type Manager struct {
+	// Rules are the risk rules to apply.
+	Rules []Rule
+
+	// Lookback is the number of closings kept for each asset.
+	Lookback int
+
+	// Session is the timeframe of the trading days for the portfolios.
+	Session asset.Timeframe
+
+	// events are the recorded events.
+	events []*Event
+
+	// mu guards the events.
+	mu sync.Mutex
+}
+
+// NewManager function initializes a new manager with the given rules.
+func NewManager(rules ...Rule) *Manager {
+	return &Manager{
+		Rules:    rules,
+		Lookback: DefaultLookback,
+		Session:  asset.Daily,
+		events:   []*Event{},
+	}
+}
+
+// Clone returns a new manager with the same rules, lookback, and session, and without any
+// recorded events.
+func (m *Manager) Clone() *Manager {
+	clone := NewManager(m.Rules...)
+	clone.Lookback = m.Lookback
+	clone.Session = m.Session
+
+	return clone
+}
+
+// NewPortfolio initializes a new portfolio that keeps enough closings for the rules.
+func (m *Manager) NewPortfolio() *Portfolio {
+	portfolio := NewPortfolio(m.Lookback)
+	portfolio.Session = m.Session
+
+	return portfolio
+}
+
+// Check applies the rules to the given proposal, and returns the allowed quantity. Each rule that reduces the
+// quantity is recorded as an event.
+func (m *Manager) Check(portfolio *Portfolio, proposal *Proposal) float64 {
+	allowed := proposal.Quantity
+
+	for _, rule := range m.Rules {
+		quantity, reason := rule.Check(portfolio, proposal)
+		quantity = math.Max(quantity, 0)
+
+		if quantity < allowed {
+			m.record(&Event{
+				Asset:     proposal.Asset,
+				Date:      proposal.Date,
+				Rule:      rule.Name(),
+				Action:    proposal.Action,
+				Requested: allowed,
+				Allowed:   quantity,
+				Reason:    reason,
+			})
+
+			allowed = quantity
+		}
+	}
+
+	return allowed
+}
+
+// Tripped checks the breaker rules, and returns true if the position in the given asset should be closed. The
+// forced closing is recorded as an event.
+func (m *Manager) Tripped(portfolio *Portfolio, name string, date time.Time, quantity float64) bool {
+	for _, rule := range m.Rules {
+		breaker, ok := rule.(Breaker)
+		if !ok {
+			continue
+		}
+
+		tripped, reason := breaker.Tripped(portfolio)
+		if !tripped {
+			continue
+		}
+
+		action := strategy.Sell
+		if quantity < 0 {
+			action = strategy.Buy
+		}
+
+		m.record(&Event{
+			Asset:     name,
+			Date:      date,
+			Rule:      rule.Name(),
+			Action:    action,
+			Requested: math.Abs(quantity),
+			Allowed:   math.Abs(quantity),
+			Reason:    reason,
+		})
+
+		return true
+	}
+
+	return false
+}
+
+// Events returns the recorded events.
+func (m *Manager) Events() []*Event {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	events := make([]*Event, len(m.events))
+	copy(events, m.events)
+
+	return events
+}
+
+// record appends the given event.
+func (m *Manager) record(event *Event) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.events = append(m.events, event)
+}
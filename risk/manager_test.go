@@ -0,0 +1,59 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package risk_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/risk"
+	"github.com/cinar/indicator/v2/strategy"
+)
+
+func TestManagerCheck(t *testing.T) {
+	manager := risk.NewManager(
+		risk.NewMaxExposure(1),
+		risk.NewMaxPositionSize(0.25),
+	)
+
+	allowed := manager.Check(testPortfolio(), testProposal("a"))
+	if allowed != 5 {
+		t.Fatalf("allowed %v", allowed)
+	}
+
+	events := manager.Events()
+	if len(events) != 2 {
+		t.Fatalf("events %v", events)
+	}
+
+	if events[0].Requested != 100 || events[0].Allowed != 80 || events[1].Requested != 80 || events[1].Allowed != 5 {
+		t.Fatalf("events %v %v", events[0], events[1])
+	}
+}
+
+func TestManagerTripped(t *testing.T) {
+	manager := risk.NewManager(
+		risk.NewMaxExposure(1),
+		risk.NewMaxDrawdown(0.1),
+	)
+
+	portfolio := testPortfolio()
+	date := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	if manager.Tripped(portfolio, "a", date, 20) {
+		t.Fatal("not expected to be tripped")
+	}
+
+	portfolio.Mark(date, 800)
+
+	if !manager.Tripped(portfolio, "a", date, -20) {
+		t.Fatal("expected to be tripped")
+	}
+
+	events := manager.Events()
+	if len(events) != 1 || events[0].Action != strategy.Buy || events[0].Allowed != 20 || events[0].Reason == "" {
+		t.Fatalf("events %v", events)
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package risk
+
+import (
+	"fmt"
+)
+
+const (
+	// DefaultMaxDrawdownFraction is the default maximum drawdown as a fraction of the peak equity.
+	DefaultMaxDrawdownFraction = 0.2
+)
+
+// MaxDrawdown is a circuit breaker that closes the positions and blocks the
+// new ones once the equity declines by the given fraction from its peak.
+// As the equity does not recover without positions, the breaker stays
+// tripped once it trips.
+type MaxDrawdown struct {
+	// Fraction is the maximum drawdown as a fraction of the peak equity.
+	Fraction float64
+}
+
+// NewMaxDrawdown function initializes a new max drawdown rule with the given fraction.
+func NewMaxDrawdown(fraction float64) *MaxDrawdown {
+	return &MaxDrawdown{
+		Fraction: fraction,
+	}
+}
+
+// Name returns the name of the rule.
+func (r *MaxDrawdown) Name() string {
+	return fmt.Sprintf("Max Drawdown (%.2f)", r.Fraction)
+}
+
+// Check returns the quantity of the given proposal that the rule allows for the given portfolio.
+func (r *MaxDrawdown) Check(portfolio *Portfolio, proposal *Proposal) (float64, string) {
+	tripped, reason := r.Tripped(portfolio)
+	if tripped {
+		return 0, reason
+	}
+
+	return proposal.Quantity, ""
+}
+
+// Tripped returns true along with the reason if the positions should be closed.
+func (r *MaxDrawdown) Tripped(portfolio *Portfolio) (bool, string) {
+	drawdown := portfolio.Drawdown()
+	if drawdown >= r.Fraction {
+		return true, fmt.Sprintf("drawdown %.4f reached the limit %.4f", drawdown, r.Fraction)
+	}
+
+	return false, ""
+}
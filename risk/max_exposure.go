@@ -0,0 +1,41 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package risk
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// DefaultMaxExposureFraction is the default maximum gross exposure as a fraction of the equity.
+	DefaultMaxExposureFraction = 1.0
+)
+
+// MaxExposure limits the total absolute market value of all positions to a fraction of the equity.
+type MaxExposure struct {
+	// Fraction is the maximum gross exposure as a fraction of the equity.
+	Fraction float64
+}
+
+// NewMaxExposure function initializes a new max exposure rule with the given fraction.
+func NewMaxExposure(fraction float64) *MaxExposure {
+	return &MaxExposure{
+		Fraction: fraction,
+	}
+}
+
+// Name returns the name of the rule.
+func (r *MaxExposure) Name() string {
+	return fmt.Sprintf("Max Exposure (%.2f)", r.Fraction)
+}
+
+// Check returns the quantity of the given proposal that the rule allows for the given portfolio.
+func (r *MaxExposure) Check(portfolio *Portfolio, proposal *Proposal) (float64, string) {
+	limit := r.Fraction * portfolio.Equity
+	allowed := math.Max(limit-portfolio.GrossExposure(), 0) / proposal.Price
+
+	return allowed, fmt.Sprintf("gross exposure %.2f limited to %.2f of equity %.2f", portfolio.GrossExposure(), r.Fraction, portfolio.Equity)
+}
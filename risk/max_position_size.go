@@ -0,0 +1,42 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package risk
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// DefaultMaxPositionSizeFraction is the default maximum position size as a fraction of the equity.
+	DefaultMaxPositionSizeFraction = 0.25
+)
+
+// MaxPositionSize limits the market value of a single position to a fraction of the equity.
+type MaxPositionSize struct {
+	// Fraction is the maximum position value as a fraction of the equity.
+	Fraction float64
+}
+
+// NewMaxPositionSize function initializes a new max position size rule with the given fraction.
+func NewMaxPositionSize(fraction float64) *MaxPositionSize {
+	return &MaxPositionSize{
+		Fraction: fraction,
+	}
+}
+
+// Name returns the name of the rule.
+func (r *MaxPositionSize) Name() string {
+	return fmt.Sprintf("Max Position Size (%.2f)", r.Fraction)
+}
+
+// Check returns the quantity of the given proposal that the rule allows for the given portfolio.
+func (r *MaxPositionSize) Check(portfolio *Portfolio, proposal *Proposal) (float64, string) {
+	limit := r.Fraction * portfolio.Equity
+	current := math.Abs(portfolio.Exposures[proposal.Asset])
+	allowed := math.Max(limit-current, 0) / proposal.Price
+
+	return allowed, fmt.Sprintf("position value limited to %.2f of equity %.2f", r.Fraction, portfolio.Equity)
+}
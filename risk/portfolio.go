@@ -0,0 +1,168 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package risk
+
+import (
+	"math"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+)
+
+const (
+	// DefaultLookback is the default number of closings kept for each asset.
+	DefaultLookback = 20
+)
+
+// Portfolio captures the state that the risk rules are evaluated against.
+type Portfolio struct {
+	// Equity is the current equity.
+	Equity float64
+
+	// Peak is the highest equity observed.
+	Peak float64
+
+	// DayStart is the equity at the beginning of the current day.
+	DayStart float64
+
+	// Exposures are the signed market values of the positions keyed by the asset name.
+	Exposures map[string]float64
+
+	// Session is the timeframe of the trading days that the daily loss is measured over. It is the
+	// calendar day in the location of the dates by default, and can be set to asset.Session for the
+	// exchange sessions.
+	Session asset.Timeframe
+
+	// day is the start of the current day.
+	day time.Time
+
+	// lookback is the number of closings kept for each asset.
+	lookback int
+
+	// closings are the recent closings keyed by the asset name.
+	closings map[string][]observation
+}
+
+// observation is a closing observed at a date.
+type observation struct {
+	// date is the date of the closing.
+	date time.Time
+
+	// closing is the closing price.
+	closing float64
+}
+
+// NewPortfolio function initializes a new portfolio keeping the given number of closings for each asset.
+func NewPortfolio(lookback int) *Portfolio {
+	return &Portfolio{
+		Exposures: make(map[string]float64),
+		Session:   asset.Daily,
+		lookback:  lookback,
+		closings:  make(map[string][]observation),
+	}
+}
+
+// Mark updates the equity at the given date, tracking the peak and the start of the day equities.
+func (p *Portfolio) Mark(date time.Time, equity float64) {
+	day := p.Session(date)
+	if p.day.IsZero() || day.After(p.day) {
+		p.day = day
+		p.DayStart = p.Equity
+
+		if p.DayStart == 0 {
+			p.DayStart = equity
+		}
+	}
+
+	p.Equity = equity
+	p.Peak = math.Max(p.Peak, equity)
+}
+
+// Observe records the given closing at the given date for the asset with the given name.
+func (p *Portfolio) Observe(name string, date time.Time, closing float64) {
+	closings := append(p.closings[name], observation{
+		date:    date,
+		closing: closing,
+	})
+	if len(closings) > p.lookback+1 {
+		closings = closings[len(closings)-p.lookback-1:]
+	}
+
+	p.closings[name] = closings
+}
+
+// Returns returns the recent log returns for the asset with the given name, oldest first.
+func (p *Portfolio) Returns(name string) []float64 {
+	closings := p.closings[name]
+	if len(closings) < 2 {
+		return nil
+	}
+
+	returns := make([]float64, len(closings)-1)
+	for i := range returns {
+		returns[i] = math.Log(closings[i+1].closing / closings[i].closing)
+	}
+
+	return returns
+}
+
+// CommonReturns returns the recent log returns for the assets with the given names over the dates
+// that both assets are observed at, oldest first, so that the returns of the two assets are
+// aligned by date.
+func (p *Portfolio) CommonReturns(x, y string) ([]float64, []float64) {
+	closings := make(map[int64]float64, len(p.closings[y]))
+	for _, o := range p.closings[y] {
+		closings[o.date.UnixNano()] = o.closing
+	}
+
+	var returnsX, returnsY []float64
+	var lastX, lastY float64
+
+	for _, o := range p.closings[x] {
+		closingY, ok := closings[o.date.UnixNano()]
+		if !ok {
+			continue
+		}
+
+		if lastX != 0 {
+			returnsX = append(returnsX, math.Log(o.closing/lastX))
+			returnsY = append(returnsY, math.Log(closingY/lastY))
+		}
+
+		lastX = o.closing
+		lastY = closingY
+	}
+
+	return returnsX, returnsY
+}
+
+// Drawdown returns the decline of the equity from its peak as a fraction of the peak.
+func (p *Portfolio) Drawdown() float64 {
+	if p.Peak <= 0 {
+		return 0
+	}
+
+	return (p.Peak - p.Equity) / p.Peak
+}
+
+// DailyLoss returns the decline of the equity since the start of the day as a fraction of the start of the day equity.
+func (p *Portfolio) DailyLoss() float64 {
+	if p.DayStart <= 0 {
+		return 0
+	}
+
+	return (p.DayStart - p.Equity) / p.DayStart
+}
+
+// GrossExposure returns the total absolute market value of the positions.
+func (p *Portfolio) GrossExposure() float64 {
+	var exposure float64
+
+	for _, value := range p.Exposures {
+		exposure += math.Abs(value)
+	}
+
+	return exposure
+}
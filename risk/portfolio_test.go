@@ -0,0 +1,131 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package risk_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/risk"
+)
+
+func TestPortfolioMark(t *testing.T) {
+	portfolio := risk.NewPortfolio(risk.DefaultLookback)
+	day := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	portfolio.Mark(day, 100)
+	portfolio.Mark(day.Add(time.Hour), 120)
+	portfolio.Mark(day.Add(2*time.Hour), 90)
+
+	if portfolio.Peak != 120 || portfolio.DayStart != 100 {
+		t.Fatalf("peak %v day start %v", portfolio.Peak, portfolio.DayStart)
+	}
+
+	if portfolio.Drawdown() != 0.25 || portfolio.DailyLoss() != 0.1 {
+		t.Fatalf("drawdown %v daily loss %v", portfolio.Drawdown(), portfolio.DailyLoss())
+	}
+
+	portfolio.Mark(day.AddDate(0, 0, 1), 81)
+
+	if portfolio.DayStart != 90 || portfolio.DailyLoss() != 0.1 {
+		t.Fatalf("day start %v daily loss %v", portfolio.DayStart, portfolio.DailyLoss())
+	}
+}
+
+func TestPortfolioMarkLocalDay(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	portfolio := risk.NewPortfolio(risk.DefaultLookback)
+
+	// The UTC midnight falls in the middle of the New York trading day.
+	open := time.Date(2024, 1, 2, 9, 30, 0, 0, newYork)
+
+	portfolio.Mark(open, 100)
+	portfolio.Mark(open.Add(8*time.Hour+30*time.Minute), 95)
+	portfolio.Mark(open.Add(10*time.Hour), 94)
+
+	if portfolio.DayStart != 100 || portfolio.DailyLoss() != 0.06 {
+		t.Fatalf("day start %v daily loss %v", portfolio.DayStart, portfolio.DailyLoss())
+	}
+
+	// A futures session starting at 18:00 begins the next trading day in the evening.
+	portfolio = risk.NewPortfolio(risk.DefaultLookback)
+	portfolio.Session = asset.Session(newYork, 18*time.Hour)
+
+	portfolio.Mark(open, 100)
+	portfolio.Mark(open.Add(7*time.Hour), 95)
+
+	if portfolio.DayStart != 100 {
+		t.Fatalf("day start %v", portfolio.DayStart)
+	}
+
+	portfolio.Mark(open.Add(9*time.Hour), 94)
+
+	if portfolio.DayStart != 95 {
+		t.Fatalf("day start %v", portfolio.DayStart)
+	}
+}
+
+func TestPortfolioCommonReturns(t *testing.T) {
+	portfolio := risk.NewPortfolio(risk.DefaultLookback)
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for i, closing := range []float64{1, 2, 4, 8} {
+		portfolio.Observe("a", day.AddDate(0, 0, i), closing)
+	}
+
+	// The asset b is not observed on the second day.
+	for i, closing := range []float64{3, 0, 12, 24} {
+		if i != 1 {
+			portfolio.Observe("b", day.AddDate(0, 0, i), closing)
+		}
+	}
+
+	x, y := portfolio.CommonReturns("a", "b")
+
+	expected := []float64{math.Log(4), math.Log(2)}
+
+	if !reflect.DeepEqual(x, expected) || !reflect.DeepEqual(y, expected) {
+		t.Fatalf("x %v y %v expected %v", x, y, expected)
+	}
+}
+
+func TestPortfolioReturns(t *testing.T) {
+	portfolio := risk.NewPortfolio(2)
+
+	if portfolio.Returns("a") != nil {
+		t.Fatal("expected no returns")
+	}
+
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for i, closing := range []float64{1, 2, 4, 8} {
+		portfolio.Observe("a", day.AddDate(0, 0, i), closing)
+	}
+
+	actual := helper.ChanToSlice(helper.RoundDigits(helper.SliceToChan(portfolio.Returns("a")), 4))
+	expected := []float64{math.Round(math.Ln2*10000) / 10000, math.Round(math.Ln2*10000) / 10000}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestPortfolioGrossExposure(t *testing.T) {
+	portfolio := risk.NewPortfolio(risk.DefaultLookback)
+	portfolio.Exposures["a"] = 100
+	portfolio.Exposures["b"] = -50
+
+	if portfolio.GrossExposure() != 150 {
+		t.Fatalf("gross exposure %v", portfolio.GrossExposure())
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package risk
+
+import (
+	"time"
+
+	"github.com/cinar/indicator/v2/strategy"
+)
+
+// Proposal represents a trade that increases the exposure, submitted to the risk rules for approval.
+type Proposal struct {
+	// Asset is the name of the asset.
+	Asset string
+
+	// Date is the date of the trade.
+	Date time.Time
+
+	// Action is either Buy or Sell.
+	Action strategy.Action
+
+	// Price is the expected execution price.
+	Price float64
+
+	// Quantity is the requested number of shares.
+	Quantity float64
+}
+
+// Rule defines a shared interface for the risk rules.
+type Rule interface {
+	// Name returns the name of the rule.
+	Name() string
+
+	// Check returns the quantity of the given proposal that the rule allows
+	// for the given portfolio, along with the reason if it is reduced.
+	Check(portfolio *Portfolio, proposal *Proposal) (float64, string)
+}
+
+// Breaker is a rule that can halt the trading and require the positions to be closed.
+type Breaker interface {
+	Rule
+
+	// Tripped returns true along with the reason if the positions should be closed.
+	Tripped(portfolio *Portfolio) (bool, string)
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package risk_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/risk"
+	"github.com/cinar/indicator/v2/strategy"
+)
+
+// testPortfolio returns a portfolio with an equity of 1000 and a position of 200 in asset a.
+func testPortfolio() *risk.Portfolio {
+	portfolio := risk.NewPortfolio(risk.DefaultLookback)
+	portfolio.Mark(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), 1000)
+	portfolio.Exposures["a"] = 200
+
+	return portfolio
+}
+
+// testDate returns the date of the given day of the test period.
+func testDate(day int) time.Time {
+	return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, day)
+}
+
+// testProposal returns a proposal to buy 100 shares of the given asset at 10.
+func testProposal(name string) *risk.Proposal {
+	return &risk.Proposal{
+		Asset:    name,
+		Action:   strategy.Buy,
+		Price:    10,
+		Quantity: 100,
+	}
+}
+
+func TestMaxPositionSize(t *testing.T) {
+	rule := risk.NewMaxPositionSize(0.25)
+
+	allowed, reason := rule.Check(testPortfolio(), testProposal("a"))
+	if allowed != 5 || reason == "" {
+		t.Fatalf("allowed %v reason %q", allowed, reason)
+	}
+
+	allowed, _ = rule.Check(testPortfolio(), testProposal("b"))
+	if allowed != 25 {
+		t.Fatalf("allowed %v", allowed)
+	}
+}
+
+func TestMaxExposure(t *testing.T) {
+	rule := risk.NewMaxExposure(0.5)
+
+	allowed, reason := rule.Check(testPortfolio(), testProposal("b"))
+	if allowed != 30 || reason == "" {
+		t.Fatalf("allowed %v reason %q", allowed, reason)
+	}
+}
+
+func TestDailyLossLimit(t *testing.T) {
+	rule := risk.NewDailyLossLimit(0.05)
+	portfolio := testPortfolio()
+
+	allowed, _ := rule.Check(portfolio, testProposal("b"))
+	if allowed != 100 {
+		t.Fatalf("allowed %v", allowed)
+	}
+
+	portfolio.Mark(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), 940)
+
+	tripped, reason := rule.Tripped(portfolio)
+	if !tripped || reason == "" {
+		t.Fatalf("tripped %v reason %q", tripped, reason)
+	}
+
+	allowed, _ = rule.Check(portfolio, testProposal("b"))
+	if allowed != 0 {
+		t.Fatalf("allowed %v", allowed)
+	}
+
+	// The limit resets on the following day.
+	portfolio.Mark(time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC), 940)
+
+	tripped, _ = rule.Tripped(portfolio)
+	if tripped {
+		t.Fatal("not expected to be tripped")
+	}
+}
+
+func TestMaxDrawdown(t *testing.T) {
+	rule := risk.NewMaxDrawdown(0.1)
+	portfolio := testPortfolio()
+
+	tripped, _ := rule.Tripped(portfolio)
+	if tripped {
+		t.Fatal("not expected to be tripped")
+	}
+
+	portfolio.Mark(time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC), 900)
+
+	tripped, reason := rule.Tripped(portfolio)
+	if !tripped || reason == "" {
+		t.Fatalf("tripped %v reason %q", tripped, reason)
+	}
+
+	allowed, _ := rule.Check(portfolio, testProposal("b"))
+	if allowed != 0 {
+		t.Fatalf("allowed %v", allowed)
+	}
+}
+
+func TestVolatilitySizing(t *testing.T) {
+	rule := risk.NewVolatilitySizing(0.15)
+	portfolio := testPortfolio()
+
+	allowed, _ := rule.Check(portfolio, testProposal("b"))
+	if allowed != 100 {
+		t.Fatalf("allowed %v", allowed)
+	}
+
+	for i, closing := range []float64{10, 11, 10, 11, 10} {
+		portfolio.Observe("b", testDate(i), closing)
+	}
+
+	allowed, reason := rule.Check(portfolio, testProposal("b"))
+	if allowed <= 0 || allowed >= 100 || reason == "" {
+		t.Fatalf("allowed %v reason %q", allowed, reason)
+	}
+
+	// Doubling the volatility target doubles the allowed quantity.
+	rule.Target *= 2
+
+	doubled, _ := rule.Check(portfolio, testProposal("b"))
+	if math.Abs(doubled-2*allowed) > 1e-9 {
+		t.Fatalf("doubled %v allowed %v", doubled, allowed)
+	}
+}
+
+func TestCorrelationCap(t *testing.T) {
+	rule := risk.NewCorrelationCap(0.8)
+	portfolio := testPortfolio()
+
+	for i, closing := range []float64{10, 11, 12, 11, 13} {
+		portfolio.Observe("a", testDate(i), closing)
+		portfolio.Observe("b", testDate(i), closing*2)
+		portfolio.Observe("c", testDate(i), 30-closing)
+	}
+
+	allowed, reason := rule.Check(portfolio, testProposal("b"))
+	if allowed != 0 || reason == "" {
+		t.Fatalf("allowed %v reason %q", allowed, reason)
+	}
+
+	allowed, _ = rule.Check(portfolio, testProposal("c"))
+	if allowed != 100 {
+		t.Fatalf("allowed %v", allowed)
+	}
+}
+
+func TestCorrelationCapAlignsDates(t *testing.T) {
+	rule := risk.NewCorrelationCap(0.8)
+	portfolio := testPortfolio()
+
+	// The asset a stops trading after the sixth day, while the asset b keeps moving against it.
+	for i, closing := range []float64{10, 11, 12, 11, 13, 12} {
+		portfolio.Observe("a", testDate(i), closing)
+	}
+
+	for i, closing := range []float64{24, 22, 26, 24, 20, 30, 18} {
+		portfolio.Observe("b", testDate(i+2), closing)
+	}
+
+	allowed, reason := rule.Check(portfolio, testProposal("b"))
+	if allowed != 0 || reason == "" {
+		t.Fatalf("allowed %v reason %q", allowed, reason)
+	}
+}
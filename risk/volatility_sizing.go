@@ -0,0 +1,63 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package risk
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// DefaultVolatilitySizingTarget is the default annualized volatility target for a position.
+	DefaultVolatilitySizingTarget = 0.15
+
+	// DefaultVolatilitySizingPeriods is the default number of periods in a year.
+	DefaultVolatilitySizingPeriods = 252
+)
+
+// VolatilitySizing scales the position size inversely to the realized
+// volatility of the asset, so that the position contributes the target
+// annualized volatility as a fraction of the equity.
+//
+//	Max Value = Equity * Target / (StdDev(Log Returns) * Sqrt(Periods))
+type VolatilitySizing struct {
+	// Target is the annualized volatility target for a position.
+	Target float64
+
+	// Periods is the number of periods in a year.
+	Periods float64
+}
+
+// NewVolatilitySizing function initializes a new volatility sizing rule with the given target.
+func NewVolatilitySizing(target float64) *VolatilitySizing {
+	return &VolatilitySizing{
+		Target:  target,
+		Periods: DefaultVolatilitySizingPeriods,
+	}
+}
+
+// Name returns the name of the rule.
+func (r *VolatilitySizing) Name() string {
+	return fmt.Sprintf("Volatility Sizing (%.2f)", r.Target)
+}
+
+// Check returns the quantity of the given proposal that the rule allows for the given portfolio.
+func (r *VolatilitySizing) Check(portfolio *Portfolio, proposal *Proposal) (float64, string) {
+	returns := portfolio.Returns(proposal.Asset)
+	if len(returns) < 2 {
+		return proposal.Quantity, ""
+	}
+
+	volatility := stdDev(returns) * math.Sqrt(r.Periods)
+	if volatility == 0 {
+		return proposal.Quantity, ""
+	}
+
+	limit := portfolio.Equity * r.Target / volatility
+	current := math.Abs(portfolio.Exposures[proposal.Asset])
+	allowed := math.Max(limit-current, 0) / proposal.Price
+
+	return allowed, fmt.Sprintf("realized volatility %.4f limits position value to %.2f", volatility, limit)
+}
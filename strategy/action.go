@@ -49,6 +49,32 @@ func ActionsToAnnotations(ac <-chan Action) <-chan string {
 	})
 }
 
+// ActionsToMarkers takes a channel of action recommendations and returns a new channel
+// containing the corresponding buy and sell markers for the reports.
+func ActionsToMarkers(ac <-chan Action) <-chan helper.ReportMarker {
+	return helper.Map(NormalizeActions(ac), func(a Action) helper.ReportMarker {
+		switch a {
+		case Buy:
+			return helper.ReportMarkerBuy
+
+		case Sell:
+			return helper.ReportMarkerSell
+
+		default:
+			return helper.ReportMarkerNone
+		}
+	})
+}
+
+// ActionsToTrades takes a channel of action recommendations and returns a new channel
+// indicating whether a trade is open at each step, from a Buy action until the following
+// Sell action, such as to shade the trades in the reports.
+func ActionsToTrades(ac <-chan Action) <-chan bool {
+	return helper.Map(DenormalizeActions(ac), func(a Action) bool {
+		return a == Buy
+	})
+}
+
 // NormalizeActions transforms the given channel of actions to ensure a consistent and
 // predictable sequence. It eliminates consecutive occurrences of the same action
 // (Buy/Sell), ensuring the order follows a pattern of Hold, Buy, Hold, Sell.
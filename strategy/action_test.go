@@ -37,6 +37,34 @@ func TestActionsToAnnotations(t *testing.T) {
 	}
 }
 
+func TestActionsToMarkers(t *testing.T) {
+	actions := helper.SliceToChan([]strategy.Action{strategy.Hold, strategy.Buy, strategy.Buy, strategy.Sell})
+	expected := helper.SliceToChan([]helper.ReportMarker{
+		helper.ReportMarkerNone, helper.ReportMarkerBuy, helper.ReportMarkerNone, helper.ReportMarkerSell,
+	})
+
+	actual := strategy.ActionsToMarkers(actions)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestActionsToTrades(t *testing.T) {
+	actions := helper.SliceToChan([]strategy.Action{
+		strategy.Hold, strategy.Sell, strategy.Buy, strategy.Hold, strategy.Sell, strategy.Hold,
+	})
+	expected := helper.SliceToChan([]bool{false, false, true, true, false, false})
+
+	actual := strategy.ActionsToTrades(actions)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestNormalizeActions(t *testing.T) {
 	actions := helper.SliceToChan([]strategy.Action{
 		strategy.Hold, strategy.Sell, strategy.Sell, strategy.Buy, strategy.Hold,
@@ -0,0 +1,107 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package arbitrage
+
+import (
+	"math"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultEngleGrangerCriticalValue is the default critical value of the Engle-Granger test statistic
+	// for two assets at the 5% significance level.
+	DefaultEngleGrangerCriticalValue = -3.34
+)
+
+// EngleGrangerResult is the result of the Engle-Granger cointegration test.
+type EngleGrangerResult struct {
+	// HedgeRatio is the slope of the cointegrating regression.
+	HedgeRatio float64
+
+	// Intercept is the intercept of the cointegrating regression.
+	Intercept float64
+
+	// Statistic is the Dickey-Fuller test statistic of the residuals. The more negative it is, the
+	// stronger the evidence of cointegration.
+	Statistic float64
+
+	// Residuals are the residuals of the cointegrating regression, the spread.
+	Residuals []float64
+}
+
+// IsCointegrated checks if the test statistic is below the given critical value, rejecting the null
+// hypothesis of no cointegration.
+func (e *EngleGrangerResult) IsCointegrated(criticalValue float64) bool {
+	return e.Statistic < criticalValue
+}
+
+// EngleGranger performs the two step Engle-Granger cointegration test on the given values of two assets.
+// First, it regresses the first values on the second values with the ordinary least squares to find the
+// hedge ratio. Then, it tests the residuals for a unit root with the Dickey-Fuller test. The values are
+// expected to have the same length of at least three.
+//
+//	A = Hedge Ratio * B + Intercept + Residual
+//	Residual - Previous Residual = Gamma * Previous Residual + Noise
+//	Statistic = Gamma / Standard Error(Gamma)
+func EngleGranger[T helper.Number](as, bs []T) *EngleGrangerResult {
+	n := float64(len(as))
+
+	meanA, meanB := 0.0, 0.0
+	for i := range as {
+		meanA += float64(as[i])
+		meanB += float64(bs[i])
+	}
+
+	meanA /= n
+	meanB /= n
+
+	covariance, variance := 0.0, 0.0
+	for i := range as {
+		covariance += (float64(as[i]) - meanA) * (float64(bs[i]) - meanB)
+		variance += (float64(bs[i]) - meanB) * (float64(bs[i]) - meanB)
+	}
+
+	result := &EngleGrangerResult{
+		Residuals: make([]float64, len(as)),
+	}
+
+	if variance != 0 {
+		result.HedgeRatio = covariance / variance
+	}
+
+	result.Intercept = meanA - result.HedgeRatio*meanB
+
+	for i := range as {
+		result.Residuals[i] = float64(as[i]) - result.HedgeRatio*float64(bs[i]) - result.Intercept
+	}
+
+	// Dickey-Fuller regression of the residual changes on the previous residuals.
+	sumLagged, sumProduct := 0.0, 0.0
+	for i := 1; i < len(result.Residuals); i++ {
+		lagged := result.Residuals[i-1]
+		sumLagged += lagged * lagged
+		sumProduct += lagged * (result.Residuals[i] - lagged)
+	}
+
+	if sumLagged == 0 || len(as) < 3 {
+		return result
+	}
+
+	gamma := sumProduct / sumLagged
+
+	sumSquares := 0.0
+	for i := 1; i < len(result.Residuals); i++ {
+		noise := result.Residuals[i] - result.Residuals[i-1] - gamma*result.Residuals[i-1]
+		sumSquares += noise * noise
+	}
+
+	standardError := math.Sqrt(sumSquares / float64(len(as)-2) / sumLagged)
+	if standardError != 0 {
+		result.Statistic = gamma / standardError
+	}
+
+	return result
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package arbitrage_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy/arbitrage"
+)
+
+func TestEngleGranger(t *testing.T) {
+	firsts, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	seconds, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/pair.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	as := helper.ChanToSlice(asset.SnapshotsAsClosings(firsts))
+	bs := helper.ChanToSlice(asset.SnapshotsAsClosings(seconds))
+
+	result := arbitrage.EngleGranger(as, bs)
+
+	if math.Abs(result.HedgeRatio-1.9517) > 0.0001 {
+		t.Fatalf("actual hedge ratio %v expected 1.9517", result.HedgeRatio)
+	}
+
+	if math.Abs(result.Intercept+31.1262) > 0.0001 {
+		t.Fatalf("actual intercept %v expected -31.1262", result.Intercept)
+	}
+
+	if !result.IsCointegrated(arbitrage.DefaultEngleGrangerCriticalValue) {
+		t.Fatalf("actual statistic %v expected cointegrated", result.Statistic)
+	}
+}
+
+func TestEngleGrangerNotCointegrated(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	as := helper.ChanToSlice(asset.SnapshotsAsClosings(snapshots))
+	bs := make([]float64, len(as))
+
+	for i := range bs {
+		bs[i] = float64(i)
+	}
+
+	result := arbitrage.EngleGranger(as, bs)
+
+	if result.IsCointegrated(arbitrage.DefaultEngleGrangerCriticalValue) {
+		t.Fatalf("actual statistic %v expected not cointegrated", result.Statistic)
+	}
+}
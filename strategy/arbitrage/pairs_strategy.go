@@ -0,0 +1,233 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package arbitrage
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+)
+
+const (
+	// DefaultPairsStrategyPeriod is the default number of closings that the cointegration and the
+	// spread are computed over.
+	DefaultPairsStrategyPeriod = 60
+
+	// DefaultPairsStrategyEntryZScore is the default spread z-score to enter a position at.
+	DefaultPairsStrategyEntryZScore = 2
+
+	// DefaultPairsStrategyExitZScore is the default spread z-score to exit a position at.
+	DefaultPairsStrategyExitZScore = 0.5
+)
+
+// pairsSignal is the state of the pair at a closing.
+type pairsSignal struct {
+	// zScore is the z-score of the spread.
+	zScore float64
+
+	// hedgeRatio is the hedge ratio of the pair, as the units of the second asset per unit of the
+	// first asset.
+	hedgeRatio float64
+
+	// first is the closing of the first asset.
+	first float64
+
+	// second is the closing of the second asset.
+	second float64
+
+	// cointegrated indicates whether the pair is cointegrated.
+	cointegrated bool
+}
+
+// PairsStrategy represents the configuration parameters for calculating the pairs trading strategy. It
+// trades the spread between two assets, given as the first and the second legs, while they are
+// cointegrated based on the Engle-Granger test over the period. The spread is the residual of the
+// regression of the first closings on the second closings, and its z-score is the number of standard
+// deviations that it is away from zero.
+// - It goes long the spread, long the first asset and short the hedge ratio of the second asset, when the
+// z-score falls below the negative of the entry z-score.
+// - It goes short the spread when the z-score rises above the entry z-score.
+// - It exits when the absolute z-score falls below the exit z-score, or when the pair is no longer
+// cointegrated.
+// The legs are weighted by their notional values, the first closing and the hedge ratio times the second
+// closing, and scaled to keep the gross exposure at one.
+type PairsStrategy struct {
+	// Period is the number of closings that the cointegration and the spread are computed over.
+	Period int
+
+	// EntryZScore is the spread z-score to enter a position at.
+	EntryZScore float64
+
+	// ExitZScore is the spread z-score to exit a position at.
+	ExitZScore float64
+
+	// CriticalValue is the critical value of the Engle-Granger test statistic.
+	CriticalValue float64
+}
+
+// NewPairsStrategy function initializes a new pairs strategy instance with the default parameters.
+func NewPairsStrategy() *PairsStrategy {
+	return NewPairsStrategyWith(
+		DefaultPairsStrategyPeriod,
+		DefaultPairsStrategyEntryZScore,
+		DefaultPairsStrategyExitZScore,
+	)
+}
+
+// NewPairsStrategyWith function initializes a new pairs strategy instance with the given parameters.
+func NewPairsStrategyWith(period int, entryZScore, exitZScore float64) *PairsStrategy {
+	return &PairsStrategy{
+		Period:        period,
+		EntryZScore:   entryZScore,
+		ExitZScore:    exitZScore,
+		CriticalValue: DefaultEngleGrangerCriticalValue,
+	}
+}
+
+// Name returns the name of the strategy.
+func (p *PairsStrategy) Name() string {
+	return fmt.Sprintf("Pairs Strategy (%d,%.2f,%.2f)", p.Period, p.EntryZScore, p.ExitZScore)
+}
+
+// Compute processes the provided date aligned snapshots of the two assets, and generates a stream of
+// positions for each asset. Any additional asset gets no position.
+func (p *PairsStrategy) Compute(snapshots []<-chan *asset.Snapshot) []<-chan float64 {
+	result := make([]<-chan float64, len(snapshots))
+
+	for i := 2; i < len(snapshots); i++ {
+		result[i] = helper.Map(snapshots[i], func(*asset.Snapshot) float64 { return 0 })
+	}
+
+	signals := p.signals(
+		asset.SnapshotsAsClosings(snapshots[0]),
+		asset.SnapshotsAsClosings(snapshots[1]),
+	)
+
+	state := 0.0
+
+	positions := helper.Duplicate(helper.Map(signals, func(signal pairsSignal) [2]float64 {
+		if !signal.cointegrated {
+			state = 0
+		} else if signal.zScore < -p.EntryZScore {
+			state = 1
+		} else if signal.zScore > p.EntryZScore {
+			state = -1
+		} else if math.Abs(signal.zScore) < p.ExitZScore {
+			state = 0
+		}
+
+		// The hedge ratio is in units, therefore the legs are weighted by their notional values.
+		gross := signal.first + math.Abs(signal.hedgeRatio)*signal.second
+		if state == 0 || gross == 0 {
+			return [2]float64{0, 0}
+		}
+
+		return [2]float64{
+			state * signal.first / gross,
+			-state * signal.hedgeRatio * signal.second / gross,
+		}
+	}), 2)
+
+	for i := range 2 {
+		// The pair is flat until a full period of closings.
+		result[i] = helper.Shift(
+			helper.Map(positions[i], func(legs [2]float64) float64 { return legs[i] }),
+			p.IdlePeriod(),
+			0,
+		)
+	}
+
+	return result
+}
+
+// Report processes the provided date aligned snapshots of the two assets, and generates a report annotated
+// with the positions.
+func (p *PairsStrategy) Report(c []<-chan *asset.Snapshot) *helper.Report {
+	//
+	// first[0]  -> dates
+	// first[1]  -> first closings
+	// second[0] -> second closings
+	// first[2]  -> z-scores
+	// second[1] |
+	// first[3]  -> positions -> annotations
+	// second[2] |            -> outcomes
+	//
+	first := helper.Duplicate(c[0], 4)
+	second := helper.Duplicate(c[1], 3)
+
+	for i := 2; i < len(c); i++ {
+		go helper.Drain(c[i])
+	}
+
+	dates := asset.SnapshotsAsDates(first[0])
+	firstClosings := asset.SnapshotsAsClosings(first[1])
+	secondClosings := asset.SnapshotsAsClosings(second[0])
+
+	zScores := helper.Shift(
+		helper.Map(
+			p.signals(asset.SnapshotsAsClosings(first[2]), asset.SnapshotsAsClosings(second[1])),
+			func(signal pairsSignal) float64 { return signal.zScore },
+		),
+		p.IdlePeriod(),
+		0,
+	)
+
+	positions, outcomes := strategy.ComputeMultiAssetWithOutcome(p, []<-chan *asset.Snapshot{first[3], second[2]})
+	firstAnnotations := strategy.ActionsToAnnotations(strategy.PositionsToActions(positions[0]))
+	secondAnnotations := strategy.ActionsToAnnotations(strategy.PositionsToActions(positions[1]))
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(p.Name(), dates)
+	report.AddChart()
+	report.AddChart()
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("First", firstClosings))
+	report.AddColumn(helper.NewAnnotationReportColumn(firstAnnotations))
+
+	report.AddColumn(helper.NewNumericReportColumn("Second", secondClosings), 1)
+	report.AddColumn(helper.NewAnnotationReportColumn(secondAnnotations), 1)
+
+	report.AddColumn(helper.NewNumericReportColumn("Z-Score", zScores), 2)
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 3)
+
+	return report
+}
+
+// IdlePeriod is the initial period that pairs strategy won't yield any results.
+func (p *PairsStrategy) IdlePeriod() int {
+	return p.Period - 1
+}
+
+// signals computes the pair signals over the period.
+func (p *PairsStrategy) signals(firsts, seconds <-chan float64) <-chan pairsSignal {
+	return helper.OperateWindow(firsts, seconds, p.Period, func(as, bs []float64) pairsSignal {
+		result := EngleGranger(as, bs)
+
+		signal := pairsSignal{
+			hedgeRatio:   result.HedgeRatio,
+			first:        as[len(as)-1],
+			second:       bs[len(bs)-1],
+			cointegrated: result.IsCointegrated(p.CriticalValue),
+		}
+
+		sumSquares := 0.0
+		for _, residual := range result.Residuals {
+			sumSquares += residual * residual
+		}
+
+		// The residuals of the regression have a zero mean.
+		std := math.Sqrt(sumSquares / float64(len(result.Residuals)))
+		if std != 0 {
+			signal.zScore = result.Residuals[len(result.Residuals)-1] / std
+		}
+
+		return signal
+	})
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package arbitrage_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/strategy/arbitrage"
+)
+
+func TestPairsStrategy(t *testing.T) {
+	type Data struct {
+		First  float64
+		Second float64
+	}
+
+	firsts, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	seconds, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/pair.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := helper.ReadFromCsvFile[Data]("testdata/pairs_strategy.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resultsSplice := helper.Duplicate(results, 2)
+	expectedFirsts := helper.Map(resultsSplice[0], func(d *Data) float64 { return d.First })
+	expectedSeconds := helper.Map(resultsSplice[1], func(d *Data) float64 { return d.Second })
+
+	pairs := arbitrage.NewPairsStrategyWith(30, 1.5, 0.5)
+	positions := pairs.Compute(asset.Align(firsts, seconds))
+
+	actualFirsts := helper.RoundDigits(positions[0], 4)
+	actualSeconds := helper.RoundDigits(positions[1], 4)
+
+	err = helper.CheckEquals(actualFirsts, expectedFirsts, actualSeconds, expectedSeconds)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPairsStrategyOutcome(t *testing.T) {
+	firsts, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	seconds, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/pair.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pairs := arbitrage.NewPairsStrategyWith(30, 1.5, 0.5)
+	positions, outcomes := strategy.ComputeMultiAssetWithOutcome(pairs, asset.Align(firsts, seconds))
+
+	for _, c := range positions {
+		go helper.Drain(c)
+	}
+
+	if len(helper.ChanToSlice(outcomes)) != 251 {
+		t.Fatal("expected an outcome for each snapshot")
+	}
+}
+
+func TestPairsStrategyReport(t *testing.T) {
+	firsts, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	seconds, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/pair.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pairs := arbitrage.NewPairsStrategyWith(30, 1.5, 0.5)
+
+	report := pairs.Report(asset.Align(firsts, seconds))
+
+	fileName := "pairs_strategy.html"
+	defer helper.Remove(t, fileName)
+
+	err = report.WriteToFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPairsStrategySpreadOutcome(t *testing.T) {
+	// The first asset is four units of the second asset at a higher price level, plus a stationary spread.
+	hedgeRatio := 4.0
+	date := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	var firsts, seconds, spreads []float64
+	var firstSnapshots, secondSnapshots []*asset.Snapshot
+
+	for i := range 200 {
+		second := 20 + (5 * math.Sin(float64(i)/7)) + (0.05 * float64(i))
+		spread := 0.5 * math.Sin(float64(i)*1.3)
+		first := (hedgeRatio * second) + 10 + spread
+
+		firsts = append(firsts, first)
+		seconds = append(seconds, second)
+		spreads = append(spreads, spread)
+
+		firstSnapshots = append(firstSnapshots, &asset.Snapshot{Date: date.AddDate(0, 0, i), Close: first})
+		secondSnapshots = append(secondSnapshots, &asset.Snapshot{Date: date.AddDate(0, 0, i), Close: second})
+	}
+
+	// The z-score of a sinusoidal spread peaks at the square root of two.
+	pairs := arbitrage.NewPairsStrategyWith(30, 1.2, 0.5)
+	positions, outcomes := strategy.ComputeMultiAssetWithOutcome(pairs, []<-chan *asset.Snapshot{
+		helper.SliceToChan(firstSnapshots),
+		helper.SliceToChan(secondSnapshots),
+	})
+
+	// The legs are paired separately, therefore they are read concurrently.
+	firstPositions := make(chan []float64)
+	go func() {
+		firstPositions <- helper.ChanToSlice(positions[0])
+	}()
+
+	secondPositions := make(chan []float64)
+	go func() {
+		secondPositions <- helper.ChanToSlice(positions[1])
+	}()
+
+	balances := helper.ChanToSlice(outcomes)
+	firstWeights := <-firstPositions
+	secondWeights := <-secondPositions
+
+	trades := 0
+
+	for i := 0; i < len(balances)-1; i++ {
+		if firstWeights[i] == 0 {
+			continue
+		}
+
+		trades++
+
+		if math.Abs(math.Abs(firstWeights[i])+math.Abs(secondWeights[i])-1) > 1e-9 {
+			t.Fatalf("index %d gross exposure %v %v", i, firstWeights[i], secondWeights[i])
+		}
+
+		// The weights are notional, therefore the hedge ratio in units is scaled by the prices.
+		implied := -secondWeights[i] * firsts[i] / (firstWeights[i] * seconds[i])
+		if math.Abs(implied-hedgeRatio) > 0.05 {
+			t.Fatalf("index %d implied hedge ratio %v expected %v", i, implied, hedgeRatio)
+		}
+
+		// Being market neutral, the position only earns the change of the spread.
+		state := math.Copysign(1, firstWeights[i])
+		expected := state * (spreads[i+1] - spreads[i]) / (firsts[i] + (hedgeRatio * seconds[i]))
+		actual := (1+balances[i+1])/(1+balances[i]) - 1
+
+		if math.Abs(actual-expected) > 2e-4 {
+			t.Fatalf("index %d actual %v expected %v", i, actual, expected)
+		}
+	}
+
+	if trades == 0 {
+		t.Fatal("expected trades")
+	}
+}
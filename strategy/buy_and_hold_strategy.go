@@ -50,6 +50,18 @@ func (*BuyAndHoldStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan Acti
 	return actions
 }
 
+// ComputeSlice processes the provided asset snapshots and generates a
+// slice of actionable recommendations.
+func (*BuyAndHoldStrategy) ComputeSlice(snapshots []*asset.Snapshot) []Action {
+	actions := make([]Action, len(snapshots))
+
+	if len(actions) > 0 {
+		actions[0] = Buy
+	}
+
+	return actions
+}
+
 // Report processes the provided asset snapshots and generates a
 // report annotated with the recommended actions.
 func (b *BuyAndHoldStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
@@ -5,7 +5,10 @@
 package compound_test
 
 import (
+	"context"
+	"runtime"
 	"testing"
+	"time"
 
 	"github.com/cinar/indicator/v2/asset"
 	"github.com/cinar/indicator/v2/helper"
@@ -53,3 +56,68 @@ func TestMacdRsiStrategyReport(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestMacdRsiStrategyCancel(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshotsSlice := helper.ChanToSlice(snapshots)
+
+	before := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// The snapshots are fed endlessly until the context is cancelled.
+	source := make(chan *asset.Snapshot)
+	go func() {
+		defer close(source)
+
+		for i := 0; ; i++ {
+			select {
+			case source <- snapshotsSlice[i%len(snapshotsSlice)]:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	macdRsi := compound.NewMacdRsiStrategy()
+	actions := strategy.ComputeWithContext(ctx, macdRsi, source)
+
+	// The consumer stops reading early.
+	for range 5 {
+		<-actions
+	}
+
+	cancel()
+
+	err = helper.CheckGoroutines(before, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMacdRsiStrategyCancelFinite(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	before := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	macdRsi := compound.NewMacdRsiStrategy()
+	actions := strategy.ComputeWithContext(ctx, macdRsi, snapshots)
+
+	// The consumer stops reading early, while the snapshots source is not aware of the context.
+	<-actions
+	cancel()
+
+	err = helper.CheckGoroutines(before, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package decorator
+
+import (
+	"fmt"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/momentum"
+	"github.com/cinar/indicator/v2/strategy"
+)
+
+const (
+	// DefaultDivergenceStrategyWindow is the default number of periods that a divergence confirms the
+	// recommendations of the inner strategy.
+	DefaultDivergenceStrategyWindow = 10
+)
+
+// OscillatorStrategy is the interface for the strategies whose recommendations are based on an oscillator,
+// such as the RSI or the MACD strategies.
+type OscillatorStrategy interface {
+	strategy.Strategy
+
+	// Oscillator processes the provided asset snapshots and generates a stream of the oscillator values
+	// that the strategy is based on.
+	Oscillator(snapshots <-chan *asset.Snapshot) <-chan float64
+
+	// OscillatorIdlePeriod is the initial period that the oscillator won't yield any results.
+	OscillatorIdlePeriod() int
+}
+
+// DivergenceStrategy confirms the recommendations of an oscillator based strategy with the divergences
+// between the closings and the oscillator. A Buy action is only recommended within the given window
+// after a bullish divergence, and a Sell action is only recommended within the given window after a
+// bearish divergence.
+type DivergenceStrategy struct {
+	// InnerStrategy is the oscillator based inner strategy.
+	InnerStrategy OscillatorStrategy
+
+	// Divergence is the divergence detector.
+	Divergence *momentum.Divergence[float64]
+
+	// Window is the number of periods that a divergence confirms the recommendations.
+	Window int
+}
+
+// NewDivergenceStrategy function initializes a new divergence strategy instance with the default parameters.
+func NewDivergenceStrategy(innerStrategy OscillatorStrategy) *DivergenceStrategy {
+	return NewDivergenceStrategyWith(
+		innerStrategy,
+		momentum.DefaultDivergenceLookback,
+		DefaultDivergenceStrategyWindow,
+	)
+}
+
+// NewDivergenceStrategyWith function initializes a new divergence strategy instance with the given parameters.
+func NewDivergenceStrategyWith(innerStrategy OscillatorStrategy, lookback, window int) *DivergenceStrategy {
+	return &DivergenceStrategy{
+		InnerStrategy: innerStrategy,
+		Divergence: momentum.NewDivergenceWith[float64](
+			lookback,
+			momentum.DefaultDivergenceMaxRange,
+		),
+		Window: window,
+	}
+}
+
+// Name returns the name of the strategy.
+func (d *DivergenceStrategy) Name() string {
+	return fmt.Sprintf("Divergence Strategy (%s)", d.InnerStrategy.Name())
+}
+
+// Compute processes the provided asset snapshots and generates a stream of actionable recommendations.
+func (d *DivergenceStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan strategy.Action {
+	snapshotsSplice := helper.Duplicate(snapshots, 2)
+
+	innerActions := d.InnerStrategy.Compute(snapshotsSplice[0])
+	divergences := d.divergences(snapshotsSplice[1])
+
+	// Periods since the last divergence of each kind.
+	sinceBullish := d.Window
+	sinceBearish := d.Window
+
+	return helper.Operate(innerActions, divergences, func(action strategy.Action, divergence momentum.DivergenceType) strategy.Action {
+		sinceBullish++
+		sinceBearish++
+
+		if divergence.IsBullish() {
+			sinceBullish = 0
+		} else if divergence.IsBearish() {
+			sinceBearish = 0
+		}
+
+		if action == strategy.Buy && sinceBullish < d.Window {
+			return strategy.Buy
+		}
+
+		if action == strategy.Sell && sinceBearish < d.Window {
+			return strategy.Sell
+		}
+
+		return strategy.Hold
+	})
+}
+
+// Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
+func (d *DivergenceStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> closings
+	// snapshots[2] -> oscillators
+	// snapshots[3] -> divergences -> divergence annotations
+	// snapshots[4] -> actions     -> annotations
+	//              -> outcomes
+	//
+	snapshots := helper.Duplicate(c, 5)
+
+	dates := asset.SnapshotsAsDates(snapshots[0])
+	closings := asset.SnapshotsAsClosings(snapshots[1])
+	oscillators := helper.Shift(
+		d.InnerStrategy.Oscillator(snapshots[2]),
+		d.InnerStrategy.OscillatorIdlePeriod(),
+		0,
+	)
+
+	divergences := helper.Map(d.divergences(snapshots[3]), func(divergence momentum.DivergenceType) string {
+		return divergence.String()
+	})
+
+	actions, outcomes := strategy.ComputeWithOutcome(d, snapshots[4])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(d.Name(), dates)
+	report.AddChart()
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closings))
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations))
+
+	report.AddColumn(helper.NewNumericReportColumn("Oscillator", oscillators), 1)
+	report.AddColumn(helper.NewAnnotationReportColumn(divergences), 1)
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 2)
+
+	return report
+}
+
+// divergences detects the divergences between the closings and the oscillator of the inner strategy.
+func (d *DivergenceStrategy) divergences(c <-chan *asset.Snapshot) <-chan momentum.DivergenceType {
+	snapshots := helper.Duplicate(c, 2)
+
+	idlePeriod := d.InnerStrategy.OscillatorIdlePeriod()
+
+	closings := helper.Skip(asset.SnapshotsAsClosings(snapshots[0]), idlePeriod)
+	oscillators := d.InnerStrategy.Oscillator(snapshots[1])
+
+	// Oscillator starts only after the idle period.
+	return helper.Shift(d.Divergence.Compute(closings, oscillators), idlePeriod, momentum.NoDivergence)
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package decorator_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/strategy/decorator"
+	"github.com/cinar/indicator/v2/strategy/momentum"
+	"github.com/cinar/indicator/v2/strategy/trend"
+)
+
+func TestDivergenceStrategy(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := helper.ReadFromCsvFile[strategy.Result]("testdata/divergence_strategy.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := helper.Map(results, func(r *strategy.Result) strategy.Action { return r.Action })
+
+	innerStrategy := momentum.NewAwesomeOscillatorStrategy()
+	divergenceStrategy := decorator.NewDivergenceStrategy(innerStrategy)
+
+	actual := divergenceStrategy.Compute(snapshots)
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDivergenceStrategyReport(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	innerStrategy := trend.NewMacdStrategy()
+	divergenceStrategy := decorator.NewDivergenceStrategy(innerStrategy)
+
+	report := divergenceStrategy.Report(snapshots)
+
+	fileName := "divergence_strategy.html"
+	defer helper.Remove(t, fileName)
+
+	err = report.WriteToFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDivergenceStrategyName(t *testing.T) {
+	innerStrategy := momentum.NewRsiStrategy()
+	divergenceStrategy := decorator.NewDivergenceStrategy(innerStrategy)
+
+	expected := "Divergence Strategy (RSI Strategy 30-70)"
+	actual := divergenceStrategy.Name()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
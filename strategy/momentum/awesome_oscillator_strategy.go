@@ -38,22 +38,70 @@ func (a *AwesomeOscillatorStrategy) Compute(snapshots <-chan *asset.Snapshot) <-
 
 	ao := a.AwesomeOscillator.Compute(highs, lows)
 
-	actions := helper.Map(ao, func(value float64) strategy.Action {
-		if value < 0 {
-			return strategy.Sell
-		}
+	actions := helper.Map(ao, a.action)
 
-		if value > 0 {
-			return strategy.Buy
+	// Awesome Oscillator starts only after the idle period.
+	actions = helper.Shift(actions, a.AwesomeOscillator.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
+
+// ComputeSlice processes the provided asset snapshots and generates a
+// slice of actionable recommendations.
+func (a *AwesomeOscillatorStrategy) ComputeSlice(snapshots []*asset.Snapshot) []strategy.Action {
+	ao := a.AwesomeOscillator.Clone()
+	actions := make([]strategy.Action, len(snapshots))
+
+	for i, snapshot := range snapshots {
+		value, ok := ao.Update(snapshot.High, snapshot.Low)
+		if ok {
+			actions[i] = a.action(value)
 		}
+	}
 
+	return actions
+}
+
+// Update processes the given asset snapshot and returns the recommended action, keeping the state
+// in the strategy instance.
+func (a *AwesomeOscillatorStrategy) Update(snapshot *asset.Snapshot) strategy.Action {
+	value, ok := a.AwesomeOscillator.Update(snapshot.High, snapshot.Low)
+	if !ok {
 		return strategy.Hold
-	})
+	}
 
-	// Awesome Oscillator starts only after the idle period.
-	actions = helper.Shift(actions, a.AwesomeOscillator.IdlePeriod(), strategy.Hold)
+	return a.action(value)
+}
 
-	return actions
+// Reset resets the state of the strategy.
+func (a *AwesomeOscillatorStrategy) Reset() {
+	a.AwesomeOscillator.Reset()
+}
+
+// MarshalState returns the state of the strategy as JSON.
+func (a *AwesomeOscillatorStrategy) MarshalState() ([]byte, error) {
+	return a.AwesomeOscillator.MarshalState()
+}
+
+// UnmarshalState restores the state of the strategy from the given JSON.
+func (a *AwesomeOscillatorStrategy) UnmarshalState(data []byte) error {
+	return a.AwesomeOscillator.UnmarshalState(data)
+}
+
+// Oscillator processes the provided asset snapshots and generates a stream of the Awesome Oscillator values that the
+// strategy is based on.
+func (a *AwesomeOscillatorStrategy) Oscillator(snapshots <-chan *asset.Snapshot) <-chan float64 {
+	snapshotsSplice := helper.Duplicate(snapshots, 2)
+
+	highs := asset.SnapshotsAsHighs(snapshotsSplice[0])
+	lows := asset.SnapshotsAsLows(snapshotsSplice[1])
+
+	return a.AwesomeOscillator.Compute(highs, lows)
+}
+
+// OscillatorIdlePeriod is the initial period that the Awesome Oscillator oscillator won't yield any results.
+func (a *AwesomeOscillatorStrategy) OscillatorIdlePeriod() int {
+	return a.AwesomeOscillator.IdlePeriod()
 }
 
 // Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
@@ -90,3 +138,16 @@ func (a *AwesomeOscillatorStrategy) Report(c <-chan *asset.Snapshot) *helper.Rep
 
 	return report
 }
+
+// action returns the recommended action for the given Awesome Oscillator value.
+func (a *AwesomeOscillatorStrategy) action(value float64) strategy.Action {
+	if value < 0 {
+		return strategy.Sell
+	}
+
+	if value > 0 {
+		return strategy.Buy
+	}
+
+	return strategy.Hold
+}
@@ -53,3 +53,21 @@ func TestAwesomeOscillatorStrategyReport(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestAwesomeOscillatorStrategyOscillator(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshotsSlice := helper.ChanToSlice(snapshots)
+
+	s := momentum.NewAwesomeOscillatorStrategy()
+
+	expected := len(snapshotsSlice) - s.OscillatorIdlePeriod()
+	actual := len(helper.ChanToSlice(s.Oscillator(helper.SliceToChan(snapshotsSlice))))
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
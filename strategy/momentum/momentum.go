@@ -27,5 +27,6 @@ func AllStrategies() []strategy.Strategy {
 		NewRsiStrategy(),
 		NewStochasticRsiStrategy(),
 		NewTripleRsiStrategy(),
+		NewWilliamsRStrategy(),
 	}
 }
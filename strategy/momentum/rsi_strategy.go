@@ -61,22 +61,65 @@ func (r *RsiStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan strategy.
 
 	rsi := r.Rsi.Compute(closings)
 
-	actions := helper.Map(rsi, func(value float64) strategy.Action {
-		if value <= r.BuyAt {
-			return strategy.Buy
-		}
+	actions := helper.Map(rsi, r.action)
+
+	// RSI starts only after the idle period.
+	actions = helper.Shift(actions, r.Rsi.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
+
+// ComputeSlice processes the provided asset snapshots and generates a
+// slice of actionable recommendations.
+func (r *RsiStrategy) ComputeSlice(snapshots []*asset.Snapshot) []strategy.Action {
+	rsi := r.Rsi.Clone()
+	actions := make([]strategy.Action, len(snapshots))
 
-		if value >= r.SellAt {
-			return strategy.Sell
+	for i, snapshot := range snapshots {
+		value, ok := rsi.Update(snapshot.Close)
+		if ok {
+			actions[i] = r.action(value)
 		}
+	}
+
+	return actions
+}
 
+// Update processes the given asset snapshot and returns the recommended action, keeping the state
+// in the strategy instance.
+func (r *RsiStrategy) Update(snapshot *asset.Snapshot) strategy.Action {
+	value, ok := r.Rsi.Update(snapshot.Close)
+	if !ok {
 		return strategy.Hold
-	})
+	}
 
-	// RSI starts only after the idle period.
-	actions = helper.Shift(actions, r.Rsi.IdlePeriod(), strategy.Hold)
+	return r.action(value)
+}
 
-	return actions
+// Reset resets the state of the strategy.
+func (r *RsiStrategy) Reset() {
+	r.Rsi.Reset()
+}
+
+// MarshalState returns the state of the strategy as JSON.
+func (r *RsiStrategy) MarshalState() ([]byte, error) {
+	return r.Rsi.MarshalState()
+}
+
+// UnmarshalState restores the state of the strategy from the given JSON.
+func (r *RsiStrategy) UnmarshalState(data []byte) error {
+	return r.Rsi.UnmarshalState(data)
+}
+
+// Oscillator processes the provided asset snapshots and generates a stream of the RSI values that the
+// strategy is based on.
+func (r *RsiStrategy) Oscillator(snapshots <-chan *asset.Snapshot) <-chan float64 {
+	return r.Rsi.Compute(asset.SnapshotsAsClosings(snapshots))
+}
+
+// OscillatorIdlePeriod is the initial period that the RSI oscillator won't yield any results.
+func (r *RsiStrategy) OscillatorIdlePeriod() int {
+	return r.Rsi.IdlePeriod()
 }
 
 // Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
@@ -109,3 +152,16 @@ func (r *RsiStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
 
 	return report
 }
+
+// action returns the recommended action for the given RSI value.
+func (r *RsiStrategy) action(value float64) strategy.Action {
+	if value <= r.BuyAt {
+		return strategy.Buy
+	}
+
+	if value >= r.SellAt {
+		return strategy.Sell
+	}
+
+	return strategy.Hold
+}
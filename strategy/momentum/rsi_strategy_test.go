@@ -53,3 +53,21 @@ func TestRsiStrategyReport(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestRsiStrategyOscillator(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshotsSlice := helper.ChanToSlice(snapshots)
+
+	s := momentum.NewRsiStrategy()
+
+	expected := len(snapshotsSlice) - s.OscillatorIdlePeriod()
+	actual := len(helper.ChanToSlice(s.Oscillator(helper.SliceToChan(snapshotsSlice))))
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
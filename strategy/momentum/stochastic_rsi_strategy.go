@@ -61,22 +61,65 @@ func (s *StochasticRsiStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan
 
 	stochasticRsi := s.StochasticRsi.Compute(closings)
 
-	actions := helper.Map(stochasticRsi, func(value float64) strategy.Action {
-		if value <= s.BuyAt {
-			return strategy.Buy
-		}
+	actions := helper.Map(stochasticRsi, s.action)
+
+	// Stochastic RSI starts only after the idle period.
+	actions = helper.Shift(actions, s.StochasticRsi.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
+
+// ComputeSlice processes the provided asset snapshots and generates a
+// slice of actionable recommendations.
+func (s *StochasticRsiStrategy) ComputeSlice(snapshots []*asset.Snapshot) []strategy.Action {
+	stochasticRsi := s.StochasticRsi.Clone()
+	actions := make([]strategy.Action, len(snapshots))
 
-		if value >= s.SellAt {
-			return strategy.Sell
+	for i, snapshot := range snapshots {
+		value, ok := stochasticRsi.Update(snapshot.Close)
+		if ok {
+			actions[i] = s.action(value)
 		}
+	}
+
+	return actions
+}
 
+// Update processes the given asset snapshot and returns the recommended action, keeping the state
+// in the strategy instance.
+func (s *StochasticRsiStrategy) Update(snapshot *asset.Snapshot) strategy.Action {
+	value, ok := s.StochasticRsi.Update(snapshot.Close)
+	if !ok {
 		return strategy.Hold
-	})
+	}
 
-	// Stochastic RSI starts only after the idle period.
-	actions = helper.Shift(actions, s.StochasticRsi.IdlePeriod(), strategy.Hold)
+	return s.action(value)
+}
 
-	return actions
+// Reset resets the state of the strategy.
+func (s *StochasticRsiStrategy) Reset() {
+	s.StochasticRsi.Reset()
+}
+
+// MarshalState returns the state of the strategy as JSON.
+func (s *StochasticRsiStrategy) MarshalState() ([]byte, error) {
+	return s.StochasticRsi.MarshalState()
+}
+
+// UnmarshalState restores the state of the strategy from the given JSON.
+func (s *StochasticRsiStrategy) UnmarshalState(data []byte) error {
+	return s.StochasticRsi.UnmarshalState(data)
+}
+
+// Oscillator processes the provided asset snapshots and generates a stream of the Stochastic RSI values that the
+// strategy is based on.
+func (s *StochasticRsiStrategy) Oscillator(snapshots <-chan *asset.Snapshot) <-chan float64 {
+	return s.StochasticRsi.Compute(asset.SnapshotsAsClosings(snapshots))
+}
+
+// OscillatorIdlePeriod is the initial period that the Stochastic RSI oscillator won't yield any results.
+func (s *StochasticRsiStrategy) OscillatorIdlePeriod() int {
+	return s.StochasticRsi.IdlePeriod()
 }
 
 // Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
@@ -112,3 +155,16 @@ func (s *StochasticRsiStrategy) Report(c <-chan *asset.Snapshot) *helper.Report
 
 	return report
 }
+
+// action returns the recommended action for the given Stochastic RSI value.
+func (s *StochasticRsiStrategy) action(value float64) strategy.Action {
+	if value <= s.BuyAt {
+		return strategy.Buy
+	}
+
+	if value >= s.SellAt {
+		return strategy.Sell
+	}
+
+	return strategy.Hold
+}
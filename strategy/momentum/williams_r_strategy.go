@@ -0,0 +1,184 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package momentum
+
+import (
+	"fmt"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/momentum"
+	"github.com/cinar/indicator/v2/strategy"
+)
+
+const (
+	// DefaultWilliamsRStrategyBuyAt defines the default Williams R level at which a Buy action is generated.
+	DefaultWilliamsRStrategyBuyAt = -80
+
+	// DefaultWilliamsRStrategySellAt defines the default Williams R level at which a Sell action is generated.
+	DefaultWilliamsRStrategySellAt = -20
+)
+
+// WilliamsRStrategy represents the configuration parameters for calculating the Williams R strategy. A
+// Williams R at or below the buy level suggests an oversold asset, while a Williams R at or above the
+// sell level suggests an overbought asset.
+type WilliamsRStrategy struct {
+	// WilliamsR represents the configuration parameters for calculating the Williams %R.
+	WilliamsR *momentum.WilliamsR[float64]
+
+	// BuyAt defines the Williams R level at which a Buy action is generated.
+	BuyAt float64
+
+	// SellAt defines the Williams R level at which a Sell action is generated.
+	SellAt float64
+}
+
+// NewWilliamsRStrategy function initializes a new Williams R strategy instance with the default parameters.
+func NewWilliamsRStrategy() *WilliamsRStrategy {
+	return NewWilliamsRStrategyWith(
+		DefaultWilliamsRStrategyBuyAt,
+		DefaultWilliamsRStrategySellAt,
+	)
+}
+
+// NewWilliamsRStrategyWith function initializes a new Williams R strategy instance with the given parameters.
+func NewWilliamsRStrategyWith(buyAt, sellAt float64) *WilliamsRStrategy {
+	return &WilliamsRStrategy{
+		WilliamsR: momentum.NewWilliamsR[float64](),
+		BuyAt:     buyAt,
+		SellAt:    sellAt,
+	}
+}
+
+// Name returns the name of the strategy.
+func (w *WilliamsRStrategy) Name() string {
+	return fmt.Sprintf("Williams R Strategy (%.0f,%.0f)", w.BuyAt, w.SellAt)
+}
+
+// Compute processes the provided asset snapshots and generates a stream of actionable recommendations.
+func (w *WilliamsRStrategy) Compute(c <-chan *asset.Snapshot) <-chan strategy.Action {
+	snapshots := helper.Duplicate(c, 3)
+	highs := asset.SnapshotsAsHighs(snapshots[0])
+	lows := asset.SnapshotsAsLows(snapshots[1])
+	closings := asset.SnapshotsAsClosings(snapshots[2])
+
+	wrs := w.WilliamsR.Compute(highs, lows, closings)
+
+	actions := helper.Map(wrs, w.action)
+
+	// Williams R starts only after the idle period.
+	actions = helper.Shift(actions, w.WilliamsR.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
+
+// ComputeSlice processes the provided asset snapshots and generates a
+// slice of actionable recommendations.
+func (w *WilliamsRStrategy) ComputeSlice(snapshots []*asset.Snapshot) []strategy.Action {
+	williamsR := w.WilliamsR.Clone()
+	actions := make([]strategy.Action, len(snapshots))
+
+	for i, snapshot := range snapshots {
+		wr, ok := williamsR.Update(snapshot.High, snapshot.Low, snapshot.Close)
+		if ok {
+			actions[i] = w.action(wr)
+		}
+	}
+
+	return actions
+}
+
+// Update processes the given asset snapshot and returns the recommended action, keeping the state
+// in the strategy instance.
+func (w *WilliamsRStrategy) Update(snapshot *asset.Snapshot) strategy.Action {
+	wr, ok := w.WilliamsR.Update(snapshot.High, snapshot.Low, snapshot.Close)
+	if !ok {
+		return strategy.Hold
+	}
+
+	return w.action(wr)
+}
+
+// Reset resets the state of the strategy.
+func (w *WilliamsRStrategy) Reset() {
+	w.WilliamsR.Reset()
+}
+
+// MarshalState returns the state of the strategy as JSON.
+func (w *WilliamsRStrategy) MarshalState() ([]byte, error) {
+	return w.WilliamsR.MarshalState()
+}
+
+// UnmarshalState restores the state of the strategy from the given JSON.
+func (w *WilliamsRStrategy) UnmarshalState(data []byte) error {
+	return w.WilliamsR.UnmarshalState(data)
+}
+
+// Oscillator processes the provided asset snapshots and generates a stream of the Williams R values that the
+// strategy is based on.
+func (w *WilliamsRStrategy) Oscillator(snapshots <-chan *asset.Snapshot) <-chan float64 {
+	snapshotsSplice := helper.Duplicate(snapshots, 3)
+
+	highs := asset.SnapshotsAsHighs(snapshotsSplice[0])
+	lows := asset.SnapshotsAsLows(snapshotsSplice[1])
+	closings := asset.SnapshotsAsClosings(snapshotsSplice[2])
+
+	return w.WilliamsR.Compute(highs, lows, closings)
+}
+
+// OscillatorIdlePeriod is the initial period that the Williams R oscillator won't yield any results.
+func (w *WilliamsRStrategy) OscillatorIdlePeriod() int {
+	return w.WilliamsR.IdlePeriod()
+}
+
+// Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
+func (w *WilliamsRStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> highs       |
+	// snapshots[2] -> lows        |
+	// snapshots[3] -> closings[1] |> wrs
+	//                 closings[0] -> closings
+	// snapshots[4] -> actions     -> annotations
+	//              -> outcomes
+	//
+	snapshots := helper.Duplicate(c, 5)
+
+	dates := asset.SnapshotsAsDates(snapshots[0])
+	highs := asset.SnapshotsAsHighs(snapshots[1])
+	lows := asset.SnapshotsAsLows(snapshots[2])
+	closings := helper.Duplicate(asset.SnapshotsAsClosings(snapshots[3]), 2)
+
+	wrs := helper.Shift(w.WilliamsR.Compute(highs, lows, closings[1]), w.WilliamsR.IdlePeriod(), 0)
+
+	actions, outcomes := strategy.ComputeWithOutcome(w, snapshots[4])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(w.Name(), dates)
+	report.AddChart()
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closings[0]))
+	report.AddColumn(helper.NewNumericReportColumn("Williams R", wrs), 1)
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations), 0, 1)
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 2)
+
+	return report
+}
+
+// action returns the recommended action for the given Williams R value.
+func (w *WilliamsRStrategy) action(wr float64) strategy.Action {
+	if wr <= w.BuyAt {
+		return strategy.Buy
+	}
+
+	if wr >= w.SellAt {
+		return strategy.Sell
+	}
+
+	return strategy.Hold
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package strategy
+
+import (
+	"strings"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+)
+
+// MultiAssetStrategy defines a shared interface for the trading strategies that trade several assets
+// together, such as the pairs trading strategies. Each asset is a leg of the trade. Instead of actions, the
+// strategy yields the position of each leg as a signed fraction of the capital, positive for long, negative
+// for short, and zero for flat.
+type MultiAssetStrategy interface {
+	// Name returns the name of the strategy.
+	Name() string
+
+	// Compute processes the provided date aligned snapshots of the assets, and generates a stream of
+	// positions for each asset.
+	Compute(snapshots []<-chan *asset.Snapshot) []<-chan float64
+
+	// Report processes the provided date aligned snapshots of the assets, and generates a report
+	// annotated with the positions.
+	Report(snapshots []<-chan *asset.Snapshot) *helper.Report
+}
+
+// MultiAssetName returns the name of the given group of assets traded together.
+func MultiAssetName(names []string) string {
+	return strings.Join(names, "+")
+}
+
+// ComputeMultiAssetWithOutcome uses the given multi-asset strategy to process the provided date aligned
+// snapshots of the assets, and generates a stream of positions for each asset along with the outcomes.
+func ComputeMultiAssetWithOutcome(s MultiAssetStrategy, snapshots []<-chan *asset.Snapshot) ([]<-chan float64, <-chan float64) {
+	legs := make([]<-chan *asset.Snapshot, len(snapshots))
+	closings := make([]<-chan float64, len(snapshots))
+
+	for i, c := range snapshots {
+		snapshotsSplice := helper.Duplicate(c, 2)
+		legs[i] = snapshotsSplice[0]
+		closings[i] = asset.SnapshotsAsClosings(snapshotsSplice[1])
+	}
+
+	computed := s.Compute(legs)
+	positions := make([]<-chan float64, len(computed))
+	outcomePositions := make([]<-chan float64, len(computed))
+
+	for i, c := range computed {
+		positionsSplice := helper.Duplicate(c, 2)
+		positions[i] = positionsSplice[0]
+		outcomePositions[i] = positionsSplice[1]
+	}
+
+	return positions, MultiAssetOutcome(closings, outcomePositions)
+}
+
+// MultiAssetOutcome simulates the potential result of holding the given positions in the assets based on
+// the provided values. The positions are rebalanced at each value, and each position earns the change of
+// its asset until the next value, including the short positions.
+func MultiAssetOutcome[T helper.Number](values []<-chan T, positions []<-chan float64) <-chan float64 {
+	type holding struct {
+		value    float64
+		position float64
+	}
+
+	// Each leg is paired separately to not block the other legs.
+	holdings := make([]<-chan holding, len(values))
+	for i := range values {
+		holdings[i] = helper.Operate(values[i], positions[i], func(value T, position float64) holding {
+			return holding{
+				value:    float64(value),
+				position: position,
+			}
+		})
+	}
+
+	result := make(chan float64)
+
+	go func() {
+		defer close(result)
+		defer func() {
+			for _, c := range holdings {
+				go helper.Drain(c)
+			}
+		}()
+
+		balance := 1.0
+		previous := make([]holding, len(holdings))
+		started := false
+
+		for {
+			change := 0.0
+
+			for i, c := range holdings {
+				current, ok := <-c
+				if !ok {
+					return
+				}
+
+				if started && previous[i].value != 0 {
+					change += previous[i].position * (current.value/previous[i].value - 1)
+				}
+
+				previous[i] = current
+			}
+
+			started = true
+			balance *= 1 + change
+
+			result <- balance - 1.0
+		}
+	}()
+
+	return result
+}
+
+// PositionsToActions converts the positions of a leg to actions, recommending a Buy action when the
+// position increases, a Sell action when the position decreases, and a Hold action otherwise.
+func PositionsToActions(positions <-chan float64) <-chan Action {
+	previous := 0.0
+
+	return helper.Map(positions, func(position float64) Action {
+		action := Hold
+
+		if position > previous {
+			action = Buy
+		} else if position < previous {
+			action = Sell
+		}
+
+		previous = position
+
+		return action
+	})
+}
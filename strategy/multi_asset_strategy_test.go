@@ -0,0 +1,56 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package strategy_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+)
+
+func TestMultiAssetOutcome(t *testing.T) {
+	values := []<-chan float64{
+		helper.SliceToChan([]float64{10, 11, 12}),
+		helper.SliceToChan([]float64{20, 19, 19}),
+	}
+
+	positions := []<-chan float64{
+		helper.SliceToChan([]float64{0.5, 0.5, 0}),
+		helper.SliceToChan([]float64{-0.5, -0.5, 0}),
+	}
+
+	expected := helper.SliceToChan([]float64{0, 0.075, 0.1239})
+
+	actual := helper.RoundDigits(strategy.MultiAssetOutcome(values, positions), 4)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPositionsToActions(t *testing.T) {
+	positions := helper.SliceToChan([]float64{0, 0.5, 0.5, -0.5, 0})
+	expected := helper.SliceToChan([]strategy.Action{
+		strategy.Hold, strategy.Buy, strategy.Hold, strategy.Sell, strategy.Buy,
+	})
+
+	actual := strategy.PositionsToActions(positions)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMultiAssetName(t *testing.T) {
+	expected := "KO+PEP"
+	actual := strategy.MultiAssetName([]string{"KO", "PEP"})
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
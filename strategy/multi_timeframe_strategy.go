@@ -0,0 +1,249 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package strategy
+
+import (
+	"sync"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+)
+
+// MultiTimeframeMode defines how the actions from the different timeframes are combined.
+type MultiTimeframeMode int
+
+const (
+	// MultiTimeframeAnd recommends an action when the base timeframe and all higher timeframes agree.
+	MultiTimeframeAnd MultiTimeframeMode = iota
+
+	// MultiTimeframeOr recommends an action when any of the timeframes recommends it and none of them
+	// recommends the opposite action.
+	MultiTimeframeOr
+
+	// MultiTimeframeGate passes the Buy actions of the base timeframe only when all higher timeframes
+	// are in a Buy state. Sell actions always pass, so that positions can be exited.
+	MultiTimeframeGate
+)
+
+// TimeframeStrategy pairs a strategy with the timeframe that the snapshots are resampled to before
+// they are provided to the strategy.
+type TimeframeStrategy struct {
+	// Strategy is the strategy that runs on the resampled snapshots.
+	Strategy Strategy
+
+	// Timeframe is the timeframe that the snapshots are resampled to.
+	Timeframe asset.Timeframe
+}
+
+// NewTimeframeStrategy function initializes a new timeframe strategy instance.
+func NewTimeframeStrategy(strategy Strategy, timeframe asset.Timeframe) *TimeframeStrategy {
+	return &TimeframeStrategy{
+		Strategy:  strategy,
+		Timeframe: timeframe,
+	}
+}
+
+// Compute resamples the provided snapshots, runs the strategy on the resampled bars, and aligns the
+// resulting actions back to the provided snapshots. Each snapshot receives the state of the strategy, the
+// last actionable recommendation, as of the last bar completed before the snapshot's own bar started.
+// The bar that the snapshot belongs to is never consulted, so no future information leaks in.
+func (t *TimeframeStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan Action {
+	snapshotsSplice := helper.Duplicate(snapshots, 2)
+
+	bars := asset.Resample(snapshotsSplice[0], t.Timeframe)
+	states := DenormalizeActions(t.Strategy.Compute(bars))
+
+	// The higher timeframe states lag behind the snapshots until each bar completes, therefore they are
+	// collected separately to not block the snapshots.
+	var mu sync.Mutex
+	cond := sync.NewCond(&mu)
+	completed := []Action{}
+	done := false
+
+	go func() {
+		for state := range states {
+			mu.Lock()
+			completed = append(completed, state)
+			cond.Broadcast()
+			mu.Unlock()
+		}
+
+		mu.Lock()
+		done = true
+		cond.Broadcast()
+		mu.Unlock()
+	}()
+
+	result := make(chan Action, cap(snapshots))
+
+	go func() {
+		defer close(result)
+
+		bar := -1
+		var start time.Time
+
+		for snapshot := range snapshotsSplice[1] {
+			barStart := t.Timeframe(snapshot.Date)
+			if bar == -1 || !barStart.Equal(start) {
+				bar++
+				start = barStart
+			}
+
+			action := Hold
+
+			if bar > 0 {
+				mu.Lock()
+				for len(completed) < bar && !done {
+					cond.Wait()
+				}
+
+				if len(completed) >= bar {
+					action = completed[bar-1]
+				}
+				mu.Unlock()
+			}
+
+			result <- action
+		}
+	}()
+
+	return result
+}
+
+// MultiTimeframeStrategy runs a base strategy on the provided snapshots and the other strategies on the
+// higher timeframes of the same snapshots, aligns the higher timeframe actions to the provided snapshots
+// without look-ahead, and combines them based on the mode. For example, a daily trend filter can gate the
+// entries of an intraday strategy.
+type MultiTimeframeStrategy struct {
+	// Strategy is the base strategy that runs on the provided snapshots.
+	Strategy Strategy
+
+	// Timeframes are the strategies that run on the higher timeframes.
+	Timeframes []*TimeframeStrategy
+
+	// Mode is how the actions from the different timeframes are combined.
+	Mode MultiTimeframeMode
+
+	// name is the name of the strategy.
+	name string
+}
+
+// NewMultiTimeframeStrategy function initializes a new multi timeframe strategy instance with the given name.
+func NewMultiTimeframeStrategy(name string, mode MultiTimeframeMode, strategy Strategy, timeframes ...*TimeframeStrategy) *MultiTimeframeStrategy {
+	return &MultiTimeframeStrategy{
+		Strategy:   strategy,
+		Timeframes: timeframes,
+		Mode:       mode,
+		name:       name,
+	}
+}
+
+// Name returns the name of the strategy.
+func (m *MultiTimeframeStrategy) Name() string {
+	return m.name
+}
+
+// Compute processes the provided asset snapshots and generates a stream of actionable recommendations.
+func (m *MultiTimeframeStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan Action {
+	snapshotsSplice := helper.Duplicate(snapshots, len(m.Timeframes)+1)
+
+	base := m.Strategy.Compute(snapshotsSplice[0])
+	if m.Mode != MultiTimeframeGate {
+		base = DenormalizeActions(base)
+	}
+
+	sources := make([]<-chan Action, len(m.Timeframes)+1)
+	sources[0] = base
+
+	for i, timeframe := range m.Timeframes {
+		sources[i+1] = timeframe.Compute(snapshotsSplice[i+1])
+	}
+
+	result := make(chan Action)
+
+	go func() {
+		defer close(result)
+
+		for {
+			actions := make([]Action, len(sources))
+
+			for i, source := range sources {
+				action, ok := <-source
+				if !ok {
+					return
+				}
+
+				actions[i] = action
+			}
+
+			result <- m.combine(actions)
+		}
+	}()
+
+	return result
+}
+
+// Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
+func (m *MultiTimeframeStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
+	snapshots := helper.Duplicate(c, 3)
+
+	dates := asset.SnapshotsAsDates(snapshots[0])
+	closings := asset.SnapshotsAsClosings(snapshots[1])
+
+	actions, outcomes := ComputeWithOutcome(m, snapshots[2])
+	annotations := ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(m.Name(), dates)
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closings))
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations))
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 1)
+
+	return report
+}
+
+// combine combines the base action followed by the higher timeframe states based on the mode.
+func (m *MultiTimeframeStrategy) combine(actions []Action) Action {
+	var buy, sell int
+
+	for _, action := range actions {
+		switch action {
+		case Buy:
+			buy++
+
+		case Sell:
+			sell++
+		}
+	}
+
+	switch m.Mode {
+	case MultiTimeframeAnd:
+		if buy == len(actions) {
+			return Buy
+		} else if sell == len(actions) {
+			return Sell
+		}
+
+	case MultiTimeframeOr:
+		if buy > 0 && sell == 0 {
+			return Buy
+		} else if sell > 0 && buy == 0 {
+			return Sell
+		}
+
+	case MultiTimeframeGate:
+		if actions[0] == Sell {
+			return Sell
+		} else if actions[0] == Buy && buy == len(actions) {
+			return Buy
+		}
+	}
+
+	return Hold
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package strategy_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/strategy/trend"
+)
+
+func newMultiTimeframeStrategy(mode strategy.MultiTimeframeMode) *strategy.MultiTimeframeStrategy {
+	return strategy.NewMultiTimeframeStrategy("Multi Timeframe Strategy", mode,
+		trend.NewMacdStrategy(),
+		strategy.NewTimeframeStrategy(trend.NewMacdStrategyWith(4, 10, 3), asset.Weekly),
+	)
+}
+
+func TestMultiTimeframeStrategy(t *testing.T) {
+	modes := map[strategy.MultiTimeframeMode]string{
+		strategy.MultiTimeframeAnd:  "testdata/multi_timeframe_and.csv",
+		strategy.MultiTimeframeOr:   "testdata/multi_timeframe_or.csv",
+		strategy.MultiTimeframeGate: "testdata/multi_timeframe_gate.csv",
+	}
+
+	for mode, fileName := range modes {
+		snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/repository/brk-b.csv")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		results, err := helper.ReadFromCsvFile[strategy.Result](fileName)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expected := helper.Map(results, func(r *strategy.Result) strategy.Action { return r.Action })
+
+		actual := newMultiTimeframeStrategy(mode).Compute(snapshots)
+
+		err = helper.CheckEquals(actual, expected)
+		if err != nil {
+			t.Fatalf("%s: %v", fileName, err)
+		}
+	}
+}
+
+func TestTimeframeStrategyNoLookAhead(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/repository/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshotsSlice := helper.ChanToSlice(snapshots)
+	timeframe := strategy.NewTimeframeStrategy(trend.NewMacdStrategyWith(4, 10, 3), asset.Weekly)
+
+	expected := helper.ChanToSlice(timeframe.Compute(helper.SliceToChan(snapshotsSlice)))
+
+	// Truncating the future must not change the past.
+	for n := 1; n < len(snapshotsSlice); n += 7 {
+		actual := helper.ChanToSlice(timeframe.Compute(helper.SliceToChan(snapshotsSlice[:n])))
+
+		err = helper.CheckEquals(helper.SliceToChan(actual), helper.SliceToChan(expected[:n]))
+		if err != nil {
+			t.Fatalf("n %d: %v", n, err)
+		}
+	}
+
+	// The first week has no completed weekly bar.
+	for i, snapshot := range snapshotsSlice {
+		if !asset.Weekly(snapshot.Date).Equal(asset.Weekly(snapshotsSlice[0].Date)) {
+			break
+		}
+
+		if expected[i] != strategy.Hold {
+			t.Fatalf("index %d actual %v", i, expected[i])
+		}
+	}
+}
+
+func TestMultiTimeframeStrategyReport(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/repository/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report := newMultiTimeframeStrategy(strategy.MultiTimeframeGate).Report(snapshots)
+
+	fileName := "multi_timeframe.html"
+	defer helper.Remove(t, fileName)
+
+	err = report.WriteToFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -7,11 +7,32 @@ package strategy
 import "github.com/cinar/indicator/v2/helper"
 
 // Outcome simulates the potential result of executing the given actions based on the provided values.
+// As the outcome is a ratio, use the ProfitAndLoss for the exact result with decimal values.
 func Outcome[T helper.Number](values <-chan T, actions <-chan Action) <-chan float64 {
+	return helper.Operate(values, actions, newOutcome[T]())
+}
+
+// OutcomeSlice simulates the potential result of executing the given actions based on the provided
+// values. It is the batch form of Outcome.
+func OutcomeSlice[T helper.Number](values []T, actions []Action) []float64 {
+	n := min(len(values), len(actions))
+	outcomes := make([]float64, n)
+	outcome := newOutcome[T]()
+
+	for i := 0; i < n; i++ {
+		outcomes[i] = outcome(values[i], actions[i])
+	}
+
+	return outcomes
+}
+
+// newOutcome returns a function that keeps the balance and the shares, executes the given action
+// based on the given value, and returns the outcome so far.
+func newOutcome[T helper.Number]() func(T, Action) float64 {
 	balance := 1.0
 	shares := 0.0
 
-	return helper.Operate(values, actions, func(value T, action Action) float64 {
+	return func(value T, action Action) float64 {
 		if balance > 0 && action == Buy {
 			shares = balance / float64(value)
 			balance = 0
@@ -21,5 +42,5 @@ func Outcome[T helper.Number](values <-chan T, actions <-chan Action) <-chan flo
 		}
 
 		return balance + (shares * float64(value)) - 1.0
-	})
+	}
 }
@@ -0,0 +1,98 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package pattern
+
+import (
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/pattern"
+	"github.com/cinar/indicator/v2/strategy"
+)
+
+// PatternStrategy represents the configuration parameters for calculating the candlestick pattern
+// strategy. A bullish pattern suggests a Buy signal, while a bearish pattern suggests a Sell signal.
+// Bars with both bullish and bearish patterns are ignored.
+type PatternStrategy struct {
+	// Detector represents the configuration parameters for recognizing the candlestick patterns.
+	Detector *pattern.Detector
+}
+
+// NewPatternStrategy function initializes a new candlestick pattern strategy instance with the
+// default parameters.
+func NewPatternStrategy() *PatternStrategy {
+	return NewPatternStrategyWith(pattern.NewDetector())
+}
+
+// NewPatternStrategyWith function initializes a new candlestick pattern strategy instance with the
+// given detector.
+func NewPatternStrategyWith(detector *pattern.Detector) *PatternStrategy {
+	return &PatternStrategy{
+		Detector: detector,
+	}
+}
+
+// Name returns the name of the strategy.
+func (*PatternStrategy) Name() string {
+	return "Candlestick Pattern Strategy"
+}
+
+// Compute processes the provided asset snapshots and generates a stream of actionable recommendations.
+func (p *PatternStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan strategy.Action {
+	patterns := p.Detector.Compute(snapshots)
+
+	actions := helper.Map(patterns, func(pt pattern.Pattern) strategy.Action {
+		bullish := pt.IsBullish()
+		bearish := pt.IsBearish()
+
+		if bullish && !bearish {
+			return strategy.Buy
+		}
+
+		if bearish && !bullish {
+			return strategy.Sell
+		}
+
+		return strategy.Hold
+	})
+
+	// Patterns start only after the idle period.
+	actions = helper.Shift(actions, p.Detector.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
+
+// Report processes the provided asset snapshots and generates a report annotated with the recommended
+// actions and the recognized patterns.
+func (p *PatternStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> closings
+	// snapshots[2] -> patterns -> pattern annotations
+	// snapshots[3] -> actions  -> annotations
+	//              -> outcomes
+	//
+	snapshots := helper.Duplicate(c, 4)
+
+	dates := asset.SnapshotsAsDates(snapshots[0])
+	closings := asset.SnapshotsAsClosings(snapshots[1])
+
+	patterns := helper.Shift(p.Detector.Compute(snapshots[2]), p.Detector.IdlePeriod(), pattern.None)
+	patternAnnotations := pattern.PatternsToAnnotations(patterns)
+
+	actions, outcomes := strategy.ComputeWithOutcome(p, snapshots[3])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(p.Name(), dates)
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closings))
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations))
+	report.AddColumn(helper.NewAnnotationReportColumn(patternAnnotations))
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 1)
+
+	return report
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package strategy
+
+import "github.com/cinar/indicator/v2/helper"
+
+// ProfitAndLoss computes the realized and the unrealized profit and loss of holding a single unit
+// of the asset by executing the given actions based on the provided values, in the units of the
+// values. Unlike the Outcome, it only adds and subtracts the values, so the result is exact when
+// the values are decimals, such as the tick-sized prices of futures.
+//
+// Example:
+//
+//	closings := helper.Map(snapshots, func(s *asset.Snapshot) helper.Decimal {
+//		return helper.NewDecimal(s.Close).Round(tick)
+//	})
+//	pnls := strategy.ProfitAndLoss(closings, actions)
+func ProfitAndLoss[T helper.Amount](values <-chan T, actions <-chan Action) <-chan T {
+	return helper.Operate(values, actions, newProfitAndLoss[T]())
+}
+
+// ProfitAndLossSlice computes the realized and the unrealized profit and loss of holding a single
+// unit of the asset by executing the given actions based on the provided values. It is the batch
+// form of ProfitAndLoss.
+func ProfitAndLossSlice[T helper.Amount](values []T, actions []Action) []T {
+	n := min(len(values), len(actions))
+	pnls := make([]T, n)
+	pnl := newProfitAndLoss[T]()
+
+	for i := 0; i < n; i++ {
+		pnls[i] = pnl(values[i], actions[i])
+	}
+
+	return pnls
+}
+
+// newProfitAndLoss returns a function that keeps the realized profit and loss and the entry value
+// of the open position, executes the given action based on the given value, and returns the
+// profit and loss so far.
+func newProfitAndLoss[T helper.Amount]() func(T, Action) T {
+	var realized T
+	var entry T
+	holding := false
+
+	return func(value T, action Action) T {
+		if !holding && action == Buy {
+			entry = value
+			holding = true
+		} else if holding && action == Sell {
+			realized += value - entry
+			holding = false
+		}
+
+		if holding {
+			return realized + value - entry
+		}
+
+		return realized
+	}
+}
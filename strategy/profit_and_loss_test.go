@@ -0,0 +1,103 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package strategy_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+)
+
+type ProfitAndLossData struct {
+	Close  helper.Decimal
+	Action strategy.Action
+	PnL    helper.Decimal
+}
+
+func TestProfitAndLoss(t *testing.T) {
+	rows, err := helper.ReadFromCsvFile[ProfitAndLossData]("testdata/profit_and_loss.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := helper.Duplicate(rows, 3)
+	closings := helper.Map(inputs[0], func(row *ProfitAndLossData) helper.Decimal { return row.Close })
+	actions := helper.Map(inputs[1], func(row *ProfitAndLossData) strategy.Action { return row.Action })
+	expected := helper.Map(inputs[2], func(row *ProfitAndLossData) helper.Decimal { return row.PnL })
+
+	actual := strategy.ProfitAndLoss(closings, actions)
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProfitAndLossSlice(t *testing.T) {
+	rows, err := helper.ReadFromCsvFile[ProfitAndLossData]("testdata/profit_and_loss.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var closings, expected []helper.Decimal
+	var floatClosings []float64
+	var actions []strategy.Action
+
+	for row := range rows {
+		closings = append(closings, row.Close)
+		floatClosings = append(floatClosings, row.Close.Float64())
+		actions = append(actions, row.Action)
+		expected = append(expected, row.PnL)
+	}
+
+	actual := strategy.ProfitAndLossSlice(closings, actions)
+	floatActual := strategy.ProfitAndLossSlice(floatClosings, actions)
+
+	floatExact := true
+
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Fatalf("index %d actual %v expected %v", i, actual[i], expected[i])
+		}
+
+		if floatActual[i] != expected[i].Float64() {
+			floatExact = false
+		}
+	}
+
+	// The same computation with float64 accumulates the floating point error.
+	if floatExact {
+		t.Fatal("expected floating point error")
+	}
+}
+
+func TestProfitAndLossDecimalContracts(t *testing.T) {
+	closings := helper.SliceToChan([]helper.Decimal{
+		helper.NewDecimal(71.27), helper.NewDecimal(71.38), helper.NewDecimal(71.19),
+		helper.NewDecimal(71.31), helper.NewDecimal(71.44), helper.NewDecimal(71.52),
+	})
+
+	actions := helper.SliceToChan([]strategy.Action{
+		strategy.Buy, strategy.Hold, strategy.Sell, strategy.Buy, strategy.Hold, strategy.Sell,
+	})
+
+	// Two contracts with a point value of 1000.
+	multiplier := helper.NewDecimalFromInt(2).Mul(helper.NewDecimalFromInt(1000))
+
+	expected := helper.SliceToChan([]helper.Decimal{
+		helper.NewDecimalFromInt(0), helper.NewDecimalFromInt(220), helper.NewDecimalFromInt(-160),
+		helper.NewDecimalFromInt(-160), helper.NewDecimalFromInt(100), helper.NewDecimalFromInt(260),
+	})
+
+	actual := helper.Map(strategy.ProfitAndLoss(closings, actions), func(pnl helper.Decimal) helper.Decimal {
+		return pnl.Mul(multiplier)
+	})
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,1006 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package spec
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/momentum"
+	"github.com/cinar/indicator/v2/pattern"
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/strategy/compound"
+	"github.com/cinar/indicator/v2/strategy/decorator"
+	momentumstrategy "github.com/cinar/indicator/v2/strategy/momentum"
+	patternstrategy "github.com/cinar/indicator/v2/strategy/pattern"
+	trendstrategy "github.com/cinar/indicator/v2/strategy/trend"
+	volatilitystrategy "github.com/cinar/indicator/v2/strategy/volatility"
+	volumestrategy "github.com/cinar/indicator/v2/strategy/volume"
+	"github.com/cinar/indicator/v2/trend"
+	"github.com/cinar/indicator/v2/volatility"
+	"github.com/cinar/indicator/v2/volume"
+)
+
+// maTypes are the moving average types that can be given as parameters.
+var maTypes = []string{"alma", "ema", "frama", "hma", "linreg", "mcginley", "sma", "smma", "t3", "vidya", "wma", "zlema"}
+
+// sessions are the session timeframes that can be given as parameters.
+var sessions = map[string]asset.Timeframe{
+	"daily":   asset.Daily,
+	"weekly":  asset.Weekly,
+	"monthly": asset.Monthly,
+}
+
+// anchoredVwapModes are the anchored VWAP strategy modes that can be given as parameters.
+var anchoredVwapModes = map[string]volumestrategy.AnchoredVwapMode{
+	"reversion": volumestrategy.AnchoredVwapReversion,
+	"breakout":  volumestrategy.AnchoredVwapBreakout,
+}
+
+// registrations provides mapping for the strategy builders and describers.
+var registrations = map[string]registration{}
+
+func init() {
+	// Base strategies
+	RegisterStrategy("buy_and_hold", buildDefault(strategy.NewBuyAndHoldStrategy), describeDefault(strategy.NewBuyAndHoldStrategy))
+
+	// Compositions
+	RegisterStrategy("and", buildAnd, describeType(describeAnd))
+	RegisterStrategy("or", buildOr, describeType(describeOr))
+	RegisterStrategy("majority", buildMajority, describeType(describeMajority))
+	RegisterStrategy("split", buildSplit, describeType(describeSplit))
+
+	// Decorators
+	RegisterStrategy("divergence", buildDivergence, describeType(describeDivergence))
+	RegisterStrategy("inverse", buildInverse, describeType(describeInverse))
+	RegisterStrategy("no_loss", buildNoLoss, describeType(describeNoLoss))
+	RegisterStrategy("stop_loss", buildStopLoss, describeType(describeStopLoss))
+
+	// Compound strategies
+	RegisterStrategy("macd_rsi", buildMacdRsi, describeType(describeMacdRsi))
+
+	// Trend strategies
+	RegisterStrategy("adx", buildAdx, describeType(describeAdx))
+	RegisterStrategy("alligator", buildAlligator, describeType(describeAlligator))
+	RegisterStrategy("apo", buildDefault(trendstrategy.NewApoStrategy), describeDefault(trendstrategy.NewApoStrategy))
+	RegisterStrategy("aroon", buildDefault(trendstrategy.NewAroonStrategy), describeDefault(trendstrategy.NewAroonStrategy))
+	RegisterStrategy("bop", buildDefault(trendstrategy.NewBopStrategy), describeDefault(trendstrategy.NewBopStrategy))
+	RegisterStrategy("cci", buildDefault(trendstrategy.NewCciStrategy), describeDefault(trendstrategy.NewCciStrategy))
+	RegisterStrategy("cfo", buildCfo, describeType(describeCfo))
+	RegisterStrategy("dema", buildDefault(trendstrategy.NewDemaStrategy), describeDefault(trendstrategy.NewDemaStrategy))
+	RegisterStrategy("envelope", buildEnvelope, describeType(describeEnvelope))
+	RegisterStrategy("golden_cross", buildGoldenCross, describeType(describeGoldenCross))
+	RegisterStrategy("kama", buildKama, describeType(describeKama))
+	RegisterStrategy("kdj", buildDefault(trendstrategy.NewKdjStrategy), describeDefault(trendstrategy.NewKdjStrategy))
+	RegisterStrategy("ma_crossover", buildMaCrossover, describeType(describeMaCrossover))
+	RegisterStrategy("macd", buildMacd, describeType(describeMacd))
+	RegisterStrategy("parabolic_sar", buildParabolicSar, describeType(describeParabolicSar))
+	RegisterStrategy("qstick", buildDefault(trendstrategy.NewQstickStrategy), describeDefault(trendstrategy.NewQstickStrategy))
+	RegisterStrategy("smma", buildSmma, describeType(describeSmma))
+	RegisterStrategy("trima", buildDefault(trendstrategy.NewTrimaStrategy), describeDefault(trendstrategy.NewTrimaStrategy))
+	RegisterStrategy("triple_ma_crossover", buildTripleMaCrossover, describeType(describeTripleMaCrossover))
+	RegisterStrategy("trix", buildDefault(trendstrategy.NewTrixStrategy), describeDefault(trendstrategy.NewTrixStrategy))
+	RegisterStrategy("tsi", buildTsi, describeType(describeTsi))
+	RegisterStrategy("vortex", buildVortex, describeType(describeVortex))
+	RegisterStrategy("vwma", buildDefault(trendstrategy.NewVwmaStrategy), describeDefault(trendstrategy.NewVwmaStrategy))
+	RegisterStrategy("weighted_close", buildWeightedClose, describeType(describeWeightedClose))
+
+	// Momentum strategies
+	RegisterStrategy("awesome_oscillator", buildDefault(momentumstrategy.NewAwesomeOscillatorStrategy), describeDefault(momentumstrategy.NewAwesomeOscillatorStrategy))
+	RegisterStrategy("rsi", buildRsi, describeType(describeRsi))
+	RegisterStrategy("stochastic_rsi", buildStochasticRsi, describeType(describeStochasticRsi))
+	RegisterStrategy("triple_rsi", buildTripleRsi, describeType(describeTripleRsi))
+	RegisterStrategy("williams_r", buildWilliamsR, describeType(describeWilliamsR))
+
+	// Pattern strategies
+	RegisterStrategy("candlestick_pattern", buildCandlestickPattern, describeType(describeCandlestickPattern))
+
+	// Volatility strategies
+	RegisterStrategy("bollinger_bands", buildDefault(volatilitystrategy.NewBollingerBandsStrategy), describeDefault(volatilitystrategy.NewBollingerBandsStrategy))
+	RegisterStrategy("donchian_breakout", buildDonchianBreakout, describeType(describeDonchianBreakout))
+	RegisterStrategy("projection_oscillator", buildDefault(volatilitystrategy.NewPoStrategy), describeDefault(volatilitystrategy.NewPoStrategy))
+	RegisterStrategy("super_trend", buildSuperTrend, describeType(describeSuperTrend))
+	RegisterStrategy("volatility_regime", buildVolatilityRegime, describeType(describeVolatilityRegime))
+
+	// Volume strategies
+	RegisterStrategy("anchored_vwap", buildAnchoredVwap, describeType(describeAnchoredVwap))
+	RegisterStrategy("chaikin_money_flow", buildChaikinMoneyFlow, describeType(describeChaikinMoneyFlow))
+	RegisterStrategy("ease_of_movement", buildEaseOfMovement, describeType(describeEaseOfMovement))
+	RegisterStrategy("force_index", buildForceIndex, describeType(describeForceIndex))
+	RegisterStrategy("money_flow_index", buildMoneyFlowIndex, describeType(describeMoneyFlowIndex))
+	RegisterStrategy("negative_volume_index", buildNegativeVolumeIndex, describeType(describeNegativeVolumeIndex))
+	RegisterStrategy("vwap_ema", buildVwapEma, describeType(describeVwapEma))
+	RegisterStrategy("weighted_average_price", buildWeightedAveragePrice, describeType(describeWeightedAveragePrice))
+}
+
+// buildDefault returns a builder for the strategies that do not take any parameters.
+func buildDefault[T strategy.Strategy](newStrategy func() T) BuilderFunc {
+	return func(_ *Parameters) (strategy.Strategy, error) {
+		return newStrategy(), nil
+	}
+}
+
+// describeType returns a describer that describes the strategies of the given type using the given function.
+func describeType[T strategy.Strategy](describe func(T) (*StrategySpec, error)) DescriberFunc {
+	return func(s strategy.Strategy) (*StrategySpec, error) {
+		t, ok := s.(T)
+		if !ok {
+			return nil, nil
+		}
+
+		return describe(t)
+	}
+}
+
+// describeDefault returns a describer for the strategies that do not take any parameters. Since their
+// parameters can not be given in a spec, only the strategies with the default parameters are described.
+func describeDefault[T strategy.Strategy](newStrategy func() T) DescriberFunc {
+	return describeType(func(s T) (*StrategySpec, error) {
+		if !reflect.DeepEqual(s, newStrategy()) {
+			return nil, fmt.Errorf("%w: only the default parameters are supported for %s", ErrUnsupportedStrategy, s.Name())
+		}
+
+		return &StrategySpec{}, nil
+	})
+}
+
+// newMa initializes a new moving average of the given type and period.
+func newMa(maType string, period int) trend.Ma[float64] {
+	switch maType {
+	case "alma":
+		return trend.NewAlmaWithPeriod[float64](period)
+
+	case "ema":
+		return trend.NewEmaWithPeriod[float64](period)
+
+	case "frama":
+		return trend.NewFramaWithPeriod[float64](period)
+
+	case "hma":
+		return trend.NewHmaWithPeriod[float64](period)
+
+	case "linreg":
+		return trend.NewLinRegWithPeriod[float64](period)
+
+	case "mcginley":
+		return trend.NewMcGinleyWithPeriod[float64](period)
+
+	case "smma":
+		return trend.NewSmmaWithPeriod[float64](period)
+
+	case "t3":
+		return trend.NewT3WithPeriod[float64](period)
+
+	case "vidya":
+		return trend.NewVidyaWithPeriod[float64](period)
+
+	case "wma":
+		return trend.NewWmaWith[float64](period)
+
+	case "zlema":
+		return trend.NewZlemaWithPeriod[float64](period)
+
+	default:
+		return trend.NewSmaWithPeriod[float64](period)
+	}
+}
+
+// describeMa returns the type and the period of the given moving average based on its string representation.
+// Only the moving averages with the default parameters other than the period are supported.
+func describeMa(ma trend.Ma[float64]) (string, int, error) {
+	name, rest, ok := strings.Cut(ma.String(), "(")
+	if ok {
+		maType := strings.ToLower(name)
+		digits, _, _ := strings.Cut(strings.TrimSuffix(rest, ")"), ",")
+
+		period, err := strconv.Atoi(digits)
+		if err == nil && slices.Contains(maTypes, maType) && newMa(maType, period).String() == ma.String() {
+			return maType, period, nil
+		}
+	}
+
+	return "", 0, fmt.Errorf("%w: moving average %s", ErrUnsupportedStrategy, ma.String())
+}
+
+// buildAnd builds a new and strategy.
+func buildAnd(p *Parameters) (strategy.Strategy, error) {
+	strategies := p.Strategies(1, -1)
+	return strategy.NewAndStrategy(p.Name(joinNames(strategies, " and ")), strategies...), nil
+}
+
+// describeAnd describes the given and strategy.
+func describeAnd(s *strategy.AndStrategy) (*StrategySpec, error) {
+	return describeGroup(s.Name(), s.Strategies)
+}
+
+// buildOr builds a new or strategy.
+func buildOr(p *Parameters) (strategy.Strategy, error) {
+	strategies := p.Strategies(1, -1)
+	return strategy.NewOrStrategy(p.Name(joinNames(strategies, " or ")), strategies...), nil
+}
+
+// describeOr describes the given or strategy.
+func describeOr(s *strategy.OrStrategy) (*StrategySpec, error) {
+	return describeGroup(s.Name(), s.Strategies)
+}
+
+// buildMajority builds a new majority strategy.
+func buildMajority(p *Parameters) (strategy.Strategy, error) {
+	strategies := p.Strategies(1, -1)
+	return strategy.NewMajorityStrategyWith(p.Name("Majority of "+joinNames(strategies, ", ")), strategies), nil
+}
+
+// describeMajority describes the given majority strategy.
+func describeMajority(s *strategy.MajorityStrategy) (*StrategySpec, error) {
+	return describeGroup(s.Name(), s.Strategies)
+}
+
+// buildSplit builds a new split strategy with the buy strategy followed by the sell strategy.
+func buildSplit(p *Parameters) (strategy.Strategy, error) {
+	strategies := p.Strategies(2, 2)
+	return strategy.NewSplitStrategy(strategies[0], strategies[1]), nil
+}
+
+// describeSplit describes the given split strategy.
+func describeSplit(s *strategy.SplitStrategy) (*StrategySpec, error) {
+	return describeGroup("", []strategy.Strategy{s.BuyStrategy, s.SellStrategy})
+}
+
+// buildDivergence builds a new divergence strategy. The inner strategy must be an oscillator strategy.
+func buildDivergence(p *Parameters) (strategy.Strategy, error) {
+	innerStrategy, ok := p.Strategies(1, 1)[0].(decorator.OscillatorStrategy)
+	if !ok {
+		p.fail("strategies[0]", fmt.Errorf("%w: expected an oscillator strategy", ErrInvalidStrategies))
+		innerStrategy = momentumstrategy.NewRsiStrategy()
+	}
+
+	return decorator.NewDivergenceStrategyWith(
+		innerStrategy,
+		p.Period("lookback", momentum.DefaultDivergenceLookback),
+		p.Period("window", decorator.DefaultDivergenceStrategyWindow),
+	), nil
+}
+
+// describeDivergence describes the given divergence strategy.
+func describeDivergence(s *decorator.DivergenceStrategy) (*StrategySpec, error) {
+	if s.Divergence.MaxRange != momentum.DefaultDivergenceMaxRange {
+		return nil, fmt.Errorf("%w: only the default maximum range is supported for %s", ErrUnsupportedStrategy, s.Name())
+	}
+
+	spec, err := describeGroup("", []strategy.Strategy{s.InnerStrategy})
+	if err != nil {
+		return nil, err
+	}
+
+	spec.Parameters = map[string]any{
+		"lookback": s.Divergence.Lookback,
+		"window":   s.Window,
+	}
+
+	return spec, nil
+}
+
+// buildInverse builds a new inverse strategy.
+func buildInverse(p *Parameters) (strategy.Strategy, error) {
+	return decorator.NewInverseStrategy(p.Strategies(1, 1)[0]), nil
+}
+
+// describeInverse describes the given inverse strategy.
+func describeInverse(s *decorator.InverseStrategy) (*StrategySpec, error) {
+	return describeGroup("", []strategy.Strategy{s.InnerStrategy})
+}
+
+// buildNoLoss builds a new no loss strategy.
+func buildNoLoss(p *Parameters) (strategy.Strategy, error) {
+	return decorator.NewNoLossStrategy(p.Strategies(1, 1)[0]), nil
+}
+
+// describeNoLoss describes the given no loss strategy.
+func describeNoLoss(s *decorator.NoLossStrategy) (*StrategySpec, error) {
+	return describeGroup("", []strategy.Strategy{s.InnertStrategy})
+}
+
+// buildStopLoss builds a new stop loss strategy.
+func buildStopLoss(p *Parameters) (strategy.Strategy, error) {
+	p.Require("percentage")
+	return decorator.NewStopLossStrategy(p.Strategies(1, 1)[0], p.Float("percentage", 0)), nil
+}
+
+// describeStopLoss describes the given stop loss strategy.
+func describeStopLoss(s *decorator.StopLossStrategy) (*StrategySpec, error) {
+	spec, err := describeGroup("", []strategy.Strategy{s.InnertStrategy})
+	if err != nil {
+		return nil, err
+	}
+
+	spec.Parameters = map[string]any{
+		"percentage": s.Percentage,
+	}
+
+	return spec, nil
+}
+
+// buildMacdRsi builds a new MACD-RSI strategy.
+func buildMacdRsi(p *Parameters) (strategy.Strategy, error) {
+	return compound.NewMacdRsiStrategyWith(
+		p.Float("buyAt", compound.DefaultMacdRsiStrategyBuyAt),
+		p.Float("sellAt", compound.DefaultMacdRsiStrategySellAt),
+	), nil
+}
+
+// describeMacdRsi describes the given MACD-RSI strategy.
+func describeMacdRsi(s *compound.MacdRsiStrategy) (*StrategySpec, error) {
+	return &StrategySpec{
+		Parameters: map[string]any{
+			"buyAt":  s.RsiStrategy.BuyAt,
+			"sellAt": s.RsiStrategy.SellAt,
+		},
+	}, nil
+}
+
+// buildAdx builds a new ADX strategy.
+func buildAdx(p *Parameters) (strategy.Strategy, error) {
+	return trendstrategy.NewAdxStrategyWith(
+		p.Period("period", trend.DefaultAdxPeriod),
+		p.Float("threshold", trendstrategy.DefaultAdxStrategyThreshold),
+	), nil
+}
+
+// describeAdx describes the given ADX strategy.
+func describeAdx(s *trendstrategy.AdxStrategy) (*StrategySpec, error) {
+	if s.Adx.Dmi.Rma.Period != s.Adx.Rma.Period {
+		return nil, fmt.Errorf("%w: only the same DMI and ADX periods are supported for %s", ErrUnsupportedStrategy, s.Name())
+	}
+
+	return &StrategySpec{
+		Parameters: map[string]any{
+			"period":    s.Adx.Rma.Period,
+			"threshold": s.Threshold,
+		},
+	}, nil
+}
+
+// buildAlligator builds a new Alligator strategy.
+func buildAlligator(p *Parameters) (strategy.Strategy, error) {
+	return trendstrategy.NewAlligatorStrategyWith(
+		p.Period("jawPeriod", trendstrategy.DefaultAlligatorStrategyJawPeriod),
+		p.Period("teethPeriod", trendstrategy.DefaultAlligatorStrategyTeethPeriod),
+		p.Period("lipPeriod", trendstrategy.DefaultAlligatorStrategyLipPeriod),
+	), nil
+}
+
+// describeAlligator describes the given Alligator strategy.
+func describeAlligator(s *trendstrategy.AlligatorStrategy) (*StrategySpec, error) {
+	return &StrategySpec{
+		Parameters: map[string]any{
+			"jawPeriod":   s.Jaw.Period,
+			"teethPeriod": s.Teeth.Period,
+			"lipPeriod":   s.Lip.Period,
+		},
+	}, nil
+}
+
+// buildCfo builds a new CFO strategy.
+func buildCfo(p *Parameters) (strategy.Strategy, error) {
+	return trendstrategy.NewCfoStrategyWith(
+		p.Period("period", trend.DefaultCfoPeriod),
+	), nil
+}
+
+// describeCfo describes the given CFO strategy.
+func describeCfo(s *trendstrategy.CfoStrategy) (*StrategySpec, error) {
+	return &StrategySpec{
+		Parameters: map[string]any{
+			"period": s.Cfo.Mlr.Mls.Sum.Period,
+		},
+	}, nil
+}
+
+// buildEnvelope builds a new Envelope strategy.
+func buildEnvelope(p *Parameters) (strategy.Strategy, error) {
+	return trendstrategy.NewEnvelopeStrategyWith(
+		trend.NewEnvelope(
+			newMa(
+				p.String("ma", "sma", maTypes...),
+				p.Period("period", trend.DefaultEnvelopePeriod),
+			),
+			p.Float("percentage", trend.DefaultEnvelopePercentage),
+		),
+	), nil
+}
+
+// describeEnvelope describes the given Envelope strategy.
+func describeEnvelope(s *trendstrategy.EnvelopeStrategy) (*StrategySpec, error) {
+	maType, period, err := describeMa(s.Envelope.Ma)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StrategySpec{
+		Parameters: map[string]any{
+			"ma":         maType,
+			"period":     period,
+			"percentage": s.Envelope.Percentage,
+		},
+	}, nil
+}
+
+// buildGoldenCross builds a new Golden Cross strategy.
+func buildGoldenCross(p *Parameters) (strategy.Strategy, error) {
+	return trendstrategy.NewGoldenCrossStrategyWith(
+		p.Period("fastPeriod", trendstrategy.DefaultGoldenCrossStrategyFastPeriod),
+		p.Period("slowPeriod", trendstrategy.DefaultGoldenCrossStrategySlowPeriod),
+	), nil
+}
+
+// describeGoldenCross describes the given Golden Cross strategy.
+func describeGoldenCross(s *trendstrategy.GoldenCrossStrategy) (*StrategySpec, error) {
+	return &StrategySpec{
+		Parameters: map[string]any{
+			"fastPeriod": s.FastEma.Period,
+			"slowPeriod": s.SlowEma.Period,
+		},
+	}, nil
+}
+
+// buildKama builds a new KAMA strategy.
+func buildKama(p *Parameters) (strategy.Strategy, error) {
+	return trendstrategy.NewKamaStrategyWith(
+		p.Period("erPeriod", trend.DefaultKamaErPeriod),
+		p.Period("fastScPeriod", trend.DefaultKamaFastScPeriod),
+		p.Period("slowScPeriod", trend.DefaultKamaSlowScPeriod),
+	), nil
+}
+
+// describeKama describes the given KAMA strategy.
+func describeKama(s *trendstrategy.KamaStrategy) (*StrategySpec, error) {
+	return &StrategySpec{
+		Parameters: map[string]any{
+			"erPeriod":     s.Kama.ErPeriod,
+			"fastScPeriod": s.Kama.FastScPeriod,
+			"slowScPeriod": s.Kama.SlowScPeriod,
+		},
+	}, nil
+}
+
+// buildMaCrossover builds a new MA crossover strategy.
+func buildMaCrossover(p *Parameters) (strategy.Strategy, error) {
+	return trendstrategy.NewMaCrossoverStrategyWith(
+		newMa(
+			p.String("fastMa", "ema", maTypes...),
+			p.Period("fastPeriod", trendstrategy.DefaultMaCrossoverStrategyFastPeriod),
+		),
+		newMa(
+			p.String("slowMa", "ema", maTypes...),
+			p.Period("slowPeriod", trendstrategy.DefaultMaCrossoverStrategySlowPeriod),
+		),
+	), nil
+}
+
+// describeMaCrossover describes the given MA crossover strategy.
+func describeMaCrossover(s *trendstrategy.MaCrossoverStrategy) (*StrategySpec, error) {
+	fastMa, fastPeriod, err := describeMa(s.Fast)
+	if err != nil {
+		return nil, err
+	}
+
+	slowMa, slowPeriod, err := describeMa(s.Slow)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StrategySpec{
+		Parameters: map[string]any{
+			"fastMa":     fastMa,
+			"fastPeriod": fastPeriod,
+			"slowMa":     slowMa,
+			"slowPeriod": slowPeriod,
+		},
+	}, nil
+}
+
+// buildMacd builds a new MACD strategy.
+func buildMacd(p *Parameters) (strategy.Strategy, error) {
+	return trendstrategy.NewMacdStrategyWith(
+		p.Period("period1", trend.DefaultMacdPeriod1),
+		p.Period("period2", trend.DefaultMacdPeriod2),
+		p.Period("period3", trend.DefaultMacdPeriod3),
+	), nil
+}
+
+// describeMacd describes the given MACD strategy.
+func describeMacd(s *trendstrategy.MacdStrategy) (*StrategySpec, error) {
+	return &StrategySpec{
+		Parameters: map[string]any{
+			"period1": s.Macd.Ema1.Period,
+			"period2": s.Macd.Ema2.Period,
+			"period3": s.Macd.Ema3.Period,
+		},
+	}, nil
+}
+
+// buildParabolicSar builds a new Parabolic SAR strategy.
+func buildParabolicSar(p *Parameters) (strategy.Strategy, error) {
+	return trendstrategy.NewParabolicSarStrategyWith(
+		p.Float("step", trend.DefaultParabolicSarStep),
+		p.Float("max", trend.DefaultParabolicSarMax),
+	), nil
+}
+
+// describeParabolicSar describes the given Parabolic SAR strategy.
+func describeParabolicSar(s *trendstrategy.ParabolicSarStrategy) (*StrategySpec, error) {
+	return &StrategySpec{
+		Parameters: map[string]any{
+			"step": s.ParabolicSar.Step,
+			"max":  s.ParabolicSar.Max,
+		},
+	}, nil
+}
+
+// buildSmma builds a new SMMA strategy.
+func buildSmma(p *Parameters) (strategy.Strategy, error) {
+	return trendstrategy.NewSmmaStrategyWith(
+		p.Period("shortPeriod", trendstrategy.DefaultSmmaStrategyShortPeriod),
+		p.Period("longPeriod", trendstrategy.DefaultSmmaStrategyLongPeriod),
+	), nil
+}
+
+// describeSmma describes the given SMMA strategy.
+func describeSmma(s *trendstrategy.SmmaStrategy) (*StrategySpec, error) {
+	return &StrategySpec{
+		Parameters: map[string]any{
+			"shortPeriod": s.ShortSmma.Period,
+			"longPeriod":  s.LongSmma.Period,
+		},
+	}, nil
+}
+
+// buildTripleMaCrossover builds a new Triple Moving Average Crossover strategy.
+func buildTripleMaCrossover(p *Parameters) (strategy.Strategy, error) {
+	return trendstrategy.NewTripleMovingAverageCrossoverStrategyWith(
+		p.Period("fastPeriod", trendstrategy.DefaultTripleMovingAverageCrossoverStrategyFastPeriod),
+		p.Period("mediumPeriod", trendstrategy.DefaultTripleMovingAverageCrossoverStrategyMediumPeriod),
+		p.Period("slowPeriod", trendstrategy.DefaultTripleMovingAverageCrossoverStrategySlowPeriod),
+	), nil
+}
+
+// describeTripleMaCrossover describes the given Triple Moving Average Crossover strategy.
+func describeTripleMaCrossover(s *trendstrategy.TripleMovingAverageCrossoverStrategy) (*StrategySpec, error) {
+	return &StrategySpec{
+		Parameters: map[string]any{
+			"fastPeriod":   s.FastEma.Period,
+			"mediumPeriod": s.MediumEma.Period,
+			"slowPeriod":   s.SlowEma.Period,
+		},
+	}, nil
+}
+
+// buildTsi builds a new TSI strategy.
+func buildTsi(p *Parameters) (strategy.Strategy, error) {
+	return trendstrategy.NewTsiStrategyWith(
+		p.Period("firstSmoothingPeriod", trend.DefaultTsiFirstSmoothingPeriod),
+		p.Period("secondSmoothingPeriod", trend.DefaultTsiSecondSmoothingPeriod),
+		p.Period("signalPeriod", trendstrategy.DefaultTsiStrategySignalPeriod),
+	), nil
+}
+
+// describeTsi describes the given TSI strategy.
+func describeTsi(s *trendstrategy.TsiStrategy) (*StrategySpec, error) {
+	first, firstOk := s.Tsi.FirstSmoothing.(*trend.Ema[float64])
+	second, secondOk := s.Tsi.SecondSmoothing.(*trend.Ema[float64])
+	signal, signalOk := s.Signal.(*trend.Ema[float64])
+
+	if !firstOk || !secondOk || !signalOk {
+		return nil, fmt.Errorf("%w: only EMA smoothing is supported for %s", ErrUnsupportedStrategy, s.Name())
+	}
+
+	return &StrategySpec{
+		Parameters: map[string]any{
+			"firstSmoothingPeriod":  first.Period,
+			"secondSmoothingPeriod": second.Period,
+			"signalPeriod":          signal.Period,
+		},
+	}, nil
+}
+
+// buildVortex builds a new Vortex strategy.
+func buildVortex(p *Parameters) (strategy.Strategy, error) {
+	return trendstrategy.NewVortexStrategyWith(
+		p.Period("period", trend.DefaultVortexPeriod),
+	), nil
+}
+
+// describeVortex describes the given Vortex strategy.
+func describeVortex(s *trendstrategy.VortexStrategy) (*StrategySpec, error) {
+	return &StrategySpec{
+		Parameters: map[string]any{
+			"period": s.Vortex.Sum.Period,
+		},
+	}, nil
+}
+
+// buildWeightedClose builds a new Weighted Close strategy.
+func buildWeightedClose(p *Parameters) (strategy.Strategy, error) {
+	return trendstrategy.NewWeightedCloseStrategyWith(
+		p.Period("maPeriod", trendstrategy.DefaultWeightedCloseStrategyMaPeriod),
+	), nil
+}
+
+// describeWeightedClose describes the given Weighted Close strategy.
+func describeWeightedClose(s *trendstrategy.WeightedCloseStrategy) (*StrategySpec, error) {
+	sma, ok := s.Ma.(*trend.Sma[float64])
+	if !ok {
+		return nil, fmt.Errorf("%w: only SMA is supported for %s", ErrUnsupportedStrategy, s.Name())
+	}
+
+	return &StrategySpec{
+		Parameters: map[string]any{
+			"maPeriod": sma.Period,
+		},
+	}, nil
+}
+
+// buildRsi builds a new RSI strategy.
+func buildRsi(p *Parameters) (strategy.Strategy, error) {
+	s := momentumstrategy.NewRsiStrategyWith(
+		p.Float("buyAt", momentumstrategy.DefaultRsiStrategyBuyAt),
+		p.Float("sellAt", momentumstrategy.DefaultRsiStrategySellAt),
+	)
+
+	s.Rsi = momentum.NewRsiWithPeriod[float64](p.Period("period", momentum.DefaultRsiPeriod))
+
+	return s, nil
+}
+
+// describeRsi describes the given RSI strategy.
+func describeRsi(s *momentumstrategy.RsiStrategy) (*StrategySpec, error) {
+	return &StrategySpec{
+		Parameters: map[string]any{
+			"period": s.Rsi.Rma.Period,
+			"buyAt":  s.BuyAt,
+			"sellAt": s.SellAt,
+		},
+	}, nil
+}
+
+// buildStochasticRsi builds a new Stochastic RSI strategy.
+func buildStochasticRsi(p *Parameters) (strategy.Strategy, error) {
+	return momentumstrategy.NewStochasticRsiStrategyWith(
+		p.Float("buyAt", momentumstrategy.DefaultStochasticRsiStrategyBuyAt),
+		p.Float("sellAt", momentumstrategy.DefaultStochasticRsiStrategySellAt),
+	), nil
+}
+
+// describeStochasticRsi describes the given Stochastic RSI strategy.
+func describeStochasticRsi(s *momentumstrategy.StochasticRsiStrategy) (*StrategySpec, error) {
+	return &StrategySpec{
+		Parameters: map[string]any{
+			"buyAt":  s.BuyAt,
+			"sellAt": s.SellAt,
+		},
+	}, nil
+}
+
+// buildTripleRsi builds a new Triple RSI strategy.
+func buildTripleRsi(p *Parameters) (strategy.Strategy, error) {
+	return momentumstrategy.NewTripleRsiStrategyWith(
+		p.Period("period", momentumstrategy.DefaultTripleRsiStrategyPeriod),
+		p.Period("smaPeriod", momentumstrategy.DefaultTripleRsiStrategyMovingAveragePeriod),
+		p.Period("downDays", momentumstrategy.DefaultTripleRsiStrategyDownDays),
+		p.Float("buySignalAt", momentumstrategy.DefaultTripleRsiStrategyBuySignalAt),
+		p.Float("buyAt", momentumstrategy.DefaultTripleRsiStrategyBuyAt),
+		p.Float("sellAt", momentumstrategy.DefaultTripleRsiStrategySellAt),
+	), nil
+}
+
+// describeTripleRsi describes the given Triple RSI strategy.
+func describeTripleRsi(s *momentumstrategy.TripleRsiStrategy) (*StrategySpec, error) {
+	return &StrategySpec{
+		Parameters: map[string]any{
+			"period":      s.Rsi.Rma.Period,
+			"smaPeriod":   s.Sma.Period,
+			"downDays":    s.DownDays,
+			"buySignalAt": s.BuySignalAt,
+			"buyAt":       s.BuyAt,
+			"sellAt":      s.SellAt,
+		},
+	}, nil
+}
+
+// buildWilliamsR builds a new Williams R strategy.
+func buildWilliamsR(p *Parameters) (strategy.Strategy, error) {
+	return momentumstrategy.NewWilliamsRStrategyWith(
+		p.Float("buyAt", momentumstrategy.DefaultWilliamsRStrategyBuyAt),
+		p.Float("sellAt", momentumstrategy.DefaultWilliamsRStrategySellAt),
+	), nil
+}
+
+// describeWilliamsR describes the given Williams R strategy.
+func describeWilliamsR(s *momentumstrategy.WilliamsRStrategy) (*StrategySpec, error) {
+	if s.WilliamsR.Max.Period != momentum.DefaultWilliamsRPeriod || s.WilliamsR.Min.Period != momentum.DefaultWilliamsRPeriod {
+		return nil, fmt.Errorf("%w: only the default period is supported for %s", ErrUnsupportedStrategy, s.Name())
+	}
+
+	return &StrategySpec{
+		Parameters: map[string]any{
+			"buyAt":  s.BuyAt,
+			"sellAt": s.SellAt,
+		},
+	}, nil
+}
+
+// buildCandlestickPattern builds a new candlestick pattern strategy.
+func buildCandlestickPattern(p *Parameters) (strategy.Strategy, error) {
+	detector := pattern.NewDetector()
+	detector.DojiBody = p.Float("dojiBody", pattern.DefaultDojiBody)
+	detector.SmallBody = p.Float("smallBody", pattern.DefaultSmallBody)
+	detector.LongShadow = p.Float("longShadow", pattern.DefaultLongShadow)
+	detector.ShortShadow = p.Float("shortShadow", pattern.DefaultShortShadow)
+	detector.TrendPeriod = p.Period("trendPeriod", pattern.DefaultTrendPeriod)
+
+	return patternstrategy.NewPatternStrategyWith(detector), nil
+}
+
+// describeCandlestickPattern describes the given candlestick pattern strategy.
+func describeCandlestickPattern(s *patternstrategy.PatternStrategy) (*StrategySpec, error) {
+	return &StrategySpec{
+		Parameters: map[string]any{
+			"dojiBody":    s.Detector.DojiBody,
+			"smallBody":   s.Detector.SmallBody,
+			"longShadow":  s.Detector.LongShadow,
+			"shortShadow": s.Detector.ShortShadow,
+			"trendPeriod": s.Detector.TrendPeriod,
+		},
+	}, nil
+}
+
+// buildDonchianBreakout builds a new Donchian Channel breakout strategy.
+func buildDonchianBreakout(p *Parameters) (strategy.Strategy, error) {
+	return volatilitystrategy.NewDonchianBreakoutStrategyWith(
+		p.Period("period", volatility.DefaultDonchianChannelPeriod),
+	), nil
+}
+
+// describeDonchianBreakout describes the given Donchian Channel breakout strategy.
+func describeDonchianBreakout(s *volatilitystrategy.DonchianBreakoutStrategy) (*StrategySpec, error) {
+	return &StrategySpec{
+		Parameters: map[string]any{
+			"period": s.DonchianChannel.Max.Period,
+		},
+	}, nil
+}
+
+// buildSuperTrend builds a new Super Trend strategy.
+func buildSuperTrend(p *Parameters) (strategy.Strategy, error) {
+	return volatilitystrategy.NewSuperTrendStrategyWith(
+		volatility.NewSuperTrendWithMa(
+			newMa(
+				p.String("ma", "hma", maTypes...),
+				p.Period("period", volatility.DefaultSuperTrendPeriod),
+			),
+			p.Float("multiplier", volatility.DefaultSuperTrendMultiplier),
+		),
+	), nil
+}
+
+// describeSuperTrend describes the given Super Trend strategy.
+func describeSuperTrend(s *volatilitystrategy.SuperTrendStrategy) (*StrategySpec, error) {
+	maType, period, err := describeMa(s.SuperTrend.Atr.Ma)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StrategySpec{
+		Parameters: map[string]any{
+			"ma":         maType,
+			"period":     period,
+			"multiplier": s.SuperTrend.Multiplier,
+		},
+	}, nil
+}
+
+// buildVolatilityRegime builds a new Volatility Regime strategy.
+func buildVolatilityRegime(p *Parameters) (strategy.Strategy, error) {
+	return volatilitystrategy.NewVolatilityRegimeStrategyWith(
+		p.Period("period", volatility.DefaultHistoricalVolatilityPeriod),
+		p.Period("lookback", volatilitystrategy.DefaultVolatilityRegimeStrategyLookback),
+		p.Float("percentile", volatilitystrategy.DefaultVolatilityRegimeStrategyPercentile),
+	), nil
+}
+
+// describeVolatilityRegime describes the given Volatility Regime strategy.
+func describeVolatilityRegime(s *volatilitystrategy.VolatilityRegimeStrategy) (*StrategySpec, error) {
+	if s.Volatility.Annualization != volatility.DefaultAnnualization {
+		return nil, fmt.Errorf("%w: %s annualization", ErrUnsupportedStrategy, s.Name())
+	}
+
+	return &StrategySpec{
+		Parameters: map[string]any{
+			"period":     s.Volatility.Period,
+			"lookback":   s.Lookback,
+			"percentile": s.Percentile,
+		},
+	}, nil
+}
+
+// buildAnchoredVwap builds a new anchored VWAP strategy.
+func buildAnchoredVwap(p *Parameters) (strategy.Strategy, error) {
+	return volumestrategy.NewAnchoredVwapStrategyWith(
+		volume.NewAnchoredVwapWith[float64](
+			sessions[p.String("session", "daily", "daily", "weekly", "monthly")],
+			p.Float("multiplier", volume.DefaultAnchoredVwapMultiplier),
+		),
+		anchoredVwapModes[p.String("mode", "reversion", "reversion", "breakout")],
+	), nil
+}
+
+// describeAnchoredVwap describes the given anchored VWAP strategy.
+func describeAnchoredVwap(s *volumestrategy.AnchoredVwapStrategy) (*StrategySpec, error) {
+	session := ""
+
+	// Only the named session timeframes can be described.
+	for name, timeframe := range sessions {
+		if reflect.ValueOf(timeframe).Pointer() == reflect.ValueOf(s.AnchoredVwap.Session).Pointer() {
+			session = name
+		}
+	}
+
+	if session == "" {
+		return nil, fmt.Errorf("%w: %s session", ErrUnsupportedStrategy, s.Name())
+	}
+
+	return &StrategySpec{
+		Parameters: map[string]any{
+			"session":    session,
+			"multiplier": s.AnchoredVwap.Multiplier,
+			"mode":       strings.ToLower(s.Mode.String()),
+		},
+	}, nil
+}
+
+// buildChaikinMoneyFlow builds a new Chaikin Money Flow strategy.
+func buildChaikinMoneyFlow(p *Parameters) (strategy.Strategy, error) {
+	return volumestrategy.NewChaikinMoneyFlowStrategyWith(
+		p.Period("period", volume.DefaultCmfPeriod),
+	), nil
+}
+
+// describeChaikinMoneyFlow describes the given Chaikin Money Flow strategy.
+func describeChaikinMoneyFlow(s *volumestrategy.ChaikinMoneyFlowStrategy) (*StrategySpec, error) {
+	return &StrategySpec{
+		Parameters: map[string]any{
+			"period": s.ChaikinMoneyFlow.Sum.Period,
+		},
+	}, nil
+}
+
+// buildEaseOfMovement builds a new Ease of Movement strategy.
+func buildEaseOfMovement(p *Parameters) (strategy.Strategy, error) {
+	return volumestrategy.NewEaseOfMovementStrategyWith(
+		p.Period("period", volume.DefaultEmvPeriod),
+	), nil
+}
+
+// describeEaseOfMovement describes the given Ease of Movement strategy.
+func describeEaseOfMovement(s *volumestrategy.EaseOfMovementStrategy) (*StrategySpec, error) {
+	return &StrategySpec{
+		Parameters: map[string]any{
+			"period": s.EaseOfMovement.Sma.Period,
+		},
+	}, nil
+}
+
+// buildForceIndex builds a new Force Index strategy.
+func buildForceIndex(p *Parameters) (strategy.Strategy, error) {
+	return volumestrategy.NewForceIndexStrategyWith(
+		p.Period("period", volume.DefaultFiPeriod),
+	), nil
+}
+
+// describeForceIndex describes the given Force Index strategy.
+func describeForceIndex(s *volumestrategy.ForceIndexStrategy) (*StrategySpec, error) {
+	return &StrategySpec{
+		Parameters: map[string]any{
+			"period": s.ForceIndex.Ema.Period,
+		},
+	}, nil
+}
+
+// buildMoneyFlowIndex builds a new Money Flow Index strategy.
+func buildMoneyFlowIndex(p *Parameters) (strategy.Strategy, error) {
+	return volumestrategy.NewMoneyFlowIndexStrategyWith(
+		p.Float("sellAt", volumestrategy.DefaultMoneyFlowIndexStrategySellAt),
+		p.Float("buyAt", volumestrategy.DefaultMoneyFlowIndexStrategyBuyAt),
+	), nil
+}
+
+// describeMoneyFlowIndex describes the given Money Flow Index strategy.
+func describeMoneyFlowIndex(s *volumestrategy.MoneyFlowIndexStrategy) (*StrategySpec, error) {
+	return &StrategySpec{
+		Parameters: map[string]any{
+			"sellAt": s.SellAt,
+			"buyAt":  s.BuyAt,
+		},
+	}, nil
+}
+
+// buildNegativeVolumeIndex builds a new Negative Volume Index strategy.
+func buildNegativeVolumeIndex(p *Parameters) (strategy.Strategy, error) {
+	return volumestrategy.NewNegativeVolumeIndexStrategyWith(
+		p.Period("emaPeriod", volumestrategy.DefaultNegativeVolumeIndexStrategyEmaPeriod),
+	), nil
+}
+
+// describeNegativeVolumeIndex describes the given Negative Volume Index strategy.
+func describeNegativeVolumeIndex(s *volumestrategy.NegativeVolumeIndexStrategy) (*StrategySpec, error) {
+	return &StrategySpec{
+		Parameters: map[string]any{
+			"emaPeriod": s.NegativeVolumeIndexEma.Period,
+		},
+	}, nil
+}
+
+// buildVwapEma builds a new VWAP EMA strategy.
+func buildVwapEma(p *Parameters) (strategy.Strategy, error) {
+	return volumestrategy.NewVwapEmaStrategyWith(
+		p.Period("fastPeriod", volumestrategy.DefaultVwapEmaStrategyFastPeriod),
+		p.Period("slowPeriod", volumestrategy.DefaultVwapEmaStrategySlowPeriod),
+		p.Period("vwapPeriod", volumestrategy.DefaultVwapEmaStrategyVwapPeriod),
+	), nil
+}
+
+// describeVwapEma describes the given VWAP EMA strategy.
+func describeVwapEma(s *volumestrategy.VwapEmaStrategy) (*StrategySpec, error) {
+	return &StrategySpec{
+		Parameters: map[string]any{
+			"fastPeriod": s.FastEma.Period,
+			"slowPeriod": s.SlowEma.Period,
+			"vwapPeriod": s.Vwap.Sum.Period,
+		},
+	}, nil
+}
+
+// buildWeightedAveragePrice builds a new Weighted Average Price strategy.
+func buildWeightedAveragePrice(p *Parameters) (strategy.Strategy, error) {
+	return volumestrategy.NewWeightedAveragePriceStrategyWith(
+		p.Period("period", volume.DefaultVwapPeriod),
+	), nil
+}
+
+// describeWeightedAveragePrice describes the given Weighted Average Price strategy.
+func describeWeightedAveragePrice(s *volumestrategy.WeightedAveragePriceStrategy) (*StrategySpec, error) {
+	return &StrategySpec{
+		Parameters: map[string]any{
+			"period": s.WeightedAveragePrice.Sum.Period,
+		},
+	}, nil
+}
+
+// describeGroup describes a strategy with the given name and inner strategies.
+func describeGroup(name string, strategies []strategy.Strategy) (*StrategySpec, error) {
+	specs, err := describeAll(strategies)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StrategySpec{
+		Name:       name,
+		Strategies: specs,
+	}, nil
+}
+
+// joinNames joins the names of the given strategies with the given separator.
+func joinNames(strategies []strategy.Strategy, separator string) string {
+	names := make([]string, len(strategies))
+	for i, s := range strategies {
+		names[i] = s.Name()
+	}
+
+	return strings.Join(names, separator)
+}
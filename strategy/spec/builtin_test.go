@@ -0,0 +1,160 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package spec_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/strategy/compound"
+	"github.com/cinar/indicator/v2/strategy/decorator"
+	"github.com/cinar/indicator/v2/strategy/momentum"
+	"github.com/cinar/indicator/v2/strategy/pattern"
+	"github.com/cinar/indicator/v2/strategy/spec"
+	"github.com/cinar/indicator/v2/strategy/trend"
+	"github.com/cinar/indicator/v2/strategy/volatility"
+	"github.com/cinar/indicator/v2/strategy/volume"
+	trendindicator "github.com/cinar/indicator/v2/trend"
+	volumeindicator "github.com/cinar/indicator/v2/volume"
+)
+
+func TestBuiltinRoundTrip(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshotsSlice := helper.ChanToSlice(snapshots)
+
+	strategies := []strategy.Strategy{}
+	strategies = append(strategies, compound.AllStrategies()...)
+	strategies = append(strategies, momentum.AllStrategies()...)
+	strategies = append(strategies, pattern.AllStrategies()...)
+	strategies = append(strategies, strategy.AllStrategies()...)
+	strategies = append(strategies, trend.AllStrategies()...)
+	strategies = append(strategies, volatility.AllStrategies()...)
+	strategies = append(strategies, volume.AllStrategies()...)
+
+	strategies = append(strategies,
+		strategy.NewAndStrategy("And", trend.NewMacdStrategyWith(5, 10, 3), momentum.NewRsiStrategyWith(20, 80)),
+		strategy.NewOrStrategy("Or", trend.NewKamaStrategyWith(5, 3, 20), trend.NewSmmaStrategyWith(5, 10)),
+		strategy.NewMajorityStrategyWith("Majority", []strategy.Strategy{trend.NewAlligatorStrategyWith(8, 5, 3), trend.NewTsiStrategyWith(10, 5, 4)}),
+		strategy.NewSplitStrategy(trend.NewTripleMovingAverageCrossoverStrategyWith(5, 10, 20), trend.NewWeightedCloseStrategyWith(10)),
+		decorator.NewInverseStrategy(momentum.NewTripleRsiStrategyWith(4, 20, 2, 50, 25, 55)),
+		decorator.NewNoLossStrategy(momentum.NewStochasticRsiStrategyWith(0.7, 0.3)),
+		decorator.NewStopLossStrategy(volume.NewChaikinMoneyFlowStrategyWith(10), 0.02),
+		decorator.NewDivergenceStrategyWith(momentum.NewAwesomeOscillatorStrategy(), 3, 5),
+		trend.NewEnvelopeStrategyWith(trendindicator.NewEnvelope[float64](trendindicator.NewEmaWithPeriod[float64](10), 5)),
+		volume.NewEaseOfMovementStrategyWith(7),
+		volume.NewForceIndexStrategyWith(7),
+		volume.NewMoneyFlowIndexStrategyWith(70, 30),
+		volume.NewNegativeVolumeIndexStrategyWith(20),
+		volume.NewWeightedAveragePriceStrategyWith(7),
+		volume.NewVwapEmaStrategyWith(5, 12, 10),
+		volatility.NewDonchianBreakoutStrategyWith(10),
+		trend.NewGoldenCrossStrategyWith(10, 20),
+		trend.NewAdxStrategyWith(10, 20),
+		trend.NewCfoStrategyWith(10),
+		trend.NewParabolicSarStrategyWith(0.01, 0.1),
+		trend.NewVortexStrategyWith(10),
+		momentum.NewWilliamsRStrategyWith(-90, -10),
+		volume.NewAnchoredVwapStrategyWith(volumeindicator.NewAnchoredVwapWith[float64](asset.Monthly, 1.5), volume.AnchoredVwapBreakout),
+		volatility.NewVolatilityRegimeStrategyWith(10, 50, 70),
+		trend.NewMaCrossoverStrategyWith(trendindicator.NewAlmaWithPeriod[float64](5), trendindicator.NewT3WithPeriod[float64](4)),
+		trend.NewMaCrossoverStrategyWith(trendindicator.NewVidyaWithPeriod[float64](7), trendindicator.NewFramaWithPeriod[float64](12)),
+		trend.NewMaCrossoverStrategyWith(trendindicator.NewMcGinleyWithPeriod[float64](7), trendindicator.NewLinRegWithPeriod[float64](12)),
+		trend.NewMaCrossoverStrategyWith(trendindicator.NewZlemaWithPeriod[float64](7), trendindicator.NewSmaWithPeriod[float64](12)),
+	)
+
+	for _, expected := range strategies {
+		described, err := spec.Describe(expected)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		data, err := spec.MarshalYAML([]*spec.StrategySpec{described})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		specs, err := spec.Unmarshal(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		actual, err := spec.Build(specs[0])
+		if err != nil {
+			t.Fatalf("%s: %v", data, err)
+		}
+
+		if actual.Name() != expected.Name() {
+			t.Fatalf("actual %s expected %s", actual.Name(), expected.Name())
+		}
+
+		err = helper.CheckEquals(
+			actual.Compute(helper.SliceToChan(snapshotsSlice)),
+			expected.Compute(helper.SliceToChan(snapshotsSlice)),
+		)
+		if err != nil {
+			t.Fatalf("%s: %v", expected.Name(), err)
+		}
+	}
+}
+
+func TestBuiltinDefaults(t *testing.T) {
+	for _, name := range spec.Types() {
+		if name == "testing" {
+			continue
+		}
+
+		s := &spec.StrategySpec{Type: name}
+
+		switch name {
+		case "and", "or", "majority", "inverse", "no_loss":
+			s.Strategies = []*spec.StrategySpec{{Type: "buy_and_hold"}}
+
+		case "divergence":
+			s.Strategies = []*spec.StrategySpec{{Type: "rsi"}}
+
+		case "split":
+			s.Strategies = []*spec.StrategySpec{{Type: "buy_and_hold"}, {Type: "buy_and_hold"}}
+
+		case "stop_loss":
+			s.Strategies = []*spec.StrategySpec{{Type: "buy_and_hold"}}
+			s.Parameters = map[string]any{"percentage": 0.1}
+		}
+
+		_, err := spec.Build(s)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+	}
+}
+
+func TestBuiltinDescribeUnsupported(t *testing.T) {
+	unsupported := []strategy.Strategy{
+		&trend.WeightedCloseStrategy{
+			WeightedClose: trendindicator.NewWeightedClose[float64](),
+			Ma:            trendindicator.NewEmaWithPeriod[float64](10),
+		},
+		&trend.TsiStrategy{
+			Tsi:    trendindicator.NewTsi[float64](),
+			Signal: trendindicator.NewSmaWithPeriod[float64](10),
+		},
+		trend.NewEnvelopeStrategyWith(trendindicator.NewEnvelope[float64](trendindicator.NewKama[float64](), 5)),
+		strategy.NewAndStrategy("", &trend.CciStrategy{Cci: trendindicator.NewCciWithPeriod[float64](5)}),
+		volume.NewAnchoredVwapStrategyWith(volumeindicator.NewAnchoredVwapWith[float64](asset.Hours(4), 2), volume.AnchoredVwapReversion),
+		trend.NewMaCrossoverStrategyWith(trendindicator.NewAlmaWith[float64](9, 0.5, 6), trendindicator.NewEma[float64]()),
+	}
+
+	for _, s := range unsupported {
+		_, err := spec.Describe(s)
+		if err == nil {
+			t.Fatalf("expected error for %s", s.Name())
+		}
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package spec
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrMissingType indicates that the strategy spec does not have a type.
+	ErrMissingType = errors.New("missing type")
+
+	// ErrUnknownType indicates that the strategy spec type is not registered.
+	ErrUnknownType = errors.New("unknown type")
+
+	// ErrUnknownParameter indicates that the parameter is not used by the strategy type.
+	ErrUnknownParameter = errors.New("unknown parameter")
+
+	// ErrMissingParameter indicates that a required parameter is missing.
+	ErrMissingParameter = errors.New("missing parameter")
+
+	// ErrInvalidParameter indicates that the parameter value is not valid.
+	ErrInvalidParameter = errors.New("invalid parameter")
+
+	// ErrInvalidStrategies indicates that the number of inner strategies is not valid for the strategy type.
+	ErrInvalidStrategies = errors.New("invalid strategies")
+
+	// ErrUnsupportedStrategy indicates that the strategy can not be described as a spec.
+	ErrUnsupportedStrategy = errors.New("unsupported strategy")
+)
+
+// FieldError is the validation error for a field of a strategy spec. The field is the path to the offending
+// field, such as strategies[1].parameters.period1.
+type FieldError struct {
+	// Field is the path to the offending field.
+	Field string
+
+	// Err is the validation error.
+	Err error
+}
+
+// Error returns the error message.
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Field, e.Err)
+}
+
+// Unwrap returns the validation error.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// fieldPath appends the given field name to the given path.
+func fieldPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
@@ -0,0 +1,232 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package spec
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/cinar/indicator/v2/strategy"
+)
+
+// Parameters provides the builders with validated access to the name, the parameters, and the inner
+// strategies of a strategy spec. The first validation error is recorded with the path to the offending
+// field, and the parameters that are not read by the builder are reported as unknown.
+type Parameters struct {
+	// path is the path to the strategy spec.
+	path string
+
+	// spec is the strategy spec.
+	spec *StrategySpec
+
+	// strategies are the built inner strategies.
+	strategies []strategy.Strategy
+
+	// used is the set of parameters read by the builder.
+	used map[string]bool
+
+	// strategiesUsed indicates whether the inner strategies are read by the builder.
+	strategiesUsed bool
+
+	// err is the first validation error.
+	err error
+}
+
+// newParameters function initializes a new parameters instance for the given strategy spec.
+func newParameters(path string, spec *StrategySpec, strategies []strategy.Strategy) *Parameters {
+	return &Parameters{
+		path:       path,
+		spec:       spec,
+		strategies: strategies,
+		used:       make(map[string]bool),
+	}
+}
+
+// Name returns the name given in the spec, or the given default name.
+func (p *Parameters) Name(defaultName string) string {
+	if p.spec.Name == "" {
+		return defaultName
+	}
+
+	return p.spec.Name
+}
+
+// Require records a validation error if the named parameter is missing.
+func (p *Parameters) Require(name string) {
+	if _, ok := p.spec.Parameters[name]; !ok {
+		p.fail(fieldPath("parameters", name), ErrMissingParameter)
+	}
+}
+
+// Int returns the named integer parameter, or the given default value if the parameter is missing.
+func (p *Parameters) Int(name string, defaultValue int) int {
+	value, ok := p.lookup(name)
+	if !ok {
+		return defaultValue
+	}
+
+	switch v := value.(type) {
+	case int:
+		return v
+
+	case int64:
+		return int(v)
+
+	case uint64:
+		return int(v)
+
+	case float64:
+		if v == math.Trunc(v) {
+			return int(v)
+		}
+	}
+
+	p.fail(fieldPath("parameters", name), fmt.Errorf("%w: expected an integer, got %v", ErrInvalidParameter, value))
+
+	return defaultValue
+}
+
+// Period returns the named period parameter, or the given default value if the parameter is missing. The
+// period must be a positive integer.
+func (p *Parameters) Period(name string, defaultValue int) int {
+	period := p.Int(name, defaultValue)
+	if period <= 0 {
+		p.fail(fieldPath("parameters", name), fmt.Errorf("%w: expected a positive period, got %d", ErrInvalidParameter, period))
+		return defaultValue
+	}
+
+	return period
+}
+
+// Float returns the named floating point parameter, or the given default value if the parameter is missing.
+func (p *Parameters) Float(name string, defaultValue float64) float64 {
+	value, ok := p.lookup(name)
+	if !ok {
+		return defaultValue
+	}
+
+	switch v := value.(type) {
+	case float64:
+		return v
+
+	case int:
+		return float64(v)
+
+	case int64:
+		return float64(v)
+
+	case uint64:
+		return float64(v)
+	}
+
+	p.fail(fieldPath("parameters", name), fmt.Errorf("%w: expected a number, got %v", ErrInvalidParameter, value))
+
+	return defaultValue
+}
+
+// String returns the named string parameter, or the given default value if the parameter is missing. The
+// value must be one of the given choices when choices are provided.
+func (p *Parameters) String(name, defaultValue string, choices ...string) string {
+	value, ok := p.lookup(name)
+	if !ok {
+		return defaultValue
+	}
+
+	s, ok := value.(string)
+	if !ok {
+		p.fail(fieldPath("parameters", name), fmt.Errorf("%w: expected a string, got %v", ErrInvalidParameter, value))
+		return defaultValue
+	}
+
+	if len(choices) == 0 {
+		return s
+	}
+
+	for _, choice := range choices {
+		if s == choice {
+			return s
+		}
+	}
+
+	p.fail(fieldPath("parameters", name), fmt.Errorf("%w: expected one of %v, got %s", ErrInvalidParameter, choices, s))
+
+	return defaultValue
+}
+
+// Strategies returns the inner strategies. The number of inner strategies must be between the given minimum
+// and maximum, where a negative maximum means no upper limit. If the validation fails, the missing inner
+// strategies are filled with buy and hold strategies so that the builder can proceed.
+func (p *Parameters) Strategies(minimum, maximum int) []strategy.Strategy {
+	p.strategiesUsed = true
+
+	count := len(p.strategies)
+	if count >= minimum && (maximum < 0 || count <= maximum) {
+		return p.strategies
+	}
+
+	if maximum < 0 {
+		p.fail("strategies", fmt.Errorf("%w: expected at least %d strategies, got %d", ErrInvalidStrategies, minimum, count))
+	} else {
+		p.fail("strategies", fmt.Errorf("%w: expected between %d and %d strategies, got %d", ErrInvalidStrategies, minimum, maximum, count))
+	}
+
+	strategies := make([]strategy.Strategy, minimum)
+	for i := range strategies {
+		strategies[i] = strategy.NewBuyAndHoldStrategy()
+	}
+
+	return strategies
+}
+
+// Err returns the first validation error, or reports the parameters and the inner strategies that are given
+// but not used by the builder.
+func (p *Parameters) Err() error {
+	if p.err != nil {
+		return p.err
+	}
+
+	names := make([]string, 0, len(p.spec.Parameters))
+	for name := range p.spec.Parameters {
+		if !p.used[name] {
+			names = append(names, name)
+		}
+	}
+
+	if len(names) > 0 {
+		sort.Strings(names)
+		return &FieldError{
+			Field: fieldPath(p.path, fieldPath("parameters", names[0])),
+			Err:   ErrUnknownParameter,
+		}
+	}
+
+	if !p.strategiesUsed && len(p.strategies) > 0 {
+		return &FieldError{
+			Field: fieldPath(p.path, "strategies"),
+			Err:   fmt.Errorf("%w: %s does not take inner strategies", ErrInvalidStrategies, p.spec.Type),
+		}
+	}
+
+	return nil
+}
+
+// lookup returns the named parameter and marks it as used.
+func (p *Parameters) lookup(name string) (any, bool) {
+	p.used[name] = true
+
+	value, ok := p.spec.Parameters[name]
+	return value, ok
+}
+
+// fail records the given error for the given field unless an earlier error is already recorded.
+func (p *Parameters) fail(field string, err error) {
+	if p.err == nil {
+		p.err = &FieldError{
+			Field: fieldPath(p.path, field),
+			Err:   err,
+		}
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package spec
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cinar/indicator/v2/strategy"
+)
+
+// BuilderFunc defines a function to build a new strategy using the given parameters.
+type BuilderFunc func(parameters *Parameters) (strategy.Strategy, error)
+
+// DescriberFunc defines a function to describe the given strategy as a spec. It returns nil if the strategy
+// is not of the type that it describes. The type of the returned spec is set by the registry.
+type DescriberFunc func(s strategy.Strategy) (*StrategySpec, error)
+
+// registration is the builder and the describer registered for a strategy type.
+type registration struct {
+	builder   BuilderFunc
+	describer DescriberFunc
+}
+
+// RegisterStrategy registers the given builder and describer for the given strategy type. The describer
+// is optional, and without it the strategies of this type can not be described.
+func RegisterStrategy(name string, builder BuilderFunc, describer DescriberFunc) {
+	registrations[name] = registration{
+		builder:   builder,
+		describer: describer,
+	}
+}
+
+// Types returns the registered strategy types in sorted order.
+func Types() []string {
+	types := make([]string, 0, len(registrations))
+	for name := range registrations {
+		types = append(types, name)
+	}
+
+	sort.Strings(types)
+
+	return types
+}
+
+// Build builds a new strategy from the given strategy spec.
+func Build(spec *StrategySpec) (strategy.Strategy, error) {
+	return build("", spec)
+}
+
+// Describe returns the strategy spec for the given strategy. Building the returned spec results in an
+// equivalent strategy.
+func Describe(s strategy.Strategy) (*StrategySpec, error) {
+	for _, name := range Types() {
+		describer := registrations[name].describer
+		if describer == nil {
+			continue
+		}
+
+		spec, err := describer(s)
+		if err != nil {
+			return nil, err
+		}
+
+		if spec != nil {
+			spec.Type = name
+			return spec, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrUnsupportedStrategy, s.Name())
+}
+
+// build builds a new strategy from the given strategy spec, prefixing the validation errors with the
+// given path.
+func build(path string, spec *StrategySpec) (strategy.Strategy, error) {
+	if spec == nil || spec.Type == "" {
+		return nil, &FieldError{
+			Field: fieldPath(path, "type"),
+			Err:   ErrMissingType,
+		}
+	}
+
+	registration, ok := registrations[spec.Type]
+	if !ok {
+		return nil, &FieldError{
+			Field: fieldPath(path, "type"),
+			Err:   fmt.Errorf("%w: %s", ErrUnknownType, spec.Type),
+		}
+	}
+
+	strategies := make([]strategy.Strategy, len(spec.Strategies))
+
+	for i, inner := range spec.Strategies {
+		var err error
+
+		strategies[i], err = build(fieldPath(path, fmt.Sprintf("strategies[%d]", i)), inner)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	parameters := newParameters(path, spec, strategies)
+
+	s, err := registration.builder(parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	err = parameters.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// describeAll describes the given inner strategies.
+func describeAll(strategies []strategy.Strategy) ([]*StrategySpec, error) {
+	specs := make([]*StrategySpec, len(strategies))
+
+	for i, s := range strategies {
+		var err error
+
+		specs[i], err = Describe(s)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return specs, nil
+}
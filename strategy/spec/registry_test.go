@@ -0,0 +1,123 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package spec_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/strategy/spec"
+)
+
+func TestBuildErrors(t *testing.T) {
+	cases := []struct {
+		spec     *spec.StrategySpec
+		field    string
+		expected error
+	}{
+		{
+			spec:     &spec.StrategySpec{},
+			field:    "type",
+			expected: spec.ErrMissingType,
+		},
+		{
+			spec:     &spec.StrategySpec{Type: "unknown"},
+			field:    "type",
+			expected: spec.ErrUnknownType,
+		},
+		{
+			spec:     &spec.StrategySpec{Type: "macd", Parameters: map[string]any{"period4": 1}},
+			field:    "parameters.period4",
+			expected: spec.ErrUnknownParameter,
+		},
+		{
+			spec:     &spec.StrategySpec{Type: "macd", Parameters: map[string]any{"period2": -1}},
+			field:    "parameters.period2",
+			expected: spec.ErrInvalidParameter,
+		},
+		{
+			spec:     &spec.StrategySpec{Type: "macd", Parameters: map[string]any{"period2": 2.5}},
+			field:    "parameters.period2",
+			expected: spec.ErrInvalidParameter,
+		},
+		{
+			spec:     &spec.StrategySpec{Type: "super_trend", Parameters: map[string]any{"ma": "xma"}},
+			field:    "parameters.ma",
+			expected: spec.ErrInvalidParameter,
+		},
+		{
+			spec:     &spec.StrategySpec{Type: "super_trend", Parameters: map[string]any{"ma": 1}},
+			field:    "parameters.ma",
+			expected: spec.ErrInvalidParameter,
+		},
+		{
+			spec:     &spec.StrategySpec{Type: "macd", Strategies: []*spec.StrategySpec{{Type: "rsi"}}},
+			field:    "strategies",
+			expected: spec.ErrInvalidStrategies,
+		},
+		{
+			spec:     &spec.StrategySpec{Type: "and"},
+			field:    "strategies",
+			expected: spec.ErrInvalidStrategies,
+		},
+		{
+			spec:     &spec.StrategySpec{Type: "split", Strategies: []*spec.StrategySpec{{Type: "rsi"}}},
+			field:    "strategies",
+			expected: spec.ErrInvalidStrategies,
+		},
+		{
+			spec:     &spec.StrategySpec{Type: "divergence", Strategies: []*spec.StrategySpec{{Type: "buy_and_hold"}}},
+			field:    "strategies[0]",
+			expected: spec.ErrInvalidStrategies,
+		},
+		{
+			spec:     &spec.StrategySpec{Type: "stop_loss", Strategies: []*spec.StrategySpec{{Type: "rsi"}}},
+			field:    "parameters.percentage",
+			expected: spec.ErrMissingParameter,
+		},
+		{
+			spec: &spec.StrategySpec{Type: "or", Strategies: []*spec.StrategySpec{
+				{Type: "rsi"},
+				{Type: "inverse", Strategies: []*spec.StrategySpec{{Type: "rsi", Parameters: map[string]any{"sellAt": "high"}}}},
+			}},
+			field:    "strategies[1].strategies[0].parameters.sellAt",
+			expected: spec.ErrInvalidParameter,
+		},
+	}
+
+	for _, c := range cases {
+		_, err := spec.Build(c.spec)
+
+		var fieldError *spec.FieldError
+		if !errors.As(err, &fieldError) || fieldError.Field != c.field || !errors.Is(err, c.expected) {
+			t.Fatalf("spec %v error %v expected %s: %v", c.spec, err, c.field, c.expected)
+		}
+	}
+}
+
+func TestRegisterStrategy(t *testing.T) {
+	spec.RegisterStrategy("testing", func(p *spec.Parameters) (strategy.Strategy, error) {
+		return strategy.NewOrStrategy(p.Name("Testing"), p.Strategies(0, -1)...), nil
+	}, nil)
+
+	s, err := spec.Build(&spec.StrategySpec{Type: "testing"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s.Name() != "Testing" {
+		t.Fatalf("actual %s", s.Name())
+	}
+
+	found := false
+	for _, name := range spec.Types() {
+		found = found || name == "testing"
+	}
+
+	if !found {
+		t.Fatalf("types %v", spec.Types())
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package spec
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cinar/indicator/v2/strategy"
+	"gopkg.in/yaml.v3"
+)
+
+// StrategySpec is the declarative definition of a strategy. The type selects the registered builder, the
+// parameters configure it, and the strategies are the inner strategies for the compositions and the
+// decorators.
+//
+// Example:
+//
+//	type: and
+//	name: MACD and RSI
+//	strategies:
+//	  - type: macd
+//	    parameters:
+//	      period1: 12
+//	      period2: 26
+//	      period3: 9
+//	  - type: rsi
+//	    parameters:
+//	      buyAt: 30
+//	      sellAt: 70
+type StrategySpec struct {
+	// Type is the registered type of the strategy.
+	Type string `json:"type" yaml:"type"`
+
+	// Name is the name of the strategy, used by the compositions.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+
+	// Parameters are the strategy parameters. The missing parameters take their default values.
+	Parameters map[string]any `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+
+	// Strategies are the inner strategies.
+	Strategies []*StrategySpec `json:"strategies,omitempty" yaml:"strategies,omitempty"`
+}
+
+// Unmarshal parses the given YAML or JSON data containing either a single strategy spec or a list of
+// strategy specs.
+func Unmarshal(data []byte) ([]*StrategySpec, error) {
+	var node yaml.Node
+
+	err := yaml.Unmarshal(data, &node)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(node.Content) == 0 {
+		return []*StrategySpec{}, nil
+	}
+
+	if node.Content[0].Kind == yaml.SequenceNode {
+		var specs []*StrategySpec
+		err = node.Content[0].Decode(&specs)
+		return specs, err
+	}
+
+	var spec StrategySpec
+	err = node.Content[0].Decode(&spec)
+
+	return []*StrategySpec{&spec}, err
+}
+
+// MarshalYAML encodes the given strategy specs as YAML.
+func MarshalYAML(specs []*StrategySpec) ([]byte, error) {
+	return yaml.Marshal(specs)
+}
+
+// MarshalJSON encodes the given strategy specs as indented JSON.
+func MarshalJSON(specs []*StrategySpec) ([]byte, error) {
+	return json.MarshalIndent(specs, "", "  ")
+}
+
+// ReadFile reads the strategy specs from the given YAML or JSON file, and builds the strategies. The
+// validation errors point to the offending field by its index in the file.
+func ReadFile(fileName string) ([]strategy.Strategy, error) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	specs, err := Unmarshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", fileName, err)
+	}
+
+	strategies := make([]strategy.Strategy, len(specs))
+
+	for i, spec := range specs {
+		strategies[i], err = build(fmt.Sprintf("[%d]", i), spec)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", fileName, err)
+		}
+	}
+
+	return strategies, nil
+}
+
+// WriteFile describes the given strategies and writes their specs to the given file. The specs are written
+// as JSON if the file has the .json extension, and as YAML otherwise.
+func WriteFile(fileName string, strategies []strategy.Strategy) error {
+	specs := make([]*StrategySpec, len(strategies))
+
+	for i, s := range strategies {
+		var err error
+
+		specs[i], err = Describe(s)
+		if err != nil {
+			return err
+		}
+	}
+
+	var data []byte
+	var err error
+
+	if strings.EqualFold(filepath.Ext(fileName), ".json") {
+		data, err = MarshalJSON(specs)
+	} else {
+		data, err = MarshalYAML(specs)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(fileName, data, 0600)
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package spec_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/strategy/spec"
+	"github.com/cinar/indicator/v2/strategy/trend"
+)
+
+func TestReadFile(t *testing.T) {
+	files := map[string][]string{
+		"testdata/strategies.yaml": {
+			"Stop Loss Strategy (MACD and RSI)",
+			"Trend Majority",
+			"SplitStrategy(Buy and Hold Strategy, Inverse Strategy (Bollinger Bands Strategy))",
+		},
+		"testdata/strategies.json": {
+			"KAMA or TSI",
+			"No Loss Strategy (Money Flow Index Strategy (85.00,15.00))",
+		},
+	}
+
+	for fileName, expected := range files {
+		strategies, err := spec.ReadFile(fileName)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		actual := make([]string, len(strategies))
+		for i, s := range strategies {
+			actual[i] = s.Name()
+		}
+
+		if !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("actual %v expected %v", actual, expected)
+		}
+	}
+}
+
+func TestReadFileErrors(t *testing.T) {
+	_, err := spec.ReadFile("testdata/missing.yaml")
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("error %v", err)
+	}
+
+	_, err = spec.ReadFile("testdata/brk-b.csv")
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	outputDir, err := os.MkdirTemp("", "spec")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer helper.RemoveAll(t, outputDir)
+
+	fileName := filepath.Join(outputDir, "invalid.yaml")
+
+	err = os.WriteFile(fileName, []byte("- type: macd\n- type: and\n  strategies:\n    - type: rsi\n      parameters:\n        buyAt: low\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = spec.ReadFile(fileName)
+
+	var fieldError *spec.FieldError
+	if !errors.As(err, &fieldError) || fieldError.Field != "[1].strategies[0].parameters.buyAt" {
+		t.Fatalf("error %v", err)
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	specs, err := spec.Unmarshal([]byte(`{"type": "macd", "parameters": {"period1": 5}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(specs) != 1 || specs[0].Type != "macd" || specs[0].Parameters["period1"] != 5 {
+		t.Fatalf("specs %v", specs)
+	}
+
+	specs, err = spec.Unmarshal([]byte{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(specs) != 0 {
+		t.Fatalf("specs %v", specs)
+	}
+}
+
+func TestWriteFile(t *testing.T) {
+	outputDir, err := os.MkdirTemp("", "spec")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer helper.RemoveAll(t, outputDir)
+
+	expected := []strategy.Strategy{
+		strategy.NewAndStrategy("Fast and Slow",
+			trend.NewMacdStrategyWith(5, 10, 3),
+			trend.NewGoldenCrossStrategyWith(10, 20),
+		),
+	}
+
+	for _, name := range []string{"strategies.yaml", "strategies.json"} {
+		fileName := filepath.Join(outputDir, name)
+
+		err = spec.WriteFile(fileName, expected)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		actual, err := spec.ReadFile(fileName)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(actual) != 1 || actual[0].Name() != expected[0].Name() {
+			t.Fatalf("actual %v expected %v", actual, expected)
+		}
+	}
+}
+
+func TestWriteFileUnsupported(t *testing.T) {
+	err := spec.WriteFile("unused.yaml", []strategy.Strategy{
+		strategy.NewMultiTimeframeStrategy("", strategy.MultiTimeframeAnd, strategy.NewBuyAndHoldStrategy()),
+	})
+	if !errors.Is(err, spec.ErrUnsupportedStrategy) {
+		t.Fatalf("error %v", err)
+	}
+}
@@ -19,6 +19,8 @@
 package strategy
 
 import (
+	"context"
+
 	"github.com/cinar/indicator/v2/asset"
 	"github.com/cinar/indicator/v2/helper"
 )
@@ -50,6 +52,80 @@ func ComputeWithOutcome(s Strategy, c <-chan *asset.Snapshot) (<-chan Action, <-
 	return actions[0], outcomes
 }
 
+// ComputeWithContext uses the given strategy to process the provided asset snapshots and generates a
+// stream of actionable recommendations, like the Compute function of the strategy. When the given
+// context is cancelled, it stops reading the snapshots, closes the actions channel, and releases the
+// goroutines behind the strategy, so the consumer can stop reading the actions early.
+func ComputeWithContext(ctx context.Context, s Strategy, snapshots <-chan *asset.Snapshot) <-chan Action {
+	return helper.Cancellable(ctx, s.Compute(helper.Cancellable(ctx, snapshots)))
+}
+
+// SliceStrategy defines an optional interface for the strategies that can also process the asset
+// snapshots that are already materialized as a slice, without the channel overhead. The following
+// strategies do not implement it, and the ComputeSlice function falls back to their channel form:
+//
+//   - strategy: AndStrategy, MajorityStrategy, MultiTimeframeStrategy, OrStrategy, SplitStrategy,
+//     TimeframeStrategy
+//   - compound: MacdRsiStrategy
+//   - decorator: DivergenceStrategy, InverseStrategy, NoLossStrategy, StopLossStrategy
+//   - momentum: TripleRsiStrategy
+//   - pattern: PatternStrategy
+//   - trend: AlligatorStrategy, ApoStrategy, DemaStrategy, GoldenCrossStrategy, MaCrossoverStrategy,
+//     QstickStrategy, SmmaStrategy, TrimaStrategy, TripleMovingAverageCrossoverStrategy, TsiStrategy,
+//     VwmaStrategy, WeightedCloseStrategy
+//   - volatility: DonchianBreakoutStrategy, PoStrategy, VolatilityRegimeStrategy
+//   - volume: NegativeVolumeIndexStrategy, VwapEmaStrategy
+//   - risk: ManagedStrategy
+type SliceStrategy interface {
+	Strategy
+
+	// ComputeSlice processes the provided asset snapshots and generates a
+	// slice of actionable recommendations, one for each snapshot.
+	ComputeSlice(snapshots []*asset.Snapshot) []Action
+}
+
+// ComputeSlice uses the given strategy to process the provided asset snapshots and generates a slice
+// of actionable recommendations. It uses the batch form if the strategy implements the SliceStrategy
+// interface, and falls back to the channel form otherwise.
+func ComputeSlice(s Strategy, snapshots []*asset.Snapshot) []Action {
+	if ss, ok := s.(SliceStrategy); ok {
+		return ss.ComputeSlice(snapshots)
+	}
+
+	return helper.ChanToSlice(s.Compute(helper.SliceToChan(snapshots)))
+}
+
+// UpdateStrategy defines an optional interface for the strategies that can also process one asset
+// snapshot at a time, keeping their state in their indicators. If the strategy also implements the
+// helper.Stateful interface, the state can be persisted with the helper.Checkpoint function and
+// restored with the helper.Restore function, so a live process can resume where it left off without
+// warming up the strategy again.
+type UpdateStrategy interface {
+	Strategy
+
+	// Update processes the given asset snapshot and returns the recommended action.
+	Update(snapshot *asset.Snapshot) Action
+
+	// Reset resets the state of the strategy.
+	Reset()
+}
+
+// ComputeSliceWithOutcome uses the given strategy to process the provided asset snapshots and
+// generates a slice of actionable recommendations and outcomes. It is the batch form of
+// ComputeWithOutcome.
+func ComputeSliceWithOutcome(s Strategy, snapshots []*asset.Snapshot) ([]Action, []float64) {
+	actions := ComputeSlice(s, snapshots)
+
+	closings := make([]float64, len(snapshots))
+	for i, snapshot := range snapshots {
+		closings[i] = snapshot.Close
+	}
+
+	outcomes := OutcomeSlice(closings, actions)
+
+	return actions, outcomes
+}
+
 // AllStrategies returns a slice containing references to all available base strategies.
 func AllStrategies() []Strategy {
 	return []Strategy{
@@ -0,0 +1,250 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package strategy_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/strategy/spec"
+)
+
+func TestComputeSliceWithOutcome(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/repository/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshotsSlice := helper.ChanToSlice(snapshots)
+
+	strategies := []strategy.Strategy{
+		// Implements the slice strategy.
+		strategy.NewBuyAndHoldStrategy(),
+
+		// Falls back to the channel form.
+		strategy.NewMajorityStrategyWith("Majority Strategy", []strategy.Strategy{
+			strategy.NewBuyAndHoldStrategy(),
+		}),
+	}
+
+	for _, s := range strategies {
+		actions, outcomes := strategy.ComputeWithOutcome(s, helper.SliceToChan(snapshotsSlice))
+
+		expectedOutcomes := make(chan []float64, 1)
+		go func() {
+			expectedOutcomes <- helper.ChanToSlice(outcomes)
+		}()
+
+		expectedActions := helper.ChanToSlice(actions)
+
+		actualActions, actualOutcomes := strategy.ComputeSliceWithOutcome(s, snapshotsSlice)
+
+		if !reflect.DeepEqual(actualActions, expectedActions) {
+			t.Fatalf("actual %v expected %v", actualActions, expectedActions)
+		}
+
+		expected := <-expectedOutcomes
+		if !reflect.DeepEqual(actualOutcomes, expected) {
+			t.Fatalf("actual %v expected %v", actualOutcomes, expected)
+		}
+	}
+}
+
+func TestComputeSlice(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/repository/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshotsSlice := helper.ChanToSlice(snapshots)
+
+	for _, name := range spec.Types() {
+		s := registrySpec(name)
+
+		t.Run(name, func(t *testing.T) {
+			expectedStrategy, err := spec.Build(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			actualStrategy, err := spec.Build(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expected := expectedStrategy.Compute(helper.SliceToChan(snapshotsSlice))
+			actual := helper.SliceToChan(strategy.ComputeSlice(actualStrategy, snapshotsSlice))
+
+			err = helper.CheckEquals(actual, expected)
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestCheckpointRestore(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/repository/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshotsSlice := helper.ChanToSlice(snapshots)
+
+	for _, name := range spec.Types() {
+		s := registrySpec(name)
+
+		t.Run(name, func(t *testing.T) {
+			uninterrupted, err := spec.Build(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// The strategies that can be updated one snapshot at a time are the ones that can be
+			// checkpointed.
+			_, stateful := uninterrupted.(helper.Stateful)
+
+			updater, ok := uninterrupted.(strategy.UpdateStrategy)
+			if !ok {
+				if stateful {
+					t.Fatalf("%T is stateful but not an update strategy", uninterrupted)
+				}
+
+				t.Skip("not an update strategy")
+			}
+
+			if !stateful {
+				t.Fatalf("%T is not stateful", uninterrupted)
+			}
+
+			expected := make([]strategy.Action, len(snapshotsSlice))
+			for i, snapshot := range snapshotsSlice {
+				expected[i] = updater.Update(snapshot)
+			}
+
+			n := len(snapshotsSlice)
+
+			for _, split := range []int{0, 1, n / 4, n / 2, n - 1} {
+				actual := make([]strategy.Action, n)
+
+				before := buildUpdateStrategy(t, s)
+				for i, snapshot := range snapshotsSlice[:split] {
+					actual[i] = before.Update(snapshot)
+				}
+
+				data, err := helper.Checkpoint(before.(helper.Stateful))
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				after := buildUpdateStrategy(t, s)
+
+				// The restored state replaces any state the strategy has.
+				for _, snapshot := range snapshotsSlice[:3] {
+					after.Update(snapshot)
+				}
+
+				err = helper.Restore(after.(helper.Stateful), data)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				for i, snapshot := range snapshotsSlice[split:] {
+					actual[split+i] = after.Update(snapshot)
+				}
+
+				if !reflect.DeepEqual(actual, expected) {
+					t.Fatalf("split %d actual %v expected %v", split, actual, expected)
+				}
+			}
+		})
+	}
+}
+
+// registrySpec returns the spec of the given registered strategy type, with the inner strategies
+// and the parameters that it requires.
+func registrySpec(name string) *spec.StrategySpec {
+	s := &spec.StrategySpec{Type: name}
+
+	switch name {
+	case "and", "or", "majority", "inverse", "no_loss":
+		s.Strategies = []*spec.StrategySpec{{Type: "macd"}}
+
+	case "divergence":
+		s.Strategies = []*spec.StrategySpec{{Type: "rsi"}}
+
+	case "split":
+		s.Strategies = []*spec.StrategySpec{{Type: "macd"}, {Type: "rsi"}}
+
+	case "stop_loss":
+		s.Strategies = []*spec.StrategySpec{{Type: "macd"}}
+		s.Parameters = map[string]any{"percentage": 0.1}
+	}
+
+	return s
+}
+
+// buildUpdateStrategy builds the update strategy from the given spec.
+func buildUpdateStrategy(t *testing.T, s *spec.StrategySpec) strategy.UpdateStrategy {
+	t.Helper()
+
+	built, err := spec.Build(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return built.(strategy.UpdateStrategy)
+}
+
+func BenchmarkComputeWithOutcome(b *testing.B) {
+	snapshots := benchmarkSnapshots(b)
+	bah := strategy.NewBuyAndHoldStrategy()
+
+	for range b.N {
+		actions, outcomes := strategy.ComputeWithOutcome(bah, helper.SliceToChan(snapshots))
+		go helper.Drain(actions)
+		helper.Drain(outcomes)
+	}
+}
+
+func BenchmarkComputeSliceWithOutcome(b *testing.B) {
+	snapshots := benchmarkSnapshots(b)
+	bah := strategy.NewBuyAndHoldStrategy()
+
+	for range b.N {
+		strategy.ComputeSliceWithOutcome(bah, snapshots)
+	}
+}
+
+func benchmarkSnapshots(b *testing.B) []*asset.Snapshot {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/repository/brk-b.csv")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	return helper.ChanToSlice(snapshots)
+}
+
+func TestComputeWithContext(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/repository/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshotsSplice := helper.Duplicate(snapshots, 2)
+
+	bah := strategy.NewBuyAndHoldStrategy()
+	expected := bah.Compute(snapshotsSplice[0])
+	actual := strategy.ComputeWithContext(context.Background(), bah, snapshotsSplice[1])
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
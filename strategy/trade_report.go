@@ -0,0 +1,52 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package strategy
+
+import (
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+)
+
+// TradeReport processes the provided asset snapshots with the given strategy and generates a
+// report of its trades. The main chart has the candlesticks with the buy and sell markers and the
+// shaded trades, followed by the volume and the outcome panes.
+func TradeReport(s Strategy, c <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> openings
+	// snapshots[2] -> highs
+	// snapshots[3] -> lows
+	// snapshots[4] -> closings
+	// snapshots[5] -> volumes
+	// snapshots[6] -> actions[0] -> markers
+	//                 actions[1] -> trades
+	//              -> outcomes
+	//
+	snapshots := helper.Duplicate(c, 7)
+
+	dates := asset.SnapshotsAsDates(snapshots[0])
+	openings := asset.SnapshotsAsOpenings(snapshots[1])
+	highs := asset.SnapshotsAsHighs(snapshots[2])
+	lows := asset.SnapshotsAsLows(snapshots[3])
+	closings := asset.SnapshotsAsClosings(snapshots[4])
+	volumes := asset.SnapshotsAsVolumes(snapshots[5])
+
+	actions, outcomes := ComputeWithOutcome(s, snapshots[6])
+	actionsSplice := helper.Duplicate(actions, 2)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(s.Name(), dates)
+	volumePane := report.AddChart()
+	outcomePane := report.AddChart()
+
+	report.AddColumn(helper.NewShadingReportColumn(ActionsToTrades(actionsSplice[1])), 0, outcomePane)
+	report.AddColumn(helper.NewCandlestickReportColumn("Price", openings, highs, lows, closings))
+	report.AddColumn(helper.NewMarkerReportColumn(ActionsToMarkers(actionsSplice[0])))
+
+	report.AddColumn(helper.NewVolumeReportColumn("Volume", volumes), volumePane)
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), outcomePane)
+
+	return report
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"fmt"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+const (
+	// DefaultAdxStrategyThreshold is the default ADX threshold above which a trend is considered strong.
+	DefaultAdxStrategyThreshold = 25
+)
+
+// AdxStrategy represents the configuration parameters for calculating the ADX strategy. When the
+// ADX is at or above the threshold, indicating a strong trend, the +DI being above the -DI suggests
+// a bullish trend, while the -DI being above the +DI suggests a bearish trend. Weak trends are
+// ignored.
+type AdxStrategy struct {
+	// Adx represents the configuration parameters for calculating the
+	// Average Directional Index (ADX).
+	Adx *trend.Adx[float64]
+
+	// Threshold is the minimum ADX value for a trend to be acted on.
+	Threshold float64
+}
+
+// NewAdxStrategy function initializes a new ADX strategy instance with the default parameters.
+func NewAdxStrategy() *AdxStrategy {
+	return NewAdxStrategyWith(
+		trend.DefaultAdxPeriod,
+		DefaultAdxStrategyThreshold,
+	)
+}
+
+// NewAdxStrategyWith function initializes a new ADX strategy instance with the given parameters.
+func NewAdxStrategyWith(period int, threshold float64) *AdxStrategy {
+	return &AdxStrategy{
+		Adx:       trend.NewAdxWithPeriod[float64](period),
+		Threshold: threshold,
+	}
+}
+
+// Name returns the name of the strategy.
+func (a *AdxStrategy) Name() string {
+	return fmt.Sprintf("ADX Strategy (%d,%.2f)", a.Adx.Rma.Period, a.Threshold)
+}
+
+// Compute processes the provided asset snapshots and generates a
+// stream of actionable recommendations.
+func (a *AdxStrategy) Compute(c <-chan *asset.Snapshot) <-chan strategy.Action {
+	snapshots := helper.Duplicate(c, 3)
+	highs := asset.SnapshotsAsHighs(snapshots[0])
+	lows := asset.SnapshotsAsLows(snapshots[1])
+	closings := asset.SnapshotsAsClosings(snapshots[2])
+
+	plusDis, minusDis, adxs, adxrs := a.Adx.Compute(highs, lows, closings)
+	go helper.Drain(adxrs)
+
+	actions := helper.Operate3(plusDis, minusDis, adxs, a.action)
+
+	// ADX starts only after a full period.
+	actions = helper.Shift(actions, a.Adx.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
+
+// ComputeSlice processes the provided asset snapshots and generates a
+// slice of actionable recommendations.
+func (a *AdxStrategy) ComputeSlice(snapshots []*asset.Snapshot) []strategy.Action {
+	adx := a.Adx.Clone()
+	actions := make([]strategy.Action, len(snapshots))
+
+	for i, snapshot := range snapshots {
+		plusDi, minusDi, adxValue, _, ok := adx.Update(snapshot.High, snapshot.Low, snapshot.Close)
+		if ok {
+			actions[i] = a.action(plusDi, minusDi, adxValue)
+		}
+	}
+
+	return actions
+}
+
+// Update processes the given asset snapshot and returns the recommended action, keeping the state
+// in the strategy instance.
+func (a *AdxStrategy) Update(snapshot *asset.Snapshot) strategy.Action {
+	plusDi, minusDi, adxValue, _, ok := a.Adx.Update(snapshot.High, snapshot.Low, snapshot.Close)
+	if !ok {
+		return strategy.Hold
+	}
+
+	return a.action(plusDi, minusDi, adxValue)
+}
+
+// Reset resets the state of the strategy.
+func (a *AdxStrategy) Reset() {
+	a.Adx.Reset()
+}
+
+// MarshalState returns the state of the strategy as JSON.
+func (a *AdxStrategy) MarshalState() ([]byte, error) {
+	return a.Adx.MarshalState()
+}
+
+// UnmarshalState restores the state of the strategy from the given JSON.
+func (a *AdxStrategy) UnmarshalState(data []byte) error {
+	return a.Adx.UnmarshalState(data)
+}
+
+// Report processes the provided asset snapshots and generates a
+// report annotated with the recommended actions.
+func (a *AdxStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> highs       |
+	// snapshots[2] -> lows        |
+	// snapshots[3] -> closings[1] |> plusDis, minusDis, adxs, adxrs
+	//                 closings[0] -> closings
+	// snapshots[4] -> actions     -> annotations
+	//              -> outcomes
+	//
+	snapshots := helper.Duplicate(c, 5)
+
+	dates := asset.SnapshotsAsDates(snapshots[0])
+	highs := asset.SnapshotsAsHighs(snapshots[1])
+	lows := asset.SnapshotsAsLows(snapshots[2])
+	closings := helper.Duplicate(asset.SnapshotsAsClosings(snapshots[3]), 2)
+
+	plusDis, minusDis, adxs, adxrs := a.Adx.Compute(highs, lows, closings[1])
+	plusDis = helper.Shift(plusDis, a.Adx.IdlePeriod(), 0)
+	minusDis = helper.Shift(minusDis, a.Adx.IdlePeriod(), 0)
+	adxs = helper.Shift(adxs, a.Adx.IdlePeriod(), 0)
+	adxrs = helper.Shift(adxrs, a.Adx.IdlePeriod(), 0)
+
+	actions, outcomes := strategy.ComputeWithOutcome(a, snapshots[4])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(a.Name(), dates)
+	report.AddChart()
+	report.AddChart()
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closings[0]))
+	report.AddColumn(helper.NewNumericReportColumn("+DI", plusDis), 1)
+	report.AddColumn(helper.NewNumericReportColumn("-DI", minusDis), 1)
+	report.AddColumn(helper.NewNumericReportColumn("ADX", adxs), 2)
+	report.AddColumn(helper.NewNumericReportColumn("ADXR", adxrs), 2)
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations), 0, 1, 2)
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 3)
+
+	return report
+}
+
+// action returns the recommended action for the given +DI, -DI, and ADX values.
+func (a *AdxStrategy) action(plusDi, minusDi, adx float64) strategy.Action {
+	// Weak trends are ignored.
+	if adx < a.Threshold {
+		return strategy.Hold
+	}
+
+	// A +DI above the -DI in a strong trend suggests a bullish trend.
+	if plusDi > minusDi {
+		return strategy.Buy
+	}
+
+	// A -DI above the +DI in a strong trend suggests a bearish trend.
+	if minusDi > plusDi {
+		return strategy.Sell
+	}
+
+	return strategy.Hold
+}
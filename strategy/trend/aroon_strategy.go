@@ -45,22 +45,54 @@ func (a *AroonStrategy) Compute(c <-chan *asset.Snapshot) <-chan strategy.Action
 
 	ups, downs := a.Aroon.Compute(highs, lows)
 
-	actions := helper.Operate(ups, downs, func(up, down float64) strategy.Action {
-		if up > down {
-			return strategy.Buy
-		}
+	actions := helper.Operate(ups, downs, a.action)
+
+	// Aroon starts only after a full period.
+	actions = helper.Shift(actions, a.Aroon.Period-1, strategy.Hold)
+
+	return actions
+}
 
-		if down > up {
-			return strategy.Sell
+// ComputeSlice processes the provided asset snapshots and generates a
+// slice of actionable recommendations.
+func (a *AroonStrategy) ComputeSlice(snapshots []*asset.Snapshot) []strategy.Action {
+	aroon := a.Aroon.Clone()
+	actions := make([]strategy.Action, len(snapshots))
+
+	for i, snapshot := range snapshots {
+		up, down, ok := aroon.Update(snapshot.High, snapshot.Low)
+		if ok {
+			actions[i] = a.action(up, down)
 		}
+	}
 
+	return actions
+}
+
+// Update processes the given asset snapshot and returns the recommended action, keeping the state
+// in the strategy instance.
+func (a *AroonStrategy) Update(snapshot *asset.Snapshot) strategy.Action {
+	up, down, ok := a.Aroon.Update(snapshot.High, snapshot.Low)
+	if !ok {
 		return strategy.Hold
-	})
+	}
 
-	// Aroon starts only after a full period.
-	actions = helper.Shift(actions, a.Aroon.Period-1, strategy.Hold)
+	return a.action(up, down)
+}
 
-	return actions
+// Reset resets the state of the strategy.
+func (a *AroonStrategy) Reset() {
+	a.Aroon.Reset()
+}
+
+// MarshalState returns the state of the strategy as JSON.
+func (a *AroonStrategy) MarshalState() ([]byte, error) {
+	return a.Aroon.MarshalState()
+}
+
+// UnmarshalState restores the state of the strategy from the given JSON.
+func (a *AroonStrategy) UnmarshalState(data []byte) error {
+	return a.Aroon.UnmarshalState(data)
 }
 
 // Report processes the provided asset snapshots and generates a
@@ -102,3 +134,16 @@ func (a *AroonStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
 
 	return report
 }
+
+// action returns the recommended action for the given Aroon up and down values.
+func (a *AroonStrategy) action(up, down float64) strategy.Action {
+	if up > down {
+		return strategy.Buy
+	}
+
+	if down > up {
+		return strategy.Sell
+	}
+
+	return strategy.Hold
+}
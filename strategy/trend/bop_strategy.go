@@ -45,17 +45,48 @@ func (b *BopStrategy) Compute(c <-chan *asset.Snapshot) <-chan strategy.Action {
 
 	bops := b.Bop.Compute(openings, highs, lows, closings)
 
-	return helper.Map(bops, func(bop float64) strategy.Action {
-		if bop > 0 {
-			return strategy.Buy
-		}
+	return helper.Map(bops, b.action)
+}
+
+// ComputeSlice processes the provided asset snapshots and generates a
+// slice of actionable recommendations.
+func (b *BopStrategy) ComputeSlice(snapshots []*asset.Snapshot) []strategy.Action {
+	actions := make([]strategy.Action, len(snapshots))
 
-		if bop < 0 {
-			return strategy.Sell
+	for i, snapshot := range snapshots {
+		bop, ok := b.Bop.Update(snapshot.Open, snapshot.High, snapshot.Low, snapshot.Close)
+		if ok {
+			actions[i] = b.action(bop)
 		}
+	}
+
+	return actions
+}
 
+// Update processes the given asset snapshot and returns the recommended action, keeping the state
+// in the strategy instance.
+func (b *BopStrategy) Update(snapshot *asset.Snapshot) strategy.Action {
+	bop, ok := b.Bop.Update(snapshot.Open, snapshot.High, snapshot.Low, snapshot.Close)
+	if !ok {
 		return strategy.Hold
-	})
+	}
+
+	return b.action(bop)
+}
+
+// Reset resets the state of the strategy.
+func (b *BopStrategy) Reset() {
+	b.Bop.Reset()
+}
+
+// MarshalState returns the state of the strategy as JSON.
+func (b *BopStrategy) MarshalState() ([]byte, error) {
+	return b.Bop.MarshalState()
+}
+
+// UnmarshalState restores the state of the strategy from the given JSON.
+func (b *BopStrategy) UnmarshalState(data []byte) error {
+	return b.Bop.UnmarshalState(data)
 }
 
 // Report processes the provided asset snapshots and generates a
@@ -97,3 +128,16 @@ func (b *BopStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
 
 	return report
 }
+
+// action returns the recommended action for the given BoP value.
+func (b *BopStrategy) action(bop float64) strategy.Action {
+	if bop > 0 {
+		return strategy.Buy
+	}
+
+	if bop < 0 {
+		return strategy.Sell
+	}
+
+	return strategy.Hold
+}
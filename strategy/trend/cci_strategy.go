@@ -40,22 +40,54 @@ func (t *CciStrategy) Compute(c <-chan *asset.Snapshot) <-chan strategy.Action {
 
 	ccis := t.Cci.Compute(highs, lows, closings)
 
-	actions := helper.Map(ccis, func(cci float64) strategy.Action {
-		if cci >= 100 {
-			return strategy.Buy
-		}
+	actions := helper.Map(ccis, t.action)
+
+	// CCI starts only after a full period.
+	actions = helper.Shift(actions, t.Cci.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
 
-		if cci <= -100 {
-			return strategy.Sell
+// ComputeSlice processes the provided asset snapshots and generates a
+// slice of actionable recommendations.
+func (t *CciStrategy) ComputeSlice(snapshots []*asset.Snapshot) []strategy.Action {
+	cci := t.Cci.Clone()
+	actions := make([]strategy.Action, len(snapshots))
+
+	for i, snapshot := range snapshots {
+		cciValue, ok := cci.Update(snapshot.High, snapshot.Low, snapshot.Close)
+		if ok {
+			actions[i] = t.action(cciValue)
 		}
+	}
 
+	return actions
+}
+
+// Update processes the given asset snapshot and returns the recommended action, keeping the state
+// in the strategy instance.
+func (t *CciStrategy) Update(snapshot *asset.Snapshot) strategy.Action {
+	cciValue, ok := t.Cci.Update(snapshot.High, snapshot.Low, snapshot.Close)
+	if !ok {
 		return strategy.Hold
-	})
+	}
 
-	// CCI starts only after a full period.
-	actions = helper.Shift(actions, t.Cci.IdlePeriod(), strategy.Hold)
+	return t.action(cciValue)
+}
 
-	return actions
+// Reset resets the state of the strategy.
+func (t *CciStrategy) Reset() {
+	t.Cci.Reset()
+}
+
+// MarshalState returns the state of the strategy as JSON.
+func (t *CciStrategy) MarshalState() ([]byte, error) {
+	return t.Cci.MarshalState()
+}
+
+// UnmarshalState restores the state of the strategy from the given JSON.
+func (t *CciStrategy) UnmarshalState(data []byte) error {
+	return t.Cci.UnmarshalState(data)
 }
 
 // Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
@@ -95,3 +127,16 @@ func (t *CciStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
 
 	return report
 }
+
+// action returns the recommended action for the given CCI value.
+func (t *CciStrategy) action(cci float64) strategy.Action {
+	if cci >= 100 {
+		return strategy.Buy
+	}
+
+	if cci <= -100 {
+		return strategy.Sell
+	}
+
+	return strategy.Hold
+}
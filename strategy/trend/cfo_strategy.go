@@ -0,0 +1,145 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"fmt"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+// CfoStrategy represents the configuration parameters for calculating the Chande Forecast
+// Oscillator (CFO) strategy. A closing value below its forecast, a negative CFO, suggests a Buy
+// signal, while a closing value above its forecast, a positive CFO, suggests a Sell signal.
+type CfoStrategy struct {
+	// Cfo represents the configuration parameters for calculating the Chande Forecast Oscillator.
+	Cfo *trend.Cfo[float64]
+}
+
+// NewCfoStrategy function initializes a new CFO strategy instance with the default parameters.
+func NewCfoStrategy() *CfoStrategy {
+	return NewCfoStrategyWith(trend.DefaultCfoPeriod)
+}
+
+// NewCfoStrategyWith function initializes a new CFO strategy instance with the given period.
+func NewCfoStrategyWith(period int) *CfoStrategy {
+	return &CfoStrategy{
+		Cfo: trend.NewCfoWithPeriod[float64](period),
+	}
+}
+
+// Name returns the name of the strategy.
+func (c *CfoStrategy) Name() string {
+	return fmt.Sprintf("CFO Strategy (%d)", c.Cfo.Mlr.Mls.Sum.Period)
+}
+
+// Compute processes the provided asset snapshots and generates a
+// stream of actionable recommendations.
+func (c *CfoStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan strategy.Action {
+	closings := asset.SnapshotsAsClosings(snapshots)
+
+	cfos := c.Cfo.Compute(closings)
+
+	actions := helper.Map(cfos, c.action)
+
+	// CFO starts only after a full period.
+	actions = helper.Shift(actions, c.Cfo.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
+
+// ComputeSlice processes the provided asset snapshots and generates a
+// slice of actionable recommendations.
+func (c *CfoStrategy) ComputeSlice(snapshots []*asset.Snapshot) []strategy.Action {
+	cfo := c.Cfo.Clone()
+	actions := make([]strategy.Action, len(snapshots))
+
+	for i, snapshot := range snapshots {
+		cfoValue, ok := cfo.Update(snapshot.Close)
+		if ok {
+			actions[i] = c.action(cfoValue)
+		}
+	}
+
+	return actions
+}
+
+// Update processes the given asset snapshot and returns the recommended action, keeping the state
+// in the strategy instance.
+func (c *CfoStrategy) Update(snapshot *asset.Snapshot) strategy.Action {
+	cfoValue, ok := c.Cfo.Update(snapshot.Close)
+	if !ok {
+		return strategy.Hold
+	}
+
+	return c.action(cfoValue)
+}
+
+// Reset resets the state of the strategy.
+func (c *CfoStrategy) Reset() {
+	c.Cfo.Reset()
+}
+
+// MarshalState returns the state of the strategy as JSON.
+func (c *CfoStrategy) MarshalState() ([]byte, error) {
+	return c.Cfo.MarshalState()
+}
+
+// UnmarshalState restores the state of the strategy from the given JSON.
+func (c *CfoStrategy) UnmarshalState(data []byte) error {
+	return c.Cfo.UnmarshalState(data)
+}
+
+// Report processes the provided asset snapshots and generates a
+// report annotated with the recommended actions.
+func (c *CfoStrategy) Report(snapshots <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> closings[0] -> closings
+	//                 closings[1] -> cfos
+	// snapshots[2] -> actions     -> annotations
+	//              -> outcomes
+	//
+	snapshotsSplice := helper.Duplicate(snapshots, 3)
+
+	dates := asset.SnapshotsAsDates(snapshotsSplice[0])
+	closings := helper.Duplicate(asset.SnapshotsAsClosings(snapshotsSplice[1]), 2)
+
+	cfos := helper.Shift(c.Cfo.Compute(closings[1]), c.Cfo.IdlePeriod(), 0)
+
+	actions, outcomes := strategy.ComputeWithOutcome(c, snapshotsSplice[2])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(c.Name(), dates)
+	report.AddChart()
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closings[0]))
+	report.AddColumn(helper.NewNumericReportColumn("CFO", cfos), 1)
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations), 0, 1)
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 2)
+
+	return report
+}
+
+// action returns the recommended action for the given CFO value.
+func (c *CfoStrategy) action(cfo float64) strategy.Action {
+	// A closing value below the forecast suggests a Buy signal.
+	if cfo < 0 {
+		return strategy.Buy
+	}
+
+	// A closing value above the forecast suggests a Sell signal.
+	if cfo > 0 {
+		return strategy.Sell
+	}
+
+	return strategy.Hold
+}
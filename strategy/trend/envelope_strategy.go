@@ -52,24 +52,54 @@ func (e *EnvelopeStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan stra
 	uppers, middles, lowers := e.Envelope.Compute(closingsSplice[0])
 	go helper.Drain(middles)
 
-	actions := helper.Operate3(uppers, lowers, closingsSplice[1], func(upper, lower, closing float64) strategy.Action {
-		// When the closing is below the lower band suggests a buy recommendation.
-		if closing < lower {
-			return strategy.Buy
-		}
+	actions := helper.Operate3(uppers, lowers, closingsSplice[1], e.action)
+
+	// Envelope start only after a full period.
+	actions = helper.Shift(actions, e.Envelope.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
 
-		// When the closing is above the upper band suggests a Sell recommendation.
-		if closing > upper {
-			return strategy.Sell
+// ComputeSlice processes the provided asset snapshots and generates a
+// slice of actionable recommendations.
+func (e *EnvelopeStrategy) ComputeSlice(snapshots []*asset.Snapshot) []strategy.Action {
+	envelope := e.Envelope.Clone()
+	actions := make([]strategy.Action, len(snapshots))
+
+	for i, snapshot := range snapshots {
+		upper, _, lower, ok := envelope.Update(snapshot.Close)
+		if ok {
+			actions[i] = e.action(upper, lower, snapshot.Close)
 		}
+	}
 
+	return actions
+}
+
+// Update processes the given asset snapshot and returns the recommended action, keeping the state
+// in the strategy instance.
+func (e *EnvelopeStrategy) Update(snapshot *asset.Snapshot) strategy.Action {
+	upper, _, lower, ok := e.Envelope.Update(snapshot.Close)
+	if !ok {
 		return strategy.Hold
-	})
+	}
 
-	// Envelope start only after a full period.
-	actions = helper.Shift(actions, e.Envelope.IdlePeriod(), strategy.Hold)
+	return e.action(upper, lower, snapshot.Close)
+}
 
-	return actions
+// Reset resets the state of the strategy.
+func (e *EnvelopeStrategy) Reset() {
+	e.Envelope.Reset()
+}
+
+// MarshalState returns the state of the strategy as JSON.
+func (e *EnvelopeStrategy) MarshalState() ([]byte, error) {
+	return e.Envelope.MarshalState()
+}
+
+// UnmarshalState restores the state of the strategy from the given JSON.
+func (e *EnvelopeStrategy) UnmarshalState(data []byte) error {
+	return e.Envelope.UnmarshalState(data)
 }
 
 // Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
@@ -115,3 +145,18 @@ func (e *EnvelopeStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
 
 	return report
 }
+
+// action returns the recommended action for the given upper and lower bands, and the closing value.
+func (e *EnvelopeStrategy) action(upper, lower, closing float64) strategy.Action {
+	// When the closing is below the lower band suggests a buy recommendation.
+	if closing < lower {
+		return strategy.Buy
+	}
+
+	// When the closing is above the upper band suggests a Sell recommendation.
+	if closing > upper {
+		return strategy.Sell
+	}
+
+	return strategy.Hold
+}
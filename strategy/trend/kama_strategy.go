@@ -50,24 +50,54 @@ func (k *KamaStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan strategy
 
 	kamas := k.Kama.Compute(closingsSplice[0])
 
-	actions := helper.Operate(kamas, closingsSplice[1], func(kama, closing float64) strategy.Action {
-		// A closing price crossing above the KAMA suggests a bullish trend.
-		if closing > kama {
-			return strategy.Buy
-		}
+	actions := helper.Operate(kamas, closingsSplice[1], k.action)
+
+	// KAMA starts only after a full period.
+	actions = helper.Shift(actions, k.Kama.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
 
-		// While crossing below the KAMA indicates a bearish trend.
-		if closing < kama {
-			return strategy.Sell
+// ComputeSlice processes the provided asset snapshots and generates a
+// slice of actionable recommendations.
+func (k *KamaStrategy) ComputeSlice(snapshots []*asset.Snapshot) []strategy.Action {
+	kama := k.Kama.Clone()
+	actions := make([]strategy.Action, len(snapshots))
+
+	for i, snapshot := range snapshots {
+		kamaValue, ok := kama.Update(snapshot.Close)
+		if ok {
+			actions[i] = k.action(kamaValue, snapshot.Close)
 		}
+	}
 
+	return actions
+}
+
+// Update processes the given asset snapshot and returns the recommended action, keeping the state
+// in the strategy instance.
+func (k *KamaStrategy) Update(snapshot *asset.Snapshot) strategy.Action {
+	kamaValue, ok := k.Kama.Update(snapshot.Close)
+	if !ok {
 		return strategy.Hold
-	})
+	}
 
-	// KAMA starts only after a full period.
-	actions = helper.Shift(actions, k.Kama.IdlePeriod(), strategy.Hold)
+	return k.action(kamaValue, snapshot.Close)
+}
 
-	return actions
+// Reset resets the state of the strategy.
+func (k *KamaStrategy) Reset() {
+	k.Kama.Reset()
+}
+
+// MarshalState returns the state of the strategy as JSON.
+func (k *KamaStrategy) MarshalState() ([]byte, error) {
+	return k.Kama.MarshalState()
+}
+
+// UnmarshalState restores the state of the strategy from the given JSON.
+func (k *KamaStrategy) UnmarshalState(data []byte) error {
+	return k.Kama.UnmarshalState(data)
 }
 
 // Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
@@ -110,3 +140,18 @@ func (k *KamaStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
 
 	return report
 }
+
+// action returns the recommended action for the given KAMA and closing values.
+func (k *KamaStrategy) action(kama, closing float64) strategy.Action {
+	// A closing price crossing above the KAMA suggests a bullish trend.
+	if closing > kama {
+		return strategy.Buy
+	}
+
+	// While crossing below the KAMA indicates a bearish trend.
+	if closing < kama {
+		return strategy.Sell
+	}
+
+	return strategy.Hold
+}
@@ -45,24 +45,54 @@ func (kdj *KdjStrategy) Compute(c <-chan *asset.Snapshot) <-chan strategy.Action
 	jk := helper.Subtract(js[0], k)
 	jd := helper.Subtract(js[1], d)
 
-	actions := helper.Operate(jk, jd, func(a, b float64) strategy.Action {
-		// Generates BUY action when j value crosses above both k and d values.
-		if a > 0 && b > 0 {
-			return strategy.Buy
-		}
+	actions := helper.Operate(jk, jd, kdj.action)
+
+	// KDJ starts only after a full period.
+	actions = helper.Shift(actions, kdj.Kdj.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
 
-		// Generates SELL action when j value crosses below both k and d values.
-		if a < 0 && b < 0 {
-			return strategy.Sell
+// ComputeSlice processes the provided asset snapshots and generates a
+// slice of actionable recommendations.
+func (kdj *KdjStrategy) ComputeSlice(snapshots []*asset.Snapshot) []strategy.Action {
+	k := kdj.Kdj.Clone()
+	actions := make([]strategy.Action, len(snapshots))
+
+	for i, snapshot := range snapshots {
+		kValue, dValue, jValue, ok := k.Update(snapshot.High, snapshot.Low, snapshot.Close)
+		if ok {
+			actions[i] = kdj.action(jValue-kValue, jValue-dValue)
 		}
+	}
 
+	return actions
+}
+
+// Update processes the given asset snapshot and returns the recommended action, keeping the state
+// in the strategy instance.
+func (kdj *KdjStrategy) Update(snapshot *asset.Snapshot) strategy.Action {
+	kValue, dValue, jValue, ok := kdj.Kdj.Update(snapshot.High, snapshot.Low, snapshot.Close)
+	if !ok {
 		return strategy.Hold
-	})
+	}
 
-	// KDJ starts only after a full period.
-	actions = helper.Shift(actions, kdj.Kdj.IdlePeriod(), strategy.Hold)
+	return kdj.action(jValue-kValue, jValue-dValue)
+}
 
-	return actions
+// Reset resets the state of the strategy.
+func (kdj *KdjStrategy) Reset() {
+	kdj.Kdj.Reset()
+}
+
+// MarshalState returns the state of the strategy as JSON.
+func (kdj *KdjStrategy) MarshalState() ([]byte, error) {
+	return kdj.Kdj.MarshalState()
+}
+
+// UnmarshalState restores the state of the strategy from the given JSON.
+func (kdj *KdjStrategy) UnmarshalState(data []byte) error {
+	return kdj.Kdj.UnmarshalState(data)
 }
 
 // Report processes the provided asset snapshots and generates a
@@ -107,3 +137,18 @@ func (kdj *KdjStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
 
 	return report
 }
+
+// action returns the recommended action for the given differences of the j value from the k and d values.
+func (kdj *KdjStrategy) action(a, b float64) strategy.Action {
+	// Generates BUY action when j value crosses above both k and d values.
+	if a > 0 && b > 0 {
+		return strategy.Buy
+	}
+
+	// Generates SELL action when j value crosses below both k and d values.
+	if a < 0 && b < 0 {
+		return strategy.Sell
+	}
+
+	return strategy.Hold
+}
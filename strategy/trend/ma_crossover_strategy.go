@@ -0,0 +1,139 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"fmt"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+const (
+	// DefaultMaCrossoverStrategyFastPeriod is the default MA crossover strategy fast period.
+	DefaultMaCrossoverStrategyFastPeriod = 20
+
+	// DefaultMaCrossoverStrategySlowPeriod is the default MA crossover strategy slow period.
+	DefaultMaCrossoverStrategySlowPeriod = 50
+)
+
+// MaCrossoverStrategy defines the parameters used to calculate the MA crossover trading strategy. This strategy
+// uses two moving averages of any kind, such as EMA, ALMA, or T3, to identify potential buy and sell signals.
+// - A buy signal is generated when the **fast** MA crosses above the **slow** MA.
+// - A sell signal is generated when the fast MA crosses below the slow MA.
+// - Otherwise, the strategy recommends holding the asset.
+type MaCrossoverStrategy struct {
+	// Fast is the fast MA.
+	Fast trend.Ma[float64]
+
+	// Slow is the slow MA.
+	Slow trend.Ma[float64]
+}
+
+// NewMaCrossoverStrategy function initializes a new MA crossover strategy instance with the default parameters,
+// using EMAs.
+func NewMaCrossoverStrategy() *MaCrossoverStrategy {
+	return NewMaCrossoverStrategyWith(
+		trend.NewEmaWithPeriod[float64](DefaultMaCrossoverStrategyFastPeriod),
+		trend.NewEmaWithPeriod[float64](DefaultMaCrossoverStrategySlowPeriod),
+	)
+}
+
+// NewMaCrossoverStrategyWith function initializes a new MA crossover strategy instance with the given MAs.
+func NewMaCrossoverStrategyWith(fast, slow trend.Ma[float64]) *MaCrossoverStrategy {
+	return &MaCrossoverStrategy{
+		Fast: fast,
+		Slow: slow,
+	}
+}
+
+// Name returns the name of the strategy.
+func (m *MaCrossoverStrategy) Name() string {
+	return fmt.Sprintf("MA Crossover Strategy (%s,%s)", m.Fast, m.Slow)
+}
+
+// Compute processes the provided asset snapshots and generates a stream of actionable recommendations.
+func (m *MaCrossoverStrategy) Compute(c <-chan *asset.Snapshot) <-chan strategy.Action {
+	fasts, slows := m.calculateMas(c)
+
+	actions := helper.Operate(fasts, slows, func(fast, slow float64) strategy.Action {
+		// A buy signal is generated when the **fast** MA crosses above the **slow** MA.
+		if fast > slow {
+			return strategy.Buy
+		}
+
+		// A sell signal is generated when the fast MA crosses below the slow MA.
+		if fast < slow {
+			return strategy.Sell
+		}
+
+		// Otherwise, the strategy recommends holding the asset.
+		return strategy.Hold
+	})
+
+	// Generate a Hold signal during the idle period.
+	actions = helper.Shift(actions, m.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
+
+// Report processes the provided asset snapshots and generates a
+// report annotated with the recommended actions.
+func (m *MaCrossoverStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> closings
+	// snapshots[2] -> fasts
+	//                 slows
+	// snapshots[3] -> actions     -> annotations
+	//              -> outcomes
+	//
+	snapshots := helper.Duplicate(c, 4)
+
+	dates := helper.Skip(asset.SnapshotsAsDates(snapshots[0]), m.IdlePeriod())
+	closings := helper.Skip(asset.SnapshotsAsClosings(snapshots[1]), m.IdlePeriod())
+
+	fasts, slows := m.calculateMas(snapshots[2])
+
+	actions, outcomes := strategy.ComputeWithOutcome(m, snapshots[3])
+	annotations := helper.Skip(strategy.ActionsToAnnotations(actions), m.IdlePeriod())
+	outcomes = helper.MultiplyBy(helper.Skip(outcomes, m.IdlePeriod()), 100)
+
+	report := helper.NewReport(m.Name(), dates)
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closings))
+	report.AddColumn(helper.NewNumericReportColumn(m.Fast.String(), fasts))
+	report.AddColumn(helper.NewNumericReportColumn(m.Slow.String(), slows))
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations))
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 1)
+
+	return report
+}
+
+// IdlePeriod is the initial period that MA crossover strategy won't yield any results.
+func (m *MaCrossoverStrategy) IdlePeriod() int {
+	return max(m.Fast.IdlePeriod(), m.Slow.IdlePeriod())
+}
+
+// calculateMas calculates the fast and slow MAs aligned to the longer idle period.
+func (m *MaCrossoverStrategy) calculateMas(c <-chan *asset.Snapshot) (<-chan float64, <-chan float64) {
+	closings := helper.Duplicate(asset.SnapshotsAsClosings(c), 2)
+
+	fasts := helper.Skip(
+		m.Fast.Compute(closings[0]),
+		m.IdlePeriod()-m.Fast.IdlePeriod(),
+	)
+
+	slows := helper.Skip(
+		m.Slow.Compute(closings[1]),
+		m.IdlePeriod()-m.Slow.IdlePeriod(),
+	)
+
+	return fasts, slows
+}
@@ -59,24 +59,68 @@ func (m *MacdStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan strategy
 
 	macds, signals := m.Macd.Compute(closings)
 
-	actions := helper.Operate(macds, signals, func(macd, signal float64) strategy.Action {
-		// A MACD value crossing above signal line suggests a bullish trend.
-		if (macd > signal) && (macd < 0) {
-			return strategy.Buy
-		}
+	actions := helper.Operate(macds, signals, m.action)
+
+	// MACD starts only after a full period.
+	actions = helper.Shift(actions, m.Macd.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
+
+// ComputeSlice processes the provided asset snapshots and generates a
+// slice of actionable recommendations.
+func (m *MacdStrategy) ComputeSlice(snapshots []*asset.Snapshot) []strategy.Action {
+	macd := m.Macd.Clone()
+	actions := make([]strategy.Action, len(snapshots))
 
-		// A MACD value crossing below signal line suggests a bearish trend.
-		if (signal > macd) && (macd > 0) {
-			return strategy.Sell
+	for i, snapshot := range snapshots {
+		macdValue, signal, ok := macd.Update(snapshot.Close)
+		if ok {
+			actions[i] = m.action(macdValue, signal)
 		}
+	}
+
+	return actions
+}
 
+// Update processes the given asset snapshot and returns the recommended action, keeping the state
+// in the strategy instance.
+func (m *MacdStrategy) Update(snapshot *asset.Snapshot) strategy.Action {
+	macdValue, signal, ok := m.Macd.Update(snapshot.Close)
+	if !ok {
 		return strategy.Hold
-	})
+	}
 
-	// MACD starts only after a full period.
-	actions = helper.Shift(actions, m.Macd.IdlePeriod(), strategy.Hold)
+	return m.action(macdValue, signal)
+}
 
-	return actions
+// Reset resets the state of the strategy.
+func (m *MacdStrategy) Reset() {
+	m.Macd.Reset()
+}
+
+// MarshalState returns the state of the strategy as JSON.
+func (m *MacdStrategy) MarshalState() ([]byte, error) {
+	return m.Macd.MarshalState()
+}
+
+// UnmarshalState restores the state of the strategy from the given JSON.
+func (m *MacdStrategy) UnmarshalState(data []byte) error {
+	return m.Macd.UnmarshalState(data)
+}
+
+// Oscillator processes the provided asset snapshots and generates a stream of the MACD values that the
+// strategy is based on.
+func (m *MacdStrategy) Oscillator(snapshots <-chan *asset.Snapshot) <-chan float64 {
+	macds, signals := m.Macd.Compute(asset.SnapshotsAsClosings(snapshots))
+	go helper.Drain(signals)
+
+	return macds
+}
+
+// OscillatorIdlePeriod is the initial period that the MACD oscillator won't yield any results.
+func (m *MacdStrategy) OscillatorIdlePeriod() int {
+	return m.Macd.IdlePeriod()
 }
 
 // Report processes the provided asset snapshots and generates a
@@ -115,3 +159,18 @@ func (m *MacdStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
 
 	return report
 }
+
+// action returns the recommended action for the given MACD and signal values.
+func (m *MacdStrategy) action(macd, signal float64) strategy.Action {
+	// A MACD value crossing above signal line suggests a bullish trend.
+	if (macd > signal) && (macd < 0) {
+		return strategy.Buy
+	}
+
+	// A MACD value crossing below signal line suggests a bearish trend.
+	if (signal > macd) && (macd > 0) {
+		return strategy.Sell
+	}
+
+	return strategy.Hold
+}
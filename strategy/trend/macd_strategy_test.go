@@ -53,3 +53,90 @@ func TestMacdStrategyReport(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestMacdStrategyOscillator(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshotsSlice := helper.ChanToSlice(snapshots)
+
+	s := trend.NewMacdStrategy()
+
+	expected := len(snapshotsSlice) - s.OscillatorIdlePeriod()
+	actual := len(helper.ChanToSlice(s.Oscillator(helper.SliceToChan(snapshotsSlice))))
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func TestMacdStrategyCheckpoint(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshotsSlice := helper.ChanToSlice(snapshots)
+	split := len(snapshotsSlice) / 2
+
+	expected := trend.NewMacdStrategy().Compute(helper.SliceToChan(snapshotsSlice))
+
+	s := trend.NewMacdStrategy()
+	actions := make([]strategy.Action, 0, len(snapshotsSlice))
+
+	for _, snapshot := range snapshotsSlice[:split] {
+		actions = append(actions, s.Update(snapshot))
+	}
+
+	data, err := helper.Checkpoint(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Continue with a new instance, as in a restarted process.
+	s = trend.NewMacdStrategy()
+
+	err = helper.Restore(s, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, snapshot := range snapshotsSlice[split:] {
+		actions = append(actions, s.Update(snapshot))
+	}
+
+	err = helper.CheckEquals(helper.SliceToChan(actions), expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func BenchmarkMacdStrategyCompute(b *testing.B) {
+	snapshots := benchmarkSnapshots(b)
+	macd := trend.NewMacdStrategy()
+
+	for range b.N {
+		helper.Drain(macd.Compute(helper.SliceToChan(snapshots)))
+	}
+}
+
+func BenchmarkMacdStrategyComputeSlice(b *testing.B) {
+	snapshots := benchmarkSnapshots(b)
+	macd := trend.NewMacdStrategy()
+
+	for range b.N {
+		macd.ComputeSlice(snapshots)
+	}
+}
+
+// benchmarkSnapshots returns the snapshots that the benchmarks are run on.
+func benchmarkSnapshots(b *testing.B) []*asset.Snapshot {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	return helper.ChanToSlice(snapshots)
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"fmt"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+// ParabolicSarStrategy represents the configuration parameters for calculating the Parabolic SAR
+// strategy. A closing value above the SAR suggests a bullish trend, while a closing value below the
+// SAR suggests a bearish trend.
+type ParabolicSarStrategy struct {
+	// ParabolicSar represents the configuration parameters for calculating the Parabolic SAR.
+	ParabolicSar *trend.ParabolicSar[float64]
+}
+
+// NewParabolicSarStrategy function initializes a new Parabolic SAR strategy instance with the
+// default parameters.
+func NewParabolicSarStrategy() *ParabolicSarStrategy {
+	return NewParabolicSarStrategyWith(
+		trend.DefaultParabolicSarStep,
+		trend.DefaultParabolicSarMax,
+	)
+}
+
+// NewParabolicSarStrategyWith function initializes a new Parabolic SAR strategy instance with the
+// given parameters.
+func NewParabolicSarStrategyWith(step, maximum float64) *ParabolicSarStrategy {
+	return &ParabolicSarStrategy{
+		ParabolicSar: trend.NewParabolicSarWith[float64](step, maximum),
+	}
+}
+
+// Name returns the name of the strategy.
+func (p *ParabolicSarStrategy) Name() string {
+	return fmt.Sprintf("Parabolic SAR Strategy (%.2f,%.2f)", p.ParabolicSar.Step, p.ParabolicSar.Max)
+}
+
+// Compute processes the provided asset snapshots and generates a
+// stream of actionable recommendations.
+func (p *ParabolicSarStrategy) Compute(c <-chan *asset.Snapshot) <-chan strategy.Action {
+	snapshots := helper.Duplicate(c, 3)
+	highs := asset.SnapshotsAsHighs(snapshots[0])
+	lows := asset.SnapshotsAsLows(snapshots[1])
+	closings := asset.SnapshotsAsClosings(snapshots[2])
+
+	sars := p.ParabolicSar.Compute(highs, lows)
+
+	actions := helper.Operate(sars, closings, p.action)
+
+	// Parabolic SAR starts only after the idle period.
+	actions = helper.Shift(actions, p.ParabolicSar.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
+
+// ComputeSlice processes the provided asset snapshots and generates a
+// slice of actionable recommendations.
+func (p *ParabolicSarStrategy) ComputeSlice(snapshots []*asset.Snapshot) []strategy.Action {
+	sar := p.ParabolicSar.Clone()
+	actions := make([]strategy.Action, len(snapshots))
+
+	for i, snapshot := range snapshots {
+		sarValue, ok := sar.Update(snapshot.High, snapshot.Low)
+		if ok {
+			actions[i] = p.action(sarValue, snapshot.Close)
+		}
+	}
+
+	return actions
+}
+
+// Update processes the given asset snapshot and returns the recommended action, keeping the state
+// in the strategy instance.
+func (p *ParabolicSarStrategy) Update(snapshot *asset.Snapshot) strategy.Action {
+	sarValue, ok := p.ParabolicSar.Update(snapshot.High, snapshot.Low)
+	if !ok {
+		return strategy.Hold
+	}
+
+	return p.action(sarValue, snapshot.Close)
+}
+
+// Reset resets the state of the strategy.
+func (p *ParabolicSarStrategy) Reset() {
+	p.ParabolicSar.Reset()
+}
+
+// MarshalState returns the state of the strategy as JSON.
+func (p *ParabolicSarStrategy) MarshalState() ([]byte, error) {
+	return p.ParabolicSar.MarshalState()
+}
+
+// UnmarshalState restores the state of the strategy from the given JSON.
+func (p *ParabolicSarStrategy) UnmarshalState(data []byte) error {
+	return p.ParabolicSar.UnmarshalState(data)
+}
+
+// Report processes the provided asset snapshots and generates a
+// report annotated with the recommended actions.
+func (p *ParabolicSarStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> highs |
+	// snapshots[2] -> lows  |> sars
+	// snapshots[3] -> closings
+	// snapshots[4] -> actions -> annotations
+	//              -> outcomes
+	//
+	snapshots := helper.Duplicate(c, 5)
+
+	dates := asset.SnapshotsAsDates(snapshots[0])
+	highs := asset.SnapshotsAsHighs(snapshots[1])
+	lows := asset.SnapshotsAsLows(snapshots[2])
+	closings := asset.SnapshotsAsClosings(snapshots[3])
+
+	sars := helper.Shift(p.ParabolicSar.Compute(highs, lows), p.ParabolicSar.IdlePeriod(), 0)
+
+	actions, outcomes := strategy.ComputeWithOutcome(p, snapshots[4])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(p.Name(), dates)
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closings))
+	report.AddColumn(helper.NewNumericReportColumn("SAR", sars))
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations))
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 1)
+
+	return report
+}
+
+// action returns the recommended action for the given PSAR and closing values.
+func (p *ParabolicSarStrategy) action(sar, closing float64) strategy.Action {
+	// A closing value above the SAR suggests a bullish trend.
+	if closing > sar {
+		return strategy.Buy
+	}
+
+	// A closing value below the SAR suggests a bearish trend.
+	if closing < sar {
+		return strategy.Sell
+	}
+
+	return strategy.Hold
+}
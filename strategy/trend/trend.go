@@ -23,21 +23,26 @@ import "github.com/cinar/indicator/v2/strategy"
 // AllStrategies returns a slice containing references to all available trend strategies.
 func AllStrategies() []strategy.Strategy {
 	return []strategy.Strategy{
+		NewAdxStrategy(),
 		NewAlligatorStrategy(),
 		NewApoStrategy(),
 		NewAroonStrategy(),
 		NewBopStrategy(),
 		NewCciStrategy(),
+		NewCfoStrategy(),
 		NewDemaStrategy(),
 		NewGoldenCrossStrategy(),
 		NewKamaStrategy(),
 		NewKdjStrategy(),
+		NewMaCrossoverStrategy(),
 		NewMacdStrategy(),
+		NewParabolicSarStrategy(),
 		NewQstickStrategy(),
 		NewSmmaStrategy(),
 		NewTrimaStrategy(),
 		NewTripleMovingAverageCrossoverStrategy(),
 		NewTsiStrategy(),
+		NewVortexStrategy(),
 		NewVwmaStrategy(),
 		NewWeightedCloseStrategy(),
 	}
@@ -37,22 +37,54 @@ func (t *TrixStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan strategy
 
 	trixs := t.Trix.Compute(closings)
 
-	actions := helper.Map(trixs, func(trix float64) strategy.Action {
-		if trix > 0 {
-			return strategy.Buy
-		}
+	actions := helper.Map(trixs, t.action)
+
+	// TRIX starts only after a full period.
+	actions = helper.Shift(actions, t.Trix.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
 
-		if trix < 0 {
-			return strategy.Sell
+// ComputeSlice processes the provided asset snapshots and generates a
+// slice of actionable recommendations.
+func (t *TrixStrategy) ComputeSlice(snapshots []*asset.Snapshot) []strategy.Action {
+	trix := t.Trix.Clone()
+	actions := make([]strategy.Action, len(snapshots))
+
+	for i, snapshot := range snapshots {
+		trixValue, ok := trix.Update(snapshot.Close)
+		if ok {
+			actions[i] = t.action(trixValue)
 		}
+	}
 
+	return actions
+}
+
+// Update processes the given asset snapshot and returns the recommended action, keeping the state
+// in the strategy instance.
+func (t *TrixStrategy) Update(snapshot *asset.Snapshot) strategy.Action {
+	trixValue, ok := t.Trix.Update(snapshot.Close)
+	if !ok {
 		return strategy.Hold
-	})
+	}
 
-	// TRIX starts only after a full period.
-	actions = helper.Shift(actions, t.Trix.IdlePeriod(), strategy.Hold)
+	return t.action(trixValue)
+}
 
-	return actions
+// Reset resets the state of the strategy.
+func (t *TrixStrategy) Reset() {
+	t.Trix.Reset()
+}
+
+// MarshalState returns the state of the strategy as JSON.
+func (t *TrixStrategy) MarshalState() ([]byte, error) {
+	return t.Trix.MarshalState()
+}
+
+// UnmarshalState restores the state of the strategy from the given JSON.
+func (t *TrixStrategy) UnmarshalState(data []byte) error {
+	return t.Trix.UnmarshalState(data)
 }
 
 // Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
@@ -88,3 +120,16 @@ func (t *TrixStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
 
 	return report
 }
+
+// action returns the recommended action for the given TRIX value.
+func (t *TrixStrategy) action(trix float64) strategy.Action {
+	if trix > 0 {
+		return strategy.Buy
+	}
+
+	if trix < 0 {
+		return strategy.Sell
+	}
+
+	return strategy.Hold
+}
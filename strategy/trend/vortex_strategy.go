@@ -0,0 +1,155 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"fmt"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+// VortexStrategy represents the configuration parameters for calculating the Vortex strategy. The
+// positive vortex line being above the negative vortex line suggests a bullish trend, while the
+// negative vortex line being above the positive vortex line suggests a bearish trend.
+type VortexStrategy struct {
+	// Vortex represents the configuration parameters for calculating the Vortex Indicator.
+	Vortex *trend.Vortex[float64]
+}
+
+// NewVortexStrategy function initializes a new Vortex strategy instance with the default parameters.
+func NewVortexStrategy() *VortexStrategy {
+	return NewVortexStrategyWith(trend.DefaultVortexPeriod)
+}
+
+// NewVortexStrategyWith function initializes a new Vortex strategy instance with the given period.
+func NewVortexStrategyWith(period int) *VortexStrategy {
+	return &VortexStrategy{
+		Vortex: trend.NewVortexWithPeriod[float64](period),
+	}
+}
+
+// Name returns the name of the strategy.
+func (v *VortexStrategy) Name() string {
+	return fmt.Sprintf("Vortex Strategy (%d)", v.Vortex.Sum.Period)
+}
+
+// Compute processes the provided asset snapshots and generates a
+// stream of actionable recommendations.
+func (v *VortexStrategy) Compute(c <-chan *asset.Snapshot) <-chan strategy.Action {
+	snapshots := helper.Duplicate(c, 3)
+	highs := asset.SnapshotsAsHighs(snapshots[0])
+	lows := asset.SnapshotsAsLows(snapshots[1])
+	closings := asset.SnapshotsAsClosings(snapshots[2])
+
+	plusVis, minusVis := v.Vortex.Compute(highs, lows, closings)
+
+	actions := helper.Operate(plusVis, minusVis, v.action)
+
+	// Vortex starts only after a full period.
+	actions = helper.Shift(actions, v.Vortex.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
+
+// ComputeSlice processes the provided asset snapshots and generates a
+// slice of actionable recommendations.
+func (v *VortexStrategy) ComputeSlice(snapshots []*asset.Snapshot) []strategy.Action {
+	vortex := v.Vortex.Clone()
+	actions := make([]strategy.Action, len(snapshots))
+
+	for i, snapshot := range snapshots {
+		plusVi, minusVi, ok := vortex.Update(snapshot.High, snapshot.Low, snapshot.Close)
+		if ok {
+			actions[i] = v.action(plusVi, minusVi)
+		}
+	}
+
+	return actions
+}
+
+// Update processes the given asset snapshot and returns the recommended action, keeping the state
+// in the strategy instance.
+func (v *VortexStrategy) Update(snapshot *asset.Snapshot) strategy.Action {
+	plusVi, minusVi, ok := v.Vortex.Update(snapshot.High, snapshot.Low, snapshot.Close)
+	if !ok {
+		return strategy.Hold
+	}
+
+	return v.action(plusVi, minusVi)
+}
+
+// Reset resets the state of the strategy.
+func (v *VortexStrategy) Reset() {
+	v.Vortex.Reset()
+}
+
+// MarshalState returns the state of the strategy as JSON.
+func (v *VortexStrategy) MarshalState() ([]byte, error) {
+	return v.Vortex.MarshalState()
+}
+
+// UnmarshalState restores the state of the strategy from the given JSON.
+func (v *VortexStrategy) UnmarshalState(data []byte) error {
+	return v.Vortex.UnmarshalState(data)
+}
+
+// Report processes the provided asset snapshots and generates a
+// report annotated with the recommended actions.
+func (v *VortexStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> highs       |
+	// snapshots[2] -> lows        |
+	// snapshots[3] -> closings[1] |> plusVis, minusVis
+	//                 closings[0] -> closings
+	// snapshots[4] -> actions     -> annotations
+	//              -> outcomes
+	//
+	snapshots := helper.Duplicate(c, 5)
+
+	dates := asset.SnapshotsAsDates(snapshots[0])
+	highs := asset.SnapshotsAsHighs(snapshots[1])
+	lows := asset.SnapshotsAsLows(snapshots[2])
+	closings := helper.Duplicate(asset.SnapshotsAsClosings(snapshots[3]), 2)
+
+	plusVis, minusVis := v.Vortex.Compute(highs, lows, closings[1])
+	plusVis = helper.Shift(plusVis, v.Vortex.IdlePeriod(), 0)
+	minusVis = helper.Shift(minusVis, v.Vortex.IdlePeriod(), 0)
+
+	actions, outcomes := strategy.ComputeWithOutcome(v, snapshots[4])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(v.Name(), dates)
+	report.AddChart()
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closings[0]))
+	report.AddColumn(helper.NewNumericReportColumn("+VI", plusVis), 1)
+	report.AddColumn(helper.NewNumericReportColumn("-VI", minusVis), 1)
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations), 0, 1)
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 2)
+
+	return report
+}
+
+// action returns the recommended action for the given +VI and -VI values.
+func (v *VortexStrategy) action(plusVi, minusVi float64) strategy.Action {
+	// The positive line above the negative line suggests a bullish trend.
+	if plusVi > minusVi {
+		return strategy.Buy
+	}
+
+	// The negative line above the positive line suggests a bearish trend.
+	if minusVi > plusVi {
+		return strategy.Sell
+	}
+
+	return strategy.Hold
+}
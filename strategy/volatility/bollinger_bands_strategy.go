@@ -43,22 +43,54 @@ func (b *BollingerBandsStrategy) Compute(snapshots <-chan *asset.Snapshot) <-cha
 
 	closings[1] = helper.Skip(closings[1], b.BollingerBands.IdlePeriod())
 
-	actions := helper.Operate3(uppers, lowers, closings[1], func(upper, lower, closing float64) strategy.Action {
-		if closing > upper {
-			return strategy.Buy
-		}
+	actions := helper.Operate3(uppers, lowers, closings[1], b.action)
+
+	// Bollinger Bands starts only after a full period.
+	actions = helper.Shift(actions, b.BollingerBands.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
 
-		if lower > closing {
-			return strategy.Sell
+// ComputeSlice processes the provided asset snapshots and generates a
+// slice of actionable recommendations.
+func (b *BollingerBandsStrategy) ComputeSlice(snapshots []*asset.Snapshot) []strategy.Action {
+	bollingerBands := b.BollingerBands.Clone()
+	actions := make([]strategy.Action, len(snapshots))
+
+	for i, snapshot := range snapshots {
+		upper, _, lower, ok := bollingerBands.Update(snapshot.Close)
+		if ok {
+			actions[i] = b.action(upper, lower, snapshot.Close)
 		}
+	}
 
+	return actions
+}
+
+// Update processes the given asset snapshot and returns the recommended action, keeping the state
+// in the strategy instance.
+func (b *BollingerBandsStrategy) Update(snapshot *asset.Snapshot) strategy.Action {
+	upper, _, lower, ok := b.BollingerBands.Update(snapshot.Close)
+	if !ok {
 		return strategy.Hold
-	})
+	}
 
-	// Bollinger Bands starts only after a full period.
-	actions = helper.Shift(actions, b.BollingerBands.IdlePeriod(), strategy.Hold)
+	return b.action(upper, lower, snapshot.Close)
+}
 
-	return actions
+// Reset resets the state of the strategy.
+func (b *BollingerBandsStrategy) Reset() {
+	b.BollingerBands.Reset()
+}
+
+// MarshalState returns the state of the strategy as JSON.
+func (b *BollingerBandsStrategy) MarshalState() ([]byte, error) {
+	return b.BollingerBands.MarshalState()
+}
+
+// UnmarshalState restores the state of the strategy from the given JSON.
+func (b *BollingerBandsStrategy) UnmarshalState(data []byte) error {
+	return b.BollingerBands.UnmarshalState(data)
 }
 
 // Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
@@ -99,3 +131,16 @@ func (b *BollingerBandsStrategy) Report(c <-chan *asset.Snapshot) *helper.Report
 
 	return report
 }
+
+// action returns the recommended action for the given upper and lower bands, and the closing value.
+func (b *BollingerBandsStrategy) action(upper, lower, closing float64) strategy.Action {
+	if closing > upper {
+		return strategy.Buy
+	}
+
+	if lower > closing {
+		return strategy.Sell
+	}
+
+	return strategy.Hold
+}
@@ -0,0 +1,197 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volatility
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/volatility"
+)
+
+// DonchianBreakoutStrategy represents the configuration parameters for calculating the Donchian Channel
+// breakout strategy. A closing value breaking above the upper channel of the previous period suggests a
+// Buy signal, while breaking below the lower channel of the previous period indicates a Sell signal.
+type DonchianBreakoutStrategy struct {
+	// DonchianChannel represents the configuration parameters for calculating the Donchian Channel.
+	DonchianChannel *volatility.DonchianChannel[float64]
+
+	// upper is the upper channel of the previous period.
+	upper float64
+
+	// lower is the lower channel of the previous period.
+	lower float64
+
+	// ready indicates whether the channels of the previous period are known.
+	ready bool
+}
+
+// NewDonchianBreakoutStrategy function initializes a new Donchian Channel breakout strategy instance with
+// the default parameters.
+func NewDonchianBreakoutStrategy() *DonchianBreakoutStrategy {
+	return NewDonchianBreakoutStrategyWith(volatility.DefaultDonchianChannelPeriod)
+}
+
+// NewDonchianBreakoutStrategyWith function initializes a new Donchian Channel breakout strategy instance
+// with the given period.
+func NewDonchianBreakoutStrategyWith(period int) *DonchianBreakoutStrategy {
+	return &DonchianBreakoutStrategy{
+		DonchianChannel: volatility.NewDonchianChannelWithPeriod[float64](period),
+	}
+}
+
+// Name returns the name of the strategy.
+func (d *DonchianBreakoutStrategy) Name() string {
+	return fmt.Sprintf("Donchian Breakout Strategy (%d)", d.DonchianChannel.IdlePeriod()+1)
+}
+
+// Compute processes the provided asset snapshots and generates a stream of actionable recommendations.
+func (d *DonchianBreakoutStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan strategy.Action {
+	closings := helper.Duplicate(
+		asset.SnapshotsAsClosings(snapshots),
+		2,
+	)
+
+	uppers, middles, lowers := d.DonchianChannel.Compute(closings[0])
+	go helper.Drain(middles)
+
+	// Each closing is compared with the channels of the previous period.
+	closings[1] = helper.Skip(closings[1], d.DonchianChannel.IdlePeriod()+1)
+
+	actions := helper.Operate3(uppers, lowers, closings[1], d.action)
+
+	// Donchian Channel starts only after a full period.
+	actions = helper.Shift(actions, d.DonchianChannel.IdlePeriod()+1, strategy.Hold)
+
+	return actions
+}
+
+// Update processes the given asset snapshot and returns the recommended action, keeping the state
+// in the strategy instance.
+func (d *DonchianBreakoutStrategy) Update(snapshot *asset.Snapshot) strategy.Action {
+	action := strategy.Hold
+	if d.ready {
+		action = d.action(d.upper, d.lower, snapshot.Close)
+	}
+
+	d.upper, _, d.lower, d.ready = d.DonchianChannel.Update(snapshot.Close)
+
+	return action
+}
+
+// Reset resets the state of the strategy.
+func (d *DonchianBreakoutStrategy) Reset() {
+	d.DonchianChannel.Reset()
+	d.upper = 0
+	d.lower = 0
+	d.ready = false
+}
+
+// donchianBreakoutStrategyState is the state of the Donchian Channel breakout strategy.
+type donchianBreakoutStrategyState struct {
+	// DonchianChannel is the Donchian Channel state.
+	DonchianChannel json.RawMessage `json:"donchianChannel"`
+
+	// Upper is the upper channel of the previous period.
+	Upper float64 `json:"upper"`
+
+	// Lower is the lower channel of the previous period.
+	Lower float64 `json:"lower"`
+
+	// Ready indicates whether the channels of the previous period are known.
+	Ready bool `json:"ready"`
+}
+
+// MarshalState returns the state of the strategy as JSON.
+func (d *DonchianBreakoutStrategy) MarshalState() ([]byte, error) {
+	donchianChannel, err := d.DonchianChannel.MarshalState()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&donchianBreakoutStrategyState{
+		DonchianChannel: donchianChannel,
+		Upper:           d.upper,
+		Lower:           d.lower,
+		Ready:           d.ready,
+	})
+}
+
+// UnmarshalState restores the state of the strategy from the given JSON.
+func (d *DonchianBreakoutStrategy) UnmarshalState(data []byte) error {
+	var state donchianBreakoutStrategyState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = d.DonchianChannel.UnmarshalState(state.DonchianChannel)
+	if err != nil {
+		return err
+	}
+
+	d.upper = state.Upper
+	d.lower = state.Lower
+	d.ready = state.Ready
+
+	return nil
+}
+
+// Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
+func (d *DonchianBreakoutStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> closings[0] -> closings
+	//                 closings[1] -> upper
+	//                             -> middle
+	//                             -> lower
+	// snapshots[2] -> actions     -> annotations
+	//              -> outcomes
+	//
+	snapshots := helper.Duplicate(c, 3)
+
+	dates := asset.SnapshotsAsDates(snapshots[0])
+	closings := helper.Duplicate(asset.SnapshotsAsClosings(snapshots[1]), 2)
+
+	uppers, middles, lowers := d.DonchianChannel.Compute(closings[0])
+	uppers = helper.Shift(uppers, d.DonchianChannel.IdlePeriod(), 0)
+	middles = helper.Shift(middles, d.DonchianChannel.IdlePeriod(), 0)
+	lowers = helper.Shift(lowers, d.DonchianChannel.IdlePeriod(), 0)
+
+	actions, outcomes := strategy.ComputeWithOutcome(d, snapshots[2])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(d.Name(), dates)
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closings[1]))
+	report.AddColumn(helper.NewNumericReportColumn("Upper", uppers))
+	report.AddColumn(helper.NewNumericReportColumn("Middle", middles))
+	report.AddColumn(helper.NewNumericReportColumn("Lower", lowers))
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations))
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 1)
+
+	return report
+}
+
+// action returns the recommended action for the given upper and lower channels of the previous period,
+// and the closing value.
+func (*DonchianBreakoutStrategy) action(upper, lower, closing float64) strategy.Action {
+	if closing > upper {
+		return strategy.Buy
+	}
+
+	if lower > closing {
+		return strategy.Sell
+	}
+
+	return strategy.Hold
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volatility_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/strategy/volatility"
+)
+
+func TestDonchianBreakoutStrategy(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := helper.ReadFromCsvFile[strategy.Result]("testdata/donchian_breakout_strategy.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := helper.Map(results, func(r *strategy.Result) strategy.Action { return r.Action })
+
+	db := volatility.NewDonchianBreakoutStrategy()
+	actual := db.Compute(snapshots)
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDonchianBreakoutStrategyUpdate(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := helper.ReadFromCsvFile[strategy.Result]("testdata/donchian_breakout_strategy.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := helper.Map(results, func(r *strategy.Result) strategy.Action { return r.Action })
+
+	db := volatility.NewDonchianBreakoutStrategy()
+	actual := helper.Map(snapshots, db.Update)
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDonchianBreakoutStrategyReport(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db := volatility.NewDonchianBreakoutStrategy()
+
+	report := db.Report(snapshots)
+
+	fileName := "donchian_breakout_strategy.html"
+	defer helper.Remove(t, fileName)
+
+	err = report.WriteToFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDonchianBreakoutStrategyCheckpoint(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshotsSlice := helper.ChanToSlice(snapshots)
+	split := len(snapshotsSlice) / 2
+
+	expected := volatility.NewDonchianBreakoutStrategy().Compute(helper.SliceToChan(snapshotsSlice))
+
+	s := volatility.NewDonchianBreakoutStrategy()
+	actions := make([]strategy.Action, 0, len(snapshotsSlice))
+
+	for _, snapshot := range snapshotsSlice[:split] {
+		actions = append(actions, s.Update(snapshot))
+	}
+
+	data, err := helper.Checkpoint(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Continue with a new instance, as in a restarted process.
+	s = volatility.NewDonchianBreakoutStrategy()
+
+	err = helper.Restore(s, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, snapshot := range snapshotsSlice[split:] {
+		actions = append(actions, s.Update(snapshot))
+	}
+
+	err = helper.CheckEquals(helper.SliceToChan(actions), expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
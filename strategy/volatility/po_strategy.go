@@ -0,0 +1,125 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volatility
+
+import (
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/trend"
+	"github.com/cinar/indicator/v2/volatility"
+)
+
+const (
+	// DefaultPoStrategySmoothPeriod is the default period for the EMA signal line of the PO.
+	DefaultPoStrategySmoothPeriod = 3
+)
+
+// PoStrategy represents the configuration parameters for calculating the Projection Oscillator (PO)
+// strategy. A PO value above its EMA signal line suggests a Buy signal, while a PO value below its
+// signal line suggests a Sell signal.
+type PoStrategy struct {
+	// Po represents the configuration parameters for calculating the Projection Oscillator.
+	Po *volatility.Po[float64]
+
+	// Signal is the EMA signal line of the PO.
+	Signal *trend.Ema[float64]
+}
+
+// NewPoStrategy function initializes a new PO strategy instance with the default parameters.
+func NewPoStrategy() *PoStrategy {
+	return NewPoStrategyWith(
+		volatility.DefaultPoPeriod,
+		DefaultPoStrategySmoothPeriod,
+	)
+}
+
+// NewPoStrategyWith function initializes a new PO strategy instance with the given parameters.
+func NewPoStrategyWith(period, smoothPeriod int) *PoStrategy {
+	return &PoStrategy{
+		Po:     volatility.NewPoWithPeriod[float64](period),
+		Signal: trend.NewEmaWithPeriod[float64](smoothPeriod),
+	}
+}
+
+// Name returns the name of the strategy.
+func (*PoStrategy) Name() string {
+	return "Projection Oscillator Strategy"
+}
+
+// Compute processes the provided asset snapshots and generates a stream of actionable recommendations.
+func (p *PoStrategy) Compute(c <-chan *asset.Snapshot) <-chan strategy.Action {
+	snapshots := helper.Duplicate(c, 3)
+	highs := asset.SnapshotsAsHighs(snapshots[0])
+	lows := asset.SnapshotsAsLows(snapshots[1])
+	closings := asset.SnapshotsAsClosings(snapshots[2])
+
+	pos := helper.Duplicate(p.Po.Compute(highs, lows, closings), 2)
+	signals := p.Signal.Compute(pos[0])
+	pos[1] = helper.Skip(pos[1], p.Signal.IdlePeriod())
+
+	actions := helper.Operate(pos[1], signals, func(po, signal float64) strategy.Action {
+		if po > signal {
+			return strategy.Buy
+		}
+
+		if po < signal {
+			return strategy.Sell
+		}
+
+		return strategy.Hold
+	})
+
+	// PO starts only after a full period.
+	actions = helper.Shift(actions, p.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
+
+// Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
+func (p *PoStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> highs       |
+	// snapshots[2] -> lows        |
+	// snapshots[3] -> closings[1] |> pos[0] -> pos
+	//                                pos[1] -> signals
+	//                 closings[0] -> closings
+	// snapshots[4] -> actions     -> annotations
+	//              -> outcomes
+	//
+	snapshots := helper.Duplicate(c, 5)
+
+	dates := asset.SnapshotsAsDates(snapshots[0])
+	highs := asset.SnapshotsAsHighs(snapshots[1])
+	lows := asset.SnapshotsAsLows(snapshots[2])
+	closings := helper.Duplicate(asset.SnapshotsAsClosings(snapshots[3]), 2)
+
+	pos := helper.Duplicate(p.Po.Compute(highs, lows, closings[1]), 2)
+	signals := helper.Shift(p.Signal.Compute(pos[1]), p.IdlePeriod(), 0)
+	pos[0] = helper.Shift(pos[0], p.Po.IdlePeriod(), 0)
+
+	actions, outcomes := strategy.ComputeWithOutcome(p, snapshots[4])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(p.Name(), dates)
+	report.AddChart()
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closings[0]))
+	report.AddColumn(helper.NewNumericReportColumn("PO", pos[0]), 1)
+	report.AddColumn(helper.NewNumericReportColumn("Signal", signals), 1)
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations), 0, 1)
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 2)
+
+	return report
+}
+
+// IdlePeriod is the initial period that PO strategy won't yield any results.
+func (p *PoStrategy) IdlePeriod() int {
+	return p.Po.IdlePeriod() + p.Signal.IdlePeriod()
+}
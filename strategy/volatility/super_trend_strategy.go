@@ -53,22 +53,54 @@ func (s *SuperTrendStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan st
 
 	closingsSplice[1] = helper.Skip(closingsSplice[1], s.SuperTrend.IdlePeriod())
 
-	actions := helper.Operate(superTrends, closingsSplice[1], func(superTrend, closing float64) strategy.Action {
-		if superTrend < closing {
-			return strategy.Buy
-		}
+	actions := helper.Operate(superTrends, closingsSplice[1], s.action)
+
+	// Super Trend starts only after a full period.
+	actions = helper.Shift(actions, s.SuperTrend.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
 
-		if superTrend > closing {
-			return strategy.Sell
+// ComputeSlice processes the provided asset snapshots and generates a
+// slice of actionable recommendations.
+func (s *SuperTrendStrategy) ComputeSlice(snapshots []*asset.Snapshot) []strategy.Action {
+	superTrend := s.SuperTrend.Clone()
+	actions := make([]strategy.Action, len(snapshots))
+
+	for i, snapshot := range snapshots {
+		value, ok := superTrend.Update(snapshot.High, snapshot.Low, snapshot.Close)
+		if ok {
+			actions[i] = s.action(value, snapshot.Close)
 		}
+	}
 
+	return actions
+}
+
+// Update processes the given asset snapshot and returns the recommended action, keeping the state
+// in the strategy instance.
+func (s *SuperTrendStrategy) Update(snapshot *asset.Snapshot) strategy.Action {
+	value, ok := s.SuperTrend.Update(snapshot.High, snapshot.Low, snapshot.Close)
+	if !ok {
 		return strategy.Hold
-	})
+	}
 
-	// Super Trend starts only after a full period.
-	actions = helper.Shift(actions, s.SuperTrend.IdlePeriod(), strategy.Hold)
+	return s.action(value, snapshot.Close)
+}
 
-	return actions
+// Reset resets the state of the strategy.
+func (s *SuperTrendStrategy) Reset() {
+	s.SuperTrend.Reset()
+}
+
+// MarshalState returns the state of the strategy as JSON.
+func (s *SuperTrendStrategy) MarshalState() ([]byte, error) {
+	return s.SuperTrend.MarshalState()
+}
+
+// UnmarshalState restores the state of the strategy from the given JSON.
+func (s *SuperTrendStrategy) UnmarshalState(data []byte) error {
+	return s.SuperTrend.UnmarshalState(data)
 }
 
 // Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
@@ -110,3 +142,16 @@ func (s *SuperTrendStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
 
 	return report
 }
+
+// action returns the recommended action for the given Super Trend and closing values.
+func (s *SuperTrendStrategy) action(superTrend, closing float64) strategy.Action {
+	if superTrend < closing {
+		return strategy.Buy
+	}
+
+	if superTrend > closing {
+		return strategy.Sell
+	}
+
+	return strategy.Hold
+}
@@ -28,7 +28,10 @@ import (
 func AllStrategies() []strategy.Strategy {
 	return []strategy.Strategy{
 		NewBollingerBandsStrategy(),
+		NewDonchianBreakoutStrategy(),
+		NewPoStrategy(),
 		NewSuperTrendStrategy(),
+		NewVolatilityRegimeStrategy(),
 		NewSuperTrendStrategyWith(
 			volatility.NewSuperTrendWithMa[float64](
 				trend.NewSmaWithPeriod[float64](volatility.DefaultSuperTrendPeriod),
@@ -0,0 +1,165 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volatility
+
+import (
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/volatility"
+)
+
+const (
+	// DefaultVolatilityRegimeStrategyLookback is the default number of volatilities that the
+	// percentile threshold is computed over.
+	DefaultVolatilityRegimeStrategyLookback = 100
+
+	// DefaultVolatilityRegimeStrategyPercentile is the default percentile of the volatilities
+	// above which the regime is considered volatile.
+	DefaultVolatilityRegimeStrategyPercentile = 80
+)
+
+// VolatilityRegimeStrategy represents the configuration parameters for calculating the volatility
+// regime strategy. It compares the realized volatility with the given percentile of the realized
+// volatilities over the lookback period. It recommends a Sell action to go flat when the
+// volatility exceeds the threshold, and a Buy action to stay invested otherwise. It can be combined
+// with other strategies to filter their actions in volatile regimes.
+type VolatilityRegimeStrategy struct {
+	// Volatility is the realized volatility estimator.
+	Volatility *volatility.HistoricalVolatility[float64]
+
+	// Lookback is the number of volatilities that the threshold is computed over.
+	Lookback int
+
+	// Percentile is the percentile of the volatilities used as the threshold.
+	Percentile float64
+}
+
+// NewVolatilityRegimeStrategy function initializes a new volatility regime strategy instance with
+// the default parameters.
+func NewVolatilityRegimeStrategy() *VolatilityRegimeStrategy {
+	return NewVolatilityRegimeStrategyWith(
+		volatility.DefaultHistoricalVolatilityPeriod,
+		DefaultVolatilityRegimeStrategyLookback,
+		DefaultVolatilityRegimeStrategyPercentile,
+	)
+}
+
+// NewVolatilityRegimeStrategyWith function initializes a new volatility regime strategy instance
+// with the given parameters.
+func NewVolatilityRegimeStrategyWith(period, lookback int, percentile float64) *VolatilityRegimeStrategy {
+	return &VolatilityRegimeStrategy{
+		Volatility: volatility.NewHistoricalVolatilityWithPeriod[float64](period),
+		Lookback:   lookback,
+		Percentile: percentile,
+	}
+}
+
+// Name returns the name of the strategy.
+func (v *VolatilityRegimeStrategy) Name() string {
+	return fmt.Sprintf("Volatility Regime Strategy (%d,%d,%.0f)", v.Volatility.Period, v.Lookback, v.Percentile)
+}
+
+// Compute processes the provided asset snapshots and generates a stream of actionable recommendations.
+func (v *VolatilityRegimeStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan strategy.Action {
+	closings := asset.SnapshotsAsClosings(snapshots)
+
+	volatilities, thresholds := v.regimes(closings)
+
+	actions := helper.Operate(volatilities, thresholds, func(volatility, threshold float64) strategy.Action {
+		// Go flat in a volatile regime.
+		if volatility > threshold {
+			return strategy.Sell
+		}
+
+		return strategy.Buy
+	})
+
+	// Volatility regime starts only after a full lookback of volatilities.
+	actions = helper.Shift(actions, v.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
+
+// Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
+func (v *VolatilityRegimeStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> closings[0] -> closings
+	//                 closings[1] -> volatilities, thresholds
+	// snapshots[2] -> actions     -> annotations
+	//              -> outcomes
+	//
+	snapshots := helper.Duplicate(c, 3)
+
+	dates := asset.SnapshotsAsDates(snapshots[0])
+	closings := helper.Duplicate(asset.SnapshotsAsClosings(snapshots[1]), 2)
+
+	volatilities, thresholds := v.regimes(closings[1])
+	volatilities = helper.Shift(volatilities, v.IdlePeriod(), 0)
+	thresholds = helper.Shift(thresholds, v.IdlePeriod(), 0)
+
+	actions, outcomes := strategy.ComputeWithOutcome(v, snapshots[2])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(v.Name(), dates)
+	report.AddChart()
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closings[0]))
+	report.AddColumn(helper.NewNumericReportColumn("Volatility", volatilities), 1)
+	report.AddColumn(helper.NewNumericReportColumn("Threshold", thresholds), 1)
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations), 0, 1)
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 2)
+
+	return report
+}
+
+// IdlePeriod is the initial period that volatility regime strategy won't yield any results.
+func (v *VolatilityRegimeStrategy) IdlePeriod() int {
+	return v.Volatility.IdlePeriod() + v.Lookback - 1
+}
+
+// regimes computes the realized volatilities and their percentile thresholds over the lookback period.
+func (v *VolatilityRegimeStrategy) regimes(closings <-chan float64) (<-chan float64, <-chan float64) {
+	volatilities := make(chan float64)
+	thresholds := make(chan float64)
+
+	go func() {
+		defer close(volatilities)
+		defer close(thresholds)
+
+		ring := helper.NewRing[float64](v.Lookback)
+		sorted := make([]float64, v.Lookback)
+
+		for volatility := range v.Volatility.Compute(closings) {
+			ring.Put(volatility)
+			if !ring.IsFull() {
+				continue
+			}
+
+			for i := range sorted {
+				sorted[i] = ring.At(i)
+			}
+
+			slices.Sort(sorted)
+
+			// Nearest rank percentile.
+			rank := int(math.Ceil(v.Percentile / 100 * float64(v.Lookback)))
+			rank = min(max(rank, 1), v.Lookback)
+
+			volatilities <- volatility
+			thresholds <- sorted[rank-1]
+		}
+	}()
+
+	return volatilities, thresholds
+}
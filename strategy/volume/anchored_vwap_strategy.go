@@ -0,0 +1,196 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volume
+
+import (
+	"fmt"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/volume"
+)
+
+// AnchoredVwapMode is the way the anchored VWAP strategy acts on the closing leaving the bands.
+type AnchoredVwapMode int
+
+const (
+	// AnchoredVwapReversion expects the closing to revert to the VWAP. It recommends a Buy action
+	// when the closing falls below the lower band, and a Sell action when the closing rises above
+	// the upper band.
+	AnchoredVwapReversion AnchoredVwapMode = iota
+
+	// AnchoredVwapBreakout expects the closing to continue away from the VWAP. It recommends a Buy
+	// action when the closing breaks above the upper band, and a Sell action when the closing breaks
+	// below the lower band.
+	AnchoredVwapBreakout
+)
+
+// String returns the name of the mode.
+func (m AnchoredVwapMode) String() string {
+	if m == AnchoredVwapBreakout {
+		return "Breakout"
+	}
+
+	return "Reversion"
+}
+
+// AnchoredVwapStrategy represents the configuration parameters for calculating the anchored VWAP
+// strategy. Depending on the mode, it trades the reversion to the VWAP or the breakout from the
+// VWAP bands, and recommends a Hold action while the closing is within the bands.
+type AnchoredVwapStrategy struct {
+	// AnchoredVwap is the anchored VWAP indicator instance.
+	AnchoredVwap *volume.AnchoredVwap[float64]
+
+	// Mode is the trading mode.
+	Mode AnchoredVwapMode
+}
+
+// NewAnchoredVwapStrategy function initializes a new anchored VWAP strategy instance with the
+// default parameters.
+func NewAnchoredVwapStrategy() *AnchoredVwapStrategy {
+	return NewAnchoredVwapStrategyWith(
+		volume.NewAnchoredVwap[float64](),
+		AnchoredVwapReversion,
+	)
+}
+
+// NewAnchoredVwapStrategyWith function initializes a new anchored VWAP strategy instance with the
+// given parameters.
+func NewAnchoredVwapStrategyWith(anchoredVwap *volume.AnchoredVwap[float64], mode AnchoredVwapMode) *AnchoredVwapStrategy {
+	return &AnchoredVwapStrategy{
+		AnchoredVwap: anchoredVwap,
+		Mode:         mode,
+	}
+}
+
+// Name returns the name of the strategy.
+func (a *AnchoredVwapStrategy) Name() string {
+	return fmt.Sprintf("Anchored VWAP Strategy (%s,%.2f)", a.Mode, a.AnchoredVwap.Multiplier)
+}
+
+// Compute processes the provided asset snapshots and generates a stream of actionable recommendations.
+func (a *AnchoredVwapStrategy) Compute(c <-chan *asset.Snapshot) <-chan strategy.Action {
+	snapshots := helper.Duplicate(c, 5)
+
+	dates := asset.SnapshotsAsDates(snapshots[0])
+	highs := asset.SnapshotsAsHighs(snapshots[1])
+	lows := asset.SnapshotsAsLows(snapshots[2])
+	closings := helper.Duplicate(asset.SnapshotsAsClosings(snapshots[3]), 2)
+	volumes := asset.SnapshotsAsVolumes(snapshots[4])
+
+	uppers, vwaps, lowers := a.AnchoredVwap.Compute(dates, highs, lows, closings[1], volumes)
+	go helper.Drain(vwaps)
+
+	actions := helper.Operate3(closings[0], uppers, lowers, a.action)
+
+	// Anchored VWAP starts with the first snapshot.
+	actions = helper.Shift(actions, a.AnchoredVwap.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
+
+// ComputeSlice processes the provided asset snapshots and generates a
+// slice of actionable recommendations.
+func (a *AnchoredVwapStrategy) ComputeSlice(snapshots []*asset.Snapshot) []strategy.Action {
+	anchoredVwap := a.AnchoredVwap.Clone()
+	actions := make([]strategy.Action, len(snapshots))
+
+	for i, snapshot := range snapshots {
+		upper, _, lower, ok := anchoredVwap.Update(snapshot.Date, snapshot.High, snapshot.Low, snapshot.Close, snapshot.Volume)
+		if ok {
+			actions[i] = a.action(snapshot.Close, upper, lower)
+		}
+	}
+
+	return actions
+}
+
+// Update processes the given asset snapshot and returns the recommended action, keeping the state
+// in the strategy instance.
+func (a *AnchoredVwapStrategy) Update(snapshot *asset.Snapshot) strategy.Action {
+	upper, _, lower, ok := a.AnchoredVwap.Update(snapshot.Date, snapshot.High, snapshot.Low, snapshot.Close, snapshot.Volume)
+	if !ok {
+		return strategy.Hold
+	}
+
+	return a.action(snapshot.Close, upper, lower)
+}
+
+// Reset resets the state of the strategy.
+func (a *AnchoredVwapStrategy) Reset() {
+	a.AnchoredVwap.Reset()
+}
+
+// MarshalState returns the state of the strategy as JSON.
+func (a *AnchoredVwapStrategy) MarshalState() ([]byte, error) {
+	return a.AnchoredVwap.MarshalState()
+}
+
+// UnmarshalState restores the state of the strategy from the given JSON.
+func (a *AnchoredVwapStrategy) UnmarshalState(data []byte) error {
+	return a.AnchoredVwap.UnmarshalState(data)
+}
+
+// Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
+func (a *AnchoredVwapStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates[0]    -> dates
+	//                 dates[1]    |
+	// snapshots[1] -> highs       |
+	// snapshots[2] -> lows        |
+	// snapshots[3] -> closings[1] |> uppers, vwaps, lowers
+	//                 closings[0] -> closings
+	// snapshots[4] -> volumes     |
+	// snapshots[5] -> actions     -> annotations
+	//              -> outcomes
+	//
+	snapshots := helper.Duplicate(c, 6)
+
+	dates := helper.Duplicate(asset.SnapshotsAsDates(snapshots[0]), 2)
+	highs := asset.SnapshotsAsHighs(snapshots[1])
+	lows := asset.SnapshotsAsLows(snapshots[2])
+	closings := helper.Duplicate(asset.SnapshotsAsClosings(snapshots[3]), 2)
+	volumes := asset.SnapshotsAsVolumes(snapshots[4])
+
+	uppers, vwaps, lowers := a.AnchoredVwap.Compute(dates[1], highs, lows, closings[1], volumes)
+
+	actions, outcomes := strategy.ComputeWithOutcome(a, snapshots[5])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(a.Name(), dates[0])
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closings[0]))
+	report.AddColumn(helper.NewNumericReportColumn("Upper", uppers))
+	report.AddColumn(helper.NewNumericReportColumn("VWAP", vwaps))
+	report.AddColumn(helper.NewNumericReportColumn("Lower", lowers))
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations))
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 1)
+
+	return report
+}
+
+// action returns the recommended action for the given closing value, and the upper and lower bands.
+func (a *AnchoredVwapStrategy) action(closing, upper, lower float64) strategy.Action {
+	above := closing > upper
+	below := closing < lower
+
+	if a.Mode == AnchoredVwapBreakout {
+		above, below = below, above
+	}
+
+	if below {
+		return strategy.Buy
+	}
+
+	if above {
+		return strategy.Sell
+	}
+
+	return strategy.Hold
+}
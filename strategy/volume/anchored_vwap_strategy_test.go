@@ -0,0 +1,132 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volume_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/strategy/volume"
+	volumeindicator "github.com/cinar/indicator/v2/volume"
+)
+
+func TestAnchoredVwapStrategy(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := helper.ReadFromCsvFile[strategy.Result]("testdata/anchored_vwap_strategy.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := helper.Map(results, func(r *strategy.Result) strategy.Action { return r.Action })
+
+	anchoredVwap := volume.NewAnchoredVwapStrategyWith(
+		volumeindicator.NewAnchoredVwapWith[float64](asset.Monthly, 2),
+		volume.AnchoredVwapReversion,
+	)
+	actual := anchoredVwap.Compute(snapshots)
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAnchoredVwapStrategyBreakout(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := helper.ReadFromCsvFile[strategy.Result]("testdata/anchored_vwap_strategy.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Breakout acts in the opposite direction of the reversion.
+	expected := helper.Map(results, func(r *strategy.Result) strategy.Action { return -r.Action })
+
+	anchoredVwap := volume.NewAnchoredVwapStrategyWith(
+		volumeindicator.NewAnchoredVwapWith[float64](asset.Monthly, 2),
+		volume.AnchoredVwapBreakout,
+	)
+	actual := anchoredVwap.Compute(snapshots)
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAnchoredVwapStrategyReport(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	anchoredVwap := volume.NewAnchoredVwapStrategy()
+	report := anchoredVwap.Report(snapshots)
+
+	fileName := "anchored_vwap_strategy.html"
+	defer helper.Remove(t, fileName)
+
+	err = report.WriteToFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAnchoredVwapStrategyCheckpoint(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshotsSlice := helper.ChanToSlice(snapshots)
+	split := len(snapshotsSlice) / 2
+
+	expected := newAnchoredVwapStrategy().Compute(helper.SliceToChan(snapshotsSlice))
+
+	s := newAnchoredVwapStrategy()
+	actions := make([]strategy.Action, 0, len(snapshotsSlice))
+
+	for _, snapshot := range snapshotsSlice[:split] {
+		actions = append(actions, s.Update(snapshot))
+	}
+
+	data, err := helper.Checkpoint(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Continue with a new instance, as in a restarted process.
+	s = newAnchoredVwapStrategy()
+
+	err = helper.Restore(s, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, snapshot := range snapshotsSlice[split:] {
+		actions = append(actions, s.Update(snapshot))
+	}
+
+	err = helper.CheckEquals(helper.SliceToChan(actions), expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func newAnchoredVwapStrategy() *volume.AnchoredVwapStrategy {
+	return volume.NewAnchoredVwapStrategyWith(
+		volumeindicator.NewAnchoredVwapWith[float64](asset.Monthly, 2),
+		volume.AnchoredVwapReversion,
+	)
+}
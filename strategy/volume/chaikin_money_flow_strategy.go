@@ -52,22 +52,54 @@ func (c *ChaikinMoneyFlowStrategy) Compute(snapshots <-chan *asset.Snapshot) <-c
 
 	cmfs := c.ChaikinMoneyFlow.Compute(highs, lows, closings, volumes)
 
-	actions := helper.Map(cmfs, func(cmf float64) strategy.Action {
-		if cmf > 0 {
-			return strategy.Buy
-		}
+	actions := helper.Map(cmfs, c.action)
+
+	// Chaikin Money Flow starts only after a full period.
+	actions = helper.Shift(actions, c.ChaikinMoneyFlow.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
 
-		if cmf < 0 {
-			return strategy.Sell
+// ComputeSlice processes the provided asset snapshots and generates a
+// slice of actionable recommendations.
+func (c *ChaikinMoneyFlowStrategy) ComputeSlice(snapshots []*asset.Snapshot) []strategy.Action {
+	cmf := c.ChaikinMoneyFlow.Clone()
+	actions := make([]strategy.Action, len(snapshots))
+
+	for i, snapshot := range snapshots {
+		value, ok := cmf.Update(snapshot.High, snapshot.Low, snapshot.Close, snapshot.Volume)
+		if ok {
+			actions[i] = c.action(value)
 		}
+	}
 
+	return actions
+}
+
+// Update processes the given asset snapshot and returns the recommended action, keeping the state
+// in the strategy instance.
+func (c *ChaikinMoneyFlowStrategy) Update(snapshot *asset.Snapshot) strategy.Action {
+	value, ok := c.ChaikinMoneyFlow.Update(snapshot.High, snapshot.Low, snapshot.Close, snapshot.Volume)
+	if !ok {
 		return strategy.Hold
-	})
+	}
 
-	// Chaikin Money Flow starts only after a full period.
-	actions = helper.Shift(actions, c.ChaikinMoneyFlow.IdlePeriod(), strategy.Hold)
+	return c.action(value)
+}
 
-	return actions
+// Reset resets the state of the strategy.
+func (c *ChaikinMoneyFlowStrategy) Reset() {
+	c.ChaikinMoneyFlow.Reset()
+}
+
+// MarshalState returns the state of the strategy as JSON.
+func (c *ChaikinMoneyFlowStrategy) MarshalState() ([]byte, error) {
+	return c.ChaikinMoneyFlow.MarshalState()
+}
+
+// UnmarshalState restores the state of the strategy from the given JSON.
+func (c *ChaikinMoneyFlowStrategy) UnmarshalState(data []byte) error {
+	return c.ChaikinMoneyFlow.UnmarshalState(data)
 }
 
 // Report function processes the provided asset snapshots and generates a report annotated with the recommended actions.
@@ -119,3 +151,16 @@ func (c *ChaikinMoneyFlowStrategy) Report(snapshots <-chan *asset.Snapshot) *hel
 
 	return report
 }
+
+// action returns the recommended action for the given CMF value.
+func (c *ChaikinMoneyFlowStrategy) action(cmf float64) strategy.Action {
+	if cmf > 0 {
+		return strategy.Buy
+	}
+
+	if cmf < 0 {
+		return strategy.Sell
+	}
+
+	return strategy.Hold
+}
@@ -51,22 +51,54 @@ func (e *EaseOfMovementStrategy) Compute(snapshots <-chan *asset.Snapshot) <-cha
 
 	emvs := e.EaseOfMovement.Compute(highs, lows, volumes)
 
-	actions := helper.Map(emvs, func(emv float64) strategy.Action {
-		if emv > 0 {
-			return strategy.Buy
-		}
+	actions := helper.Map(emvs, e.action)
+
+	// Ease of Movement starts only after a full period.
+	actions = helper.Shift(actions, e.EaseOfMovement.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
 
-		if emv < 0 {
-			return strategy.Sell
+// ComputeSlice processes the provided asset snapshots and generates a
+// slice of actionable recommendations.
+func (e *EaseOfMovementStrategy) ComputeSlice(snapshots []*asset.Snapshot) []strategy.Action {
+	emv := e.EaseOfMovement.Clone()
+	actions := make([]strategy.Action, len(snapshots))
+
+	for i, snapshot := range snapshots {
+		value, ok := emv.Update(snapshot.High, snapshot.Low, snapshot.Volume)
+		if ok {
+			actions[i] = e.action(value)
 		}
+	}
 
+	return actions
+}
+
+// Update processes the given asset snapshot and returns the recommended action, keeping the state
+// in the strategy instance.
+func (e *EaseOfMovementStrategy) Update(snapshot *asset.Snapshot) strategy.Action {
+	value, ok := e.EaseOfMovement.Update(snapshot.High, snapshot.Low, snapshot.Volume)
+	if !ok {
 		return strategy.Hold
-	})
+	}
 
-	// Ease of Movement starts only after a full period.
-	actions = helper.Shift(actions, e.EaseOfMovement.IdlePeriod(), strategy.Hold)
+	return e.action(value)
+}
 
-	return actions
+// Reset resets the state of the strategy.
+func (e *EaseOfMovementStrategy) Reset() {
+	e.EaseOfMovement.Reset()
+}
+
+// MarshalState returns the state of the strategy as JSON.
+func (e *EaseOfMovementStrategy) MarshalState() ([]byte, error) {
+	return e.EaseOfMovement.MarshalState()
+}
+
+// UnmarshalState restores the state of the strategy from the given JSON.
+func (e *EaseOfMovementStrategy) UnmarshalState(data []byte) error {
+	return e.EaseOfMovement.UnmarshalState(data)
 }
 
 // Report function processes the provided asset snapshots and generates a report annotated with the recommended actions.
@@ -117,3 +149,16 @@ func (e *EaseOfMovementStrategy) Report(snapshots <-chan *asset.Snapshot) *helpe
 
 	return report
 }
+
+// action returns the recommended action for the given EMV value.
+func (e *EaseOfMovementStrategy) action(emv float64) strategy.Action {
+	if emv > 0 {
+		return strategy.Buy
+	}
+
+	if emv < 0 {
+		return strategy.Sell
+	}
+
+	return strategy.Hold
+}
@@ -48,22 +48,54 @@ func (f *ForceIndexStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan st
 
 	fis := f.ForceIndex.Compute(closings, volumes)
 
-	actions := helper.Map(fis, func(fi float64) strategy.Action {
-		if fi > 0 {
-			return strategy.Buy
-		}
+	actions := helper.Map(fis, f.action)
+
+	// Force Index starts only after a full period.
+	actions = helper.Shift(actions, f.ForceIndex.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
 
-		if fi < 0 {
-			return strategy.Sell
+// ComputeSlice processes the provided asset snapshots and generates a
+// slice of actionable recommendations.
+func (f *ForceIndexStrategy) ComputeSlice(snapshots []*asset.Snapshot) []strategy.Action {
+	fi := f.ForceIndex.Clone()
+	actions := make([]strategy.Action, len(snapshots))
+
+	for i, snapshot := range snapshots {
+		value, ok := fi.Update(snapshot.Close, snapshot.Volume)
+		if ok {
+			actions[i] = f.action(value)
 		}
+	}
 
+	return actions
+}
+
+// Update processes the given asset snapshot and returns the recommended action, keeping the state
+// in the strategy instance.
+func (f *ForceIndexStrategy) Update(snapshot *asset.Snapshot) strategy.Action {
+	value, ok := f.ForceIndex.Update(snapshot.Close, snapshot.Volume)
+	if !ok {
 		return strategy.Hold
-	})
+	}
 
-	// Force Index starts only after a full period.
-	actions = helper.Shift(actions, f.ForceIndex.IdlePeriod(), strategy.Hold)
+	return f.action(value)
+}
 
-	return actions
+// Reset resets the state of the strategy.
+func (f *ForceIndexStrategy) Reset() {
+	f.ForceIndex.Reset()
+}
+
+// MarshalState returns the state of the strategy as JSON.
+func (f *ForceIndexStrategy) MarshalState() ([]byte, error) {
+	return f.ForceIndex.MarshalState()
+}
+
+// UnmarshalState restores the state of the strategy from the given JSON.
+func (f *ForceIndexStrategy) UnmarshalState(data []byte) error {
+	return f.ForceIndex.UnmarshalState(data)
 }
 
 // Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
@@ -109,3 +141,16 @@ func (f *ForceIndexStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
 
 	return report
 }
+
+// action returns the recommended action for the given FI value.
+func (f *ForceIndexStrategy) action(fi float64) strategy.Action {
+	if fi > 0 {
+		return strategy.Buy
+	}
+
+	if fi < 0 {
+		return strategy.Sell
+	}
+
+	return strategy.Hold
+}
@@ -68,22 +68,54 @@ func (m *MoneyFlowIndexStrategy) Compute(snapshots <-chan *asset.Snapshot) <-cha
 
 	mfis := m.MoneyFlowIndex.Compute(highs, lows, closings, volumes)
 
-	actions := helper.Map(mfis, func(mfi float64) strategy.Action {
-		if mfi >= m.SellAt {
-			return strategy.Sell
-		}
+	actions := helper.Map(mfis, m.action)
+
+	// Money Flow Index starts only after a full period.
+	actions = helper.Shift(actions, m.MoneyFlowIndex.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
 
-		if mfi <= m.BuyAt {
-			return strategy.Buy
+// ComputeSlice processes the provided asset snapshots and generates a
+// slice of actionable recommendations.
+func (m *MoneyFlowIndexStrategy) ComputeSlice(snapshots []*asset.Snapshot) []strategy.Action {
+	mfi := m.MoneyFlowIndex.Clone()
+	actions := make([]strategy.Action, len(snapshots))
+
+	for i, snapshot := range snapshots {
+		value, ok := mfi.Update(snapshot.High, snapshot.Low, snapshot.Close, snapshot.Volume)
+		if ok {
+			actions[i] = m.action(value)
 		}
+	}
 
+	return actions
+}
+
+// Update processes the given asset snapshot and returns the recommended action, keeping the state
+// in the strategy instance.
+func (m *MoneyFlowIndexStrategy) Update(snapshot *asset.Snapshot) strategy.Action {
+	value, ok := m.MoneyFlowIndex.Update(snapshot.High, snapshot.Low, snapshot.Close, snapshot.Volume)
+	if !ok {
 		return strategy.Hold
-	})
+	}
 
-	// Money Flow Index starts only after a full period.
-	actions = helper.Shift(actions, m.MoneyFlowIndex.IdlePeriod(), strategy.Hold)
+	return m.action(value)
+}
 
-	return actions
+// Reset resets the state of the strategy.
+func (m *MoneyFlowIndexStrategy) Reset() {
+	m.MoneyFlowIndex.Reset()
+}
+
+// MarshalState returns the state of the strategy as JSON.
+func (m *MoneyFlowIndexStrategy) MarshalState() ([]byte, error) {
+	return m.MoneyFlowIndex.MarshalState()
+}
+
+// UnmarshalState restores the state of the strategy from the given JSON.
+func (m *MoneyFlowIndexStrategy) UnmarshalState(data []byte) error {
+	return m.MoneyFlowIndex.UnmarshalState(data)
 }
 
 // Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
@@ -135,3 +167,16 @@ func (m *MoneyFlowIndexStrategy) Report(c <-chan *asset.Snapshot) *helper.Report
 
 	return report
 }
+
+// action returns the recommended action for the given MFI value.
+func (m *MoneyFlowIndexStrategy) action(mfi float64) strategy.Action {
+	if mfi >= m.SellAt {
+		return strategy.Sell
+	}
+
+	if mfi <= m.BuyAt {
+		return strategy.Buy
+	}
+
+	return strategy.Hold
+}
@@ -25,11 +25,13 @@ import (
 // AllStrategies returns a slice containing references to all available volume strategies.
 func AllStrategies() []strategy.Strategy {
 	return []strategy.Strategy{
+		NewAnchoredVwapStrategy(),
 		NewChaikinMoneyFlowStrategy(),
 		NewEaseOfMovementStrategy(),
 		NewForceIndexStrategy(),
 		NewMoneyFlowIndexStrategy(),
 		NewNegativeVolumeIndexStrategy(),
+		NewVwapEmaStrategy(),
 		NewWeightedAveragePriceStrategy(),
 	}
 }
@@ -0,0 +1,226 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volume
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/strategy"
+	"github.com/cinar/indicator/v2/trend"
+	"github.com/cinar/indicator/v2/volume"
+)
+
+const (
+	// DefaultVwapEmaStrategyFastPeriod is the default fast EMA period of 9.
+	DefaultVwapEmaStrategyFastPeriod = 9
+
+	// DefaultVwapEmaStrategySlowPeriod is the default slow EMA period of 21.
+	DefaultVwapEmaStrategySlowPeriod = 21
+
+	// DefaultVwapEmaStrategyVwapPeriod is the default VWAP period of 20.
+	DefaultVwapEmaStrategyVwapPeriod = 20
+)
+
+// VwapEmaStrategy represents the configuration parameters for calculating the VWAP EMA crossover
+// strategy. The fast EMA being above both the slow EMA and the VWAP suggests a Buy signal, while the
+// fast EMA being below both of them indicates a Sell signal.
+type VwapEmaStrategy struct {
+	// FastEma is the fast EMA instance.
+	FastEma *trend.Ema[float64]
+
+	// SlowEma is the slow EMA instance.
+	SlowEma *trend.Ema[float64]
+
+	// Vwap is the VWAP instance.
+	Vwap *volume.Vwap[float64]
+}
+
+// NewVwapEmaStrategy function initializes a new VWAP EMA strategy instance with the default parameters.
+func NewVwapEmaStrategy() *VwapEmaStrategy {
+	return NewVwapEmaStrategyWith(
+		DefaultVwapEmaStrategyFastPeriod,
+		DefaultVwapEmaStrategySlowPeriod,
+		DefaultVwapEmaStrategyVwapPeriod,
+	)
+}
+
+// NewVwapEmaStrategyWith function initializes a new VWAP EMA strategy instance with the given parameters.
+func NewVwapEmaStrategyWith(fastPeriod, slowPeriod, vwapPeriod int) *VwapEmaStrategy {
+	return &VwapEmaStrategy{
+		FastEma: trend.NewEmaWithPeriod[float64](fastPeriod),
+		SlowEma: trend.NewEmaWithPeriod[float64](slowPeriod),
+		Vwap:    volume.NewVwapWithPeriod[float64](vwapPeriod),
+	}
+}
+
+// Name returns the name of the strategy.
+func (v *VwapEmaStrategy) Name() string {
+	return fmt.Sprintf("VWAP EMA Strategy (%d,%d,%d)", v.FastEma.Period, v.SlowEma.Period, v.Vwap.IdlePeriod()+1)
+}
+
+// Compute processes the provided asset snapshots and generates a stream of actionable recommendations.
+func (v *VwapEmaStrategy) Compute(snapshots <-chan *asset.Snapshot) <-chan strategy.Action {
+	snapshotsSplice := helper.Duplicate(snapshots, 2)
+
+	closingsSplice := helper.Duplicate(
+		asset.SnapshotsAsClosings(snapshotsSplice[0]),
+		3,
+	)
+
+	volumes := asset.SnapshotsAsVolumes(snapshotsSplice[1])
+
+	idlePeriod := v.IdlePeriod()
+
+	fasts := helper.Skip(v.FastEma.Compute(closingsSplice[0]), idlePeriod-v.FastEma.IdlePeriod())
+	slows := helper.Skip(v.SlowEma.Compute(closingsSplice[1]), idlePeriod-v.SlowEma.IdlePeriod())
+	vwaps := helper.Skip(v.Vwap.Compute(closingsSplice[2], volumes), idlePeriod-v.Vwap.IdlePeriod())
+
+	actions := helper.Operate3(fasts, slows, vwaps, v.action)
+
+	// The EMAs and the VWAP start only after a full period.
+	actions = helper.Shift(actions, idlePeriod, strategy.Hold)
+
+	return actions
+}
+
+// Update processes the given asset snapshot and returns the recommended action, keeping the state
+// in the strategy instance.
+func (v *VwapEmaStrategy) Update(snapshot *asset.Snapshot) strategy.Action {
+	fast, fastOk := v.FastEma.Update(snapshot.Close)
+	slow, slowOk := v.SlowEma.Update(snapshot.Close)
+	vwap, vwapOk := v.Vwap.Update(snapshot.Close, snapshot.Volume)
+
+	if !fastOk || !slowOk || !vwapOk {
+		return strategy.Hold
+	}
+
+	return v.action(fast, slow, vwap)
+}
+
+// Reset resets the state of the strategy.
+func (v *VwapEmaStrategy) Reset() {
+	v.FastEma.Reset()
+	v.SlowEma.Reset()
+	v.Vwap.Reset()
+}
+
+// vwapEmaStrategyState is the state of the VWAP EMA strategy.
+type vwapEmaStrategyState struct {
+	// FastEma is the fast EMA state.
+	FastEma json.RawMessage `json:"fastEma"`
+
+	// SlowEma is the slow EMA state.
+	SlowEma json.RawMessage `json:"slowEma"`
+
+	// Vwap is the VWAP state.
+	Vwap json.RawMessage `json:"vwap"`
+}
+
+// MarshalState returns the state of the strategy as JSON.
+func (v *VwapEmaStrategy) MarshalState() ([]byte, error) {
+	var err error
+
+	state := vwapEmaStrategyState{}
+
+	state.FastEma, err = v.FastEma.MarshalState()
+	if err != nil {
+		return nil, err
+	}
+
+	state.SlowEma, err = v.SlowEma.MarshalState()
+	if err != nil {
+		return nil, err
+	}
+
+	state.Vwap, err = v.Vwap.MarshalState()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState restores the state of the strategy from the given JSON.
+func (v *VwapEmaStrategy) UnmarshalState(data []byte) error {
+	var state vwapEmaStrategyState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = v.FastEma.UnmarshalState(state.FastEma)
+	if err != nil {
+		return err
+	}
+
+	err = v.SlowEma.UnmarshalState(state.SlowEma)
+	if err != nil {
+		return err
+	}
+
+	return v.Vwap.UnmarshalState(state.Vwap)
+}
+
+// IdlePeriod is the initial period that the VWAP EMA strategy won't yield any results.
+func (v *VwapEmaStrategy) IdlePeriod() int {
+	return max(v.FastEma.IdlePeriod(), v.SlowEma.IdlePeriod(), v.Vwap.IdlePeriod())
+}
+
+// Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
+func (v *VwapEmaStrategy) Report(c <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> closings[0] -> closings
+	//                 closings[1] -> fast EMA
+	//                 closings[2] -> slow EMA
+	//                 closings[3] -> VWAP
+	// snapshots[2] -> volumes
+	// snapshots[3] -> actions     -> annotations
+	//              -> outcomes
+	//
+	snapshots := helper.Duplicate(c, 4)
+
+	dates := asset.SnapshotsAsDates(snapshots[0])
+	closings := helper.Duplicate(asset.SnapshotsAsClosings(snapshots[1]), 4)
+	volumes := asset.SnapshotsAsVolumes(snapshots[2])
+
+	fasts := helper.Shift(v.FastEma.Compute(closings[1]), v.FastEma.IdlePeriod(), 0)
+	slows := helper.Shift(v.SlowEma.Compute(closings[2]), v.SlowEma.IdlePeriod(), 0)
+	vwaps := helper.Shift(v.Vwap.Compute(closings[3], volumes), v.Vwap.IdlePeriod(), 0)
+
+	actions, outcomes := strategy.ComputeWithOutcome(v, snapshots[3])
+	annotations := strategy.ActionsToAnnotations(actions)
+	outcomes = helper.MultiplyBy(outcomes, 100)
+
+	report := helper.NewReport(v.Name(), dates)
+	report.AddChart()
+
+	report.AddColumn(helper.NewNumericReportColumn("Close", closings[0]))
+	report.AddColumn(helper.NewNumericReportColumn("Fast EMA", fasts))
+	report.AddColumn(helper.NewNumericReportColumn("Slow EMA", slows))
+	report.AddColumn(helper.NewNumericReportColumn("VWAP", vwaps))
+	report.AddColumn(helper.NewAnnotationReportColumn(annotations))
+
+	report.AddColumn(helper.NewNumericReportColumn("Outcome", outcomes), 1)
+
+	return report
+}
+
+// action returns the recommended action for the given fast EMA, slow EMA, and VWAP values.
+func (*VwapEmaStrategy) action(fast, slow, vwap float64) strategy.Action {
+	if fast > slow && fast > vwap {
+		return strategy.Buy
+	}
+
+	if fast < slow && fast < vwap {
+		return strategy.Sell
+	}
+
+	return strategy.Hold
+}
@@ -56,22 +56,54 @@ func (v *WeightedAveragePriceStrategy) Compute(snapshots <-chan *asset.Snapshot)
 	vwaps := v.WeightedAveragePrice.Compute(closingsSplice[1], volumes)
 	closingsSplice[0] = helper.Skip(closingsSplice[0], v.WeightedAveragePrice.IdlePeriod())
 
-	actions := helper.Operate(closingsSplice[0], vwaps, func(closing, vwap float64) strategy.Action {
-		if vwap > closing {
-			return strategy.Buy
-		}
+	actions := helper.Operate(closingsSplice[0], vwaps, v.action)
+
+	// Weighted Average Price starts only after a full period.
+	actions = helper.Shift(actions, v.WeightedAveragePrice.IdlePeriod(), strategy.Hold)
+
+	return actions
+}
 
-		if vwap < closing {
-			return strategy.Sell
+// ComputeSlice processes the provided asset snapshots and generates a
+// slice of actionable recommendations.
+func (v *WeightedAveragePriceStrategy) ComputeSlice(snapshots []*asset.Snapshot) []strategy.Action {
+	vwap := v.WeightedAveragePrice.Clone()
+	actions := make([]strategy.Action, len(snapshots))
+
+	for i, snapshot := range snapshots {
+		value, ok := vwap.Update(snapshot.Close, snapshot.Volume)
+		if ok {
+			actions[i] = v.action(snapshot.Close, value)
 		}
+	}
 
+	return actions
+}
+
+// Update processes the given asset snapshot and returns the recommended action, keeping the state
+// in the strategy instance.
+func (v *WeightedAveragePriceStrategy) Update(snapshot *asset.Snapshot) strategy.Action {
+	value, ok := v.WeightedAveragePrice.Update(snapshot.Close, snapshot.Volume)
+	if !ok {
 		return strategy.Hold
-	})
+	}
 
-	// Weighted Average Price starts only after a full period.
-	actions = helper.Shift(actions, v.WeightedAveragePrice.IdlePeriod(), strategy.Hold)
+	return v.action(snapshot.Close, value)
+}
 
-	return actions
+// Reset resets the state of the strategy.
+func (v *WeightedAveragePriceStrategy) Reset() {
+	v.WeightedAveragePrice.Reset()
+}
+
+// MarshalState returns the state of the strategy as JSON.
+func (v *WeightedAveragePriceStrategy) MarshalState() ([]byte, error) {
+	return v.WeightedAveragePrice.MarshalState()
+}
+
+// UnmarshalState restores the state of the strategy from the given JSON.
+func (v *WeightedAveragePriceStrategy) UnmarshalState(data []byte) error {
+	return v.WeightedAveragePrice.UnmarshalState(data)
 }
 
 // Report processes the provided asset snapshots and generates a report annotated with the recommended actions.
@@ -116,3 +148,16 @@ func (v *WeightedAveragePriceStrategy) Report(c <-chan *asset.Snapshot) *helper.
 
 	return report
 }
+
+// action returns the recommended action for the given closing and VWAP values.
+func (v *WeightedAveragePriceStrategy) action(closing, vwap float64) strategy.Action {
+	if vwap > closing {
+		return strategy.Buy
+	}
+
+	if vwap < closing {
+		return strategy.Sell
+	}
+
+	return strategy.Hold
+}
@@ -13,7 +13,7 @@ def test_enhanced_backtest():
     # Minimal test configuration
     config = {
         "instrument": "DummyTest",
-        "strategy": "VWAP EMA Crossover (9,21,20)",
+        "strategy": "VWAP EMA Strategy (9,21,20)",
         "initialCapital": 50000,
         "positionSize": 1,
         "stopLoss": 2,
@@ -0,0 +1,201 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"encoding/json"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultAdxPeriod is the default period for the Average Directional Index (ADX).
+	DefaultAdxPeriod = 14
+)
+
+// Adx represents the configuration parameters for calculating the Average Directional Index (ADX)
+// and the Average Directional Movement Index Rating (ADXR) developed by J. Welles Wilder. The ADX
+// measures the strength of a trend regardless of its direction. Values above 25 usually indicate
+// a strong trend, and values below 20 indicate a weak or absent trend.
+//
+//	ADX = RMA(DX)
+//	ADXR = (ADX + ADX[Period - 1 periods ago]) / 2
+//
+// Example:
+//
+//	adx := trend.NewAdx[float64]()
+//	plusDi, minusDi, adxs, adxrs := adx.Compute(highs, lows, closings)
+type Adx[T helper.Number] struct {
+	// Dmi is the directional movement index.
+	Dmi *Dmi[T]
+
+	// Rma is the RMA used to smooth the DX.
+	Rma *Rma[T]
+
+	// dmi is the DMI state.
+	dmi *Dmi[T]
+
+	// rma is the DX RMA state.
+	rma *Rma[T]
+
+	// adxs is the ADX values in the ADXR lookback.
+	adxs *helper.Ring[T]
+}
+
+// NewAdx function initializes a new ADX instance with the default parameters.
+func NewAdx[T helper.Number]() *Adx[T] {
+	return NewAdxWithPeriod[T](DefaultAdxPeriod)
+}
+
+// NewAdxWithPeriod function initializes a new ADX instance with the given period.
+func NewAdxWithPeriod[T helper.Number](period int) *Adx[T] {
+	return &Adx[T]{
+		Dmi: NewDmiWithPeriod[T](period),
+		Rma: NewRmaWithPeriod[T](period),
+	}
+}
+
+// Compute function takes a channel of highs, lows, and closings, and computes the ADX over the
+// specified period. Returns +DI, -DI, ADX, and ADXR, all aligned to the ADXR.
+func (a *Adx[T]) Compute(highs, lows, closings <-chan T) (<-chan T, <-chan T, <-chan T, <-chan T) {
+	adx := a.Clone()
+
+	values := helper.Compute3(highs, lows, closings, func(high, low, closing T) ([]T, bool) {
+		plusDi, minusDi, adxValue, adxr, ok := adx.Update(high, low, closing)
+		return []T{plusDi, minusDi, adxValue, adxr}, ok
+	})
+
+	outputs := helper.Unzip(values, 4)
+
+	return outputs[0], outputs[1], outputs[2], outputs[3]
+}
+
+// ComputeSlice function takes a slice of highs, lows, and closings, and computes the ADX over the
+// specified period. Returns +DI, -DI, ADX, and ADXR, all aligned to the ADXR.
+func (a *Adx[T]) ComputeSlice(highs, lows, closings []T) ([]T, []T, []T, []T) {
+	adx := a.Clone()
+
+	values := helper.ComputeSlice3(highs, lows, closings, func(high, low, closing T) ([]T, bool) {
+		plusDi, minusDi, adxValue, adxr, ok := adx.Update(high, low, closing)
+		return []T{plusDi, minusDi, adxValue, adxr}, ok
+	})
+
+	outputs := helper.UnzipSlice(values, 4)
+
+	return outputs[0], outputs[1], outputs[2], outputs[3]
+}
+
+// Update function takes a high, a low, and a closing value, and updates the ADX. It returns the +DI,
+// -DI, ADX, and ADXR values, and whether the ADX has passed its idle period and the values are ready.
+func (a *Adx[T]) Update(high, low, closing T) (T, T, T, T, bool) {
+	if a.dmi == nil {
+		a.dmi = a.Dmi.Clone()
+		a.rma = a.Rma.Clone()
+		a.adxs = helper.NewRing[T](a.Rma.Period)
+	}
+
+	plusDi, minusDi, dx, ok := a.dmi.Update(high, low, closing)
+	if !ok {
+		return 0, 0, 0, 0, false
+	}
+
+	adx, ok := a.rma.Update(dx)
+	if !ok {
+		return 0, 0, 0, 0, false
+	}
+
+	a.adxs.Put(adx)
+	if !a.adxs.IsFull() {
+		return 0, 0, 0, 0, false
+	}
+
+	adxr := (adx + a.adxs.At(0)) / 2
+
+	return plusDi, minusDi, adx, adxr, true
+}
+
+// Reset function resets the state of the ADX.
+func (a *Adx[T]) Reset() {
+	a.dmi = nil
+	a.rma = nil
+	a.adxs = nil
+}
+
+// Clone function returns a new ADX instance with the same configuration and a fresh state.
+func (a *Adx[T]) Clone() *Adx[T] {
+	return &Adx[T]{
+		Dmi: a.Dmi.Clone(),
+		Rma: a.Rma.Clone(),
+	}
+}
+
+// adxState is the state of the ADX.
+type adxState struct {
+	// Dmi is the DMI state.
+	Dmi json.RawMessage `json:"dmi"`
+
+	// Rma is the DX RMA state.
+	Rma json.RawMessage `json:"rma"`
+
+	// Adxs is the ADX values in the ADXR lookback.
+	Adxs json.RawMessage `json:"adxs"`
+}
+
+// MarshalState function returns the state of the ADX as JSON.
+func (a *Adx[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := adxState{}
+
+	state.Dmi, err = helper.MarshalStateOf(a.dmi)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Rma, err = helper.MarshalStateOf(a.rma)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Adxs, err = helper.MarshalStateOf(a.adxs)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the ADX from the given JSON.
+func (a *Adx[T]) UnmarshalState(data []byte) error {
+	var state adxState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&a.dmi, state.Dmi, a.Dmi.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&a.rma, state.Rma, a.Rma.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&a.adxs, state.Adxs, func() *helper.Ring[T] { return helper.NewRing[T](a.Rma.Period) })
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// IdlePeriod is the initial period that ADX won't yield any results.
+func (a *Adx[T]) IdlePeriod() int {
+	// DMI idle period, RMA idle period, and the ADXR lookback.
+	return a.Dmi.IdlePeriod() + a.Rma.IdlePeriod() + a.Rma.Period - 1
+}
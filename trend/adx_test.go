@@ -0,0 +1,63 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+func TestAdx(t *testing.T) {
+	type Data struct {
+		High    float64
+		Low     float64
+		Close   float64
+		PlusDi  float64
+		MinusDi float64
+		Adx     float64
+		Adxr    float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/adx.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := helper.Duplicate(input, 7)
+	highs := helper.Map(inputs[0], func(d *Data) float64 { return d.High })
+	lows := helper.Map(inputs[1], func(d *Data) float64 { return d.Low })
+	closings := helper.Map(inputs[2], func(d *Data) float64 { return d.Close })
+	expectedPlusDi := helper.Map(inputs[3], func(d *Data) float64 { return d.PlusDi })
+	expectedMinusDi := helper.Map(inputs[4], func(d *Data) float64 { return d.MinusDi })
+	expectedAdx := helper.Map(inputs[5], func(d *Data) float64 { return d.Adx })
+	expectedAdxr := helper.Map(inputs[6], func(d *Data) float64 { return d.Adxr })
+
+	adx := trend.NewAdx[float64]()
+	actualPlusDi, actualMinusDi, actualAdx, actualAdxr := adx.Compute(highs, lows, closings)
+
+	actualPlusDi = helper.RoundDigits(actualPlusDi, 2)
+	actualPlusDi = helper.Shift(actualPlusDi, adx.IdlePeriod(), 0)
+
+	actualMinusDi = helper.RoundDigits(actualMinusDi, 2)
+	actualMinusDi = helper.Shift(actualMinusDi, adx.IdlePeriod(), 0)
+
+	actualAdx = helper.RoundDigits(actualAdx, 2)
+	actualAdx = helper.Shift(actualAdx, adx.IdlePeriod(), 0)
+
+	actualAdxr = helper.RoundDigits(actualAdxr, 2)
+	actualAdxr = helper.Shift(actualAdxr, adx.IdlePeriod(), 0)
+
+	err = helper.CheckEquals(
+		actualPlusDi, expectedPlusDi,
+		actualMinusDi, expectedMinusDi,
+		actualAdx, expectedAdx,
+		actualAdxr, expectedAdxr,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultAlmaPeriod is the default ALMA period of 9.
+	DefaultAlmaPeriod = 9
+
+	// DefaultAlmaOffset is the default ALMA offset of 0.85.
+	DefaultAlmaOffset = 0.85
+
+	// DefaultAlmaSigma is the default ALMA sigma of 6.
+	DefaultAlmaSigma = 6
+)
+
+// Alma represents the parameters for calculating the Arnaud Legoux Moving Average (ALMA). It
+// applies a Gaussian filter over the period, where the offset moves the center of the filter
+// towards the recent values to reduce the lag, and the sigma controls its sharpness.
+//
+//	M = Offset * (Period - 1)
+//	S = Period / Sigma
+//	W(i) = Exp(-Pow(i - M, 2) / (2 * Pow(S, 2)))
+//	ALMA = Sum(W(i) * Value(i)) / Sum(W(i))
+//
+// Example:
+//
+//	alma := trend.NewAlma[float64]()
+//	result := alma.Compute(c)
+type Alma[T helper.Number] struct {
+	// Period is the time period.
+	Period int
+
+	// Offset is the position of the center of the filter, from 0 for the oldest to 1 for the latest.
+	Offset float64
+
+	// Sigma is the sharpness of the filter.
+	Sigma float64
+
+	// weights is the filter weights.
+	weights []float64
+
+	// sum is the sum of the filter weights.
+	sum float64
+
+	// window is the values in the period.
+	window *helper.Ring[float64]
+}
+
+// NewAlma function initializes a new ALMA instance with the default parameters.
+func NewAlma[T helper.Number]() *Alma[T] {
+	return NewAlmaWithPeriod[T](DefaultAlmaPeriod)
+}
+
+// NewAlmaWithPeriod function initializes a new ALMA instance with the given period.
+func NewAlmaWithPeriod[T helper.Number](period int) *Alma[T] {
+	return NewAlmaWith[T](period, DefaultAlmaOffset, DefaultAlmaSigma)
+}
+
+// NewAlmaWith function initializes a new ALMA instance with the given parameters.
+func NewAlmaWith[T helper.Number](period int, offset, sigma float64) *Alma[T] {
+	return &Alma[T]{
+		Period: period,
+		Offset: offset,
+		Sigma:  sigma,
+	}
+}
+
+// Compute function takes a channel of numbers and computes the ALMA over the specified period.
+func (a *Alma[T]) Compute(c <-chan T) <-chan T {
+	return helper.Compute(c, a.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the ALMA over the specified period.
+func (a *Alma[T]) ComputeSlice(c []T) []T {
+	return helper.ComputeSlice(c, a.Clone().Update)
+}
+
+// Update function takes a value and updates the ALMA. It returns the ALMA value, and whether the
+// ALMA has passed its idle period and the value is ready.
+func (a *Alma[T]) Update(value T) (T, bool) {
+	if a.window == nil {
+		a.initWeights()
+		a.window = helper.NewRing[float64](a.Period)
+	}
+
+	a.window.Put(float64(value))
+	if !a.window.IsFull() {
+		return 0, false
+	}
+
+	alma := 0.0
+	for i, weight := range a.weights {
+		alma += weight * a.window.At(i)
+	}
+
+	return T(alma / a.sum), true
+}
+
+// Reset function resets the state of the ALMA.
+func (a *Alma[T]) Reset() {
+	a.weights = nil
+	a.sum = 0
+	a.window = nil
+}
+
+// Clone function returns a new ALMA instance with the same configuration and a fresh state.
+func (a *Alma[T]) Clone() *Alma[T] {
+	return NewAlmaWith[T](a.Period, a.Offset, a.Sigma)
+}
+
+// almaState is the state of the ALMA.
+type almaState struct {
+	// Window is the values in the period.
+	Window json.RawMessage `json:"window"`
+}
+
+// MarshalState function returns the state of the ALMA as JSON. The weights are not part of the
+// state, as they are computed from the configuration.
+func (a *Alma[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := almaState{}
+
+	state.Window, err = helper.MarshalStateOf(a.window)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the ALMA from the given JSON.
+func (a *Alma[T]) UnmarshalState(data []byte) error {
+	var state almaState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&a.window, state.Window, func() *helper.Ring[float64] {
+		return helper.NewRing[float64](a.Period)
+	})
+	if err != nil {
+		return err
+	}
+
+	if a.window == nil {
+		a.weights = nil
+		a.sum = 0
+	} else if a.weights == nil {
+		a.initWeights()
+	}
+
+	return nil
+}
+
+// IdlePeriod is the initial period that ALMA won't yield any results.
+func (a *Alma[T]) IdlePeriod() int {
+	return a.Period - 1
+}
+
+// String is the string representation of the ALMA.
+func (a *Alma[T]) String() string {
+	return fmt.Sprintf("ALMA(%d,%g,%g)", a.Period, a.Offset, a.Sigma)
+}
+
+// initWeights initializes the Gaussian weights and their sum based on the offset and the sigma.
+func (a *Alma[T]) initWeights() {
+	m := a.Offset * float64(a.Period-1)
+	s := float64(a.Period) / a.Sigma
+
+	a.weights = make([]float64, a.Period)
+	a.sum = 0
+
+	for i := range a.weights {
+		a.weights[i] = math.Exp(-math.Pow(float64(i)-m, 2) / (2 * s * s))
+		a.sum += a.weights[i]
+	}
+}
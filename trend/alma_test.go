@@ -0,0 +1,61 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+func TestAlma(t *testing.T) {
+	type Data struct {
+		Close float64
+		Alma  float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/alma.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := helper.Duplicate(input, 2)
+	closing := helper.Map(inputs[0], func(d *Data) float64 { return d.Close })
+	expected := helper.Map(inputs[1], func(d *Data) float64 { return d.Alma })
+
+	alma := trend.NewAlma[float64]()
+	actual := alma.Compute(closing)
+
+	actual = helper.RoundDigits(actual, 2)
+	expected = helper.Skip(expected, alma.IdlePeriod())
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAlmaEmpty(t *testing.T) {
+	input := helper.SliceToChan([]float64{})
+	expected := helper.SliceToChan([]float64{})
+
+	alma := trend.NewAlma[float64]()
+	actual := alma.Compute(input)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAlmaString(t *testing.T) {
+	expected := "ALMA(1,0.5,2)"
+	actual := trend.NewAlmaWith[float64](1, 0.5, 2).String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
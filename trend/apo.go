@@ -4,7 +4,11 @@
 
 package trend
 
-import "github.com/cinar/indicator/v2/helper"
+import (
+	"encoding/json"
+
+	"github.com/cinar/indicator/v2/helper"
+)
 
 //goland:noinspection ALL
 const (
@@ -51,6 +55,18 @@ type Apo[T helper.Number] struct {
 
 	// Slow smoothing.
 	SlowSmoothing T
+
+	// fastEma is the fast EMA state.
+	fastEma *Ema[T]
+
+	// slowEma is the slow EMA state.
+	slowEma *Ema[T]
+
+	// fastEmas is the fast EMA values waiting for the matching slow EMA values.
+	fastEmas *helper.Ring[T]
+
+	// slowEmas is the slow EMA values waiting for the matching fast EMA values.
+	slowEmas *helper.Ring[T]
 }
 
 // NewApo function initializes a new APO instance
@@ -67,16 +83,135 @@ func NewApo[T helper.Number]() *Apo[T] {
 // Compute function takes a channel of numbers and computes the APO
 // over the specified period.
 func (apo *Apo[T]) Compute(c <-chan T) <-chan T {
-	c = helper.Buffered(c, apo.SlowPeriod)
-	cs := helper.Duplicate(c, 2)
+	return helper.Compute(c, apo.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the APO
+// over the specified period.
+func (apo *Apo[T]) ComputeSlice(c []T) []T {
+	return helper.ComputeSlice(c, apo.Clone().Update)
+}
+
+// Update function takes a value and updates the APO. It returns the APO value, and whether the
+// APO has passed its idle period and the value is ready.
+func (apo *Apo[T]) Update(value T) (T, bool) {
+	if apo.fastEma == nil {
+		apo.fastEma = NewEma[T]()
+		apo.fastEma.Period = apo.FastPeriod
+
+		apo.slowEma = NewEma[T]()
+		apo.slowEma.Period = apo.SlowPeriod
+
+		apo.fastEmas = helper.NewRing[T](max(apo.SlowPeriod-apo.FastPeriod, 0) + 1)
+		apo.slowEmas = helper.NewRing[T](max(apo.FastPeriod-apo.SlowPeriod, 0) + 1)
+	}
+
+	fastEma, ok := apo.fastEma.Update(value)
+	if ok {
+		apo.fastEmas.Put(fastEma)
+	}
+
+	slowEma, ok := apo.slowEma.Update(value)
+	if ok {
+		apo.slowEmas.Put(slowEma)
+	}
+
+	if !apo.fastEmas.IsFull() || !apo.slowEmas.IsFull() {
+		return 0, false
+	}
+
+	return apo.fastEmas.At(0) - apo.slowEmas.At(0), true
+}
+
+// Reset function resets the state of the APO.
+func (apo *Apo[T]) Reset() {
+	apo.fastEma = nil
+	apo.slowEma = nil
+	apo.fastEmas = nil
+	apo.slowEmas = nil
+}
+
+// Clone function returns a new APO instance with the same configuration and a fresh state.
+func (apo *Apo[T]) Clone() *Apo[T] {
+	return &Apo[T]{
+		FastPeriod:    apo.FastPeriod,
+		FastSmoothing: apo.FastSmoothing,
+		SlowPeriod:    apo.SlowPeriod,
+		SlowSmoothing: apo.SlowSmoothing,
+	}
+}
+
+// apoState is the state of the APO.
+type apoState struct {
+	// FastEma is the fast EMA state.
+	FastEma json.RawMessage `json:"fastEma"`
+
+	// SlowEma is the slow EMA state.
+	SlowEma json.RawMessage `json:"slowEma"`
+
+	// FastEmas is the fast EMA values aligned with the slow EMA values.
+	FastEmas json.RawMessage `json:"fastEmas"`
+
+	// SlowEmas is the slow EMA values aligned with the fast EMA values.
+	SlowEmas json.RawMessage `json:"slowEmas"`
+}
+
+// MarshalState function returns the state of the APO as JSON.
+func (apo *Apo[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := apoState{}
+
+	state.FastEma, err = helper.MarshalStateOf(apo.fastEma)
+	if err != nil {
+		return nil, err
+	}
+
+	state.SlowEma, err = helper.MarshalStateOf(apo.slowEma)
+	if err != nil {
+		return nil, err
+	}
 
-	fastEma := NewEma[T]()
-	fastEma.Period = apo.FastPeriod
-	cs[0] = fastEma.Compute(cs[0])
+	state.FastEmas, err = helper.MarshalStateOf(apo.fastEmas)
+	if err != nil {
+		return nil, err
+	}
+
+	state.SlowEmas, err = helper.MarshalStateOf(apo.slowEmas)
+	if err != nil {
+		return nil, err
+	}
 
-	slowEma := NewEma[T]()
-	slowEma.Period = apo.SlowPeriod
-	cs[1] = slowEma.Compute(cs[1])
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the APO from the given JSON.
+func (apo *Apo[T]) UnmarshalState(data []byte) error {
+	var state apoState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&apo.fastEma, state.FastEma, func() *Ema[T] { return NewEmaWithPeriod[T](apo.FastPeriod) })
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&apo.slowEma, state.SlowEma, func() *Ema[T] { return NewEmaWithPeriod[T](apo.SlowPeriod) })
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&apo.fastEmas, state.FastEmas, func() *helper.Ring[T] {
+		return helper.NewRing[T](max(apo.SlowPeriod-apo.FastPeriod, 0) + 1)
+	})
+	if err != nil {
+		return err
+	}
 
-	return helper.Subtract(cs[0], cs[1])
+	return helper.UnmarshalStateOf(&apo.slowEmas, state.SlowEmas, func() *helper.Ring[T] {
+		return helper.NewRing[T](max(apo.FastPeriod-apo.SlowPeriod, 0) + 1)
+	})
 }
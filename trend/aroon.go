@@ -4,7 +4,11 @@
 
 package trend
 
-import "github.com/cinar/indicator/v2/helper"
+import (
+	"encoding/json"
+
+	"github.com/cinar/indicator/v2/helper"
+)
 
 const (
 	// DefaultAroonPeriod is the default Aroon period of 25.
@@ -30,6 +34,27 @@ const (
 type Aroon[T helper.Number] struct {
 	// Period is the period to use.
 	Period int
+
+	// movingMax is the moving max state.
+	movingMax *MovingMax[T]
+
+	// movingMin is the moving min state.
+	movingMin *MovingMin[T]
+
+	// lastHigh is the last highest high.
+	lastHigh T
+
+	// lastLow is the last lowest low.
+	lastLow T
+
+	// sinceLastHigh is the number of periods since the last highest high changed.
+	sinceLastHigh T
+
+	// sinceLastLow is the number of periods since the last lowest low changed.
+	sinceLastLow T
+
+	// ready indicates whether the last highest high and the last lowest low are available.
+	ready bool
 }
 
 // NewAroon function initializes a new Aroon instance
@@ -43,25 +68,164 @@ func NewAroon[T helper.Number]() *Aroon[T] {
 // Compute function takes a channel of numbers and computes the Aroon
 // over the specified period.
 func (a *Aroon[T]) Compute(high, low <-chan T) (<-chan T, <-chan T) {
-	movingMax := NewMovingMaxWithPeriod[T](a.Period)
-	movingMin := NewMovingMinWithPeriod[T](a.Period)
+	aroon := a.Clone()
+
+	values := helper.Compute2(high, low, func(high, low T) ([]T, bool) {
+		up, down, ok := aroon.Update(high, low)
+		return []T{up, down}, ok
+	})
+
+	outputs := helper.Unzip(values, 2)
+
+	return outputs[0], outputs[1]
+}
+
+// ComputeSlice function takes a slice of numbers and computes the Aroon
+// over the specified period.
+func (a *Aroon[T]) ComputeSlice(high, low []T) ([]T, []T) {
+	aroon := a.Clone()
+
+	values := helper.ComputeSlice2(high, low, func(high, low T) ([]T, bool) {
+		up, down, ok := aroon.Update(high, low)
+		return []T{up, down}, ok
+	})
 
-	sinceLastHigh := helper.Since[T, T](movingMax.Compute(high))
-	sinceLastLow := helper.Since[T, T](movingMin.Compute(low))
+	outputs := helper.UnzipSlice(values, 2)
+
+	return outputs[0], outputs[1]
+}
+
+// Update function takes a high and a low value, and updates the Aroon. It returns the Aroon Up and
+// the Aroon Down values, and whether the Aroon has passed its idle period and the values are ready.
+func (a *Aroon[T]) Update(high, low T) (T, T, bool) {
+	if a.movingMax == nil {
+		a.movingMax = NewMovingMaxWithPeriod[T](a.Period)
+		a.movingMin = NewMovingMinWithPeriod[T](a.Period)
+	}
+
+	highest, _ := a.movingMax.Update(high)
+
+	lowest, ok := a.movingMin.Update(low)
+	if !ok {
+		return 0, 0, false
+	}
+
+	if !a.ready || a.lastHigh != highest {
+		a.lastHigh = highest
+		a.sinceLastHigh = 0
+	} else {
+		a.sinceLastHigh++
+	}
+
+	if !a.ready || a.lastLow != lowest {
+		a.lastLow = lowest
+		a.sinceLastLow = 0
+	} else {
+		a.sinceLastLow++
+	}
+
+	a.ready = true
 
 	// Aroon Up = ((25 - Period Since Last 25 Period High) / 25) * 100
-	aroonUp := helper.MultiplyBy(sinceLastHigh, -1)
-	aroonUp = helper.IncrementBy(aroonUp, T(a.Period))
-	aroonUp = helper.DivideBy(aroonUp, T(a.Period))
-	aroonUp = helper.MultiplyBy(aroonUp, 100)
-	aroonUp = helper.RoundDigits(aroonUp, 0)
+	up := helper.RoundDigit((((a.sinceLastHigh*-1)+T(a.Period))/T(a.Period))*100, 0)
 
 	// Aroon Down = ((25 - Period Since Last 25 Period Low) / 25) * 100
-	aroonDown := helper.MultiplyBy(sinceLastLow, -1)
-	aroonDown = helper.IncrementBy(aroonDown, T(a.Period))
-	aroonDown = helper.DivideBy(aroonDown, T(a.Period))
-	aroonDown = helper.MultiplyBy(aroonDown, 100)
-	aroonDown = helper.RoundDigits(aroonDown, 0)
+	down := helper.RoundDigit((((a.sinceLastLow*-1)+T(a.Period))/T(a.Period))*100, 0)
+
+	return up, down, true
+}
+
+// Reset function resets the state of the Aroon.
+func (a *Aroon[T]) Reset() {
+	a.movingMax = nil
+	a.movingMin = nil
+	a.lastHigh = 0
+	a.lastLow = 0
+	a.sinceLastHigh = 0
+	a.sinceLastLow = 0
+	a.ready = false
+}
+
+// Clone function returns a new Aroon instance with the same configuration and a fresh state.
+func (a *Aroon[T]) Clone() *Aroon[T] {
+	return &Aroon[T]{
+		Period: a.Period,
+	}
+}
+
+// aroonState is the state of the Aroon.
+type aroonState[T helper.Number] struct {
+	// MovingMax is the moving max state.
+	MovingMax json.RawMessage `json:"movingMax"`
+
+	// MovingMin is the moving min state.
+	MovingMin json.RawMessage `json:"movingMin"`
+
+	// LastHigh is the last highest high.
+	LastHigh T `json:"lastHigh"`
+
+	// LastLow is the last lowest low.
+	LastLow T `json:"lastLow"`
+
+	// SinceLastHigh is the number of periods since the last highest high changed.
+	SinceLastHigh T `json:"sinceLastHigh"`
+
+	// SinceLastLow is the number of periods since the last lowest low changed.
+	SinceLastLow T `json:"sinceLastLow"`
+
+	// Ready indicates whether the last highest high and the last lowest low are available.
+	Ready bool `json:"ready"`
+}
+
+// MarshalState function returns the state of the Aroon as JSON.
+func (a *Aroon[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := aroonState[T]{
+		LastHigh:      a.lastHigh,
+		LastLow:       a.lastLow,
+		SinceLastHigh: a.sinceLastHigh,
+		SinceLastLow:  a.sinceLastLow,
+		Ready:         a.ready,
+	}
+
+	state.MovingMax, err = helper.MarshalStateOf(a.movingMax)
+	if err != nil {
+		return nil, err
+	}
+
+	state.MovingMin, err = helper.MarshalStateOf(a.movingMin)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the Aroon from the given JSON.
+func (a *Aroon[T]) UnmarshalState(data []byte) error {
+	var state aroonState[T]
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&a.movingMax, state.MovingMax, func() *MovingMax[T] { return NewMovingMaxWithPeriod[T](a.Period) })
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&a.movingMin, state.MovingMin, func() *MovingMin[T] { return NewMovingMinWithPeriod[T](a.Period) })
+	if err != nil {
+		return err
+	}
+
+	a.lastHigh = state.LastHigh
+	a.lastLow = state.LastLow
+	a.sinceLastHigh = state.SinceLastHigh
+	a.sinceLastLow = state.SinceLastLow
+	a.ready = state.Ready
 
-	return aroonUp, aroonDown
+	return nil
 }
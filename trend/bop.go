@@ -4,7 +4,11 @@
 
 package trend
 
-import "github.com/cinar/indicator/v2/helper"
+import (
+	"encoding/json"
+
+	"github.com/cinar/indicator/v2/helper"
+)
 
 // Bop gauges the strength of buying and selling forces using
 // the Balance of Power (BoP) indicator. A positive BoP value
@@ -23,6 +27,36 @@ func NewBop[T helper.Number]() *Bop[T] {
 
 // Compute processes a channel of open, high, low, and close values,
 // computing the BOP for each entry.
-func (*Bop[T]) Compute(opening, high, low, closing <-chan T) <-chan T {
-	return helper.Divide(helper.Subtract(closing, opening), helper.Subtract(high, low))
+func (b *Bop[T]) Compute(opening, high, low, closing <-chan T) <-chan T {
+	return helper.Compute4(opening, high, low, closing, b.Update)
+}
+
+// ComputeSlice processes a slice of open, high, low, and close values,
+// computing the BOP for each entry.
+func (b *Bop[T]) ComputeSlice(opening, high, low, closing []T) []T {
+	return helper.ComputeSlice4(opening, high, low, closing, b.Update)
+}
+
+// Update function takes the opening, high, low, and closing values, and computes the BOP. The BOP
+// does not have an idle period, and the value is always ready.
+func (*Bop[T]) Update(opening, high, low, closing T) (T, bool) {
+	return (closing - opening) / (high - low), true
+}
+
+// Reset function resets the state of the BOP. The BOP does not have a state.
+func (*Bop[T]) Reset() {
+}
+
+// bopState is the state of the BOP. The BOP does not have a state.
+type bopState struct{}
+
+// MarshalState function returns the state of the BOP as JSON. The BOP does not have a state.
+func (*Bop[T]) MarshalState() ([]byte, error) {
+	return json.Marshal(&bopState{})
+}
+
+// UnmarshalState function restores the state of the BOP from the given JSON. The BOP does not have
+// a state.
+func (*Bop[T]) UnmarshalState(data []byte) error {
+	return json.Unmarshal(data, &bopState{})
 }
@@ -5,6 +5,9 @@
 package trend
 
 import (
+	"encoding/json"
+	"math"
+
 	"github.com/cinar/indicator/v2/helper"
 )
 
@@ -29,6 +32,12 @@ const (
 type Cci[T helper.Number] struct {
 	// Time period.
 	Period int
+
+	// sma1 is the typical price SMA state.
+	sma1 *Sma[T]
+
+	// sma2 is the mean deviation SMA state.
+	sma2 *Sma[T]
 }
 
 // NewCci function initializes a new CCI instance with the default parameters.
@@ -45,45 +54,98 @@ func NewCciWithPeriod[T helper.Number](period int) *Cci[T] {
 
 // Compute function takes a channel of numbers and computes the CCI and the signal line.
 func (c *Cci[T]) Compute(highs, lows, closings <-chan T) <-chan T {
-	typicalPrice := NewTypicalPrice[T]()
-	sma1 := NewSmaWithPeriod[T](c.Period)
-	sma2 := NewSmaWithPeriod[T](c.Period)
+	return helper.Compute3(highs, lows, closings, c.Clone().Update)
+}
 
-	tps := helper.Duplicate[T](
-		typicalPrice.Compute(highs, lows, closings),
-		3,
-	)
+// ComputeSlice function takes a slice of numbers and computes the CCI and the signal line.
+func (c *Cci[T]) ComputeSlice(highs, lows, closings []T) []T {
+	return helper.ComputeSlice3(highs, lows, closings, c.Clone().Update)
+}
 
-	mas := helper.Duplicate[T](
-		sma1.Compute(tps[0]),
-		2,
-	)
+// Update function takes a high, a low, and a closing value, and updates the CCI. It returns the CCI
+// value, and whether the CCI has passed its idle period and the value is ready.
+func (c *Cci[T]) Update(high, low, closing T) (T, bool) {
+	if c.sma1 == nil {
+		c.sma1 = NewSmaWithPeriod[T](c.Period)
+		c.sma2 = NewSmaWithPeriod[T](c.Period)
+	}
 
-	tps[1] = helper.Skip(tps[1], sma1.Period-1)
-	tps[2] = helper.Skip(tps[2], sma1.Period-1)
+	tp, _ := NewTypicalPrice[T]().Update(high, low, closing)
 
-	md := sma2.Compute(
-		helper.Abs(
-			helper.Subtract(tps[1], mas[0]),
-		),
-	)
+	ma, ok := c.sma1.Update(tp)
+	if !ok {
+		return 0, false
+	}
 
-	mas[1] = helper.Skip(mas[1], sma2.Period-1)
-	tps[2] = helper.Skip(tps[2], sma2.Period-1)
+	md, ok := c.sma2.Update(T(math.Abs(float64(tp - ma))))
+	if !ok {
+		return 0, false
+	}
 
 	multiplier := 0.015
 
-	cci := helper.Divide(
-		helper.Subtract(
-			tps[2], mas[1],
-		),
-		helper.MultiplyBy[T](
-			md,
-			T(multiplier),
-		),
-	)
-
-	return cci
+	return (tp - ma) / (md * T(multiplier)), true
+}
+
+// Reset function resets the state of the CCI.
+func (c *Cci[T]) Reset() {
+	c.sma1 = nil
+	c.sma2 = nil
+}
+
+// Clone function returns a new CCI instance with the same configuration and a fresh state.
+func (c *Cci[T]) Clone() *Cci[T] {
+	return NewCciWithPeriod[T](c.Period)
+}
+
+// cciState is the state of the CCI.
+type cciState struct {
+	// Sma1 is the typical price SMA state.
+	Sma1 json.RawMessage `json:"sma1"`
+
+	// Sma2 is the mean deviation SMA state.
+	Sma2 json.RawMessage `json:"sma2"`
+}
+
+// MarshalState function returns the state of the CCI as JSON.
+func (c *Cci[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := cciState{}
+
+	state.Sma1, err = helper.MarshalStateOf(c.sma1)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Sma2, err = helper.MarshalStateOf(c.sma2)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the CCI from the given JSON.
+func (c *Cci[T]) UnmarshalState(data []byte) error {
+	var state cciState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&c.sma1, state.Sma1, func() *Sma[T] { return NewSmaWithPeriod[T](c.Period) })
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&c.sma2, state.Sma2, func() *Sma[T] { return NewSmaWithPeriod[T](c.Period) })
+	if err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // IdlePeriod is the initial period that CCI won't yield any results.
@@ -0,0 +1,144 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"encoding/json"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultCfoPeriod is the default period for the Chande Forecast Oscillator (CFO).
+	DefaultCfoPeriod = 14
+)
+
+// Cfo represents the configuration parameters for calculating the Chande Forecast Oscillator (CFO).
+// It measures the percentage difference between the closing price and its linear regression
+// forecast over the given period. A positive value indicates that the closing price is above the
+// forecast, and a negative value indicates that it is below the forecast.
+//
+//	Forecast = MLR(period, x, closing)
+//	CFO = 100 * (Closing - Forecast) / Closing
+//
+// Example:
+//
+//	cfo := trend.NewCfo[float64]()
+//	values := cfo.Compute(closings)
+type Cfo[T helper.Number] struct {
+	// Mlr is the Moving Linear Regression instance.
+	Mlr *Mlr[T]
+
+	// mlr is the MLR state.
+	mlr *Mlr[T]
+
+	// x is the last x value.
+	x T
+}
+
+// NewCfo function initializes a new CFO instance with the default parameters.
+func NewCfo[T helper.Number]() *Cfo[T] {
+	return NewCfoWithPeriod[T](DefaultCfoPeriod)
+}
+
+// NewCfoWithPeriod function initializes a new CFO instance with the given period.
+func NewCfoWithPeriod[T helper.Number](period int) *Cfo[T] {
+	return &Cfo[T]{
+		Mlr: NewMlrWithPeriod[T](period),
+	}
+}
+
+// Compute function takes a channel of numbers and computes the CFO over the specified period.
+func (c *Cfo[T]) Compute(closings <-chan T) <-chan T {
+	return helper.Compute(closings, c.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the CFO over the specified period.
+func (c *Cfo[T]) ComputeSlice(closings []T) []T {
+	return helper.ComputeSlice(closings, c.Clone().Update)
+}
+
+// Update function takes a value and updates the CFO. It returns the CFO value, and whether the
+// CFO has passed its idle period and the value is ready.
+func (c *Cfo[T]) Update(closing T) (T, bool) {
+	if c.mlr == nil {
+		c.mlr = c.Mlr.Clone()
+	}
+
+	c.x++
+
+	forecast, ok := c.mlr.Update(c.x, closing)
+	if !ok {
+		return 0, false
+	}
+
+	if closing == 0 {
+		return 0, true
+	}
+
+	return 100 * (closing - forecast) / closing, true
+}
+
+// Reset function resets the state of the CFO.
+func (c *Cfo[T]) Reset() {
+	c.mlr = nil
+	c.x = 0
+}
+
+// Clone function returns a new CFO instance with the same configuration and a fresh state.
+func (c *Cfo[T]) Clone() *Cfo[T] {
+	return &Cfo[T]{
+		Mlr: c.Mlr.Clone(),
+	}
+}
+
+// cfoState is the state of the CFO.
+type cfoState[T helper.Number] struct {
+	// Mlr is the MLR state.
+	Mlr json.RawMessage `json:"mlr"`
+
+	// X is the last x value.
+	X T `json:"x"`
+}
+
+// MarshalState function returns the state of the CFO as JSON.
+func (c *Cfo[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := cfoState[T]{
+		X: c.x,
+	}
+
+	state.Mlr, err = helper.MarshalStateOf(c.mlr)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the CFO from the given JSON.
+func (c *Cfo[T]) UnmarshalState(data []byte) error {
+	var state cfoState[T]
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&c.mlr, state.Mlr, c.Mlr.Clone)
+	if err != nil {
+		return err
+	}
+
+	c.x = state.X
+
+	return nil
+}
+
+// IdlePeriod is the initial period that CFO won't yield any results.
+func (c *Cfo[T]) IdlePeriod() int {
+	return c.Mlr.IdlePeriod()
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+func TestCfo(t *testing.T) {
+	type Data struct {
+		Close float64
+		Cfo   float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/cfo.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := helper.Duplicate(input, 2)
+	closings := helper.Map(inputs[0], func(d *Data) float64 { return d.Close })
+	expected := helper.Map(inputs[1], func(d *Data) float64 { return d.Cfo })
+
+	cfo := trend.NewCfo[float64]()
+	actual := cfo.Compute(closings)
+	actual = helper.RoundDigits(actual, 2)
+	actual = helper.Shift(actual, cfo.IdlePeriod(), 0)
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
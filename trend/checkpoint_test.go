@@ -0,0 +1,378 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+// checkpointBar is a bar of the synthetic input of the checkpoint tests.
+type checkpointBar struct {
+	Open   float64
+	High   float64
+	Low    float64
+	Close  float64
+	Volume float64
+}
+
+// checkpointCase is an indicator that is checkpointed and restored.
+type checkpointCase struct {
+	// New returns a new instance of the indicator.
+	New func() helper.Stateful
+
+	// Update updates the given indicator with the given bar.
+	Update func(s helper.Stateful, bar checkpointBar) ([]float64, bool)
+}
+
+func checkpointBars(n int) []checkpointBar {
+	bars := make([]checkpointBar, n)
+
+	for i := range bars {
+		x := float64(i)
+		closing := 100 + 10*math.Sin(x/9) + 3*math.Sin(x/2.3) + 0.1*x
+
+		bars[i] = checkpointBar{
+			Open:   closing - 0.5*math.Cos(x),
+			High:   closing + 1 + 0.5*math.Abs(math.Sin(x/1.7)),
+			Low:    closing - 1 - 0.5*math.Abs(math.Cos(x/1.3)),
+			Close:  closing,
+			Volume: 1000 + 300*math.Sin(x/5),
+		}
+	}
+
+	return bars
+}
+
+func values1(value float64, ok bool) ([]float64, bool) {
+	return []float64{value}, ok
+}
+
+func values2(value1, value2 float64, ok bool) ([]float64, bool) {
+	return []float64{value1, value2}, ok
+}
+
+func values3(value1, value2, value3 float64, ok bool) ([]float64, bool) {
+	return []float64{value1, value2, value3}, ok
+}
+
+func values4(value1, value2, value3, value4 float64, ok bool) ([]float64, bool) {
+	return []float64{value1, value2, value3, value4}, ok
+}
+
+func TestCheckpointRestore(t *testing.T) {
+	cases := map[string]checkpointCase{
+		"Adx": {
+			New: func() helper.Stateful { return trend.NewAdx[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values4(s.(*trend.Adx[float64]).Update(b.High, b.Low, b.Close))
+			},
+		},
+		"Alma": {
+			New: func() helper.Stateful { return trend.NewAlma[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*trend.Alma[float64]).Update(b.Close))
+			},
+		},
+		"Apo": {
+			New: func() helper.Stateful { return trend.NewApo[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*trend.Apo[float64]).Update(b.Close))
+			},
+		},
+		"Aroon": {
+			New: func() helper.Stateful { return trend.NewAroon[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values2(s.(*trend.Aroon[float64]).Update(b.High, b.Low))
+			},
+		},
+		"Bop": {
+			New: func() helper.Stateful { return trend.NewBop[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*trend.Bop[float64]).Update(b.Open, b.High, b.Low, b.Close))
+			},
+		},
+		"Cci": {
+			New: func() helper.Stateful { return trend.NewCci[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*trend.Cci[float64]).Update(b.High, b.Low, b.Close))
+			},
+		},
+		"Cfo": {
+			New: func() helper.Stateful { return trend.NewCfo[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*trend.Cfo[float64]).Update(b.Close))
+			},
+		},
+		"Dema": {
+			New: func() helper.Stateful { return trend.NewDema[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*trend.Dema[float64]).Update(b.Close))
+			},
+		},
+		"Dmi": {
+			New: func() helper.Stateful { return trend.NewDmi[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values3(s.(*trend.Dmi[float64]).Update(b.High, b.Low, b.Close))
+			},
+		},
+		"Ema": {
+			New: func() helper.Stateful { return trend.NewEma[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*trend.Ema[float64]).Update(b.Close))
+			},
+		},
+		"Envelope": {
+			New: func() helper.Stateful { return trend.NewEnvelopeWithEma[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values3(s.(*trend.Envelope[float64]).Update(b.Close))
+			},
+		},
+		"Frama": {
+			New: func() helper.Stateful { return trend.NewFrama[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*trend.Frama[float64]).Update(b.Close))
+			},
+		},
+		"Hma": {
+			New: func() helper.Stateful { return trend.NewHmaWithPeriod[float64](16) },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*trend.Hma[float64]).Update(b.Close))
+			},
+		},
+		"Kama": {
+			New: func() helper.Stateful { return trend.NewKama[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*trend.Kama[float64]).Update(b.Close))
+			},
+		},
+		"Kdj": {
+			New: func() helper.Stateful { return trend.NewKdj[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values3(s.(*trend.Kdj[float64]).Update(b.High, b.Low, b.Close))
+			},
+		},
+		"LinReg": {
+			New: func() helper.Stateful { return trend.NewLinReg[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*trend.LinReg[float64]).Update(b.Close))
+			},
+		},
+		"Macd": {
+			New: func() helper.Stateful { return trend.NewMacd[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values2(s.(*trend.Macd[float64]).Update(b.Close))
+			},
+		},
+		"MassIndex": {
+			New: func() helper.Stateful { return trend.NewMassIndex[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*trend.MassIndex[float64]).Update(b.High, b.Low))
+			},
+		},
+		"McGinley": {
+			New: func() helper.Stateful { return trend.NewMcGinley[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*trend.McGinley[float64]).Update(b.Close))
+			},
+		},
+		"Mlr": {
+			New: func() helper.Stateful { return trend.NewMlrWithPeriod[float64](20) },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*trend.Mlr[float64]).Update(b.Open, b.Close))
+			},
+		},
+		"Mls": {
+			New: func() helper.Stateful { return trend.NewMlsWithPeriod[float64](20) },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values2(s.(*trend.Mls[float64]).Update(b.Open, b.Close))
+			},
+		},
+		"MovingMax": {
+			New: func() helper.Stateful { return trend.NewMovingMaxWithPeriod[float64](10) },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*trend.MovingMax[float64]).Update(b.High))
+			},
+		},
+		"MovingMin": {
+			New: func() helper.Stateful { return trend.NewMovingMinWithPeriod[float64](10) },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*trend.MovingMin[float64]).Update(b.Low))
+			},
+		},
+		"MovingSum": {
+			New: func() helper.Stateful { return trend.NewMovingSumWithPeriod[float64](10) },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*trend.MovingSum[float64]).Update(b.Volume))
+			},
+		},
+		"ParabolicSar": {
+			New: func() helper.Stateful { return trend.NewParabolicSar[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*trend.ParabolicSar[float64]).Update(b.High, b.Low))
+			},
+		},
+		"Rma": {
+			New: func() helper.Stateful { return trend.NewRma[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*trend.Rma[float64]).Update(b.Close))
+			},
+		},
+		"Roc": {
+			New: func() helper.Stateful { return trend.NewRoc[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*trend.Roc[float64]).Update(b.Close))
+			},
+		},
+		"Sma": {
+			New: func() helper.Stateful { return trend.NewSma[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*trend.Sma[float64]).Update(b.Close))
+			},
+		},
+		"Smma": {
+			New: func() helper.Stateful { return trend.NewSmma[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*trend.Smma[float64]).Update(b.Close))
+			},
+		},
+		"T3": {
+			New: func() helper.Stateful { return trend.NewT3[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*trend.T3[float64]).Update(b.Close))
+			},
+		},
+		"Tema": {
+			New: func() helper.Stateful { return trend.NewTema[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*trend.Tema[float64]).Update(b.Close))
+			},
+		},
+		"Trima": {
+			New: func() helper.Stateful { return trend.NewTrima[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*trend.Trima[float64]).Update(b.Close))
+			},
+		},
+		"Trix": {
+			New: func() helper.Stateful { return trend.NewTrix[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*trend.Trix[float64]).Update(b.Close))
+			},
+		},
+		"Tsi": {
+			New: func() helper.Stateful { return trend.NewTsi[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*trend.Tsi[float64]).Update(b.Close))
+			},
+		},
+		"TypicalPrice": {
+			New: func() helper.Stateful { return trend.NewTypicalPrice[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*trend.TypicalPrice[float64]).Update(b.High, b.Low, b.Close))
+			},
+		},
+		"Vidya": {
+			New: func() helper.Stateful { return trend.NewVidya[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*trend.Vidya[float64]).Update(b.Close))
+			},
+		},
+		"Vortex": {
+			New: func() helper.Stateful { return trend.NewVortex[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values2(s.(*trend.Vortex[float64]).Update(b.High, b.Low, b.Close))
+			},
+		},
+		"Vwma": {
+			New: func() helper.Stateful { return trend.NewVwma[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*trend.Vwma[float64]).Update(b.Close, b.Volume))
+			},
+		},
+		"WeightedClose": {
+			New: func() helper.Stateful { return trend.NewWeightedClose[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*trend.WeightedClose[float64]).Update(b.High, b.Low, b.Close))
+			},
+		},
+		"Wma": {
+			New: func() helper.Stateful { return trend.NewWmaWith[float64](10) },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*trend.Wma[float64]).Update(b.Close))
+			},
+		},
+		"Zlema": {
+			New: func() helper.Stateful { return trend.NewZlema[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*trend.Zlema[float64]).Update(b.Close))
+			},
+		},
+	}
+
+	bars := checkpointBars(120)
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			checkCheckpointRestore(t, c, bars)
+		})
+	}
+}
+
+// checkCheckpointRestore checks that the indicator restored from a checkpoint taken after each bar
+// produces the same output as the uninterrupted one.
+func checkCheckpointRestore(t *testing.T, c checkpointCase, bars []checkpointBar) {
+	t.Helper()
+
+	type output struct {
+		Values []float64
+		Ok     bool
+	}
+
+	expected := make([]output, len(bars))
+
+	uninterrupted := c.New()
+	for i, bar := range bars {
+		expected[i].Values, expected[i].Ok = c.Update(uninterrupted, bar)
+	}
+
+	for split := 0; split <= len(bars); split++ {
+		actual := make([]output, len(bars))
+
+		before := c.New()
+		for i, bar := range bars[:split] {
+			actual[i].Values, actual[i].Ok = c.Update(before, bar)
+		}
+
+		data, err := helper.Checkpoint(before)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		after := c.New()
+
+		// The restored state replaces any state the indicator has.
+		for _, bar := range bars[:3] {
+			c.Update(after, bar)
+		}
+
+		err = helper.Restore(after, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i, bar := range bars[split:] {
+			actual[split+i].Values, actual[split+i].Ok = c.Update(after, bar)
+		}
+
+		if !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("split %d actual %v expected %v", split, actual, expected)
+		}
+	}
+}
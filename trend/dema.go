@@ -4,7 +4,11 @@
 
 package trend
 
-import "github.com/cinar/indicator/v2/helper"
+import (
+	"encoding/json"
+
+	"github.com/cinar/indicator/v2/helper"
+)
 
 // Dema represents the parameters for calculating the Double Exponential Moving Average (DEMA).
 // A bullish cross occurs when DEMA with 5 days period moves above DEMA with 35 days period.
@@ -27,6 +31,15 @@ type Dema[T helper.Number] struct {
 	// Ema2 represents the configuration parameters for
 	// calculating the second EMA.
 	Ema2 *Ema[T]
+
+	// ema1 is the first EMA state.
+	ema1 *Ema[T]
+
+	// ema2 is the second EMA state.
+	ema2 *Ema[T]
+
+	// ema1s is the first EMA values over the second EMA period.
+	ema1s *helper.Ring[T]
 }
 
 // NewDema function initializes a new DEMA instance
@@ -41,13 +54,110 @@ func NewDema[T helper.Number]() *Dema[T] {
 // Compute function takes a channel of numbers and computes the DEMA
 // over the specified period.
 func (d *Dema[T]) Compute(c <-chan T) <-chan T {
-	ema1 := helper.Duplicate(d.Ema1.Compute(c), 2)
-	ema2 := d.Ema2.Compute(ema1[1])
+	return helper.Compute(c, d.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the DEMA
+// over the specified period.
+func (d *Dema[T]) ComputeSlice(c []T) []T {
+	return helper.ComputeSlice(c, d.Clone().Update)
+}
+
+// Update function takes a value and updates the DEMA. It returns the DEMA value, and whether the
+// DEMA has passed its idle period and the value is ready.
+func (d *Dema[T]) Update(value T) (T, bool) {
+	if d.ema1 == nil {
+		d.ema1 = d.Ema1.Clone()
+		d.ema2 = d.Ema2.Clone()
+		d.ema1s = helper.NewRing[T](d.Ema2.Period)
+	}
+
+	ema1, ok := d.ema1.Update(value)
+	if !ok {
+		return 0, false
+	}
+
+	d.ema1s.Put(ema1)
+
+	ema2, ok := d.ema2.Update(ema1)
+	if !ok {
+		return 0, false
+	}
+
+	return d.ema1s.At(0)*2 - ema2, true
+}
 
-	doubleEma1 := helper.MultiplyBy(ema1[0], 2)
-	doubleEma1 = helper.Buffered(doubleEma1, d.Ema2.Period)
+// Reset function resets the state of the DEMA.
+func (d *Dema[T]) Reset() {
+	d.ema1 = nil
+	d.ema2 = nil
+	d.ema1s = nil
+}
+
+// Clone function returns a new DEMA instance with the same configuration and a fresh state.
+func (d *Dema[T]) Clone() *Dema[T] {
+	return &Dema[T]{
+		Ema1: d.Ema1.Clone(),
+		Ema2: d.Ema2.Clone(),
+	}
+}
+
+// demaState is the state of the DEMA.
+type demaState struct {
+	// Ema1 is the first EMA state.
+	Ema1 json.RawMessage `json:"ema1"`
+
+	// Ema2 is the second EMA state.
+	Ema2 json.RawMessage `json:"ema2"`
+
+	// Ema1s is the first EMA values over the second EMA period.
+	Ema1s json.RawMessage `json:"ema1s"`
+}
+
+// MarshalState function returns the state of the DEMA as JSON.
+func (d *Dema[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := demaState{}
+
+	state.Ema1, err = helper.MarshalStateOf(d.ema1)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Ema2, err = helper.MarshalStateOf(d.ema2)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Ema1s, err = helper.MarshalStateOf(d.ema1s)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the DEMA from the given JSON.
+func (d *Dema[T]) UnmarshalState(data []byte) error {
+	var state demaState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&d.ema1, state.Ema1, d.Ema1.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&d.ema2, state.Ema2, d.Ema2.Clone)
+	if err != nil {
+		return err
+	}
 
-	return helper.Subtract(doubleEma1, ema2)
+	return helper.UnmarshalStateOf(&d.ema1s, state.Ema1s, func() *helper.Ring[T] { return helper.NewRing[T](d.Ema2.Period) })
 }
 
 // IdlePeriod is the initial period that DEMA won't yield any results.
@@ -0,0 +1,269 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"encoding/json"
+	"math"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultDmiPeriod is the default period for the Directional Movement Index (DMI).
+	DefaultDmiPeriod = 14
+)
+
+// Dmi represents the configuration parameters for calculating the Directional Movement Index (DMI)
+// developed by J. Welles Wilder. It is composed of the Positive Directional Indicator (+DI) and
+// the Negative Directional Indicator (-DI), and the Directional Movement Index (DX) that measures
+// the spread between the two.
+//
+//	Up Move = High - Previous High
+//	Down Move = Previous Low - Low
+//	+DM = Up Move if Up Move > Down Move and Up Move > 0, otherwise 0
+//	-DM = Down Move if Down Move > Up Move and Down Move > 0, otherwise 0
+//	TR = Max((High - Low), (High - Previous Closing), (Previous Closing - Low))
+//	+DI = 100 * RMA(+DM) / RMA(TR)
+//	-DI = 100 * RMA(-DM) / RMA(TR)
+//	DX = 100 * Abs(+DI - -DI) / (+DI + -DI)
+//
+// Example:
+//
+//	dmi := trend.NewDmi[float64]()
+//	plusDi, minusDi, dx := dmi.Compute(highs, lows, closings)
+type Dmi[T helper.Number] struct {
+	// Rma is the RMA used to smooth the directional movements and the true range.
+	Rma *Rma[T]
+
+	// trRma is the true range RMA state.
+	trRma *Rma[T]
+
+	// plusDmRma is the positive directional movement RMA state.
+	plusDmRma *Rma[T]
+
+	// minusDmRma is the negative directional movement RMA state.
+	minusDmRma *Rma[T]
+
+	// previousHigh is the previous high.
+	previousHigh T
+
+	// previousLow is the previous low.
+	previousLow T
+
+	// previousClosing is the previous closing.
+	previousClosing T
+}
+
+// NewDmi function initializes a new DMI instance with the default parameters.
+func NewDmi[T helper.Number]() *Dmi[T] {
+	return NewDmiWithPeriod[T](DefaultDmiPeriod)
+}
+
+// NewDmiWithPeriod function initializes a new DMI instance with the given period.
+func NewDmiWithPeriod[T helper.Number](period int) *Dmi[T] {
+	return &Dmi[T]{
+		Rma: NewRmaWithPeriod[T](period),
+	}
+}
+
+// Compute function takes a channel of highs, lows, and closings, and computes the DMI over the
+// specified period. Returns +DI, -DI, and DX.
+func (d *Dmi[T]) Compute(highs, lows, closings <-chan T) (<-chan T, <-chan T, <-chan T) {
+	dmi := d.Clone()
+
+	values := helper.Compute3(highs, lows, closings, func(high, low, closing T) ([]T, bool) {
+		plusDi, minusDi, dx, ok := dmi.Update(high, low, closing)
+		return []T{plusDi, minusDi, dx}, ok
+	})
+
+	outputs := helper.Unzip(values, 3)
+
+	return outputs[0], outputs[1], outputs[2]
+}
+
+// ComputeSlice function takes a slice of highs, lows, and closings, and computes the DMI over the
+// specified period. Returns +DI, -DI, and DX.
+func (d *Dmi[T]) ComputeSlice(highs, lows, closings []T) ([]T, []T, []T) {
+	dmi := d.Clone()
+
+	values := helper.ComputeSlice3(highs, lows, closings, func(high, low, closing T) ([]T, bool) {
+		plusDi, minusDi, dx, ok := dmi.Update(high, low, closing)
+		return []T{plusDi, minusDi, dx}, ok
+	})
+
+	outputs := helper.UnzipSlice(values, 3)
+
+	return outputs[0], outputs[1], outputs[2]
+}
+
+// Update function takes a high, a low, and a closing value, and updates the DMI. It returns the +DI,
+// -DI, and DX values, and whether the DMI has passed its idle period and the values are ready.
+func (d *Dmi[T]) Update(high, low, closing T) (T, T, T, bool) {
+	if d.trRma == nil {
+		d.trRma = d.Rma.Clone()
+		d.plusDmRma = d.Rma.Clone()
+		d.minusDmRma = d.Rma.Clone()
+
+		d.previousHigh = high
+		d.previousLow = low
+		d.previousClosing = closing
+
+		return 0, 0, 0, false
+	}
+
+	upMove := high - d.previousHigh
+	downMove := (low - d.previousLow) * -1
+
+	var plusDm, minusDm T
+
+	if upMove > downMove && upMove > 0 {
+		plusDm = upMove
+	}
+
+	if downMove > upMove && downMove > 0 {
+		minusDm = downMove
+	}
+
+	// Use previous closing.
+	tr := T(math.Max(float64(high-low), math.Max(float64(high-d.previousClosing), float64(d.previousClosing-low))))
+
+	d.previousHigh = high
+	d.previousLow = low
+	d.previousClosing = closing
+
+	smoothedTr, _ := d.trRma.Update(tr)
+	smoothedPlusDm, _ := d.plusDmRma.Update(plusDm)
+
+	smoothedMinusDm, ok := d.minusDmRma.Update(minusDm)
+	if !ok {
+		return 0, 0, 0, false
+	}
+
+	plusDi := directionalIndicator(smoothedPlusDm, smoothedTr)
+	minusDi := directionalIndicator(smoothedMinusDm, smoothedTr)
+
+	var dx T
+
+	sum := plusDi + minusDi
+	if sum != 0 {
+		dx = T(100 * math.Abs(float64(plusDi-minusDi)) / float64(sum))
+	}
+
+	return plusDi, minusDi, dx, true
+}
+
+// Reset function resets the state of the DMI.
+func (d *Dmi[T]) Reset() {
+	d.trRma = nil
+	d.plusDmRma = nil
+	d.minusDmRma = nil
+	d.previousHigh = 0
+	d.previousLow = 0
+	d.previousClosing = 0
+}
+
+// Clone function returns a new DMI instance with the same configuration and a fresh state.
+func (d *Dmi[T]) Clone() *Dmi[T] {
+	return &Dmi[T]{
+		Rma: d.Rma.Clone(),
+	}
+}
+
+// dmiState is the state of the DMI.
+type dmiState[T helper.Number] struct {
+	// TrRma is the true range RMA state.
+	TrRma json.RawMessage `json:"trRma"`
+
+	// PlusDmRma is the positive directional movement RMA state.
+	PlusDmRma json.RawMessage `json:"plusDmRma"`
+
+	// MinusDmRma is the negative directional movement RMA state.
+	MinusDmRma json.RawMessage `json:"minusDmRma"`
+
+	// PreviousHigh is the previous high.
+	PreviousHigh T `json:"previousHigh"`
+
+	// PreviousLow is the previous low.
+	PreviousLow T `json:"previousLow"`
+
+	// PreviousClosing is the previous closing.
+	PreviousClosing T `json:"previousClosing"`
+}
+
+// MarshalState function returns the state of the DMI as JSON.
+func (d *Dmi[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := dmiState[T]{
+		PreviousHigh:    d.previousHigh,
+		PreviousLow:     d.previousLow,
+		PreviousClosing: d.previousClosing,
+	}
+
+	state.TrRma, err = helper.MarshalStateOf(d.trRma)
+	if err != nil {
+		return nil, err
+	}
+
+	state.PlusDmRma, err = helper.MarshalStateOf(d.plusDmRma)
+	if err != nil {
+		return nil, err
+	}
+
+	state.MinusDmRma, err = helper.MarshalStateOf(d.minusDmRma)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the DMI from the given JSON.
+func (d *Dmi[T]) UnmarshalState(data []byte) error {
+	var state dmiState[T]
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&d.trRma, state.TrRma, d.Rma.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&d.plusDmRma, state.PlusDmRma, d.Rma.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&d.minusDmRma, state.MinusDmRma, d.Rma.Clone)
+	if err != nil {
+		return err
+	}
+
+	d.previousHigh = state.PreviousHigh
+	d.previousLow = state.PreviousLow
+	d.previousClosing = state.PreviousClosing
+
+	return nil
+}
+
+// IdlePeriod is the initial period that DMI won't yield any results.
+func (d *Dmi[T]) IdlePeriod() int {
+	// RMA idle period and for using the previous values.
+	return d.Rma.IdlePeriod() + 1
+}
+
+// directionalIndicator computes the directional indicator from the smoothed
+// directional movement and the smoothed true range.
+func directionalIndicator[T helper.Number](dm, tr T) T {
+	if tr == 0 {
+		return 0
+	}
+
+	return 100 * dm / tr
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+func TestDmi(t *testing.T) {
+	type Data struct {
+		High    float64
+		Low     float64
+		Close   float64
+		PlusDi  float64
+		MinusDi float64
+		Dx      float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/dmi.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := helper.Duplicate(input, 6)
+	highs := helper.Map(inputs[0], func(d *Data) float64 { return d.High })
+	lows := helper.Map(inputs[1], func(d *Data) float64 { return d.Low })
+	closings := helper.Map(inputs[2], func(d *Data) float64 { return d.Close })
+	expectedPlusDi := helper.Map(inputs[3], func(d *Data) float64 { return d.PlusDi })
+	expectedMinusDi := helper.Map(inputs[4], func(d *Data) float64 { return d.MinusDi })
+	expectedDx := helper.Map(inputs[5], func(d *Data) float64 { return d.Dx })
+
+	dmi := trend.NewDmi[float64]()
+	actualPlusDi, actualMinusDi, actualDx := dmi.Compute(highs, lows, closings)
+
+	actualPlusDi = helper.RoundDigits(actualPlusDi, 2)
+	actualPlusDi = helper.Shift(actualPlusDi, dmi.IdlePeriod(), 0)
+
+	actualMinusDi = helper.RoundDigits(actualMinusDi, 2)
+	actualMinusDi = helper.Shift(actualMinusDi, dmi.IdlePeriod(), 0)
+
+	actualDx = helper.RoundDigits(actualDx, 2)
+	actualDx = helper.Shift(actualDx, dmi.IdlePeriod(), 0)
+
+	err = helper.CheckEquals(actualPlusDi, expectedPlusDi, actualMinusDi, expectedMinusDi, actualDx, expectedDx)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -5,6 +5,7 @@
 package trend
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/cinar/indicator/v2/helper"
@@ -32,6 +33,15 @@ type Ema[T helper.Number] struct {
 
 	// Smoothing constant.
 	Smoothing T
+
+	// sma is the SMA used for the initial EMA value.
+	sma *Sma[T]
+
+	// ema is the last EMA value.
+	ema T
+
+	// ready indicates whether the initial EMA value is computed.
+	ready bool
 }
 
 // NewEma function initializes a new EMA instance with the default parameters.
@@ -52,27 +62,94 @@ func NewEmaWithPeriod[T helper.Number](period int) *Ema[T] {
 
 // Compute function takes a channel of numbers and computes the EMA over the specified period.
 func (e *Ema[T]) Compute(c <-chan T) <-chan T {
-	result := make(chan T, cap(c))
+	return helper.Compute(c, e.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the EMA over the specified period.
+func (e *Ema[T]) ComputeSlice(c []T) []T {
+	return helper.ComputeSlice(c, e.Clone().Update)
+}
+
+// Update function takes a value and updates the EMA. It returns the EMA value, and whether the
+// EMA has passed its idle period and the value is ready.
+func (e *Ema[T]) Update(value T) (T, bool) {
+	if e.ready {
+		e.ema = (value-e.ema)*(e.Smoothing/T(e.Period+1)) + e.ema
+		return e.ema, true
+	}
 
-	go func() {
-		defer close(result)
+	// Initial EMA value is the SMA.
+	if e.sma == nil {
+		e.sma = NewSmaWithPeriod[T](e.Period)
+	}
+
+	e.ema, e.ready = e.sma.Update(value)
+
+	return e.ema, e.ready
+}
 
-		// Initial EMA value is the SMA.
-		sma := NewSma[T]()
-		sma.Period = e.Period
+// Reset function resets the state of the EMA.
+func (e *Ema[T]) Reset() {
+	e.sma = nil
+	e.ema = 0
+	e.ready = false
+}
 
-		before := <-sma.Compute(helper.Head(c, e.Period))
-		result <- before
+// Clone function returns a new EMA instance with the same configuration and a fresh state.
+func (e *Ema[T]) Clone() *Ema[T] {
+	return &Ema[T]{
+		Period:    e.Period,
+		Smoothing: e.Smoothing,
+	}
+}
 
-		multiplier := e.Smoothing / T(e.Period+1)
+// emaState is the state of the EMA.
+type emaState[T helper.Number] struct {
+	// Sma is the SMA used for the initial EMA value.
+	Sma json.RawMessage `json:"sma"`
+
+	// Ema is the last EMA value.
+	Ema T `json:"ema"`
+
+	// Ready indicates whether the initial EMA value is computed.
+	Ready bool `json:"ready"`
+}
+
+// MarshalState function returns the state of the EMA as JSON.
+func (e *Ema[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := emaState[T]{
+		Ema:   e.ema,
+		Ready: e.ready,
+	}
+
+	state.Sma, err = helper.MarshalStateOf(e.sma)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the EMA from the given JSON.
+func (e *Ema[T]) UnmarshalState(data []byte) error {
+	var state emaState[T]
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&e.sma, state.Sma, func() *Sma[T] { return NewSmaWithPeriod[T](e.Period) })
+	if err != nil {
+		return err
+	}
 
-		for n := range c {
-			before = (n-before)*multiplier + before
-			result <- before
-		}
-	}()
+	e.ema = state.Ema
+	e.ready = state.Ready
 
-	return result
+	return nil
 }
 
 // IdlePeriod is the initial period that EMA yield any results.
@@ -5,6 +5,7 @@
 package trend
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/cinar/indicator/v2/helper"
@@ -25,6 +26,9 @@ type Envelope[T helper.Number] struct {
 
 	// Percentage is the envelope percentage.
 	Percentage T
+
+	// ma is the moving average state.
+	ma Ma[T]
 }
 
 // NewEnvelope function initializes a new Envelope instance with the default parameters.
@@ -53,22 +57,95 @@ func NewEnvelopeWithEma[T helper.Number]() *Envelope[T] {
 
 // Compute function takes a channel of numbers and computes the Envelope over the specified period.
 func (e *Envelope[T]) Compute(closings <-chan T) (<-chan T, <-chan T, <-chan T) {
-	middleSplice := helper.Duplicate(
-		e.Ma.Compute(closings),
-		3,
-	)
+	envelope := e.Clone()
 
-	upper := helper.MultiplyBy(
-		middleSplice[0],
-		1+(e.Percentage/100.0),
-	)
+	values := helper.Compute(closings, func(closing T) ([]T, bool) {
+		upper, middle, lower, ok := envelope.Update(closing)
+		return []T{upper, middle, lower}, ok
+	})
 
-	lower := helper.MultiplyBy(
-		middleSplice[2],
-		1-(e.Percentage/100.0),
-	)
+	outputs := helper.Unzip(values, 3)
+
+	return outputs[0], outputs[1], outputs[2]
+}
+
+// ComputeSlice function takes a slice of numbers and computes the Envelope over the specified period.
+func (e *Envelope[T]) ComputeSlice(closings []T) ([]T, []T, []T) {
+	envelope := e.Clone()
+
+	values := helper.ComputeSlice(closings, func(closing T) ([]T, bool) {
+		upper, middle, lower, ok := envelope.Update(closing)
+		return []T{upper, middle, lower}, ok
+	})
+
+	outputs := helper.UnzipSlice(values, 3)
+
+	return outputs[0], outputs[1], outputs[2]
+}
+
+// Update function takes a closing value and updates the Envelope. It returns the upper, middle, and
+// lower bands, and whether the Envelope has passed its idle period and the values are ready.
+func (e *Envelope[T]) Update(closing T) (T, T, T, bool) {
+	if e.ma == nil {
+		e.ma = CloneMa(e.Ma)
+	}
+
+	middle, ok := e.ma.Update(closing)
+	if !ok {
+		return 0, 0, 0, false
+	}
+
+	upper := middle * (1 + (e.Percentage / 100.0))
+	lower := middle * (1 - (e.Percentage / 100.0))
+
+	return upper, middle, lower, true
+}
+
+// Reset function resets the state of the Envelope.
+func (e *Envelope[T]) Reset() {
+	e.ma = nil
+}
+
+// Clone function returns a new Envelope instance with the same configuration and a fresh state.
+func (e *Envelope[T]) Clone() *Envelope[T] {
+	return NewEnvelope(CloneMa(e.Ma), e.Percentage)
+}
+
+// envelopeState is the state of the Envelope.
+type envelopeState struct {
+	// Ma is the moving average state.
+	Ma json.RawMessage `json:"ma"`
+}
+
+// MarshalState function returns the state of the Envelope as JSON.
+func (e *Envelope[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := envelopeState{}
+
+	state.Ma, err = helper.MarshalInterfaceState(e.ma)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the Envelope from the given JSON.
+func (e *Envelope[T]) UnmarshalState(data []byte) error {
+	var state envelopeState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalInterfaceState(&e.ma, state.Ma, func() Ma[T] { return CloneMa(e.Ma) })
+	if err != nil {
+		return err
+	}
 
-	return upper, middleSplice[1], lower
+	return nil
 }
 
 // IdlePeriod is the initial period that Envelope yield any results.
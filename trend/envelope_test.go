@@ -5,6 +5,8 @@
 package trend_test
 
 import (
+	"errors"
+	"reflect"
 	"testing"
 
 	"github.com/cinar/indicator/v2/helper"
@@ -93,3 +95,64 @@ func TestEnvelopeString(t *testing.T) {
 		t.Fatalf("actual %v expected %v", actual, expected)
 	}
 }
+
+func TestEnvelopeCheckpoint(t *testing.T) {
+	type Data struct {
+		Close float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/envelope_ema.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	closings := helper.ChanToSlice(helper.Map(input, func(d *Data) float64 { return d.Close }))
+	split := len(closings) / 2
+
+	expected := trend.NewEnvelopeWithEma[float64]()
+	envelope := trend.NewEnvelopeWithEma[float64]()
+
+	for _, closing := range closings[:split] {
+		expected.Update(closing)
+		envelope.Update(closing)
+	}
+
+	data, err := helper.Checkpoint(envelope)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	restored := trend.NewEnvelopeWithEma[float64]()
+
+	err = helper.Restore(restored, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, closing := range closings[split:] {
+		upper, middle, lower, ok := restored.Update(closing)
+		expectedUpper, expectedMiddle, expectedLower, expectedOk := expected.Update(closing)
+
+		actual := []any{upper, middle, lower, ok}
+		want := []any{expectedUpper, expectedMiddle, expectedLower, expectedOk}
+
+		if !reflect.DeepEqual(actual, want) {
+			t.Fatalf("actual %v expected %v", actual, want)
+		}
+	}
+}
+
+// notStatefulMa is a moving average provided by the caller that does not export its state.
+type notStatefulMa struct {
+	trend.Ma[float64]
+}
+
+func TestEnvelopeCheckpointNotStateful(t *testing.T) {
+	envelope := trend.NewEnvelope[float64](&notStatefulMa{trend.NewAlmaWithPeriod[float64](5)}, 2)
+	envelope.Update(1)
+
+	_, err := helper.Checkpoint(envelope)
+	if !errors.Is(err, helper.ErrNotStateful) {
+		t.Fatalf("actual %v expected %v", err, helper.ErrNotStateful)
+	}
+}
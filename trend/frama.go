@@ -0,0 +1,172 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultFramaPeriod is the default FRAMA period of 16.
+	DefaultFramaPeriod = 16
+)
+
+// Frama represents the parameters for calculating the Fractal Adaptive Moving Average (FRAMA)
+// developed by John Ehlers. It estimates the fractal dimension of the values over the period, and
+// uses it to adapt the smoothing factor, following the values closely when they trend, and
+// flattening when they range. The period must be even.
+//
+//	N1 = (Max - Min) / (Period / 2) over the older half of the period
+//	N2 = (Max - Min) / (Period / 2) over the newer half of the period
+//	N3 = (Max - Min) / Period over the period
+//	D = (Ln(N1 + N2) - Ln(N3)) / Ln(2)
+//	Alpha = Min(Max(Exp(-4.6 * (D - 1)), 0.01), 1)
+//	FRAMA = Alpha * Value + (1 - Alpha) * Previous FRAMA
+//
+// The FRAMA follows the values until the first period is full. When the values are flat, the
+// alpha is 1.
+//
+// Example:
+//
+//	frama := trend.NewFrama[float64]()
+//	result := frama.Compute(c)
+type Frama[T helper.Number] struct {
+	// Period is the time period.
+	Period int
+
+	// window is the values in the period.
+	window []float64
+
+	// frama is the last FRAMA value.
+	frama float64
+}
+
+// NewFrama function initializes a new FRAMA instance with the default parameters.
+func NewFrama[T helper.Number]() *Frama[T] {
+	return NewFramaWithPeriod[T](DefaultFramaPeriod)
+}
+
+// NewFramaWithPeriod function initializes a new FRAMA instance with the given period.
+func NewFramaWithPeriod[T helper.Number](period int) *Frama[T] {
+	return &Frama[T]{
+		Period: period,
+	}
+}
+
+// Compute function takes a channel of numbers and computes the FRAMA over the specified period.
+func (f *Frama[T]) Compute(c <-chan T) <-chan T {
+	return helper.Compute(c, f.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the FRAMA over the specified period.
+func (f *Frama[T]) ComputeSlice(c []T) []T {
+	return helper.ComputeSlice(c, f.Clone().Update)
+}
+
+// Update function takes a value and updates the FRAMA. It returns the FRAMA value, and whether the
+// FRAMA has passed its idle period and the value is ready.
+func (f *Frama[T]) Update(n T) (T, bool) {
+	value := float64(n)
+
+	if f.window == nil {
+		f.window = make([]float64, 0, f.Period)
+	}
+
+	if len(f.window) == f.Period {
+		f.window = append(f.window[:0], f.window[1:]...)
+	}
+
+	f.window = append(f.window, value)
+	if len(f.window) < f.Period {
+		f.frama = value
+		return 0, false
+	}
+
+	half := f.Period / 2
+	n1 := spread(f.window[:half]) / float64(half)
+	n2 := spread(f.window[half:]) / float64(half)
+	n3 := spread(f.window) / float64(f.Period)
+
+	alpha := 1.0
+	if n1+n2 > 0 && n3 > 0 {
+		dimension := (math.Log(n1+n2) - math.Log(n3)) / math.Ln2
+		alpha = min(max(math.Exp(-4.6*(dimension-1)), 0.01), 1)
+	}
+
+	f.frama = alpha*value + (1-alpha)*f.frama
+
+	return T(f.frama), true
+}
+
+// Reset function resets the state of the FRAMA.
+func (f *Frama[T]) Reset() {
+	f.window = nil
+	f.frama = 0
+}
+
+// Clone function returns a new FRAMA instance with the same configuration and a fresh state.
+func (f *Frama[T]) Clone() *Frama[T] {
+	return NewFramaWithPeriod[T](f.Period)
+}
+
+// framaState is the state of the FRAMA.
+type framaState struct {
+	// Window is the values in the period.
+	Window []float64 `json:"window"`
+
+	// Frama is the last FRAMA value.
+	Frama float64 `json:"frama"`
+}
+
+// MarshalState function returns the state of the FRAMA as JSON.
+func (f *Frama[T]) MarshalState() ([]byte, error) {
+	return json.Marshal(&framaState{
+		Window: f.window,
+		Frama:  f.frama,
+	})
+}
+
+// UnmarshalState function restores the state of the FRAMA from the given JSON.
+func (f *Frama[T]) UnmarshalState(data []byte) error {
+	var state framaState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	if len(state.Window) > f.Period {
+		return fmt.Errorf("invalid FRAMA state with %d values of period %d", len(state.Window), f.Period)
+	}
+
+	f.window = nil
+	if state.Window != nil {
+		f.window = append(make([]float64, 0, f.Period), state.Window...)
+	}
+
+	f.frama = state.Frama
+
+	return nil
+}
+
+// IdlePeriod is the initial period that FRAMA won't yield any results.
+func (f *Frama[T]) IdlePeriod() int {
+	return f.Period - 1
+}
+
+// String is the string representation of the FRAMA.
+func (f *Frama[T]) String() string {
+	return fmt.Sprintf("FRAMA(%d)", f.Period)
+}
+
+// spread returns the difference between the highest and the lowest of the given values.
+func spread(values []float64) float64 {
+	return slices.Max(values) - slices.Min(values)
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+func TestFrama(t *testing.T) {
+	type Data struct {
+		Close float64
+		Frama float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/frama.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := helper.Duplicate(input, 2)
+	closing := helper.Map(inputs[0], func(d *Data) float64 { return d.Close })
+	expected := helper.Map(inputs[1], func(d *Data) float64 { return d.Frama })
+
+	frama := trend.NewFrama[float64]()
+	actual := frama.Compute(closing)
+
+	actual = helper.RoundDigits(actual, 2)
+	expected = helper.Skip(expected, frama.IdlePeriod())
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFramaEmpty(t *testing.T) {
+	input := helper.SliceToChan([]float64{})
+	expected := helper.SliceToChan([]float64{})
+
+	frama := trend.NewFrama[float64]()
+	actual := frama.Compute(input)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFramaString(t *testing.T) {
+	expected := "FRAMA(10)"
+	actual := trend.NewFramaWithPeriod[float64](10).String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
@@ -5,6 +5,7 @@
 package trend
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 
@@ -40,29 +41,108 @@ func NewHmaWithPeriod[T helper.Number](period int) *Hma[T] {
 
 // Compute function takes a channel of numbers and computes the HMA and the signal line.
 func (h *Hma[T]) Compute(values <-chan T) <-chan T {
-	valuesSplice := helper.Duplicate(values, 2)
+	return helper.Compute(values, h.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the HMA and the signal line.
+func (h *Hma[T]) ComputeSlice(values []T) []T {
+	return helper.ComputeSlice(values, h.Clone().Update)
+}
 
+// Update function takes a value and updates the HMA. It returns the HMA value, and whether the
+// HMA has passed its idle period and the value is ready.
+func (h *Hma[T]) Update(value T) (T, bool) {
 	//	WMA1 = WMA(period/2 , values)
-	wmas1 := h.wma1.Compute(valuesSplice[0])
+	wma1, _ := h.wma1.Update(value)
 
 	//	WMA2 = WMA(period, values)
-	wmas2 := h.wma2.Compute(valuesSplice[1])
-
-	wmas1 = helper.Skip(wmas1, h.wma2.IdlePeriod()-h.wma1.IdlePeriod())
+	wma2, ok := h.wma2.Update(value)
+	if !ok {
+		return 0, false
+	}
 
 	// WMA3 = WMA(sqrt(period), (2 * WMA1) - WMA2)
-	wmas3 := h.wma3.Compute(
-		helper.Subtract(
-			helper.MultiplyBy(
-				wmas1,
-				2,
-			),
-			wmas2,
-		),
-	)
-
 	// HMA = WMA3
-	return wmas3
+	return h.wma3.Update(wma1*2 - wma2)
+}
+
+// Reset function resets the state of the HMA.
+func (h *Hma[T]) Reset() {
+	h.wma1.Reset()
+	h.wma2.Reset()
+	h.wma3.Reset()
+}
+
+// Clone function returns a new HMA instance with the same configuration and a fresh state.
+func (h *Hma[T]) Clone() *Hma[T] {
+	return &Hma[T]{
+		wma1: h.wma1.Clone(),
+		wma2: h.wma2.Clone(),
+		wma3: h.wma3.Clone(),
+	}
+}
+
+// hmaState is the state of the HMA.
+type hmaState struct {
+	// First WMA.
+	Wma1 json.RawMessage `json:"wma1"`
+
+	// Second WMA.
+	Wma2 json.RawMessage `json:"wma2"`
+
+	// Third WMA.
+	Wma3 json.RawMessage `json:"wma3"`
+}
+
+// MarshalState function returns the state of the HMA as JSON.
+func (h *Hma[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := hmaState{}
+
+	state.Wma1, err = helper.MarshalStateOf(h.wma1)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Wma2, err = helper.MarshalStateOf(h.wma2)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Wma3, err = helper.MarshalStateOf(h.wma3)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the HMA from the given JSON.
+func (h *Hma[T]) UnmarshalState(data []byte) error {
+	var state hmaState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&h.wma1, state.Wma1, h.wma1.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&h.wma2, state.Wma2, h.wma2.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&h.wma3, state.Wma3, h.wma3.Clone)
+	if err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // IdlePeriod is the initial period that HMA won't yield any results.
@@ -5,7 +5,9 @@
 package trend
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
 
 	"github.com/cinar/indicator/v2/helper"
 )
@@ -44,6 +46,18 @@ type Kama[T helper.Number] struct {
 
 	// SlowScPeriod is the Slow Smoothing Constant time period.
 	SlowScPeriod int
+
+	// window is the closings in the Efficiency Ratio period.
+	window *helper.Ring[T]
+
+	// volatility is the moving sum of the absolute changes.
+	volatility *MovingSum[T]
+
+	// previous is the previous closing.
+	previous T
+
+	// kama is the last KAMA value.
+	kama T
 }
 
 // NewKama function initializes a new KAMA instance with the default parameters.
@@ -66,68 +80,131 @@ func NewKamaWith[T helper.Number](erPeriod, fastScPeriod, slowScPeriod int) *Kam
 
 // Compute function takes a channel of numbers and computes the KAMA over the specified period.
 func (k *Kama[T]) Compute(closings <-chan T) <-chan T {
-	closingsSplice := helper.Duplicate(closings, 3)
+	return helper.Compute(closings, k.Clone().Update)
+}
 
-	//	Direction = Abs(Close - Previous Close Period Ago)
-	directions := helper.Abs(
-		helper.Change(closingsSplice[0], k.ErPeriod),
-	)
+// ComputeSlice function takes a slice of numbers and computes the KAMA over the specified period.
+func (k *Kama[T]) ComputeSlice(closings []T) []T {
+	return helper.ComputeSlice(closings, k.Clone().Update)
+}
+
+// Update function takes a value and updates the KAMA. It returns the KAMA value, and whether the
+// KAMA has passed its idle period and the value is ready.
+func (k *Kama[T]) Update(closing T) (T, bool) {
+	if k.window == nil {
+		k.window = helper.NewRing[T](k.ErPeriod)
+		k.volatility = NewMovingSumWithPeriod[T](k.ErPeriod)
+		k.previous = closing
+	}
 
 	//	Volatility = MovingSum(Period, Abs(Close - Previous Close))
-	movingSum := NewMovingSumWithPeriod[T](k.ErPeriod)
-	volatilitys := movingSum.Compute(
-		helper.Abs(
-			helper.Change(closingsSplice[1], 1),
-		),
-	)
+	var volatility T
+	if !k.window.IsEmpty() {
+		volatility, _ = k.volatility.Update(T(math.Abs(float64(closing - k.previous))))
+	}
+
+	k.previous = closing
+
+	full := k.window.IsFull()
+	before := k.window.Put(closing)
+
+	if !full {
+		// Initial KAMA value is the closing.
+		k.kama = closing
+		return 0, false
+	}
+
+	//	Direction = Abs(Close - Previous Close Period Ago)
+	direction := T(math.Abs(float64(closing - before)))
 
 	//	Efficiency Ratio (ER) = Direction / Volatility
-	ers := helper.Divide(directions, volatilitys)
+	er := direction / volatility
 
 	//	Smoothing Constant (SC) = (ER * (2/(Slow + 1) - 2/(Fast + 1)) + (2/(Slow + 1)))^2
 	fastSc := T(2.0) / T(k.FastScPeriod+1)
 	slowSc := T(2.0) / T(k.SlowScPeriod+1)
 
-	scs := helper.Pow(
-		helper.IncrementBy(
-			helper.MultiplyBy(
-				ers,
-				fastSc-slowSc,
-			),
-			slowSc,
-		),
-		2,
-	)
+	sc := T(math.Pow(float64(er*(fastSc-slowSc)+slowSc), 2))
 
 	//	KAMA = Previous KAMA + SC * (Price - Previous KAMA)
-	closingsSplice[2] = helper.Skip(closingsSplice[2], k.ErPeriod-1)
+	k.kama = k.kama + sc*(closing-k.kama)
 
-	kama := make(chan T)
+	return k.kama, true
+}
 
-	go func() {
-		defer close(kama)
-		defer helper.Drain(scs)
-		defer helper.Drain(closingsSplice[2])
+// Reset function resets the state of the KAMA.
+func (k *Kama[T]) Reset() {
+	k.window = nil
+	k.volatility = nil
+	k.previous = 0
+	k.kama = 0
+}
 
-		prevKama, ok := <-closingsSplice[2]
-		if !ok {
-			return
-		}
+// Clone function returns a new KAMA instance with the same configuration and a fresh state.
+func (k *Kama[T]) Clone() *Kama[T] {
+	return NewKamaWith[T](k.ErPeriod, k.FastScPeriod, k.SlowScPeriod)
+}
+
+// kamaState is the state of the KAMA.
+type kamaState[T helper.Number] struct {
+	// Window is the closings in the Efficiency Ratio period.
+	Window json.RawMessage `json:"window"`
+
+	// Volatility is the moving sum of the absolute changes.
+	Volatility json.RawMessage `json:"volatility"`
+
+	// Previous is the previous closing.
+	Previous T `json:"previous"`
+
+	// Kama is the last KAMA value.
+	Kama T `json:"kama"`
+}
+
+// MarshalState function returns the state of the KAMA as JSON.
+func (k *Kama[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := kamaState[T]{
+		Previous: k.previous,
+		Kama:     k.kama,
+	}
+
+	state.Window, err = helper.MarshalStateOf(k.window)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Volatility, err = helper.MarshalStateOf(k.volatility)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
 
-		for {
-			closing, ok := <-closingsSplice[2]
-			if !ok {
-				break
-			}
+// UnmarshalState function restores the state of the KAMA from the given JSON.
+func (k *Kama[T]) UnmarshalState(data []byte) error {
+	var state kamaState[T]
 
-			sc := <-scs
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&k.window, state.Window, func() *helper.Ring[T] { return helper.NewRing[T](k.ErPeriod) })
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&k.volatility, state.Volatility, func() *MovingSum[T] { return NewMovingSumWithPeriod[T](k.ErPeriod) })
+	if err != nil {
+		return err
+	}
 
-			prevKama = prevKama + sc*(closing-prevKama)
-			kama <- prevKama
-		}
-	}()
+	k.previous = state.Previous
+	k.kama = state.Kama
 
-	return kama
+	return nil
 }
 
 // IdlePeriod is the initial period that KAMA yield any results.
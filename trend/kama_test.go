@@ -5,6 +5,7 @@
 package trend_test
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/cinar/indicator/v2/helper"
@@ -65,3 +66,52 @@ func TestKamaString(t *testing.T) {
 		t.Fatalf("actual %v expected %v", actual, expected)
 	}
 }
+
+func TestKamaCheckpoint(t *testing.T) {
+	type Data struct {
+		Close float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/kama.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	closings := helper.ChanToSlice(helper.Map(input, func(d *Data) float64 { return d.Close }))
+	split := len(closings) / 2
+
+	expected := trend.NewKama[float64]().ComputeSlice(closings)
+
+	kama := trend.NewKama[float64]()
+	var actual []float64
+
+	for _, closing := range closings[:split] {
+		value, ok := kama.Update(closing)
+		if ok {
+			actual = append(actual, value)
+		}
+	}
+
+	data, err := helper.Checkpoint(kama)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	restored := trend.NewKama[float64]()
+
+	err = helper.Restore(restored, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, closing := range closings[split:] {
+		value, ok := restored.Update(closing)
+		if ok {
+			actual = append(actual, value)
+		}
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
@@ -4,7 +4,11 @@
 
 package trend
 
-import "github.com/cinar/indicator/v2/helper"
+import (
+	"encoding/json"
+
+	"github.com/cinar/indicator/v2/helper"
+)
 
 const (
 	// DefaultKdjMinMaxPeriod is the default period for moving min
@@ -50,6 +54,18 @@ type Kdj[T helper.Number] struct {
 
 	// Sma2 is the SMA of K.
 	Sma2 *Sma[T]
+
+	// movingMax is the highest high state.
+	movingMax *MovingMax[T]
+
+	// movingMin is the lowest low state.
+	movingMin *MovingMin[T]
+
+	// sma1 is the SMA of RSV state.
+	sma1 *Sma[T]
+
+	// sma2 is the SMA of K state.
+	sma2 *Sma[T]
 }
 
 // NewKdj function initializes a new Kdj instance with the default parameters
@@ -72,41 +88,159 @@ func NewKdj[T helper.Number]() *Kdj[T] {
 // Compute function takes a channel of numbers and computes the KDJ
 // over the specified period. Returns K, D, J.
 func (kdj *Kdj[T]) Compute(high, low, closing <-chan T) (<-chan T, <-chan T, <-chan T) {
-	highest := kdj.MovingMax.Compute(high)
-	lowests := helper.Duplicate(
-		kdj.MovingMin.Compute(low),
-		2,
-	)
-
-	closing = helper.Skip(closing, kdj.MovingMax.Period-1)
-
-	rsv := helper.MultiplyBy(
-		helper.Divide(
-			helper.Subtract(closing, lowests[0]),
-			helper.Subtract(highest, lowests[1]),
-		),
-		100,
-	)
-
-	ks := helper.Duplicate(
-		kdj.Sma1.Compute(rsv),
-		3,
-	)
-
-	ds := helper.Duplicate(
-		kdj.Sma2.Compute(ks[0]),
-		2,
-	)
-
-	ks[1] = helper.Skip(ks[1], kdj.Sma2.Period-1)
-	ks[2] = helper.Skip(ks[2], kdj.Sma2.Period-1)
-
-	j := helper.Subtract(
-		helper.MultiplyBy(ks[1], 3),
-		helper.MultiplyBy(ds[0], 2),
-	)
-
-	return ks[2], ds[1], j
+	clone := kdj.Clone()
+
+	values := helper.Compute3(high, low, closing, func(high, low, closing T) ([]T, bool) {
+		k, d, j, ok := clone.Update(high, low, closing)
+		return []T{k, d, j}, ok
+	})
+
+	outputs := helper.Unzip(values, 3)
+
+	return outputs[0], outputs[1], outputs[2]
+}
+
+// ComputeSlice function takes a slice of numbers and computes the KDJ
+// over the specified period. Returns K, D, J.
+func (kdj *Kdj[T]) ComputeSlice(high, low, closing []T) ([]T, []T, []T) {
+	clone := kdj.Clone()
+
+	values := helper.ComputeSlice3(high, low, closing, func(high, low, closing T) ([]T, bool) {
+		k, d, j, ok := clone.Update(high, low, closing)
+		return []T{k, d, j}, ok
+	})
+
+	outputs := helper.UnzipSlice(values, 3)
+
+	return outputs[0], outputs[1], outputs[2]
+}
+
+// Update function takes a high, a low, and a closing value, and updates the KDJ. It returns the K, D,
+// and J values, and whether the KDJ has passed its idle period and the values are ready.
+func (kdj *Kdj[T]) Update(high, low, closing T) (T, T, T, bool) {
+	if kdj.movingMax == nil {
+		kdj.movingMax = kdj.MovingMax.Clone()
+		kdj.movingMin = kdj.MovingMin.Clone()
+		kdj.sma1 = kdj.Sma1.Clone()
+		kdj.sma2 = kdj.Sma2.Clone()
+	}
+
+	highest, _ := kdj.movingMax.Update(high)
+
+	lowest, ok := kdj.movingMin.Update(low)
+	if !ok {
+		return 0, 0, 0, false
+	}
+
+	rsv := ((closing - lowest) / (highest - lowest)) * 100
+
+	k, ok := kdj.sma1.Update(rsv)
+	if !ok {
+		return 0, 0, 0, false
+	}
+
+	d, ok := kdj.sma2.Update(k)
+	if !ok {
+		return 0, 0, 0, false
+	}
+
+	j := k*3 - d*2
+
+	return k, d, j, true
+}
+
+// Reset function resets the state of the KDJ.
+func (kdj *Kdj[T]) Reset() {
+	kdj.movingMax = nil
+	kdj.movingMin = nil
+	kdj.sma1 = nil
+	kdj.sma2 = nil
+}
+
+// Clone function returns a new KDJ instance with the same configuration and a fresh state.
+func (kdj *Kdj[T]) Clone() *Kdj[T] {
+	return &Kdj[T]{
+		MovingMax: kdj.MovingMax.Clone(),
+		MovingMin: kdj.MovingMin.Clone(),
+		Sma1:      kdj.Sma1.Clone(),
+		Sma2:      kdj.Sma2.Clone(),
+	}
+}
+
+// kdjState is the state of the KDJ.
+type kdjState struct {
+	// MovingMax is the highest high state.
+	MovingMax json.RawMessage `json:"movingMax"`
+
+	// MovingMin is the lowest low state.
+	MovingMin json.RawMessage `json:"movingMin"`
+
+	// Sma1 is the SMA of RSV state.
+	Sma1 json.RawMessage `json:"sma1"`
+
+	// Sma2 is the SMA of K state.
+	Sma2 json.RawMessage `json:"sma2"`
+}
+
+// MarshalState function returns the state of the KDJ as JSON.
+func (kdj *Kdj[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := kdjState{}
+
+	state.MovingMax, err = helper.MarshalStateOf(kdj.movingMax)
+	if err != nil {
+		return nil, err
+	}
+
+	state.MovingMin, err = helper.MarshalStateOf(kdj.movingMin)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Sma1, err = helper.MarshalStateOf(kdj.sma1)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Sma2, err = helper.MarshalStateOf(kdj.sma2)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the KDJ from the given JSON.
+func (kdj *Kdj[T]) UnmarshalState(data []byte) error {
+	var state kdjState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&kdj.movingMax, state.MovingMax, kdj.MovingMax.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&kdj.movingMin, state.MovingMin, kdj.MovingMin.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&kdj.sma1, state.Sma1, kdj.Sma1.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&kdj.sma2, state.Sma2, kdj.Sma2.Clone)
+	if err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // IdlePeriod is the initial period that KDJ won't yield any results.
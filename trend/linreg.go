@@ -0,0 +1,148 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultLinRegPeriod is the default Linear Regression Moving Average period of 14.
+	DefaultLinRegPeriod = 14
+)
+
+// LinReg represents the parameters for calculating the Linear Regression Moving Average, also known
+// as the Least Squares Moving Average (LSMA). It fits a least squares line to the values over the
+// period, and yields the end point of the line at the latest value.
+//
+//	X = 0, 1, ..., Period - 1
+//	M = (Period * Sum(X * Value) - Sum(X) * Sum(Value)) / (Period * Sum(X * X) - Sum(X) * Sum(X))
+//	B = (Sum(Value) - M * Sum(X)) / Period
+//	LinReg = M * (Period - 1) + B
+//
+// Example:
+//
+//	linReg := trend.NewLinReg[float64]()
+//	result := linReg.Compute(c)
+type LinReg[T helper.Number] struct {
+	// Period is the time period.
+	Period int
+
+	// window is the values in the period.
+	window *helper.Ring[float64]
+}
+
+// NewLinReg function initializes a new Linear Regression Moving Average instance with the default parameters.
+func NewLinReg[T helper.Number]() *LinReg[T] {
+	return NewLinRegWithPeriod[T](DefaultLinRegPeriod)
+}
+
+// NewLinRegWithPeriod function initializes a new Linear Regression Moving Average instance with the given period.
+func NewLinRegWithPeriod[T helper.Number](period int) *LinReg[T] {
+	return &LinReg[T]{
+		Period: period,
+	}
+}
+
+// Compute function takes a channel of numbers and computes the Linear Regression Moving Average over the specified period.
+func (l *LinReg[T]) Compute(c <-chan T) <-chan T {
+	return helper.Compute(c, l.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the Linear Regression Moving Average over the specified period.
+func (l *LinReg[T]) ComputeSlice(c []T) []T {
+	return helper.ComputeSlice(c, l.Clone().Update)
+}
+
+// Update function takes a value and updates the Linear Regression Moving Average. It returns the
+// Linear Regression Moving Average value, and whether the period is full and the value is ready.
+func (l *LinReg[T]) Update(value T) (T, bool) {
+	if l.window == nil {
+		l.window = helper.NewRing[float64](l.Period)
+	}
+
+	l.window.Put(float64(value))
+	if !l.window.IsFull() {
+		return 0, false
+	}
+
+	n := float64(l.Period)
+	sumX := n * (n - 1) / 2
+	sumXX := n * (n - 1) * (2*n - 1) / 6
+	divisor := n*sumXX - sumX*sumX
+
+	// A single value is its own regression.
+	if divisor == 0 {
+		return value, true
+	}
+
+	sumY, sumXY := 0.0, 0.0
+	for i := 0; i < l.Period; i++ {
+		sumY += l.window.At(i)
+		sumXY += float64(i) * l.window.At(i)
+	}
+
+	m := (n*sumXY - sumX*sumY) / divisor
+	b := (sumY - m*sumX) / n
+
+	return T(m*(n-1) + b), true
+}
+
+// Reset function resets the state of the Linear Regression Moving Average.
+func (l *LinReg[T]) Reset() {
+	l.window = nil
+}
+
+// Clone function returns a new Linear Regression Moving Average instance with the same configuration and a fresh state.
+func (l *LinReg[T]) Clone() *LinReg[T] {
+	return NewLinRegWithPeriod[T](l.Period)
+}
+
+// linRegState is the state of the LinReg.
+type linRegState struct {
+	// Window is the values in the period.
+	Window json.RawMessage `json:"window"`
+}
+
+// MarshalState function returns the state of the LinReg as JSON.
+func (l *LinReg[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := linRegState{}
+
+	state.Window, err = helper.MarshalStateOf(l.window)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the LinReg from the given JSON.
+func (l *LinReg[T]) UnmarshalState(data []byte) error {
+	var state linRegState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	return helper.UnmarshalStateOf(&l.window, state.Window, func() *helper.Ring[float64] {
+		return helper.NewRing[float64](l.Period)
+	})
+}
+
+// IdlePeriod is the initial period that Linear Regression Moving Average won't yield any results.
+func (l *LinReg[T]) IdlePeriod() int {
+	return l.Period - 1
+}
+
+// String is the string representation of the Linear Regression Moving Average.
+func (l *LinReg[T]) String() string {
+	return fmt.Sprintf("LINREG(%d)", l.Period)
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+func TestLinReg(t *testing.T) {
+	type Data struct {
+		Close  float64
+		LinReg float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/linreg.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := helper.Duplicate(input, 2)
+	closing := helper.Map(inputs[0], func(d *Data) float64 { return d.Close })
+	expected := helper.Map(inputs[1], func(d *Data) float64 { return d.LinReg })
+
+	linReg := trend.NewLinReg[float64]()
+	actual := linReg.Compute(closing)
+
+	actual = helper.RoundDigits(actual, 2)
+	expected = helper.Skip(expected, linReg.IdlePeriod())
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLinRegEmpty(t *testing.T) {
+	input := helper.SliceToChan([]float64{})
+	expected := helper.SliceToChan([]float64{})
+
+	linReg := trend.NewLinReg[float64]()
+	actual := linReg.Compute(input)
+
+	err := helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLinRegString(t *testing.T) {
+	expected := "LINREG(5)"
+	actual := trend.NewLinRegWithPeriod[float64](5).String()
+
+	if actual != expected {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
@@ -13,9 +13,64 @@ type Ma[T helper.Number] interface {
 	// Compute function takes a channel of numbers and computes the MA.
 	Compute(<-chan T) <-chan T
 
+	// Update function takes a value and updates the MA. It returns the MA value, and whether the MA
+	// has passed its idle period and the value is ready.
+	Update(T) (T, bool)
+
+	// Reset function resets the state of the MA.
+	Reset()
+
 	// IdlePeriod is the initial period that MA won't yield any results.
 	IdlePeriod() int
 
 	// String is the string representation of the MA instance.
 	String() string
 }
+
+// CloneMa returns a new instance of the given MA with the same configuration and a fresh state. The
+// MAs other than the ones provided by this package are not cloned, and are returned as is.
+func CloneMa[T helper.Number](ma Ma[T]) Ma[T] {
+	switch m := ma.(type) {
+	case *Alma[T]:
+		return m.Clone()
+
+	case *Ema[T]:
+		return m.Clone()
+
+	case *Frama[T]:
+		return m.Clone()
+
+	case *Hma[T]:
+		return m.Clone()
+
+	case *Kama[T]:
+		return m.Clone()
+
+	case *LinReg[T]:
+		return m.Clone()
+
+	case *McGinley[T]:
+		return m.Clone()
+
+	case *Sma[T]:
+		return m.Clone()
+
+	case *Smma[T]:
+		return m.Clone()
+
+	case *T3[T]:
+		return m.Clone()
+
+	case *Vidya[T]:
+		return m.Clone()
+
+	case *Wma[T]:
+		return m.Clone()
+
+	case *Zlema[T]:
+		return m.Clone()
+
+	default:
+		return ma
+	}
+}
@@ -5,6 +5,8 @@
 package trend
 
 import (
+	"encoding/json"
+
 	"github.com/cinar/indicator/v2/helper"
 )
 
@@ -30,6 +32,15 @@ type Macd[T helper.Number] struct {
 	Ema1 *Ema[T]
 	Ema2 *Ema[T]
 	Ema3 *Ema[T]
+
+	// ema1 is the first EMA state.
+	ema1 *Ema[T]
+
+	// ema2 is the second EMA state.
+	ema2 *Ema[T]
+
+	// ema3 is the signal EMA state.
+	ema3 *Ema[T]
 }
 
 // NewMacd function initializes a new MACD instance with the default parameters.
@@ -53,18 +64,136 @@ func NewMacdWithPeriod[T helper.Number](period1, period2, period3 int) *Macd[T]
 // Compute function takes a channel of numbers and computes the MACD
 // and the signal line.
 func (m *Macd[T]) Compute(c <-chan T) (<-chan T, <-chan T) {
-	snapshots := helper.Duplicate(c, 2)
+	macd := m.Clone()
+
+	values := helper.Compute(c, func(value T) ([]T, bool) {
+		macdValue, signal, ok := macd.Update(value)
+		return []T{macdValue, signal}, ok
+	})
+
+	outputs := helper.Unzip(values, 2)
+
+	return outputs[0], outputs[1]
+}
+
+// ComputeSlice function takes a slice of numbers and computes the MACD
+// and the signal line.
+func (m *Macd[T]) ComputeSlice(c []T) ([]T, []T) {
+	macd := m.Clone()
+
+	values := helper.ComputeSlice(c, func(value T) ([]T, bool) {
+		macdValue, signal, ok := macd.Update(value)
+		return []T{macdValue, signal}, ok
+	})
+
+	outputs := helper.UnzipSlice(values, 2)
+
+	return outputs[0], outputs[1]
+}
+
+// Update function takes a value and updates the MACD. It returns the MACD and the signal line values,
+// and whether the MACD has passed its idle period and the values are ready.
+func (m *Macd[T]) Update(value T) (T, T, bool) {
+	if m.ema1 == nil {
+		m.ema1 = m.Ema1.Clone()
+		m.ema2 = m.Ema2.Clone()
+		m.ema3 = m.Ema3.Clone()
+	}
+
+	ema1, _ := m.ema1.Update(value)
+
+	ema2, ok := m.ema2.Update(value)
+	if !ok {
+		return 0, 0, false
+	}
+
+	macd := ema1 - ema2
+
+	signal, ok := m.ema3.Update(macd)
+	if !ok {
+		return 0, 0, false
+	}
+
+	return macd, signal, true
+}
+
+// Reset function resets the state of the MACD.
+func (m *Macd[T]) Reset() {
+	m.ema1 = nil
+	m.ema2 = nil
+	m.ema3 = nil
+}
 
-	emas1 := m.Ema1.Compute(snapshots[0])
-	emas1 = helper.Skip(emas1, m.Ema2.Period-m.Ema1.Period)
+// Clone function returns a new MACD instance with the same configuration and a fresh state.
+func (m *Macd[T]) Clone() *Macd[T] {
+	return &Macd[T]{
+		Ema1: m.Ema1.Clone(),
+		Ema2: m.Ema2.Clone(),
+		Ema3: m.Ema3.Clone(),
+	}
+}
 
-	emas2 := m.Ema2.Compute(snapshots[1])
+// macdState is the state of the MACD.
+type macdState struct {
+	// Ema1 is the first EMA state.
+	Ema1 json.RawMessage `json:"ema1"`
+
+	// Ema2 is the second EMA state.
+	Ema2 json.RawMessage `json:"ema2"`
+
+	// Ema3 is the signal EMA state.
+	Ema3 json.RawMessage `json:"ema3"`
+}
+
+// MarshalState function returns the state of the MACD as JSON.
+func (m *Macd[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := macdState{}
+
+	state.Ema1, err = helper.MarshalStateOf(m.ema1)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Ema2, err = helper.MarshalStateOf(m.ema2)
+	if err != nil {
+		return nil, err
+	}
 
-	macds := helper.Duplicate(helper.Subtract(emas1, emas2), 2)
-	macds[0] = helper.Skip(macds[0], m.Ema3.Period-1)
-	signal := m.Ema3.Compute(macds[1])
+	state.Ema3, err = helper.MarshalStateOf(m.ema3)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the MACD from the given JSON.
+func (m *Macd[T]) UnmarshalState(data []byte) error {
+	var state macdState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&m.ema1, state.Ema1, m.Ema1.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&m.ema2, state.Ema2, m.Ema2.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&m.ema3, state.Ema3, m.Ema3.Clone)
+	if err != nil {
+		return err
+	}
 
-	return macds[0], signal
+	return nil
 }
 
 // IdlePeriod is the initial period that MACD won't yield any results.
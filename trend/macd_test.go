@@ -47,3 +47,83 @@ func TestMacd(t *testing.T) {
 		}
 	}
 }
+
+func TestMacdUpdate(t *testing.T) {
+	type Data struct {
+		Close  float64
+		Macd   float64
+		Signal float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/macd.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := helper.ChanToSlice(input)
+	macd := trend.NewMacd[float64]()
+
+	for i, d := range data {
+		actualMacd, actualSignal, ok := macd.Update(d.Close)
+		if ok != (i >= macd.IdlePeriod()) {
+			t.Fatalf("index %d ready %v", i, ok)
+		}
+
+		if !ok {
+			continue
+		}
+
+		if helper.RoundDigit(actualMacd, 2) != d.Macd {
+			t.Fatalf("actual %v expected %v", actualMacd, d.Macd)
+		}
+
+		if helper.RoundDigit(actualSignal, 2) != d.Signal {
+			t.Fatalf("actual %v expected %v", actualSignal, d.Signal)
+		}
+	}
+}
+
+func TestMacdComputeSlice(t *testing.T) {
+	type Data struct {
+		Close float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/macd.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	closings := helper.ChanToSlice(helper.Map(input, func(d *Data) float64 { return d.Close }))
+
+	macd := trend.NewMacd[float64]()
+	expectedMacds, expectedSignals := macd.Compute(helper.SliceToChan(closings))
+	actualMacds, actualSignals := macd.ComputeSlice(closings)
+
+	err = helper.CheckEquals(
+		helper.SliceToChan(actualMacds), expectedMacds,
+		helper.SliceToChan(actualSignals), expectedSignals,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func BenchmarkMacdCompute(b *testing.B) {
+	input := benchmarkValues()
+	macd := trend.NewMacd[float64]()
+
+	for range b.N {
+		macds, signals := macd.Compute(helper.SliceToChan(input))
+		go helper.Drain(macds)
+		helper.Drain(signals)
+	}
+}
+
+func BenchmarkMacdComputeSlice(b *testing.B) {
+	input := benchmarkValues()
+	macd := trend.NewMacd[float64]()
+
+	for range b.N {
+		macd.ComputeSlice(input)
+	}
+}
@@ -5,6 +5,8 @@
 package trend
 
 import (
+	"encoding/json"
+
 	"github.com/cinar/indicator/v2/helper"
 )
 
@@ -33,6 +35,15 @@ type MassIndex[T helper.Number] struct {
 	Ema1      *Ema[T]
 	Ema2      *Ema[T]
 	MovingSum *MovingSum[T]
+
+	// ema1 is the first EMA state.
+	ema1 *Ema[T]
+
+	// ema2 is the second EMA state.
+	ema2 *Ema[T]
+
+	// movingSum is the moving sum state.
+	movingSum *MovingSum[T]
 }
 
 // NewMassIndex function initializes a new APO instance
@@ -53,20 +64,108 @@ func NewMassIndex[T helper.Number]() *MassIndex[T] {
 
 // Compute function takes a channel of numbers and computes the Mass Index.
 func (m *MassIndex[T]) Compute(highs, lows <-chan T) <-chan T {
-	ema1 := helper.Duplicate(
-		m.Ema1.Compute(
-			helper.Subtract(highs, lows),
-		),
-		2,
-	)
+	return helper.Compute2(highs, lows, m.Clone().Update)
+}
 
-	ema2 := m.Ema2.Compute(ema1[0])
-	ema1[1] = helper.Skip(ema1[1], m.Ema2.Period-1)
+// ComputeSlice function takes a slice of numbers and computes the Mass Index.
+func (m *MassIndex[T]) ComputeSlice(highs, lows []T) []T {
+	return helper.ComputeSlice2(highs, lows, m.Clone().Update)
+}
 
-	ratio := helper.Divide(ema1[1], ema2)
-	mi := m.MovingSum.Compute(ratio)
+// Update function takes a high and a low value, and updates the Mass Index. It returns the Mass Index
+// value, and whether the Mass Index has passed its idle period and the value is ready.
+func (m *MassIndex[T]) Update(high, low T) (T, bool) {
+	if m.ema1 == nil {
+		m.ema1 = m.Ema1.Clone()
+		m.ema2 = m.Ema2.Clone()
+		m.movingSum = m.MovingSum.Clone()
+	}
 
-	return mi
+	ema1, ok := m.ema1.Update(high - low)
+	if !ok {
+		return 0, false
+	}
+
+	ema2, ok := m.ema2.Update(ema1)
+	if !ok {
+		return 0, false
+	}
+
+	return m.movingSum.Update(ema1 / ema2)
+}
+
+// Reset function resets the state of the Mass Index.
+func (m *MassIndex[T]) Reset() {
+	m.ema1 = nil
+	m.ema2 = nil
+	m.movingSum = nil
+}
+
+// Clone function returns a new Mass Index instance with the same configuration and a fresh state.
+func (m *MassIndex[T]) Clone() *MassIndex[T] {
+	return &MassIndex[T]{
+		Ema1:      m.Ema1.Clone(),
+		Ema2:      m.Ema2.Clone(),
+		MovingSum: m.MovingSum.Clone(),
+	}
+}
+
+// massIndexState is the state of the Mass Index.
+type massIndexState struct {
+	// Ema1 is the first EMA state.
+	Ema1 json.RawMessage `json:"ema1"`
+
+	// Ema2 is the second EMA state.
+	Ema2 json.RawMessage `json:"ema2"`
+
+	// MovingSum is the moving sum state.
+	MovingSum json.RawMessage `json:"movingSum"`
+}
+
+// MarshalState function returns the state of the Mass Index as JSON.
+func (m *MassIndex[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := massIndexState{}
+
+	state.Ema1, err = helper.MarshalStateOf(m.ema1)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Ema2, err = helper.MarshalStateOf(m.ema2)
+	if err != nil {
+		return nil, err
+	}
+
+	state.MovingSum, err = helper.MarshalStateOf(m.movingSum)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the Mass Index from the given JSON.
+func (m *MassIndex[T]) UnmarshalState(data []byte) error {
+	var state massIndexState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&m.ema1, state.Ema1, m.Ema1.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&m.ema2, state.Ema2, m.Ema2.Clone)
+	if err != nil {
+		return err
+	}
+
+	return helper.UnmarshalStateOf(&m.movingSum, state.MovingSum, m.MovingSum.Clone)
 }
 
 // IdlePeriod is the initial period that Mass Index won't yield any results.
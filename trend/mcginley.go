@@ -0,0 +1,162 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultMcGinleyPeriod is the default McGinley Dynamic period of 14.
+	DefaultMcGinleyPeriod = 14
+)
+
+// McGinley represents the parameters for calculating the McGinley Dynamic developed by John
+// McGinley. It adjusts its speed to the market automatically, speeding up when the values fall
+// below it, and slowing down when the values rise above it, to reduce whipsaws.
+//
+//	MD = Previous MD + (Value - Previous MD) / (Period * Pow(Value / Previous MD, 4))
+//
+// The initial MD is the SMA of the first period.
+//
+// Example:
+//
+//	mcginley := trend.NewMcGinley[float64]()
+//	result := mcginley.Compute(c)
+type McGinley[T helper.Number] struct {
+	// Period is the time period.
+	Period int
+
+	// sma is the SMA used for the initial MD value.
+	sma *Sma[T]
+
+	// md is the last MD value.
+	md float64
+
+	// ready indicates whether the initial MD value is computed.
+	ready bool
+}
+
+// NewMcGinley function initializes a new McGinley Dynamic instance with the default parameters.
+func NewMcGinley[T helper.Number]() *McGinley[T] {
+	return NewMcGinleyWithPeriod[T](DefaultMcGinleyPeriod)
+}
+
+// NewMcGinleyWithPeriod function initializes a new McGinley Dynamic instance with the given period.
+func NewMcGinleyWithPeriod[T helper.Number](period int) *McGinley[T] {
+	return &McGinley[T]{
+		Period: period,
+	}
+}
+
+// Compute function takes a channel of numbers and computes the McGinley Dynamic over the specified period.
+func (m *McGinley[T]) Compute(c <-chan T) <-chan T {
+	return helper.Compute(c, m.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the McGinley Dynamic over the specified period.
+func (m *McGinley[T]) ComputeSlice(c []T) []T {
+	return helper.ComputeSlice(c, m.Clone().Update)
+}
+
+// Update function takes a value and updates the McGinley Dynamic. It returns the McGinley Dynamic value, and whether the
+// McGinley Dynamic has passed its idle period and the value is ready.
+func (m *McGinley[T]) Update(value T) (T, bool) {
+	if m.ready {
+		v := float64(value)
+		m.md += (v - m.md) / (float64(m.Period) * math.Pow(v/m.md, 4))
+		return T(m.md), true
+	}
+
+	// Initial MD value is the SMA.
+	if m.sma == nil {
+		m.sma = NewSmaWithPeriod[T](m.Period)
+	}
+
+	first, ok := m.sma.Update(value)
+	if !ok {
+		return 0, false
+	}
+
+	m.md = float64(first)
+	m.ready = true
+
+	return first, true
+}
+
+// Reset function resets the state of the McGinley Dynamic.
+func (m *McGinley[T]) Reset() {
+	m.sma = nil
+	m.md = 0
+	m.ready = false
+}
+
+// Clone function returns a new McGinley Dynamic instance with the same configuration and a fresh state.
+func (m *McGinley[T]) Clone() *McGinley[T] {
+	return NewMcGinleyWithPeriod[T](m.Period)
+}
+
+// mcGinleyState is the state of the McGinley Dynamic.
+type mcGinleyState struct {
+	// Sma is the SMA used for the initial value.
+	Sma json.RawMessage `json:"sma"`
+
+	// Md is the last McGinley Dynamic value.
+	Md float64 `json:"md"`
+
+	// Ready indicates whether the initial value is computed.
+	Ready bool `json:"ready"`
+}
+
+// MarshalState function returns the state of the McGinley Dynamic as JSON.
+func (m *McGinley[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := mcGinleyState{
+		Md:    m.md,
+		Ready: m.ready,
+	}
+
+	state.Sma, err = helper.MarshalStateOf(m.sma)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the McGinley Dynamic from the given JSON.
+func (m *McGinley[T]) UnmarshalState(data []byte) error {
+	var state mcGinleyState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&m.sma, state.Sma, func() *Sma[T] { return NewSmaWithPeriod[T](m.Period) })
+	if err != nil {
+		return err
+	}
+
+	m.md = state.Md
+	m.ready = state.Ready
+
+	return nil
+}
+
+// IdlePeriod is the initial period that McGinley Dynamic won't yield any results.
+func (m *McGinley[T]) IdlePeriod() int {
+	return m.Period - 1
+}
+
+// String is the string representation of the McGinley Dynamic.
+func (m *McGinley[T]) String() string {
+	return fmt.Sprintf("MCGINLEY(%d)", m.Period)
+}
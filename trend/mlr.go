@@ -5,6 +5,8 @@
 package trend
 
 import (
+	"encoding/json"
+
 	"github.com/cinar/indicator/v2/helper"
 )
 
@@ -19,6 +21,9 @@ import (
 type Mlr[T helper.Number] struct {
 	// Mls is the Moving Least Square instance.
 	Mls *Mls[T]
+
+	// mls is the MLS state.
+	mls *Mls[T]
 }
 
 // NewMlrWithPeriod function initializes a new MLR instance with the given period.
@@ -30,21 +35,76 @@ func NewMlrWithPeriod[T helper.Number](period int) *Mlr[T] {
 
 // Compute function takes a channel of numbers and computes the MLR r.
 func (m *Mlr[T]) Compute(x, y <-chan T) <-chan T {
-	xSplice := helper.Duplicate(x, 2)
+	return helper.Compute2(x, y, m.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the MLR r.
+func (m *Mlr[T]) ComputeSlice(x, y []T) []T {
+	return helper.ComputeSlice2(x, y, m.Clone().Update)
+}
+
+// Update function takes an x and a y value, and updates the MLR. It returns the MLR r value, and
+// whether the MLR has passed its idle period and the value is ready.
+func (m *Mlr[T]) Update(x, y T) (T, bool) {
+	if m.mls == nil {
+		m.mls = m.Mls.Clone()
+	}
 
-	ms, bs := m.Mls.Compute(xSplice[0], y)
+	mValue, b, ok := m.mls.Update(x, y)
+	if !ok {
+		return 0, false
+	}
+
+	return mValue*x + b, true
+}
 
-	xSplice[1] = helper.Skip(xSplice[1], m.Mls.IdlePeriod())
+// Reset function resets the state of the MLR.
+func (m *Mlr[T]) Reset() {
+	m.mls = nil
+}
 
-	r := helper.Add(
-		helper.Multiply(
-			ms,
-			xSplice[1],
-		),
-		bs,
-	)
+// Clone function returns a new MLR instance with the same configuration and a fresh state.
+func (m *Mlr[T]) Clone() *Mlr[T] {
+	return &Mlr[T]{
+		Mls: m.Mls.Clone(),
+	}
+}
+
+// mlrState is the state of the MLR.
+type mlrState struct {
+	// Mls is the MLS state.
+	Mls json.RawMessage `json:"mls"`
+}
+
+// MarshalState function returns the state of the MLR as JSON.
+func (m *Mlr[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := mlrState{}
+
+	state.Mls, err = helper.MarshalStateOf(m.mls)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the MLR from the given JSON.
+func (m *Mlr[T]) UnmarshalState(data []byte) error {
+	var state mlrState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&m.mls, state.Mls, m.Mls.Clone)
+	if err != nil {
+		return err
+	}
 
-	return r
+	return nil
 }
 
 // IdlePeriod is the initial period that MLR won't yield any results.
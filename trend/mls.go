@@ -5,6 +5,9 @@
 package trend
 
 import (
+	"encoding/json"
+	"math"
+
 	"github.com/cinar/indicator/v2/helper"
 )
 
@@ -25,6 +28,18 @@ import (
 type Mls[T helper.Number] struct {
 	// Sum is the moving sum instance.
 	Sum *MovingSum[T]
+
+	// sumXY is the moving sum state of the x and y products.
+	sumXY *MovingSum[T]
+
+	// sumX is the moving sum state of the x values.
+	sumX *MovingSum[T]
+
+	// sumY is the moving sum state of the y values.
+	sumY *MovingSum[T]
+
+	// sumX2 is the moving sum state of the x squares.
+	sumX2 *MovingSum[T]
 }
 
 // NewMlsWithPeriod function initializes a new MLS instance with the given period.
@@ -36,69 +51,151 @@ func NewMlsWithPeriod[T helper.Number](period int) *Mls[T] {
 
 // Compute function takes a channel of numbers and computes the MLS m and b.
 func (m *Mls[T]) Compute(x, y <-chan T) (<-chan T, <-chan T) {
-	xSplice := helper.Duplicate(x, 3)
-	ySplice := helper.Duplicate(y, 2)
+	mls := m.Clone()
+
+	values := helper.Compute2(x, y, func(x, y T) ([]T, bool) {
+		mValue, b, ok := mls.Update(x, y)
+		return []T{mValue, b}, ok
+	})
+
+	outputs := helper.Unzip(values, 2)
 
-	sumXY := m.Sum.Compute(
-		helper.Operate(xSplice[0], ySplice[0], func(a, b T) T {
-			return a * b
-		}),
-	)
+	return outputs[0], outputs[1]
+}
+
+// ComputeSlice function takes a slice of numbers and computes the MLS m and b.
+func (m *Mls[T]) ComputeSlice(x, y []T) ([]T, []T) {
+	mls := m.Clone()
 
-	sumXSplice := helper.Duplicate(
-		m.Sum.Compute(xSplice[1]),
-		4,
-	)
+	values := helper.ComputeSlice2(x, y, func(x, y T) ([]T, bool) {
+		mValue, b, ok := mls.Update(x, y)
+		return []T{mValue, b}, ok
+	})
 
-	sumYSplice := helper.Duplicate(
-		m.Sum.Compute(ySplice[1]),
-		2,
-	)
+	outputs := helper.UnzipSlice(values, 2)
 
-	sumX2 := m.Sum.Compute(
-		helper.Pow(xSplice[2], 2),
-	)
+	return outputs[0], outputs[1]
+}
+
+// Update function takes an x and a y value, and updates the MLS. It returns the MLS m and b values,
+// and whether the MLS has passed its idle period and the values are ready.
+func (m *Mls[T]) Update(x, y T) (T, T, bool) {
+	if m.sumXY == nil {
+		m.sumXY = m.Sum.Clone()
+		m.sumX = m.Sum.Clone()
+		m.sumY = m.Sum.Clone()
+		m.sumX2 = m.Sum.Clone()
+	}
+
+	sumXY, _ := m.sumXY.Update(x * y)
+	sumX, _ := m.sumX.Update(x)
+	sumY, _ := m.sumY.Update(y)
+
+	sumX2, ok := m.sumX2.Update(T(math.Pow(float64(x), 2)))
+	if !ok {
+		return 0, 0, false
+	}
+
+	period := T(m.Sum.Period)
 
 	// m = (period * sumXY - sumX * sumY) / (period * sumX2 - sumX * sumX)
-	mSplice := helper.Duplicate(
-		helper.Divide(
-			helper.Subtract(
-				helper.MultiplyBy(
-					sumXY,
-					T(m.Sum.Period),
-				),
-				helper.Multiply(
-					sumXSplice[0],
-					sumYSplice[0],
-				),
-			),
-			helper.Subtract(
-				helper.MultiplyBy(
-					sumX2,
-					T(m.Sum.Period),
-				),
-				helper.Multiply(
-					sumXSplice[1],
-					sumXSplice[2],
-				),
-			),
-		),
-		2,
-	)
+	mValue := (sumXY*period - sumX*sumY) / (sumX2*period - sumX*sumX)
 
 	// b = (sumY - m * sumX) / period
-	b := helper.DivideBy(
-		helper.Subtract(
-			sumYSplice[1],
-			helper.Multiply(
-				mSplice[1],
-				sumXSplice[3],
-			),
-		),
-		T(m.Sum.Period),
-	)
-
-	return mSplice[0], b
+	b := (sumY - mValue*sumX) / period
+
+	return mValue, b, true
+}
+
+// Reset function resets the state of the MLS.
+func (m *Mls[T]) Reset() {
+	m.sumXY = nil
+	m.sumX = nil
+	m.sumY = nil
+	m.sumX2 = nil
+}
+
+// Clone function returns a new MLS instance with the same configuration and a fresh state.
+func (m *Mls[T]) Clone() *Mls[T] {
+	return &Mls[T]{
+		Sum: m.Sum.Clone(),
+	}
+}
+
+// mlsState is the state of the MLS.
+type mlsState struct {
+	// SumXY is the moving sum state of the x and y products.
+	SumXY json.RawMessage `json:"sumXY"`
+
+	// SumX is the moving sum state of the x values.
+	SumX json.RawMessage `json:"sumX"`
+
+	// SumY is the moving sum state of the y values.
+	SumY json.RawMessage `json:"sumY"`
+
+	// SumX2 is the moving sum state of the x squares.
+	SumX2 json.RawMessage `json:"sumX2"`
+}
+
+// MarshalState function returns the state of the MLS as JSON.
+func (m *Mls[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := mlsState{}
+
+	state.SumXY, err = helper.MarshalStateOf(m.sumXY)
+	if err != nil {
+		return nil, err
+	}
+
+	state.SumX, err = helper.MarshalStateOf(m.sumX)
+	if err != nil {
+		return nil, err
+	}
+
+	state.SumY, err = helper.MarshalStateOf(m.sumY)
+	if err != nil {
+		return nil, err
+	}
+
+	state.SumX2, err = helper.MarshalStateOf(m.sumX2)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the MLS from the given JSON.
+func (m *Mls[T]) UnmarshalState(data []byte) error {
+	var state mlsState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&m.sumXY, state.SumXY, m.Sum.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&m.sumX, state.SumX, m.Sum.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&m.sumY, state.SumY, m.Sum.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&m.sumX2, state.SumX2, m.Sum.Clone)
+	if err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // IdlePeriod is the initial period that MLS won't yield any results.
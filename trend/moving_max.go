@@ -4,7 +4,11 @@
 
 package trend
 
-import "github.com/cinar/indicator/v2/helper"
+import (
+	"encoding/json"
+
+	"github.com/cinar/indicator/v2/helper"
+)
 
 // MovingMax represents the configuration parameters for calculating the
 // Moving Max over the specified period.
@@ -13,6 +17,12 @@ import "github.com/cinar/indicator/v2/helper"
 type MovingMax[T helper.Number] struct {
 	// Time period.
 	Period int
+
+	// window is the values in the period.
+	window *helper.Ring[T]
+
+	// bst is the sorted values in the period.
+	bst *helper.Bst[T]
 }
 
 // NewMovingMax function initializes a new Moving Max instance with the default parameters.
@@ -30,18 +40,90 @@ func NewMovingMaxWithPeriod[T helper.Number](period int) *MovingMax[T] {
 // Compute function takes a channel of numbers and computes the
 // Moving Max over the specified period.
 func (m *MovingMax[T]) Compute(c <-chan T) <-chan T {
-	cs := helper.Duplicate(c, 2)
-	cs[1] = helper.Shift(cs[1], m.Period, 0)
+	return helper.Compute(c, m.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the
+// Moving Max over the specified period.
+func (m *MovingMax[T]) ComputeSlice(c []T) []T {
+	return helper.ComputeSlice(c, m.Clone().Update)
+}
+
+// Update function takes a value and updates the Moving Max. It returns the Moving Max, and whether
+// the period is full and the value is ready.
+func (m *MovingMax[T]) Update(value T) (T, bool) {
+	if m.window == nil {
+		m.window = helper.NewRing[T](m.Period)
+		m.bst = helper.NewBst[T]()
+	}
+
+	full := m.window.IsFull()
+
+	removed := m.window.Put(value)
+	if full {
+		m.bst.Remove(removed)
+	}
+
+	m.bst.Insert(value)
+
+	return m.bst.Max(), m.window.IsFull()
+}
+
+// Reset function resets the state of the Moving Max.
+func (m *MovingMax[T]) Reset() {
+	m.window = nil
+	m.bst = nil
+}
+
+// Clone function returns a new Moving Max instance with the same configuration and a fresh state.
+func (m *MovingMax[T]) Clone() *MovingMax[T] {
+	return NewMovingMaxWithPeriod[T](m.Period)
+}
+
+// movingMaxState is the state of the Moving Max.
+type movingMaxState struct {
+	// Window is the window of the values.
+	Window json.RawMessage `json:"window"`
+}
 
-	bst := helper.NewBst[T]()
+// MarshalState function returns the state of the Moving Max as JSON.
+func (m *MovingMax[T]) MarshalState() ([]byte, error) {
+	window, err := helper.MarshalStateOf(m.window)
+	if err != nil {
+		return nil, err
+	}
 
-	maxs := helper.Operate(cs[0], cs[1], func(c, b T) T {
-		bst.Insert(c)
-		bst.Remove(b)
-		return bst.Max()
+	return json.Marshal(&movingMaxState{
+		Window: window,
 	})
+}
+
+// UnmarshalState function restores the state of the Moving Max from the given JSON. The binary
+// search tree is rebuilt from the values in the window.
+func (m *MovingMax[T]) UnmarshalState(data []byte) error {
+	var state movingMaxState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&m.window, state.Window, func() *helper.Ring[T] { return helper.NewRing[T](m.Period) })
+	if err != nil {
+		return err
+	}
+
+	m.bst = nil
+
+	if m.window != nil {
+		m.bst = helper.NewBst[T]()
+
+		for i := 0; i < m.window.Len(); i++ {
+			m.bst.Insert(m.window.At(i))
+		}
+	}
 
-	return helper.Skip(maxs, m.Period-1)
+	return nil
 }
 
 // IdlePeriod is the initial period that Mocing Max won't yield any results.
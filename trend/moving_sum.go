@@ -4,7 +4,11 @@
 
 package trend
 
-import "github.com/cinar/indicator/v2/helper"
+import (
+	"encoding/json"
+
+	"github.com/cinar/indicator/v2/helper"
+)
 
 // MovingSum represents the configuration parameters for calculating the Moving Sum over the specified period.
 //
@@ -15,6 +19,12 @@ import "github.com/cinar/indicator/v2/helper"
 type MovingSum[T helper.Number] struct {
 	// Time period.
 	Period int
+
+	// window is the values in the period.
+	window *helper.Ring[T]
+
+	// sum is the sum of the values in the period.
+	sum T
 }
 
 // NewMovingSum function initializes a new Moving Sum instance with the default parameters.
@@ -32,17 +42,80 @@ func NewMovingSumWithPeriod[T helper.Number](period int) *MovingSum[T] {
 // Compute function takes a channel of numbers and computes the
 // Moving Sum over the specified period.
 func (m *MovingSum[T]) Compute(c <-chan T) <-chan T {
-	cs := helper.Duplicate(c, 2)
-	cs[1] = helper.Shift(cs[1], m.Period, 0)
+	return helper.Compute(c, m.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the
+// Moving Sum over the specified period.
+func (m *MovingSum[T]) ComputeSlice(c []T) []T {
+	return helper.ComputeSlice(c, m.Clone().Update)
+}
+
+// Update function takes a value and updates the Moving Sum. It returns the Moving Sum, and whether
+// the period is full and the value is ready.
+func (m *MovingSum[T]) Update(value T) (T, bool) {
+	if m.window == nil {
+		m.window = helper.NewRing[T](m.Period)
+	}
+
+	m.sum = m.sum + value - m.window.Put(value)
+
+	return m.sum, m.window.IsFull()
+}
+
+// Reset function resets the state of the Moving Sum.
+func (m *MovingSum[T]) Reset() {
+	m.window = nil
+	m.sum = 0
+}
+
+// Clone function returns a new Moving Sum instance with the same configuration and a fresh state.
+func (m *MovingSum[T]) Clone() *MovingSum[T] {
+	return NewMovingSumWithPeriod[T](m.Period)
+}
+
+// movingSumState is the state of the Moving Sum.
+type movingSumState[T helper.Number] struct {
+	// Window is the values in the period.
+	Window json.RawMessage `json:"window"`
+
+	// Sum is the sum of the values in the period.
+	Sum T `json:"sum"`
+}
 
-	sum := T(0)
+// MarshalState function returns the state of the Moving Sum as JSON.
+func (m *MovingSum[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := movingSumState[T]{
+		Sum: m.sum,
+	}
+
+	state.Window, err = helper.MarshalStateOf(m.window)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the Moving Sum from the given JSON.
+func (m *MovingSum[T]) UnmarshalState(data []byte) error {
+	var state movingSumState[T]
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&m.window, state.Window, func() *helper.Ring[T] { return helper.NewRing[T](m.Period) })
+	if err != nil {
+		return err
+	}
 
-	sums := helper.Operate(cs[0], cs[1], func(c, b T) T {
-		sum = sum + c - b
-		return sum
-	})
+	m.sum = state.Sum
 
-	return helper.Skip(sums, m.Period-1)
+	return nil
 }
 
 // IdlePeriod is the initial period that Moving Sum won't yield any results.
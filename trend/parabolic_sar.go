@@ -0,0 +1,221 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"encoding/json"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultParabolicSarStep is the default acceleration factor step for the Parabolic SAR.
+	DefaultParabolicSarStep = 0.02
+
+	// DefaultParabolicSarMax is the default maximum acceleration factor for the Parabolic SAR.
+	DefaultParabolicSarMax = 0.2
+)
+
+// ParabolicSar represents the configuration parameters for calculating the Parabolic SAR (Stop and
+// Reverse) developed by J. Welles Wilder. It trails the price as the trend extends, and flips to the
+// other side of the price when the price crosses it. A SAR below the price suggests a bullish trend,
+// while a SAR above the price suggests a bearish trend.
+//
+//	SAR = Previous SAR + AF * (EP - Previous SAR)
+//
+// The extreme point (EP) is the highest high in an uptrend, and the lowest low in a downtrend. The
+// acceleration factor (AF) starts at the step, and increases by the step each time a new extreme
+// point is recorded, up to the max. When the trend reverses, the SAR is set to the last extreme point.
+//
+// Example:
+//
+//	psar := trend.NewParabolicSar[float64]()
+//	sars := psar.Compute(highs, lows)
+type ParabolicSar[T helper.Number] struct {
+	// Step is the acceleration factor step.
+	Step float64
+
+	// Max is the maximum acceleration factor.
+	Max float64
+
+	// rising indicates whether the trend is bullish.
+	rising bool
+
+	// sar is the last SAR value.
+	sar T
+
+	// ep is the extreme point.
+	ep T
+
+	// af is the acceleration factor.
+	af float64
+
+	// prevHighs is the previous two highs.
+	prevHighs [2]T
+
+	// prevLows is the previous two lows.
+	prevLows [2]T
+
+	// started indicates whether the initial SAR value is computed.
+	started bool
+}
+
+// NewParabolicSar function initializes a new Parabolic SAR instance with the default parameters.
+func NewParabolicSar[T helper.Number]() *ParabolicSar[T] {
+	return NewParabolicSarWith[T](DefaultParabolicSarStep, DefaultParabolicSarMax)
+}
+
+// NewParabolicSarWith function initializes a new Parabolic SAR instance with the given parameters.
+func NewParabolicSarWith[T helper.Number](step, maximum float64) *ParabolicSar[T] {
+	return &ParabolicSar[T]{
+		Step: step,
+		Max:  maximum,
+	}
+}
+
+// Compute function takes a channel of highs and lows, and computes the Parabolic SAR. The
+// trend is assumed to be bullish initially.
+func (p *ParabolicSar[T]) Compute(highs, lows <-chan T) <-chan T {
+	return helper.Compute2(highs, lows, p.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of highs and lows, and computes the Parabolic SAR. The
+// trend is assumed to be bullish initially.
+func (p *ParabolicSar[T]) ComputeSlice(highs, lows []T) []T {
+	return helper.ComputeSlice2(highs, lows, p.Clone().Update)
+}
+
+// Update function takes a high and a low value, and updates the Parabolic SAR. It returns the Parabolic
+// SAR value, and whether the value is ready. The Parabolic SAR does not have an idle period.
+func (p *ParabolicSar[T]) Update(high, low T) (T, bool) {
+	if !p.started {
+		p.started = true
+		p.rising = true
+		p.sar = low
+		p.ep = high
+		p.af = p.Step
+
+		// Previous two highs and lows.
+		p.prevHighs = [2]T{high, high}
+		p.prevLows = [2]T{low, low}
+
+		return p.sar, true
+	}
+
+	p.sar += T(p.af * float64(p.ep-p.sar))
+
+	if p.rising {
+		// SAR can not be above the previous two lows.
+		p.sar = min(p.sar, p.prevLows[0], p.prevLows[1])
+
+		if low < p.sar {
+			p.rising = false
+			p.sar = p.ep
+			p.ep = low
+			p.af = p.Step
+		} else if high > p.ep {
+			p.ep = high
+			p.af = min(p.af+p.Step, p.Max)
+		}
+	} else {
+		// SAR can not be below the previous two highs.
+		p.sar = max(p.sar, p.prevHighs[0], p.prevHighs[1])
+
+		if high > p.sar {
+			p.rising = true
+			p.sar = p.ep
+			p.ep = high
+			p.af = p.Step
+		} else if low < p.ep {
+			p.ep = low
+			p.af = min(p.af+p.Step, p.Max)
+		}
+	}
+
+	p.prevHighs[0], p.prevHighs[1] = p.prevHighs[1], high
+	p.prevLows[0], p.prevLows[1] = p.prevLows[1], low
+
+	return p.sar, true
+}
+
+// Reset function resets the state of the Parabolic SAR.
+func (p *ParabolicSar[T]) Reset() {
+	p.rising = false
+	p.sar = 0
+	p.ep = 0
+	p.af = 0
+	p.prevHighs = [2]T{}
+	p.prevLows = [2]T{}
+	p.started = false
+}
+
+// Clone function returns a new Parabolic SAR instance with the same configuration and a fresh state.
+func (p *ParabolicSar[T]) Clone() *ParabolicSar[T] {
+	return NewParabolicSarWith[T](p.Step, p.Max)
+}
+
+// parabolicSarState is the state of the Parabolic SAR.
+type parabolicSarState[T helper.Number] struct {
+	// Rising indicates whether the trend is bullish.
+	Rising bool `json:"rising"`
+
+	// Sar is the last SAR value.
+	Sar T `json:"sar"`
+
+	// Ep is the extreme point.
+	Ep T `json:"ep"`
+
+	// Af is the acceleration factor.
+	Af float64 `json:"af"`
+
+	// PrevHighs is the previous two highs.
+	PrevHighs [2]T `json:"prevHighs"`
+
+	// PrevLows is the previous two lows.
+	PrevLows [2]T `json:"prevLows"`
+
+	// Started indicates whether the initial SAR value is computed.
+	Started bool `json:"started"`
+}
+
+// MarshalState function returns the state of the Parabolic SAR as JSON.
+func (p *ParabolicSar[T]) MarshalState() ([]byte, error) {
+	state := parabolicSarState[T]{
+		Rising:    p.rising,
+		Sar:       p.sar,
+		Ep:        p.ep,
+		Af:        p.af,
+		PrevHighs: p.prevHighs,
+		PrevLows:  p.prevLows,
+		Started:   p.started,
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the Parabolic SAR from the given JSON.
+func (p *ParabolicSar[T]) UnmarshalState(data []byte) error {
+	var state parabolicSarState[T]
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	p.rising = state.Rising
+	p.sar = state.Sar
+	p.ep = state.Ep
+	p.af = state.Af
+	p.prevHighs = state.PrevHighs
+	p.prevLows = state.PrevLows
+	p.started = state.Started
+
+	return nil
+}
+
+// IdlePeriod is the initial period that Parabolic SAR won't yield any results.
+func (*ParabolicSar[T]) IdlePeriod() int {
+	return 0
+}
@@ -4,7 +4,11 @@
 
 package trend
 
-import "github.com/cinar/indicator/v2/helper"
+import (
+	"encoding/json"
+
+	"github.com/cinar/indicator/v2/helper"
+)
 
 const (
 	// DefaultRmaPeriod is the default RMA period.
@@ -25,6 +29,15 @@ const (
 type Rma[T helper.Number] struct {
 	// Time period.
 	Period int
+
+	// sma is the SMA used for the initial RMA value.
+	sma *Sma[T]
+
+	// rma is the last RMA value.
+	rma T
+
+	// ready indicates whether the initial RMA value is computed.
+	ready bool
 }
 
 // NewRma function initializes a new RMA instance with the default parameters.
@@ -41,25 +54,91 @@ func NewRmaWithPeriod[T helper.Number](period int) *Rma[T] {
 
 // Compute function takes a channel of numbers and computes the RMA over the specified period.
 func (r *Rma[T]) Compute(c <-chan T) <-chan T {
-	result := make(chan T, cap(c))
+	return helper.Compute(c, r.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the RMA over the specified period.
+func (r *Rma[T]) ComputeSlice(c []T) []T {
+	return helper.ComputeSlice(c, r.Clone().Update)
+}
+
+// Update function takes a value and updates the RMA. It returns the RMA value, and whether the
+// RMA has passed its idle period and the value is ready.
+func (r *Rma[T]) Update(value T) (T, bool) {
+	if r.ready {
+		r.rma = ((r.rma * T(r.Period-1)) + value) / T(r.Period)
+		return r.rma, true
+	}
+
+	// Initial RMA value is the SMA.
+	if r.sma == nil {
+		r.sma = NewSmaWithPeriod[T](r.Period)
+	}
+
+	r.rma, r.ready = r.sma.Update(value)
+
+	return r.rma, r.ready
+}
+
+// Reset function resets the state of the RMA.
+func (r *Rma[T]) Reset() {
+	r.sma = nil
+	r.rma = 0
+	r.ready = false
+}
+
+// Clone function returns a new RMA instance with the same configuration and a fresh state.
+func (r *Rma[T]) Clone() *Rma[T] {
+	return NewRmaWithPeriod[T](r.Period)
+}
+
+// rmaState is the state of the RMA.
+type rmaState[T helper.Number] struct {
+	// Sma is the SMA used for the initial RMA value.
+	Sma json.RawMessage `json:"sma"`
+
+	// Rma is the last RMA value.
+	Rma T `json:"rma"`
+
+	// Ready indicates whether the initial RMA value is computed.
+	Ready bool `json:"ready"`
+}
+
+// MarshalState function returns the state of the RMA as JSON.
+func (r *Rma[T]) MarshalState() ([]byte, error) {
+	var err error
 
-	go func() {
-		defer close(result)
+	state := rmaState[T]{
+		Rma:   r.rma,
+		Ready: r.ready,
+	}
+
+	state.Sma, err = helper.MarshalStateOf(r.sma)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the RMA from the given JSON.
+func (r *Rma[T]) UnmarshalState(data []byte) error {
+	var state rmaState[T]
 
-		// Initial RMA value is the SMA.
-		sma := NewSma[T]()
-		sma.Period = r.Period
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
 
-		before := <-sma.Compute(helper.Head(c, r.Period))
-		result <- before
+	err = helper.UnmarshalStateOf(&r.sma, state.Sma, func() *Sma[T] { return NewSmaWithPeriod[T](r.Period) })
+	if err != nil {
+		return err
+	}
 
-		for n := range c {
-			before = ((before * T(r.Period-1)) + n) / T(r.Period)
-			result <- before
-		}
-	}()
+	r.rma = state.Rma
+	r.ready = state.Ready
 
-	return result
+	return nil
 }
 
 // IdlePeriod is the initial period that RMA won't yield any results.
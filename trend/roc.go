@@ -5,6 +5,7 @@
 package trend
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/cinar/indicator/v2/helper"
@@ -21,6 +22,9 @@ const (
 type Roc[T helper.Float] struct {
 	// Time period.
 	Period int
+
+	// window is the values in the period.
+	window *helper.Ring[T]
 }
 
 // NewRoc function initializes a new Roc instance with the default parameters.
@@ -40,25 +44,76 @@ func NewRocWithPeriod[T helper.Float](period int) *Roc[T] {
 
 // Compute function takes a channel of numbers and computes the ROC and the signal line.
 func (r *Roc[T]) Compute(values <-chan T) <-chan T {
-	window := helper.NewRing[T](r.Period)
+	return helper.Compute(values, r.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the ROC and the signal line.
+func (r *Roc[T]) ComputeSlice(values []T) []T {
+	return helper.ComputeSlice(values, r.Clone().Update)
+}
+
+// Update function takes a value and updates the ROC. It returns the ROC value, and whether the
+// ROC has passed its idle period and the value is ready.
+func (r *Roc[T]) Update(value T) (T, bool) {
+	if r.window == nil {
+		r.window = helper.NewRing[T](r.Period)
+	}
 
-	rocs := helper.Map(values, func(value T) T {
-		var result T
+	var result T
 
-		if window.IsFull() {
-			p, ok := window.Get()
-			if ok && p != 0 {
-				result = (value - p) / p
-			}
+	full := r.window.IsFull()
+	if full {
+		p, ok := r.window.Get()
+		if ok && p != 0 {
+			result = (value - p) / p
 		}
-		window.Put(value)
+	}
+
+	r.window.Put(value)
+
+	return result, full
+}
 
-		return result
-	})
+// Reset function resets the state of the ROC.
+func (r *Roc[T]) Reset() {
+	r.window = nil
+}
 
-	rocs = helper.Skip(rocs, r.IdlePeriod())
+// Clone function returns a new ROC instance with the same configuration and a fresh state.
+func (r *Roc[T]) Clone() *Roc[T] {
+	return NewRocWithPeriod[T](r.Period)
+}
+
+// rocState is the state of the ROC.
+type rocState struct {
+	// Window is the values in the period.
+	Window json.RawMessage `json:"window"`
+}
+
+// MarshalState function returns the state of the ROC as JSON.
+func (r *Roc[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := rocState{}
+
+	state.Window, err = helper.MarshalStateOf(r.window)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the ROC from the given JSON.
+func (r *Roc[T]) UnmarshalState(data []byte) error {
+	var state rocState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
 
-	return rocs
+	return helper.UnmarshalStateOf(&r.window, state.Window, func() *helper.Ring[T] { return helper.NewRing[T](r.Period) })
 }
 
 // IdlePeriod is the initial period that ROC won't yield any results.
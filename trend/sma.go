@@ -5,6 +5,7 @@
 package trend
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/cinar/indicator/v2/helper"
@@ -26,6 +27,9 @@ const (
 type Sma[T helper.Number] struct {
 	// Period is the time period for the SMA.
 	Period int
+
+	// sum is the moving sum over the period.
+	sum *MovingSum[T]
 }
 
 // NewSma function initializes a new SMA instance with the default parameters.
@@ -42,12 +46,74 @@ func NewSmaWithPeriod[T helper.Number](period int) *Sma[T] {
 
 // Compute function takes a channel of numbers and computes the SMA over the specified period.
 func (s *Sma[T]) Compute(c <-chan T) <-chan T {
-	sum := NewMovingSum[T]()
-	sum.Period = s.Period
+	return helper.Compute(c, s.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the SMA over the specified period.
+func (s *Sma[T]) ComputeSlice(c []T) []T {
+	return helper.ComputeSlice(c, s.Clone().Update)
+}
+
+// Update function takes a value and updates the SMA. It returns the SMA value, and whether the
+// period is full and the value is ready.
+func (s *Sma[T]) Update(value T) (T, bool) {
+	if s.sum == nil {
+		s.sum = NewMovingSumWithPeriod[T](s.Period)
+	}
+
+	sum, ok := s.sum.Update(value)
+	if !ok {
+		return 0, false
+	}
+
+	return sum / T(s.Period), true
+}
+
+// Reset function resets the state of the SMA.
+func (s *Sma[T]) Reset() {
+	s.sum = nil
+}
+
+// Clone function returns a new SMA instance with the same configuration and a fresh state.
+func (s *Sma[T]) Clone() *Sma[T] {
+	return NewSmaWithPeriod[T](s.Period)
+}
+
+// smaState is the state of the SMA.
+type smaState struct {
+	// Sum is the moving sum over the period.
+	Sum json.RawMessage `json:"sum"`
+}
+
+// MarshalState function returns the state of the SMA as JSON.
+func (s *Sma[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := smaState{}
+
+	state.Sum, err = helper.MarshalStateOf(s.sum)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the SMA from the given JSON.
+func (s *Sma[T]) UnmarshalState(data []byte) error {
+	var state smaState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&s.sum, state.Sum, func() *MovingSum[T] { return NewMovingSumWithPeriod[T](s.Period) })
+	if err != nil {
+		return err
+	}
 
-	return helper.Apply(sum.Compute(c), func(sum T) T {
-		return sum / T(s.Period)
-	})
+	return nil
 }
 
 // IdlePeriod is the initial period that SMA won't yield any results.
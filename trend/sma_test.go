@@ -7,6 +7,7 @@ package trend_test
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/cinar/indicator/v2/helper"
 	"github.com/cinar/indicator/v2/trend"
@@ -46,3 +47,110 @@ func TestSmaString(t *testing.T) {
 		t.Fatalf("actual %v expected %v", actual, expected)
 	}
 }
+
+func TestSmaUpdate(t *testing.T) {
+	input := []float64{
+		22.27, 22.19, 22.08, 22.17, 22.18, 22.13, 22.23, 22.43, 22.24,
+		22.29, 22.15, 22.39, 22.38, 22.61, 23.36, 24.05, 23.75, 23.83,
+		23.95, 23.63, 23.82, 23.87, 23.65, 23.19, 23.10, 23.33, 22.68,
+		23.10, 22.40, 22.17,
+	}
+
+	sma := trend.NewSmaWithPeriod[float64](10)
+	expected := helper.ChanToSlice(sma.Compute(helper.SliceToChan(input)))
+
+	for range 2 {
+		var actual []float64
+
+		for i, value := range input {
+			result, ok := sma.Update(value)
+			if ok != (i >= sma.IdlePeriod()) {
+				t.Fatalf("index %d ready %v", i, ok)
+			}
+
+			if ok {
+				actual = append(actual, result)
+			}
+		}
+
+		if !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("actual %v expected %v", actual, expected)
+		}
+
+		sma.Reset()
+	}
+}
+
+func TestSmaComputeSlice(t *testing.T) {
+	input := []float64{
+		22.27, 22.19, 22.08, 22.17, 22.18, 22.13, 22.23, 22.43, 22.24,
+		22.29, 22.15, 22.39, 22.38, 22.61, 23.36, 24.05, 23.75, 23.83,
+		23.95, 23.63, 23.82, 23.87, 23.65, 23.19, 23.10, 23.33, 22.68,
+		23.10, 22.40, 22.17,
+	}
+
+	sma := trend.NewSmaWithPeriod[float64](10)
+
+	expected := helper.ChanToSlice(sma.Compute(helper.SliceToChan(input)))
+	actual := sma.ComputeSlice(input)
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
+
+func BenchmarkSmaCompute(b *testing.B) {
+	input := benchmarkValues()
+	sma := trend.NewSma[float64]()
+
+	for range b.N {
+		helper.Drain(sma.Compute(helper.SliceToChan(input)))
+	}
+}
+
+func BenchmarkSmaComputeSlice(b *testing.B) {
+	input := benchmarkValues()
+	sma := trend.NewSma[float64]()
+
+	for range b.N {
+		sma.ComputeSlice(input)
+	}
+}
+
+// benchmarkValues returns the values that the benchmarks are run on.
+func benchmarkValues() []float64 {
+	values := make([]float64, 10000)
+
+	for i := range values {
+		values[i] = 100 + float64(i%50)
+	}
+
+	return values
+}
+
+func TestSmaComputeSeries(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2024, time.January, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	closings := helper.SliceToChan([]helper.SeriesPoint[float64]{
+		{Time: day(1), Value: 1, Valid: true},
+		{Time: day(2), Value: 2, Valid: true},
+		{Time: day(3), Value: 3, Valid: true},
+		{Time: day(4), Value: 4, Valid: true},
+	})
+
+	expected := []helper.SeriesPoint[float64]{
+		{Time: day(1)},
+		{Time: day(2), Value: 1.5, Valid: true},
+		{Time: day(3), Value: 2.5, Valid: true},
+		{Time: day(4), Value: 3.5, Valid: true},
+	}
+
+	sma := trend.NewSmaWithPeriod[float64](2)
+	actual := helper.ChanToSlice(helper.ComputeSeries(closings, sma.Update))
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual, expected)
+	}
+}
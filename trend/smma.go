@@ -5,6 +5,7 @@
 package trend
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/cinar/indicator/v2/helper"
@@ -29,6 +30,15 @@ const (
 type Smma[T helper.Number] struct {
 	// Time period.
 	Period int
+
+	// sma is the SMA used for the initial SMMA value.
+	sma *Sma[T]
+
+	// smma is the last SMMA value.
+	smma T
+
+	// ready indicates whether the initial SMMA value is computed.
+	ready bool
 }
 
 // NewSmma function initializes a new SMMA instance with the default parameters.
@@ -45,24 +55,91 @@ func NewSmmaWithPeriod[T helper.Number](period int) *Smma[T] {
 
 // Compute function takes a channel of numbers and computes the SMMA over the specified period.
 func (s *Smma[T]) Compute(c <-chan T) <-chan T {
-	result := make(chan T, cap(c))
+	return helper.Compute(c, s.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the SMMA over the specified period.
+func (s *Smma[T]) ComputeSlice(c []T) []T {
+	return helper.ComputeSlice(c, s.Clone().Update)
+}
+
+// Update function takes a value and updates the SMMA. It returns the SMMA value, and whether the
+// SMMA has passed its idle period and the value is ready.
+func (s *Smma[T]) Update(value T) (T, bool) {
+	if s.ready {
+		s.smma = ((s.smma * (T(s.Period) - 1)) + value) / T(s.Period)
+		return s.smma, true
+	}
+
+	// Initial SMMA value is the SMA.
+	if s.sma == nil {
+		s.sma = NewSmaWithPeriod[T](s.Period)
+	}
+
+	s.smma, s.ready = s.sma.Update(value)
+
+	return s.smma, s.ready
+}
+
+// Reset function resets the state of the SMMA.
+func (s *Smma[T]) Reset() {
+	s.sma = nil
+	s.smma = 0
+	s.ready = false
+}
 
-	go func() {
-		defer close(result)
+// Clone function returns a new SMMA instance with the same configuration and a fresh state.
+func (s *Smma[T]) Clone() *Smma[T] {
+	return NewSmmaWithPeriod[T](s.Period)
+}
+
+// smmaState is the state of the SMMA.
+type smmaState[T helper.Number] struct {
+	// Sma is the SMA used for the initial SMMA value.
+	Sma json.RawMessage `json:"sma"`
+
+	// Smma is the last SMMA value.
+	Smma T `json:"smma"`
+
+	// Ready indicates whether the initial SMMA value is computed.
+	Ready bool `json:"ready"`
+}
+
+// MarshalState function returns the state of the SMMA as JSON.
+func (s *Smma[T]) MarshalState() ([]byte, error) {
+	var err error
 
-		// Initial SMMA value is the SMA.
-		sma := NewSmaWithPeriod[T](s.Period)
+	state := smmaState[T]{
+		Smma:  s.smma,
+		Ready: s.ready,
+	}
 
-		before := <-sma.Compute(helper.Head(c, s.Period))
-		result <- before
+	state.Sma, err = helper.MarshalStateOf(s.sma)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the SMMA from the given JSON.
+func (s *Smma[T]) UnmarshalState(data []byte) error {
+	var state smmaState[T]
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&s.sma, state.Sma, func() *Sma[T] { return NewSmaWithPeriod[T](s.Period) })
+	if err != nil {
+		return err
+	}
 
-		for n := range c {
-			before = ((before * (T(s.Period) - 1)) + n) / T(s.Period)
-			result <- before
-		}
-	}()
+	s.smma = state.Smma
+	s.ready = state.Ready
 
-	return result
+	return nil
 }
 
 // IdlePeriod is the initial period that SMMA yield any results.
@@ -0,0 +1,176 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultT3Period is the default T3 period of 5.
+	DefaultT3Period = 5
+
+	// DefaultT3VolumeFactor is the default T3 volume factor of 0.7.
+	DefaultT3VolumeFactor = 0.7
+)
+
+// T3 represents the parameters for calculating the Tillson T3 Moving Average. It applies the
+// Generalized DEMA (GD) three times, resulting in a smooth moving average with a low lag. The
+// volume factor controls how much the GD overshoots the EMA to reduce the lag.
+//
+//	GD(Value) = EMA(Value) * (1 + Volume Factor) - EMA(EMA(Value)) * Volume Factor
+//	T3 = GD(GD(GD(Value)))
+//
+// Example:
+//
+//	t3 := trend.NewT3[float64]()
+//	result := t3.Compute(c)
+type T3[T helper.Number] struct {
+	// Period is the time period of the EMAs.
+	Period int
+
+	// VolumeFactor is the volume factor.
+	VolumeFactor float64
+
+	// emas are the pairs of EMAs for the three Generalized DEMAs.
+	emas []*Ema[T]
+}
+
+// NewT3 function initializes a new T3 instance with the default parameters.
+func NewT3[T helper.Number]() *T3[T] {
+	return NewT3WithPeriod[T](DefaultT3Period)
+}
+
+// NewT3WithPeriod function initializes a new T3 instance with the given period.
+func NewT3WithPeriod[T helper.Number](period int) *T3[T] {
+	return NewT3With[T](period, DefaultT3VolumeFactor)
+}
+
+// NewT3With function initializes a new T3 instance with the given parameters.
+func NewT3With[T helper.Number](period int, volumeFactor float64) *T3[T] {
+	return &T3[T]{
+		Period:       period,
+		VolumeFactor: volumeFactor,
+	}
+}
+
+// Compute function takes a channel of numbers and computes the T3 over the specified period.
+func (t *T3[T]) Compute(c <-chan T) <-chan T {
+	return helper.Compute(c, t.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the T3 over the specified period.
+func (t *T3[T]) ComputeSlice(c []T) []T {
+	return helper.ComputeSlice(c, t.Clone().Update)
+}
+
+// Update function takes a value and updates the T3. It returns the T3 value, and whether the
+// T3 has passed its idle period and the value is ready.
+func (t *T3[T]) Update(value T) (T, bool) {
+	if t.emas == nil {
+		t.emas = make([]*Ema[T], 6)
+		for i := range t.emas {
+			t.emas[i] = NewEmaWithPeriod[T](t.Period)
+		}
+	}
+
+	// Generalized DEMA is applied three times.
+	for i := 0; i < len(t.emas); i += 2 {
+		ema, ok := t.emas[i].Update(value)
+		if !ok {
+			return 0, false
+		}
+
+		ema2, ok := t.emas[i+1].Update(ema)
+		if !ok {
+			return 0, false
+		}
+
+		value = T(float64(ema)*(1+t.VolumeFactor) - float64(ema2)*t.VolumeFactor)
+	}
+
+	return value, true
+}
+
+// Reset function resets the state of the T3.
+func (t *T3[T]) Reset() {
+	t.emas = nil
+}
+
+// Clone function returns a new T3 instance with the same configuration and a fresh state.
+func (t *T3[T]) Clone() *T3[T] {
+	return NewT3With[T](t.Period, t.VolumeFactor)
+}
+
+// t3State is the state of the T3.
+type t3State struct {
+	// Emas is the states of the six EMAs.
+	Emas []json.RawMessage `json:"emas"`
+}
+
+// MarshalState function returns the state of the T3 as JSON.
+func (t *T3[T]) MarshalState() ([]byte, error) {
+	state := t3State{}
+
+	if t.emas != nil {
+		state.Emas = make([]json.RawMessage, len(t.emas))
+
+		for i, ema := range t.emas {
+			var err error
+
+			state.Emas[i], err = helper.MarshalStateOf(ema)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the T3 from the given JSON.
+func (t *T3[T]) UnmarshalState(data []byte) error {
+	var state t3State
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	if state.Emas == nil {
+		t.emas = nil
+		return nil
+	}
+
+	if len(state.Emas) != 6 {
+		return fmt.Errorf("invalid T3 state with %d EMAs", len(state.Emas))
+	}
+
+	emas := make([]*Ema[T], len(state.Emas))
+
+	for i := range emas {
+		err = helper.UnmarshalStateOf(&emas[i], state.Emas[i], func() *Ema[T] { return NewEmaWithPeriod[T](t.Period) })
+		if err != nil {
+			return err
+		}
+	}
+
+	t.emas = emas
+
+	return nil
+}
+
+// IdlePeriod is the initial period that T3 won't yield any results.
+func (t *T3[T]) IdlePeriod() int {
+	return 6 * (t.Period - 1)
+}
+
+// String is the string representation of the T3.
+func (t *T3[T]) String() string {
+	return fmt.Sprintf("T3(%d,%g)", t.Period, t.VolumeFactor)
+}
@@ -5,6 +5,8 @@
 package trend
 
 import (
+	"encoding/json"
+
 	"github.com/cinar/indicator/v2/helper"
 )
 
@@ -19,6 +21,15 @@ type Tema[T helper.Number] struct {
 	Ema1 *Ema[T]
 	Ema2 *Ema[T]
 	Ema3 *Ema[T]
+
+	// ema1 is the first EMA state.
+	ema1 *Ema[T]
+
+	// ema2 is the second EMA state.
+	ema2 *Ema[T]
+
+	// ema3 is the third EMA state.
+	ema3 *Ema[T]
 }
 
 // NewTema function initializes a new TEMA instance
@@ -34,31 +45,114 @@ func NewTema[T helper.Number]() *Tema[T] {
 // Compute function takes a channel of numbers and computes the TEMA
 // and the signal line.
 func (t *Tema[T]) Compute(c <-chan T) <-chan T {
-	ema1 := helper.Duplicate(
-		t.Ema1.Compute(c),
-		2,
-	)
-
-	ema2 := helper.Duplicate(
-		t.Ema2.Compute(ema1[0]),
-		2,
-	)
-
-	ema1[1] = helper.Skip(ema1[1], t.Ema2.Period-1)
-
-	ema3 := t.Ema3.Compute(ema2[0])
-	ema1[1] = helper.Skip(ema1[1], t.Ema3.Period-1)
-	ema2[1] = helper.Skip(ema2[1], t.Ema3.Period-1)
-
-	tema := helper.Add(
-		helper.Subtract(
-			helper.MultiplyBy(ema1[1], 3),
-			helper.MultiplyBy(ema2[1], 3),
-		),
-		ema3,
-	)
-
-	return tema
+	return helper.Compute(c, t.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the TEMA
+// and the signal line.
+func (t *Tema[T]) ComputeSlice(c []T) []T {
+	return helper.ComputeSlice(c, t.Clone().Update)
+}
+
+// Update function takes a value and updates the TEMA. It returns the TEMA value, and whether the
+// TEMA has passed its idle period and the value is ready.
+func (t *Tema[T]) Update(value T) (T, bool) {
+	if t.ema1 == nil {
+		t.ema1 = t.Ema1.Clone()
+		t.ema2 = t.Ema2.Clone()
+		t.ema3 = t.Ema3.Clone()
+	}
+
+	ema1, ok := t.ema1.Update(value)
+	if !ok {
+		return 0, false
+	}
+
+	ema2, ok := t.ema2.Update(ema1)
+	if !ok {
+		return 0, false
+	}
+
+	ema3, ok := t.ema3.Update(ema2)
+	if !ok {
+		return 0, false
+	}
+
+	return (ema1*3 - ema2*3) + ema3, true
+}
+
+// Reset function resets the state of the TEMA.
+func (t *Tema[T]) Reset() {
+	t.ema1 = nil
+	t.ema2 = nil
+	t.ema3 = nil
+}
+
+// Clone function returns a new TEMA instance with the same configuration and a fresh state.
+func (t *Tema[T]) Clone() *Tema[T] {
+	return &Tema[T]{
+		Ema1: t.Ema1.Clone(),
+		Ema2: t.Ema2.Clone(),
+		Ema3: t.Ema3.Clone(),
+	}
+}
+
+// temaState is the state of the TEMA.
+type temaState struct {
+	// Ema1 is the first EMA state.
+	Ema1 json.RawMessage `json:"ema1"`
+
+	// Ema2 is the second EMA state.
+	Ema2 json.RawMessage `json:"ema2"`
+
+	// Ema3 is the third EMA state.
+	Ema3 json.RawMessage `json:"ema3"`
+}
+
+// MarshalState function returns the state of the TEMA as JSON.
+func (t *Tema[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := temaState{}
+
+	state.Ema1, err = helper.MarshalStateOf(t.ema1)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Ema2, err = helper.MarshalStateOf(t.ema2)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Ema3, err = helper.MarshalStateOf(t.ema3)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the TEMA from the given JSON.
+func (t *Tema[T]) UnmarshalState(data []byte) error {
+	var state temaState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&t.ema1, state.Ema1, t.Ema1.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&t.ema2, state.Ema2, t.Ema2.Clone)
+	if err != nil {
+		return err
+	}
+
+	return helper.UnmarshalStateOf(&t.ema3, state.Ema3, t.Ema3.Clone)
 }
 
 // IdlePeriod is the initial period that TEMA won't yield any results.
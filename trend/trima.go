@@ -5,6 +5,8 @@
 package trend
 
 import (
+	"encoding/json"
+
 	"github.com/cinar/indicator/v2/helper"
 )
 
@@ -26,6 +28,12 @@ const (
 type Trima[T helper.Number] struct {
 	// Time period.
 	Period int
+
+	// sma1 is the outer SMA state.
+	sma1 *Sma[T]
+
+	// sma2 is the inner SMA state.
+	sma2 *Sma[T]
 }
 
 // NewTrima function initializes a new TRIMA instance
@@ -39,17 +47,90 @@ func NewTrima[T helper.Number]() *Trima[T] {
 // Compute function takes a channel of numbers and computes the TRIMA
 // and the signal line.
 func (t *Trima[T]) Compute(c <-chan T) <-chan T {
-	period1, period2 := t.calculatePeriods()
+	return helper.Compute(c, t.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the TRIMA
+// and the signal line.
+func (t *Trima[T]) ComputeSlice(c []T) []T {
+	return helper.ComputeSlice(c, t.Clone().Update)
+}
+
+// Update function takes a value and updates the TRIMA. It returns the TRIMA value, and whether the
+// TRIMA has passed its idle period and the value is ready.
+func (t *Trima[T]) Update(value T) (T, bool) {
+	if t.sma1 == nil {
+		period1, period2 := t.calculatePeriods()
+		t.sma1 = NewSmaWithPeriod[T](period1)
+		t.sma2 = NewSmaWithPeriod[T](period2)
+	}
 
-	sma1 := NewSma[T]()
-	sma1.Period = period1
+	sma2, ok := t.sma2.Update(value)
+	if !ok {
+		return 0, false
+	}
 
-	sma2 := NewSma[T]()
-	sma2.Period = period2
+	return t.sma1.Update(sma2)
+}
+
+// Reset function resets the state of the TRIMA.
+func (t *Trima[T]) Reset() {
+	t.sma1 = nil
+	t.sma2 = nil
+}
+
+// Clone function returns a new TRIMA instance with the same configuration and a fresh state.
+func (t *Trima[T]) Clone() *Trima[T] {
+	return &Trima[T]{
+		Period: t.Period,
+	}
+}
 
-	trima := sma1.Compute(sma2.Compute(c))
+// trimaState is the state of the TRIMA.
+type trimaState struct {
+	// Sma1 is the outer SMA state.
+	Sma1 json.RawMessage `json:"sma1"`
+
+	// Sma2 is the inner SMA state.
+	Sma2 json.RawMessage `json:"sma2"`
+}
+
+// MarshalState function returns the state of the TRIMA as JSON.
+func (t *Trima[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := trimaState{}
+
+	state.Sma1, err = helper.MarshalStateOf(t.sma1)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Sma2, err = helper.MarshalStateOf(t.sma2)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the TRIMA from the given JSON.
+func (t *Trima[T]) UnmarshalState(data []byte) error {
+	var state trimaState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	period1, period2 := t.calculatePeriods()
+
+	err = helper.UnmarshalStateOf(&t.sma1, state.Sma1, func() *Sma[T] { return NewSmaWithPeriod[T](period1) })
+	if err != nil {
+		return err
+	}
 
-	return trima
+	return helper.UnmarshalStateOf(&t.sma2, state.Sma2, func() *Sma[T] { return NewSmaWithPeriod[T](period2) })
 }
 
 // IdlePeriod is the initial period that TRIMA won't yield any results.
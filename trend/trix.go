@@ -5,6 +5,8 @@
 package trend
 
 import (
+	"encoding/json"
+
 	"github.com/cinar/indicator/v2/helper"
 )
 
@@ -29,6 +31,15 @@ const (
 type Trix[T helper.Number] struct {
 	// Time period.
 	Period int
+
+	// emas is the triple EMA states.
+	emas []*Ema[T]
+
+	// previous is the previous triple EMA value.
+	previous T
+
+	// ready indicates whether the previous triple EMA value is available.
+	ready bool
 }
 
 // NewTrix function initializes a new TRIX instance with the default parameters.
@@ -40,19 +51,115 @@ func NewTrix[T helper.Number]() *Trix[T] {
 
 // Compute function takes a channel of numbers and computes the TRIX and the signal line.
 func (t *Trix[T]) Compute(c <-chan T) <-chan T {
-	ema1 := NewEmaWithPeriod[T](t.Period)
-	ema2 := NewEmaWithPeriod[T](t.Period)
-	ema3 := NewEmaWithPeriod[T](t.Period)
+	return helper.Compute(c, t.Clone().Update)
+}
 
-	emas := ema3.Compute(
-		ema2.Compute(
-			ema1.Compute(c),
-		),
-	)
+// ComputeSlice function takes a slice of numbers and computes the TRIX and the signal line.
+func (t *Trix[T]) ComputeSlice(c []T) []T {
+	return helper.ComputeSlice(c, t.Clone().Update)
+}
+
+// Update function takes a value and updates the TRIX. It returns the TRIX value, and whether the
+// TRIX has passed its idle period and the value is ready.
+func (t *Trix[T]) Update(value T) (T, bool) {
+	if t.emas == nil {
+		t.emas = []*Ema[T]{
+			NewEmaWithPeriod[T](t.Period),
+			NewEmaWithPeriod[T](t.Period),
+			NewEmaWithPeriod[T](t.Period),
+		}
+	}
+
+	for _, ema := range t.emas {
+		var ok bool
+
+		value, ok = ema.Update(value)
+		if !ok {
+			return 0, false
+		}
+	}
+
+	previous, ready := t.previous, t.ready
+	t.previous, t.ready = value, true
+
+	if !ready {
+		return 0, false
+	}
+
+	return (value - previous) / previous, true
+}
+
+// Reset function resets the state of the TRIX.
+func (t *Trix[T]) Reset() {
+	t.emas = nil
+	t.previous = 0
+	t.ready = false
+}
+
+// Clone function returns a new TRIX instance with the same configuration and a fresh state.
+func (t *Trix[T]) Clone() *Trix[T] {
+	return &Trix[T]{
+		Period: t.Period,
+	}
+}
+
+// trixState is the state of the TRIX.
+type trixState[T helper.Number] struct {
+	// Emas is the triple EMA states.
+	Emas []json.RawMessage `json:"emas"`
+
+	// Previous is the previous triple EMA value.
+	Previous T `json:"previous"`
+
+	// Ready indicates whether the previous triple EMA value is available.
+	Ready bool `json:"ready"`
+}
+
+// MarshalState function returns the state of the TRIX as JSON.
+func (t *Trix[T]) MarshalState() ([]byte, error) {
+	state := trixState[T]{
+		Previous: t.previous,
+		Ready:    t.ready,
+	}
+
+	for _, ema := range t.emas {
+		emaState, err := ema.MarshalState()
+		if err != nil {
+			return nil, err
+		}
+
+		state.Emas = append(state.Emas, emaState)
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the TRIX from the given JSON.
+func (t *Trix[T]) UnmarshalState(data []byte) error {
+	var state trixState[T]
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	t.emas = nil
+
+	for _, emaState := range state.Emas {
+		ema := NewEmaWithPeriod[T](t.Period)
+
+		err = ema.UnmarshalState(emaState)
+		if err != nil {
+			return err
+		}
+
+		t.emas = append(t.emas, ema)
+	}
 
-	trix := helper.ChangeRatio[T](emas, 1)
+	t.previous = state.Previous
+	t.ready = state.Ready
 
-	return trix
+	return nil
 }
 
 // IdlePeriod is the initial period that TRIX won't yield any results.
@@ -5,7 +5,9 @@
 package trend
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
 
 	"github.com/cinar/indicator/v2/helper"
 )
@@ -35,6 +37,24 @@ type Tsi[T helper.Number] struct {
 
 	// SecondSmoothing is the second smoothing moving average.
 	SecondSmoothing Ma[T]
+
+	// pcdsFirst is the first smoothing state of the price changes.
+	pcdsFirst Ma[T]
+
+	// pcdsSecond is the second smoothing state of the price changes.
+	pcdsSecond Ma[T]
+
+	// apcdsFirst is the first smoothing state of the absolute price changes.
+	apcdsFirst Ma[T]
+
+	// apcdsSecond is the second smoothing state of the absolute price changes.
+	apcdsSecond Ma[T]
+
+	// previous is the previous closing.
+	previous T
+
+	// started indicates whether the previous closing is available.
+	started bool
 }
 
 // NewTsi function initializes a new TSI instance with the default parameters.
@@ -55,36 +75,160 @@ func NewTsiWith[T helper.Number](firstSmoothingPeriod, secondSmoothingPeriod int
 
 // Compute function takes a channel of numbers and computes the TSI over the specified period.
 func (t *Tsi[T]) Compute(closings <-chan T) <-chan T {
+	return helper.Compute(closings, t.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the TSI over the specified period.
+func (t *Tsi[T]) ComputeSlice(closings []T) []T {
+	return helper.ComputeSlice(closings, t.Clone().Update)
+}
+
+// Update function takes a value and updates the TSI. It returns the TSI value, and whether the
+// TSI has passed its idle period and the value is ready.
+func (t *Tsi[T]) Update(closing T) (T, bool) {
+	if !t.started {
+		t.pcdsFirst = CloneMa(t.FirstSmoothing)
+		t.pcdsSecond = CloneMa(t.SecondSmoothing)
+		t.apcdsFirst = CloneMa(t.FirstSmoothing)
+		t.apcdsSecond = CloneMa(t.SecondSmoothing)
+
+		t.previous = closing
+		t.started = true
+
+		return 0, false
+	}
+
 	// Price change
-	pcsSplice := helper.Duplicate(
-		helper.Change(closings, 1),
-		2,
-	)
+	pc := closing - t.previous
+	t.previous = closing
 
 	//	PCDS = Ema(13, Ema(25, (Current - Prior)))
-	pcds := t.FirstSmoothing.Compute(
-		t.SecondSmoothing.Compute(
-			pcsSplice[0],
-		),
-	)
+	pcds, pcdsOk := t.pcdsSecond.Update(pc)
+	if pcdsOk {
+		pcds, pcdsOk = t.pcdsFirst.Update(pcds)
+	}
 
 	// APCDS = Ema(13, Ema(25, Abs(Current - Prior)))
-	apcds := t.FirstSmoothing.Compute(
-		t.SecondSmoothing.Compute(
-			helper.Abs(pcsSplice[1]),
-		),
-	)
+	apcds, apcdsOk := t.apcdsSecond.Update(T(math.Abs(float64(pc))))
+	if apcdsOk {
+		apcds, apcdsOk = t.apcdsFirst.Update(apcds)
+	}
+
+	if !pcdsOk || !apcdsOk {
+		return 0, false
+	}
 
 	// TSI = (PCDS / APCDS) * 100
-	tsi := helper.MultiplyBy(
-		helper.Divide(
-			pcds,
-			apcds,
-		),
-		T(100),
-	)
+	return (pcds / apcds) * T(100), true
+}
+
+// Reset function resets the state of the TSI.
+func (t *Tsi[T]) Reset() {
+	t.pcdsFirst = nil
+	t.pcdsSecond = nil
+	t.apcdsFirst = nil
+	t.apcdsSecond = nil
+	t.previous = 0
+	t.started = false
+}
+
+// Clone function returns a new TSI instance with the same configuration and a fresh state.
+func (t *Tsi[T]) Clone() *Tsi[T] {
+	return &Tsi[T]{
+		FirstSmoothing:  CloneMa(t.FirstSmoothing),
+		SecondSmoothing: CloneMa(t.SecondSmoothing),
+	}
+}
+
+// tsiState is the state of the TSI.
+type tsiState[T helper.Number] struct {
+	// PcdsFirst is the first smoothing state of the price changes.
+	PcdsFirst json.RawMessage `json:"pcdsFirst"`
+
+	// PcdsSecond is the second smoothing state of the price changes.
+	PcdsSecond json.RawMessage `json:"pcdsSecond"`
+
+	// ApcdsFirst is the first smoothing state of the absolute price changes.
+	ApcdsFirst json.RawMessage `json:"apcdsFirst"`
+
+	// ApcdsSecond is the second smoothing state of the absolute price changes.
+	ApcdsSecond json.RawMessage `json:"apcdsSecond"`
+
+	// Previous is the previous closing.
+	Previous T `json:"previous"`
+
+	// Started indicates whether the first closing is received.
+	Started bool `json:"started"`
+}
+
+// MarshalState function returns the state of the TSI as JSON.
+func (t *Tsi[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := tsiState[T]{
+		Previous: t.previous,
+		Started:  t.started,
+	}
+
+	state.PcdsFirst, err = helper.MarshalInterfaceState(t.pcdsFirst)
+	if err != nil {
+		return nil, err
+	}
+
+	state.PcdsSecond, err = helper.MarshalInterfaceState(t.pcdsSecond)
+	if err != nil {
+		return nil, err
+	}
+
+	state.ApcdsFirst, err = helper.MarshalInterfaceState(t.apcdsFirst)
+	if err != nil {
+		return nil, err
+	}
+
+	state.ApcdsSecond, err = helper.MarshalInterfaceState(t.apcdsSecond)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the TSI from the given JSON.
+func (t *Tsi[T]) UnmarshalState(data []byte) error {
+	var state tsiState[T]
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	first := func() Ma[T] { return CloneMa(t.FirstSmoothing) }
+	second := func() Ma[T] { return CloneMa(t.SecondSmoothing) }
+
+	err = helper.UnmarshalInterfaceState(&t.pcdsFirst, state.PcdsFirst, first)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalInterfaceState(&t.pcdsSecond, state.PcdsSecond, second)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalInterfaceState(&t.apcdsFirst, state.ApcdsFirst, first)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalInterfaceState(&t.apcdsSecond, state.ApcdsSecond, second)
+	if err != nil {
+		return err
+	}
+
+	t.previous = state.Previous
+	t.started = state.Started
 
-	return tsi
+	return nil
 }
 
 // IdlePeriod is the initial period that TSI yield any results.
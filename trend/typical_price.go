@@ -5,6 +5,8 @@
 package trend
 
 import (
+	"encoding/json"
+
 	"github.com/cinar/indicator/v2/helper"
 )
 
@@ -21,12 +23,36 @@ func NewTypicalPrice[T helper.Number]() *TypicalPrice[T] {
 }
 
 // Compute function takes a channel of numbers and computes the Typical Price and the signal line.
-func (*TypicalPrice[T]) Compute(high, low, closing <-chan T) <-chan T {
-	return helper.DivideBy(
-		helper.Add(
-			helper.Add(high, low),
-			closing,
-		),
-		3,
-	)
+func (t *TypicalPrice[T]) Compute(high, low, closing <-chan T) <-chan T {
+	return helper.Compute3(high, low, closing, t.Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the Typical Price and the signal line.
+func (t *TypicalPrice[T]) ComputeSlice(high, low, closing []T) []T {
+	return helper.ComputeSlice3(high, low, closing, t.Update)
+}
+
+// Update function takes the high, low, and closing values, and computes the Typical Price. The
+// Typical Price does not have an idle period, and the value is always ready.
+func (*TypicalPrice[T]) Update(high, low, closing T) (T, bool) {
+	return ((high + low) + closing) / 3, true
+}
+
+// Reset function resets the state of the Typical Price. The Typical Price does not have a state.
+func (*TypicalPrice[T]) Reset() {
+}
+
+// typicalPriceState is the state of the Typical Price. The Typical Price does not have a state.
+type typicalPriceState struct{}
+
+// MarshalState function returns the state of the Typical Price as JSON. The Typical Price does not
+// have a state.
+func (*TypicalPrice[T]) MarshalState() ([]byte, error) {
+	return json.Marshal(&typicalPriceState{})
+}
+
+// UnmarshalState function restores the state of the Typical Price from the given JSON. The Typical
+// Price does not have a state.
+func (*TypicalPrice[T]) UnmarshalState(data []byte) error {
+	return json.Unmarshal(data, &typicalPriceState{})
 }
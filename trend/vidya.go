@@ -0,0 +1,180 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultVidyaPeriod is the default VIDYA period of 9.
+	DefaultVidyaPeriod = 9
+)
+
+// Vidya represents the parameters for calculating the Variable Index Dynamic Average (VIDYA)
+// developed by Tushar Chande. It is an EMA whose smoothing factor is scaled by the absolute
+// Chande Momentum Oscillator (CMO), so that it speeds up in trending markets and slows down in
+// ranging markets.
+//
+//	CMO = (Sum(Up Changes) - Sum(Down Changes)) / (Sum(Up Changes) + Sum(Down Changes))
+//	Alpha = 2 / (Period + 1)
+//	VIDYA = Alpha * Abs(CMO) * Value + (1 - Alpha * Abs(CMO)) * Previous VIDYA
+//
+// The CMO is computed over the same period. The VIDYA starts from the first value, and it is
+// yielded once the CMO has a full period of changes.
+//
+// Example:
+//
+//	vidya := trend.NewVidya[float64]()
+//	result := vidya.Compute(c)
+type Vidya[T helper.Number] struct {
+	// Period is the time period.
+	Period int
+
+	// changes is the changes in the period.
+	changes *helper.Ring[float64]
+
+	// previous is the previous value.
+	previous float64
+
+	// vidya is the last VIDYA value.
+	vidya float64
+}
+
+// NewVidya function initializes a new VIDYA instance with the default parameters.
+func NewVidya[T helper.Number]() *Vidya[T] {
+	return NewVidyaWithPeriod[T](DefaultVidyaPeriod)
+}
+
+// NewVidyaWithPeriod function initializes a new VIDYA instance with the given period.
+func NewVidyaWithPeriod[T helper.Number](period int) *Vidya[T] {
+	return &Vidya[T]{
+		Period: period,
+	}
+}
+
+// Compute function takes a channel of numbers and computes the VIDYA over the specified period.
+func (v *Vidya[T]) Compute(c <-chan T) <-chan T {
+	return helper.Compute(c, v.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the VIDYA over the specified period.
+func (v *Vidya[T]) ComputeSlice(c []T) []T {
+	return helper.ComputeSlice(c, v.Clone().Update)
+}
+
+// Update function takes a value and updates the VIDYA. It returns the VIDYA value, and whether the
+// VIDYA has passed its idle period and the value is ready.
+func (v *Vidya[T]) Update(n T) (T, bool) {
+	value := float64(n)
+
+	// First value initializes the VIDYA.
+	if v.changes == nil {
+		v.changes = helper.NewRing[float64](v.Period)
+		v.previous = value
+		v.vidya = value
+		return 0, false
+	}
+
+	v.changes.Put(value - v.previous)
+	v.previous = value
+
+	up, down := 0.0, 0.0
+	for i := 0; i < v.Period; i++ {
+		change := v.changes.At(i)
+		if change > 0 {
+			up += change
+		} else {
+			down -= change
+		}
+	}
+
+	cmo := 0.0
+	if up+down > 0 {
+		cmo = (up - down) / (up + down)
+	}
+
+	alpha := 2 / float64(v.Period+1)
+	v.vidya = alpha*math.Abs(cmo)*value + (1-alpha*math.Abs(cmo))*v.vidya
+
+	return T(v.vidya), v.changes.IsFull()
+}
+
+// Reset function resets the state of the VIDYA.
+func (v *Vidya[T]) Reset() {
+	v.changes = nil
+	v.previous = 0
+	v.vidya = 0
+}
+
+// Clone function returns a new VIDYA instance with the same configuration and a fresh state.
+func (v *Vidya[T]) Clone() *Vidya[T] {
+	return NewVidyaWithPeriod[T](v.Period)
+}
+
+// vidyaState is the state of the VIDYA.
+type vidyaState struct {
+	// Changes is the changes in the period.
+	Changes json.RawMessage `json:"changes"`
+
+	// Previous is the previous value.
+	Previous float64 `json:"previous"`
+
+	// Vidya is the last VIDYA value.
+	Vidya float64 `json:"vidya"`
+}
+
+// MarshalState function returns the state of the VIDYA as JSON.
+func (v *Vidya[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := vidyaState{
+		Previous: v.previous,
+		Vidya:    v.vidya,
+	}
+
+	state.Changes, err = helper.MarshalStateOf(v.changes)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the VIDYA from the given JSON.
+func (v *Vidya[T]) UnmarshalState(data []byte) error {
+	var state vidyaState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&v.changes, state.Changes, func() *helper.Ring[float64] {
+		return helper.NewRing[float64](v.Period)
+	})
+	if err != nil {
+		return err
+	}
+
+	v.previous = state.Previous
+	v.vidya = state.Vidya
+
+	return nil
+}
+
+// IdlePeriod is the initial period that VIDYA won't yield any results.
+func (v *Vidya[T]) IdlePeriod() int {
+	return v.Period
+}
+
+// String is the string representation of the VIDYA.
+func (v *Vidya[T]) String() string {
+	return fmt.Sprintf("VIDYA(%d)", v.Period)
+}
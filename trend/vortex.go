@@ -0,0 +1,236 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"encoding/json"
+	"math"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultVortexPeriod is the default period for the Vortex Indicator.
+	DefaultVortexPeriod = 14
+)
+
+// Vortex represents the configuration parameters for calculating the Vortex Indicator. It consists of
+// two oscillators, the positive and the negative vortex lines, that capture the positive and the
+// negative trend movements. The positive line crossing above the negative line suggests a bullish
+// trend, while crossing below suggests a bearish trend.
+//
+//	+VM = Abs(High - Previous Low)
+//	-VM = Abs(Low - Previous High)
+//	TR = Max((High - Low), (High - Previous Closing), (Previous Closing - Low))
+//	+VI = Sum(+VM, period) / Sum(TR, period)
+//	-VI = Sum(-VM, period) / Sum(TR, period)
+//
+// Example:
+//
+//	vortex := trend.NewVortex[float64]()
+//	plusVi, minusVi := vortex.Compute(highs, lows, closings)
+type Vortex[T helper.Number] struct {
+	// Sum is the Moving Sum instance.
+	Sum *MovingSum[T]
+
+	// trSum is the true range moving sum state.
+	trSum *MovingSum[T]
+
+	// plusVmSum is the positive vortex movement moving sum state.
+	plusVmSum *MovingSum[T]
+
+	// minusVmSum is the negative vortex movement moving sum state.
+	minusVmSum *MovingSum[T]
+
+	// previousHigh is the previous high.
+	previousHigh T
+
+	// previousLow is the previous low.
+	previousLow T
+
+	// previousClosing is the previous closing.
+	previousClosing T
+}
+
+// NewVortex function initializes a new Vortex instance with the default parameters.
+func NewVortex[T helper.Number]() *Vortex[T] {
+	return NewVortexWithPeriod[T](DefaultVortexPeriod)
+}
+
+// NewVortexWithPeriod function initializes a new Vortex instance with the given period.
+func NewVortexWithPeriod[T helper.Number](period int) *Vortex[T] {
+	return &Vortex[T]{
+		Sum: NewMovingSumWithPeriod[T](period),
+	}
+}
+
+// Compute function takes a channel of highs, lows, and closings, and computes the Vortex Indicator
+// over the specified period. Returns +VI and -VI.
+func (v *Vortex[T]) Compute(highs, lows, closings <-chan T) (<-chan T, <-chan T) {
+	vortex := v.Clone()
+
+	values := helper.Compute3(highs, lows, closings, func(high, low, closing T) ([]T, bool) {
+		plusVi, minusVi, ok := vortex.Update(high, low, closing)
+		return []T{plusVi, minusVi}, ok
+	})
+
+	outputs := helper.Unzip(values, 2)
+
+	return outputs[0], outputs[1]
+}
+
+// ComputeSlice function takes a slice of highs, lows, and closings, and computes the Vortex Indicator
+// over the specified period. Returns +VI and -VI.
+func (v *Vortex[T]) ComputeSlice(highs, lows, closings []T) ([]T, []T) {
+	vortex := v.Clone()
+
+	values := helper.ComputeSlice3(highs, lows, closings, func(high, low, closing T) ([]T, bool) {
+		plusVi, minusVi, ok := vortex.Update(high, low, closing)
+		return []T{plusVi, minusVi}, ok
+	})
+
+	outputs := helper.UnzipSlice(values, 2)
+
+	return outputs[0], outputs[1]
+}
+
+// Update function takes a high, a low, and a closing value, and updates the Vortex Indicator. It returns
+// the +VI and -VI values, and whether the Vortex Indicator has passed its idle period and the values are
+// ready.
+func (v *Vortex[T]) Update(high, low, closing T) (T, T, bool) {
+	if v.trSum == nil {
+		v.trSum = v.Sum.Clone()
+		v.plusVmSum = v.Sum.Clone()
+		v.minusVmSum = v.Sum.Clone()
+
+		v.previousHigh = high
+		v.previousLow = low
+		v.previousClosing = closing
+
+		return 0, 0, false
+	}
+
+	// Use the previous values.
+	plusVm := T(math.Abs(float64(high - v.previousLow)))
+	minusVm := T(math.Abs(float64(low - v.previousHigh)))
+	tr := T(math.Max(float64(high-low), math.Max(float64(high-v.previousClosing), float64(v.previousClosing-low))))
+
+	v.previousHigh = high
+	v.previousLow = low
+	v.previousClosing = closing
+
+	trs, _ := v.trSum.Update(tr)
+	plusVms, _ := v.plusVmSum.Update(plusVm)
+
+	minusVms, ok := v.minusVmSum.Update(minusVm)
+	if !ok {
+		return 0, 0, false
+	}
+
+	return plusVms / trs, minusVms / trs, true
+}
+
+// Reset function resets the state of the Vortex Indicator.
+func (v *Vortex[T]) Reset() {
+	v.trSum = nil
+	v.plusVmSum = nil
+	v.minusVmSum = nil
+	v.previousHigh = 0
+	v.previousLow = 0
+	v.previousClosing = 0
+}
+
+// Clone function returns a new Vortex Indicator instance with the same configuration and a fresh state.
+func (v *Vortex[T]) Clone() *Vortex[T] {
+	return &Vortex[T]{
+		Sum: v.Sum.Clone(),
+	}
+}
+
+// vortexState is the state of the Vortex Indicator.
+type vortexState[T helper.Number] struct {
+	// TrSum is the true range moving sum state.
+	TrSum json.RawMessage `json:"trSum"`
+
+	// PlusVmSum is the positive vortex movement moving sum state.
+	PlusVmSum json.RawMessage `json:"plusVmSum"`
+
+	// MinusVmSum is the negative vortex movement moving sum state.
+	MinusVmSum json.RawMessage `json:"minusVmSum"`
+
+	// PreviousHigh is the previous high.
+	PreviousHigh T `json:"previousHigh"`
+
+	// PreviousLow is the previous low.
+	PreviousLow T `json:"previousLow"`
+
+	// PreviousClosing is the previous closing.
+	PreviousClosing T `json:"previousClosing"`
+}
+
+// MarshalState function returns the state of the Vortex Indicator as JSON.
+func (v *Vortex[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := vortexState[T]{
+		PreviousHigh:    v.previousHigh,
+		PreviousLow:     v.previousLow,
+		PreviousClosing: v.previousClosing,
+	}
+
+	state.TrSum, err = helper.MarshalStateOf(v.trSum)
+	if err != nil {
+		return nil, err
+	}
+
+	state.PlusVmSum, err = helper.MarshalStateOf(v.plusVmSum)
+	if err != nil {
+		return nil, err
+	}
+
+	state.MinusVmSum, err = helper.MarshalStateOf(v.minusVmSum)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the Vortex Indicator from the given JSON.
+func (v *Vortex[T]) UnmarshalState(data []byte) error {
+	var state vortexState[T]
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&v.trSum, state.TrSum, v.Sum.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&v.plusVmSum, state.PlusVmSum, v.Sum.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&v.minusVmSum, state.MinusVmSum, v.Sum.Clone)
+	if err != nil {
+		return err
+	}
+
+	v.previousHigh = state.PreviousHigh
+	v.previousLow = state.PreviousLow
+	v.previousClosing = state.PreviousClosing
+
+	return nil
+}
+
+// IdlePeriod is the initial period that Vortex won't yield any results.
+func (v *Vortex[T]) IdlePeriod() int {
+	// Moving sum idle period and for using the previous values.
+	return v.Sum.IdlePeriod() + 1
+}
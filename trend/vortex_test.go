@@ -0,0 +1,48 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+func TestVortex(t *testing.T) {
+	type Data struct {
+		High    float64
+		Low     float64
+		Close   float64
+		PlusVi  float64
+		MinusVi float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/vortex.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := helper.Duplicate(input, 5)
+	highs := helper.Map(inputs[0], func(d *Data) float64 { return d.High })
+	lows := helper.Map(inputs[1], func(d *Data) float64 { return d.Low })
+	closings := helper.Map(inputs[2], func(d *Data) float64 { return d.Close })
+	expectedPlusVi := helper.Map(inputs[3], func(d *Data) float64 { return d.PlusVi })
+	expectedMinusVi := helper.Map(inputs[4], func(d *Data) float64 { return d.MinusVi })
+
+	vortex := trend.NewVortex[float64]()
+	actualPlusVi, actualMinusVi := vortex.Compute(highs, lows, closings)
+
+	actualPlusVi = helper.RoundDigits(actualPlusVi, 2)
+	actualPlusVi = helper.Shift(actualPlusVi, vortex.IdlePeriod(), 0)
+
+	actualMinusVi = helper.RoundDigits(actualMinusVi, 2)
+	actualMinusVi = helper.Shift(actualMinusVi, vortex.IdlePeriod(), 0)
+
+	err = helper.CheckEquals(actualPlusVi, expectedPlusVi, actualMinusVi, expectedMinusVi)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -5,6 +5,8 @@
 package trend
 
 import (
+	"encoding/json"
+
 	"github.com/cinar/indicator/v2/helper"
 )
 
@@ -21,6 +23,12 @@ const (
 type Vwma[T helper.Number] struct {
 	// Time period.
 	Period int
+
+	// closingVolumes is the moving sum of the closing and volume products.
+	closingVolumes *MovingSum[T]
+
+	// volumes is the moving sum of the volumes.
+	volumes *MovingSum[T]
 }
 
 // NewVwma function initializes a new VWMA instance with the default parameters.
@@ -32,17 +40,90 @@ func NewVwma[T helper.Number]() *Vwma[T] {
 
 // Compute function takes a channel of numbers and computes the VWMA and the signal line.
 func (v *Vwma[T]) Compute(closing, volume <-chan T) <-chan T {
-	volumes := helper.Duplicate(volume, 2)
+	return helper.Compute2(closing, volume, v.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the VWMA and the signal line.
+func (v *Vwma[T]) ComputeSlice(closing, volume []T) []T {
+	return helper.ComputeSlice2(closing, volume, v.Clone().Update)
+}
+
+// Update function takes a closing and a volume value, and updates the VWMA. It returns the VWMA value,
+// and whether the VWMA has passed its idle period and the value is ready.
+func (v *Vwma[T]) Update(closing, volume T) (T, bool) {
+	if v.volumes == nil {
+		v.closingVolumes = NewMovingSumWithPeriod[T](v.Period)
+		v.volumes = NewMovingSumWithPeriod[T](v.Period)
+	}
+
+	closingVolumes, _ := v.closingVolumes.Update(closing * volume)
 
-	sum := NewMovingSum[T]()
-	sum.Period = v.Period
+	volumes, ok := v.volumes.Update(volume)
+	if !ok {
+		return 0, false
+	}
+
+	return closingVolumes / volumes, true
+}
+
+// Reset function resets the state of the VWMA.
+func (v *Vwma[T]) Reset() {
+	v.closingVolumes = nil
+	v.volumes = nil
+}
+
+// Clone function returns a new VWMA instance with the same configuration and a fresh state.
+func (v *Vwma[T]) Clone() *Vwma[T] {
+	return &Vwma[T]{
+		Period: v.Period,
+	}
+}
+
+// vwmaState is the state of the VWMA.
+type vwmaState struct {
+	// ClosingVolumes is the moving sum state of the closing times the volume.
+	ClosingVolumes json.RawMessage `json:"closingVolumes"`
+
+	// Volumes is the moving sum state of the volumes.
+	Volumes json.RawMessage `json:"volumes"`
+}
+
+// MarshalState function returns the state of the VWMA as JSON.
+func (v *Vwma[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := vwmaState{}
+
+	state.ClosingVolumes, err = helper.MarshalStateOf(v.closingVolumes)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Volumes, err = helper.MarshalStateOf(v.volumes)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the VWMA from the given JSON.
+func (v *Vwma[T]) UnmarshalState(data []byte) error {
+	var state vwmaState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	init := func() *MovingSum[T] { return NewMovingSumWithPeriod[T](v.Period) }
+
+	err = helper.UnmarshalStateOf(&v.closingVolumes, state.ClosingVolumes, init)
+	if err != nil {
+		return err
+	}
 
-	return helper.Divide(
-		sum.Compute(
-			helper.Multiply(closing, volumes[0]),
-		),
-		sum.Compute(volumes[1]),
-	)
+	return helper.UnmarshalStateOf(&v.volumes, state.Volumes, init)
 }
 
 // IdlePeriod is the initial period that VWMA won't yield any results.
@@ -5,6 +5,8 @@
 package trend
 
 import (
+	"encoding/json"
+
 	"github.com/cinar/indicator/v2/helper"
 )
 
@@ -25,11 +27,24 @@ func NewWeightedClose[T helper.Number]() *WeightedClose[T] {
 }
 
 // Compute function takes a channel of numbers and computes the Weighted Close over the specified period.
-func (*WeightedClose[T]) Compute(highs, lows, closes <-chan T) <-chan T {
-	return helper.Operate3(highs, lows, closes, func(high, low, close T) T {
-		// Weighted Close = (High + Low + (Close * 2)) / 4
-		return (high + low + (close * 2)) / 4
-	})
+func (w *WeightedClose[T]) Compute(highs, lows, closes <-chan T) <-chan T {
+	return helper.Compute3(highs, lows, closes, w.Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the Weighted Close over the specified period.
+func (w *WeightedClose[T]) ComputeSlice(highs, lows, closes []T) []T {
+	return helper.ComputeSlice3(highs, lows, closes, w.Update)
+}
+
+// Update function takes the high, low, and closing values, and computes the Weighted Close. The
+// Weighted Close does not have an idle period, and the value is always ready.
+func (*WeightedClose[T]) Update(high, low, close T) (T, bool) {
+	// Weighted Close = (High + Low + (Close * 2)) / 4
+	return (high + low + (close * 2)) / 4, true
+}
+
+// Reset function resets the state of the Weighted Close. The Weighted Close does not have a state.
+func (*WeightedClose[T]) Reset() {
 }
 
 // IdlePeriod is the initial period that Weighted Close yield any results.
@@ -37,6 +52,21 @@ func (*WeightedClose[T]) IdlePeriod() int {
 	return 0
 }
 
+// weightedCloseState is the state of the Weighted Close. The Weighted Close does not have a state.
+type weightedCloseState struct{}
+
+// MarshalState function returns the state of the Weighted Close as JSON. The Weighted Close does
+// not have a state.
+func (*WeightedClose[T]) MarshalState() ([]byte, error) {
+	return json.Marshal(&weightedCloseState{})
+}
+
+// UnmarshalState function restores the state of the Weighted Close from the given JSON. The
+// Weighted Close does not have a state.
+func (*WeightedClose[T]) UnmarshalState(data []byte) error {
+	return json.Unmarshal(data, &weightedCloseState{})
+}
+
 // String is the string representation of the Weighted Close.
 func (*WeightedClose[T]) String() string {
 	return "Weighted Close"
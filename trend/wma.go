@@ -5,6 +5,7 @@
 package trend
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/cinar/indicator/v2/helper"
@@ -17,6 +18,9 @@ import (
 type Wma[T helper.Number] struct {
 	// Time period.
 	Period int
+
+	// window is the values in the period.
+	window *helper.Ring[T]
 }
 
 // NewWmaWith function initializes a new WMA instance with the given parameters.
@@ -28,27 +32,81 @@ func NewWmaWith[T helper.Number](period int) *Wma[T] {
 
 // Compute function takes a channel of numbers and computes the WMA and the signal line.
 func (w *Wma[T]) Compute(values <-chan T) <-chan T {
-	window := helper.NewRing[T](w.Period)
+	return helper.Compute(values, w.Clone().Update)
+}
 
-	wmas := helper.Map(values, func(value T) T {
-		window.Put(value)
+// ComputeSlice function takes a slice of numbers and computes the WMA and the signal line.
+func (w *Wma[T]) ComputeSlice(values []T) []T {
+	return helper.ComputeSlice(values, w.Clone().Update)
+}
 
-		if !window.IsFull() {
-			return T(0)
-		}
+// Update function takes a value and updates the WMA. It returns the WMA value, and whether the
+// period is full and the value is ready.
+func (w *Wma[T]) Update(value T) (T, bool) {
+	if w.window == nil {
+		w.window = helper.NewRing[T](w.Period)
+	}
 
-		var sum T
+	w.window.Put(value)
 
-		for i := 0; i < w.Period; i++ {
-			sum += window.At(i) * T(i+1) / T(w.Period)
-		}
+	if !w.window.IsFull() {
+		return 0, false
+	}
+
+	var sum T
 
-		return sum / 2
-	})
+	for i := 0; i < w.Period; i++ {
+		sum += w.window.At(i) * T(i+1) / T(w.Period)
+	}
+
+	return sum / 2, true
+}
+
+// Reset function resets the state of the WMA.
+func (w *Wma[T]) Reset() {
+	w.window = nil
+}
+
+// Clone function returns a new WMA instance with the same configuration and a fresh state.
+func (w *Wma[T]) Clone() *Wma[T] {
+	return NewWmaWith[T](w.Period)
+}
 
-	wmas = helper.Skip(wmas, w.IdlePeriod())
+// wmaState is the state of the WMA.
+type wmaState struct {
+	// Window is the values in the period.
+	Window json.RawMessage `json:"window"`
+}
+
+// MarshalState function returns the state of the WMA as JSON.
+func (w *Wma[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := wmaState{}
+
+	state.Window, err = helper.MarshalStateOf(w.window)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the WMA from the given JSON.
+func (w *Wma[T]) UnmarshalState(data []byte) error {
+	var state wmaState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&w.window, state.Window, func() *helper.Ring[T] { return helper.NewRing[T](w.Period) })
+	if err != nil {
+		return err
+	}
 
-	return wmas
+	return nil
 }
 
 // IdlePeriod is the initial period that WMA won't yield any results.
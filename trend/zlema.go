@@ -0,0 +1,160 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package trend
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultZlemaPeriod is the default ZLEMA period of 20.
+	DefaultZlemaPeriod = 20
+)
+
+// Zlema represents the parameters for calculating the Zero Lag Exponential Moving Average (ZLEMA).
+// It removes the lag of the EMA by adding the momentum over the lag period to the values before
+// smoothing them.
+//
+//	Lag = (Period - 1) / 2
+//	ZLEMA = EMA(2 * Value - Value[Lag periods ago])
+//
+// Example:
+//
+//	zlema := trend.NewZlema[float64]()
+//	result := zlema.Compute(c)
+type Zlema[T helper.Number] struct {
+	// Ema is the EMA instance.
+	Ema *Ema[T]
+
+	// window is the values in the lag period.
+	window *helper.Ring[T]
+
+	// ema is the EMA state.
+	ema *Ema[T]
+}
+
+// NewZlema function initializes a new ZLEMA instance with the default parameters.
+func NewZlema[T helper.Number]() *Zlema[T] {
+	return NewZlemaWithPeriod[T](DefaultZlemaPeriod)
+}
+
+// NewZlemaWithPeriod function initializes a new ZLEMA instance with the given period.
+func NewZlemaWithPeriod[T helper.Number](period int) *Zlema[T] {
+	return &Zlema[T]{
+		Ema: NewEmaWithPeriod[T](period),
+	}
+}
+
+// Compute function takes a channel of numbers and computes the ZLEMA over the specified period.
+func (z *Zlema[T]) Compute(c <-chan T) <-chan T {
+	return helper.Compute(c, z.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the ZLEMA over the specified period.
+func (z *Zlema[T]) ComputeSlice(c []T) []T {
+	return helper.ComputeSlice(c, z.Clone().Update)
+}
+
+// Update function takes a value and updates the ZLEMA. It returns the ZLEMA value, and whether the
+// ZLEMA has passed its idle period and the value is ready.
+func (z *Zlema[T]) Update(value T) (T, bool) {
+	if z.ema == nil {
+		z.ema = z.Ema.Clone()
+	}
+
+	lag := z.lag()
+	if lag > 0 {
+		if z.window == nil {
+			z.window = helper.NewRing[T](lag)
+		}
+
+		full := z.window.IsFull()
+		before := z.window.Put(value)
+
+		if !full {
+			return 0, false
+		}
+
+		value = 2*value - before
+	}
+
+	return z.ema.Update(value)
+}
+
+// Reset function resets the state of the ZLEMA.
+func (z *Zlema[T]) Reset() {
+	z.window = nil
+	z.ema = nil
+}
+
+// Clone function returns a new ZLEMA instance with the same configuration and a fresh state.
+func (z *Zlema[T]) Clone() *Zlema[T] {
+	return &Zlema[T]{
+		Ema: z.Ema.Clone(),
+	}
+}
+
+// zlemaState is the state of the ZLEMA.
+type zlemaState struct {
+	// Window is the values in the lag period.
+	Window json.RawMessage `json:"window"`
+
+	// Ema is the EMA state.
+	Ema json.RawMessage `json:"ema"`
+}
+
+// MarshalState function returns the state of the ZLEMA as JSON.
+func (z *Zlema[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := zlemaState{}
+
+	state.Window, err = helper.MarshalStateOf(z.window)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Ema, err = helper.MarshalStateOf(z.ema)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the ZLEMA from the given JSON.
+func (z *Zlema[T]) UnmarshalState(data []byte) error {
+	var state zlemaState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&z.window, state.Window, func() *helper.Ring[T] { return helper.NewRing[T](z.lag()) })
+	if err != nil {
+		return err
+	}
+
+	return helper.UnmarshalStateOf(&z.ema, state.Ema, z.Ema.Clone)
+}
+
+// IdlePeriod is the initial period that ZLEMA won't yield any results.
+func (z *Zlema[T]) IdlePeriod() int {
+	return z.lag() + z.Ema.IdlePeriod()
+}
+
+// String is the string representation of the ZLEMA.
+func (z *Zlema[T]) String() string {
+	return fmt.Sprintf("ZLEMA(%d)", z.Ema.Period)
+}
+
+// lag returns the lag period.
+func (z *Zlema[T]) lag() int {
+	return (z.Ema.Period - 1) / 2
+}
@@ -5,6 +5,8 @@
 package volatility
 
 import (
+	"encoding/json"
+
 	"github.com/cinar/indicator/v2/helper"
 	"github.com/cinar/indicator/v2/trend"
 )
@@ -28,6 +30,15 @@ const (
 type AccelerationBands[T helper.Number] struct {
 	// Time period.
 	Period int
+
+	// upperSma is the upper band SMA state.
+	upperSma *trend.Sma[T]
+
+	// middleSma is the middle band SMA state.
+	middleSma *trend.Sma[T]
+
+	// lowerSma is the lower band SMA state.
+	lowerSma *trend.Sma[T]
 }
 
 // NewAccelerationBands function initializes a new Acceleration Bands instance with the default parameters.
@@ -39,48 +50,127 @@ func NewAccelerationBands[T helper.Number]() *AccelerationBands[T] {
 
 // Compute function takes a channel of numbers and computes the Acceleration Bands over the specified period.
 func (a *AccelerationBands[T]) Compute(high, low, closing <-chan T) (<-chan T, <-chan T, <-chan T) {
-	highs := helper.Duplicate(high, 3)
-	lows := helper.Duplicate(low, 3)
-
-	ks := helper.Duplicate(
-		helper.Divide(
-			helper.Subtract(highs[0], lows[0]),
-			helper.Add(highs[1], lows[1]),
-		),
-		2,
-	)
-
-	sma := trend.NewSmaWithPeriod[T](a.Period)
-
-	upper := sma.Compute(
-		helper.Multiply(
-			highs[2],
-			helper.IncrementBy(
-				helper.MultiplyBy(
-					ks[0],
-					4,
-				),
-				1,
-			),
-		),
-	)
-
-	middle := sma.Compute(closing)
-
-	lower := sma.Compute(
-		helper.Multiply(
-			lows[2],
-			helper.IncrementBy(
-				helper.MultiplyBy(
-					ks[1],
-					-4,
-				),
-				1,
-			),
-		),
-	)
-
-	return upper, middle, lower
+	accelerationBands := a.Clone()
+
+	values := helper.Compute3(high, low, closing, func(high, low, closing T) ([]T, bool) {
+		upper, middle, lower, ok := accelerationBands.Update(high, low, closing)
+		return []T{upper, middle, lower}, ok
+	})
+
+	outputs := helper.Unzip(values, 3)
+
+	return outputs[0], outputs[1], outputs[2]
+}
+
+// ComputeSlice function takes a slice of numbers and computes the Acceleration Bands over the specified period.
+func (a *AccelerationBands[T]) ComputeSlice(high, low, closing []T) ([]T, []T, []T) {
+	accelerationBands := a.Clone()
+
+	values := helper.ComputeSlice3(high, low, closing, func(high, low, closing T) ([]T, bool) {
+		upper, middle, lower, ok := accelerationBands.Update(high, low, closing)
+		return []T{upper, middle, lower}, ok
+	})
+
+	outputs := helper.UnzipSlice(values, 3)
+
+	return outputs[0], outputs[1], outputs[2]
+}
+
+// Update function takes a high, a low, and a closing value, and updates the Acceleration Bands. It
+// returns the upper, middle, and lower bands, and whether the Acceleration Bands has passed its idle
+// period and the values are ready.
+func (a *AccelerationBands[T]) Update(high, low, closing T) (T, T, T, bool) {
+	if a.upperSma == nil {
+		a.upperSma = trend.NewSmaWithPeriod[T](a.Period)
+		a.middleSma = trend.NewSmaWithPeriod[T](a.Period)
+		a.lowerSma = trend.NewSmaWithPeriod[T](a.Period)
+	}
+
+	k := (high - low) / (high + low)
+
+	upper, _ := a.upperSma.Update(high * (k*4 + 1))
+	middle, _ := a.middleSma.Update(closing)
+
+	lower, ok := a.lowerSma.Update(low * (k*-4 + 1))
+	if !ok {
+		return 0, 0, 0, false
+	}
+
+	return upper, middle, lower, true
+}
+
+// Reset function resets the state of the Acceleration Bands.
+func (a *AccelerationBands[T]) Reset() {
+	a.upperSma = nil
+	a.middleSma = nil
+	a.lowerSma = nil
+}
+
+// Clone function returns a new Acceleration Bands instance with the same configuration and a fresh state.
+func (a *AccelerationBands[T]) Clone() *AccelerationBands[T] {
+	return &AccelerationBands[T]{
+		Period: a.Period,
+	}
+}
+
+// accelerationBandsState is the state of the Acceleration Bands.
+type accelerationBandsState struct {
+	// UpperSma is the upper band SMA state.
+	UpperSma json.RawMessage `json:"upperSma"`
+
+	// MiddleSma is the middle band SMA state.
+	MiddleSma json.RawMessage `json:"middleSma"`
+
+	// LowerSma is the lower band SMA state.
+	LowerSma json.RawMessage `json:"lowerSma"`
+}
+
+// MarshalState function returns the state of the Acceleration Bands as JSON.
+func (a *AccelerationBands[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := accelerationBandsState{}
+
+	state.UpperSma, err = helper.MarshalStateOf(a.upperSma)
+	if err != nil {
+		return nil, err
+	}
+
+	state.MiddleSma, err = helper.MarshalStateOf(a.middleSma)
+	if err != nil {
+		return nil, err
+	}
+
+	state.LowerSma, err = helper.MarshalStateOf(a.lowerSma)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the Acceleration Bands from the given JSON.
+func (a *AccelerationBands[T]) UnmarshalState(data []byte) error {
+	var state accelerationBandsState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	init := func() *trend.Sma[T] { return trend.NewSmaWithPeriod[T](a.Period) }
+
+	err = helper.UnmarshalStateOf(&a.upperSma, state.UpperSma, init)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&a.middleSma, state.MiddleSma, init)
+	if err != nil {
+		return err
+	}
+
+	return helper.UnmarshalStateOf(&a.lowerSma, state.LowerSma, init)
 }
 
 // IdlePeriod is the initial period that Acceleration Bands won't yield any results.
@@ -5,6 +5,7 @@
 package volatility
 
 import (
+	"encoding/json"
 	"math"
 
 	"github.com/cinar/indicator/v2/helper"
@@ -32,6 +33,12 @@ const (
 type Atr[T helper.Number] struct {
 	// Ma is the moving average for the ATR.
 	Ma trend.Ma[T]
+
+	// ma is the moving average state.
+	ma trend.Ma[T]
+
+	// previousClosing is the previous closing.
+	previousClosing T
 }
 
 // NewAtr function initializes a new ATR instance with the default parameters.
@@ -53,17 +60,84 @@ func NewAtrWithMa[T helper.Number](ma trend.Ma[T]) *Atr[T] {
 
 // Compute function takes a channel of numbers and computes the ATR over the specified period.
 func (a *Atr[T]) Compute(highs, lows, closings <-chan T) <-chan T {
-	// Use previous closing by skipping highs and lows by one.
-	highs = helper.Skip(highs, 1)
-	lows = helper.Skip(lows, 1)
+	return helper.Compute3(highs, lows, closings, a.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the ATR over the specified period.
+func (a *Atr[T]) ComputeSlice(highs, lows, closings []T) []T {
+	return helper.ComputeSlice3(highs, lows, closings, a.Clone().Update)
+}
+
+// Update function takes a high, a low, and a closing value, and updates the ATR. It returns the ATR
+// value, and whether the ATR has passed its idle period and the value is ready.
+func (a *Atr[T]) Update(high, low, closing T) (T, bool) {
+	if a.ma == nil {
+		a.ma = trend.CloneMa(a.Ma)
+		a.previousClosing = closing
+
+		return 0, false
+	}
+
+	// Use previous closing.
+	tr := T(math.Max(float64(high-low), math.Max(float64(high-a.previousClosing), float64(a.previousClosing-low))))
+	a.previousClosing = closing
+
+	return a.ma.Update(tr)
+}
+
+// Reset function resets the state of the ATR.
+func (a *Atr[T]) Reset() {
+	a.ma = nil
+	a.previousClosing = 0
+}
+
+// Clone function returns a new ATR instance with the same configuration and a fresh state.
+func (a *Atr[T]) Clone() *Atr[T] {
+	return NewAtrWithMa(trend.CloneMa(a.Ma))
+}
 
-	tr := helper.Operate3(highs, lows, closings, func(high, low, closing T) T {
-		return T(math.Max(float64(high-low), math.Max(float64(high-closing), float64(closing-low))))
-	})
+// atrState is the state of the ATR.
+type atrState[T helper.Number] struct {
+	// Ma is the moving average state.
+	Ma json.RawMessage `json:"ma"`
+
+	// PreviousClosing is the previous closing.
+	PreviousClosing T `json:"previousClosing"`
+}
+
+// MarshalState function returns the state of the ATR as JSON.
+func (a *Atr[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := atrState[T]{
+		PreviousClosing: a.previousClosing,
+	}
+
+	state.Ma, err = helper.MarshalInterfaceState(a.ma)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the ATR from the given JSON.
+func (a *Atr[T]) UnmarshalState(data []byte) error {
+	var state atrState[T]
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalInterfaceState(&a.ma, state.Ma, func() trend.Ma[T] { return trend.CloneMa(a.Ma) })
+	if err != nil {
+		return err
+	}
 
-	atr := a.Ma.Compute(tr)
+	a.previousClosing = state.PreviousClosing
 
-	return atr
+	return nil
 }
 
 // IdlePeriod is the initial period that Acceleration Bands won't yield any results.
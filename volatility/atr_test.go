@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/trend"
 	"github.com/cinar/indicator/v2/volatility"
 )
 
@@ -41,3 +42,122 @@ func TestAtr(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestAtrUpdate(t *testing.T) {
+	type Data struct {
+		High  float64
+		Low   float64
+		Close float64
+		Atr   float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/atr.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := helper.ChanToSlice(input)
+	atr := volatility.NewAtrWithPeriod[float64](50)
+
+	for i, d := range data {
+		actual, ok := atr.Update(d.High, d.Low, d.Close)
+		if ok != (i >= atr.IdlePeriod()) {
+			t.Fatalf("index %d ready %v", i, ok)
+		}
+
+		if ok && helper.RoundDigit(actual, 2) != d.Atr {
+			t.Fatalf("index %d actual %v expected %v", i, actual, d.Atr)
+		}
+	}
+}
+
+func TestAtrClone(t *testing.T) {
+	atr := volatility.NewAtrWithPeriod[float64](3)
+
+	for _, value := range []float64{10, 11, 12, 13} {
+		atr.Update(value+1, value-1, value)
+	}
+
+	clone := atr.Clone()
+
+	_, ok := clone.Update(11, 9, 10)
+	if ok {
+		t.Fatal("clone is not fresh")
+	}
+}
+
+func TestAtrComputeSlice(t *testing.T) {
+	type Data struct {
+		High  float64
+		Low   float64
+		Close float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/atr.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := helper.ChanToSlice(input)
+	highs := make([]float64, len(data))
+	lows := make([]float64, len(data))
+	closings := make([]float64, len(data))
+
+	for i, d := range data {
+		highs[i], lows[i], closings[i] = d.High, d.Low, d.Close
+	}
+
+	atr := volatility.NewAtrWithPeriod[float64](50)
+	expected := atr.Compute(helper.SliceToChan(highs), helper.SliceToChan(lows), helper.SliceToChan(closings))
+	actual := helper.SliceToChan(atr.ComputeSlice(highs, lows, closings))
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAtrCheckpointWithHma(t *testing.T) {
+	type Data struct {
+		High  float64
+		Low   float64
+		Close float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/atr.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := helper.ChanToSlice(input)
+	split := len(data) / 2
+
+	expected := volatility.NewAtrWithMa(trend.NewHmaWithPeriod[float64](14))
+	atr := volatility.NewAtrWithMa(trend.NewHmaWithPeriod[float64](14))
+
+	for _, d := range data[:split] {
+		expected.Update(d.High, d.Low, d.Close)
+		atr.Update(d.High, d.Low, d.Close)
+	}
+
+	state, err := helper.Checkpoint(atr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	restored := volatility.NewAtrWithMa(trend.NewHmaWithPeriod[float64](14))
+
+	err = helper.Restore(restored, state)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, d := range data[split:] {
+		actual, actualOk := restored.Update(d.High, d.Low, d.Close)
+		want, wantOk := expected.Update(d.High, d.Low, d.Close)
+
+		if actual != want || actualOk != wantOk {
+			t.Fatalf("index %d actual %v expected %v", split+i, actual, want)
+		}
+	}
+}
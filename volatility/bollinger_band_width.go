@@ -5,6 +5,8 @@
 package volatility
 
 import (
+	"encoding/json"
+
 	"github.com/cinar/indicator/v2/helper"
 )
 
@@ -24,6 +26,9 @@ import (
 type BollingerBandWidth[T helper.Number] struct {
 	// Bollinger bands.
 	BollingerBands *BollingerBands[T]
+
+	// bollingerBands is the Bollinger Bands state.
+	bollingerBands *BollingerBands[T]
 }
 
 // NewBollingerBandWidth function initializes a new Bollinger Band Width instance with the default parameters.
@@ -35,12 +40,71 @@ func NewBollingerBandWidth[T helper.Number]() *BollingerBandWidth[T] {
 
 // Compute function takes a channel of numbers and computes the Bollinger Band Width.
 func (b *BollingerBandWidth[T]) Compute(c <-chan T) <-chan T {
-	upper, middle, lower := b.BollingerBands.Compute(c)
+	return helper.Compute(c, b.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the Bollinger Band Width.
+func (b *BollingerBandWidth[T]) ComputeSlice(c []T) []T {
+	return helper.ComputeSlice(c, b.Clone().Update)
+}
+
+// Update function takes a value and updates the Bollinger Band Width. It returns the Bollinger Band Width value, and whether the
+// Bollinger Band Width has passed its idle period and the value is ready.
+func (b *BollingerBandWidth[T]) Update(value T) (T, bool) {
+	if b.bollingerBands == nil {
+		b.bollingerBands = b.BollingerBands.Clone()
+	}
+
+	upper, middle, lower, ok := b.bollingerBands.Update(value)
+	if !ok {
+		return 0, false
+	}
+
+	return (upper - lower) / middle, true
+}
+
+// Reset function resets the state of the Bollinger Band Width.
+func (b *BollingerBandWidth[T]) Reset() {
+	b.bollingerBands = nil
+}
+
+// Clone function returns a new Bollinger Band Width instance with the same configuration and a fresh state.
+func (b *BollingerBandWidth[T]) Clone() *BollingerBandWidth[T] {
+	return &BollingerBandWidth[T]{
+		BollingerBands: b.BollingerBands.Clone(),
+	}
+}
+
+// bollingerBandWidthState is the state of the Bollinger Band Width.
+type bollingerBandWidthState struct {
+	// BollingerBands is the Bollinger Bands state.
+	BollingerBands json.RawMessage `json:"bollingerBands"`
+}
+
+// MarshalState function returns the state of the Bollinger Band Width as JSON.
+func (b *BollingerBandWidth[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := bollingerBandWidthState{}
+
+	state.BollingerBands, err = helper.MarshalStateOf(b.bollingerBands)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the Bollinger Band Width from the given JSON.
+func (b *BollingerBandWidth[T]) UnmarshalState(data []byte) error {
+	var state bollingerBandWidthState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
 
-	return helper.Divide(
-		helper.Subtract(upper, lower),
-		middle,
-	)
+	return helper.UnmarshalStateOf(&b.bollingerBands, state.BollingerBands, b.BollingerBands.Clone)
 }
 
 // IdlePeriod is the initial period that Bollinger Band Width won't yield any results.
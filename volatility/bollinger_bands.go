@@ -5,6 +5,8 @@
 package volatility
 
 import (
+	"encoding/json"
+
 	"github.com/cinar/indicator/v2/helper"
 	"github.com/cinar/indicator/v2/trend"
 )
@@ -29,6 +31,12 @@ const (
 type BollingerBands[T helper.Number] struct {
 	// Time period.
 	Period int
+
+	// sma is the SMA state.
+	sma *trend.Sma[T]
+
+	// std is the Moving Standard Deviation state.
+	std *MovingStd[T]
 }
 
 // NewBollingerBands function initializes a new Bollinger Bands instance with the default parameters.
@@ -45,34 +53,111 @@ func NewBollingerBandsWithPeriod[T helper.Number](period int) *BollingerBands[T]
 
 // Compute function takes a channel of numbers and computes the Bollinger Bands over the specified period.
 func (b *BollingerBands[T]) Compute(c <-chan T) (<-chan T, <-chan T, <-chan T) {
-	cs := helper.Duplicate(c, 2)
-	sma := trend.NewSmaWithPeriod[T](b.Period)
-	std := NewMovingStdWithPeriod[T](b.Period)
-
-	middleBands := helper.Duplicate(
-		sma.Compute(cs[0]),
-		3,
-	)
-
-	std2s := helper.Duplicate(
-		helper.MultiplyBy(
-			std.Compute(cs[1]),
-			2,
-		),
-		2,
-	)
-
-	upperBand := helper.Add(
-		middleBands[0],
-		std2s[0],
-	)
-
-	lowerBand := helper.Subtract(
-		middleBands[1],
-		std2s[1],
-	)
-
-	return upperBand, middleBands[2], lowerBand
+	bollingerBands := b.Clone()
+
+	values := helper.Compute(c, func(value T) ([]T, bool) {
+		upper, middle, lower, ok := bollingerBands.Update(value)
+		return []T{upper, middle, lower}, ok
+	})
+
+	outputs := helper.Unzip(values, 3)
+
+	return outputs[0], outputs[1], outputs[2]
+}
+
+// ComputeSlice function takes a slice of numbers and computes the Bollinger Bands over the specified period.
+func (b *BollingerBands[T]) ComputeSlice(c []T) ([]T, []T, []T) {
+	bollingerBands := b.Clone()
+
+	values := helper.ComputeSlice(c, func(value T) ([]T, bool) {
+		upper, middle, lower, ok := bollingerBands.Update(value)
+		return []T{upper, middle, lower}, ok
+	})
+
+	outputs := helper.UnzipSlice(values, 3)
+
+	return outputs[0], outputs[1], outputs[2]
+}
+
+// Update function takes a value and updates the Bollinger Bands. It returns the upper, middle, and lower
+// bands, and whether the Bollinger Bands has passed its idle period and the values are ready.
+func (b *BollingerBands[T]) Update(value T) (T, T, T, bool) {
+	if b.sma == nil {
+		b.sma = trend.NewSmaWithPeriod[T](b.Period)
+		b.std = NewMovingStdWithPeriod[T](b.Period)
+	}
+
+	middle, _ := b.sma.Update(value)
+
+	std, ok := b.std.Update(value)
+	if !ok {
+		return 0, 0, 0, false
+	}
+
+	std2 := std * 2
+
+	return middle + std2, middle, middle - std2, true
+}
+
+// Reset function resets the state of the Bollinger Bands.
+func (b *BollingerBands[T]) Reset() {
+	b.sma = nil
+	b.std = nil
+}
+
+// Clone function returns a new Bollinger Bands instance with the same configuration and a fresh state.
+func (b *BollingerBands[T]) Clone() *BollingerBands[T] {
+	return NewBollingerBandsWithPeriod[T](b.Period)
+}
+
+// bollingerBandsState is the state of the Bollinger Bands.
+type bollingerBandsState struct {
+	// Sma is the SMA state.
+	Sma json.RawMessage `json:"sma"`
+
+	// Std is the Moving Standard Deviation state.
+	Std json.RawMessage `json:"std"`
+}
+
+// MarshalState function returns the state of the Bollinger Bands as JSON.
+func (b *BollingerBands[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := bollingerBandsState{}
+
+	state.Sma, err = helper.MarshalStateOf(b.sma)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Std, err = helper.MarshalStateOf(b.std)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the Bollinger Bands from the given JSON.
+func (b *BollingerBands[T]) UnmarshalState(data []byte) error {
+	var state bollingerBandsState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&b.sma, state.Sma, func() *trend.Sma[T] { return trend.NewSmaWithPeriod[T](b.Period) })
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&b.std, state.Std, func() *MovingStd[T] { return NewMovingStdWithPeriod[T](b.Period) })
+	if err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // IdlePeriod is the initial period that Bollinger Bands won't yield any results.
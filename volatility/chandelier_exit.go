@@ -5,6 +5,8 @@
 package volatility
 
 import (
+	"encoding/json"
+
 	"github.com/cinar/indicator/v2/helper"
 	"github.com/cinar/indicator/v2/trend"
 )
@@ -33,6 +35,15 @@ type ChandelierExit[T helper.Number] struct {
 
 	// Multiplier is for sensitivity.
 	Multiplier T
+
+	// movingMax is the Moving Max state.
+	movingMax *trend.MovingMax[T]
+
+	// movingMin is the Moving Min state.
+	movingMin *trend.MovingMin[T]
+
+	// atr is the ATR state.
+	atr *Atr[T]
 }
 
 // NewChandelierExit function initializes a new Chandelier Exit instance with the default parameters.
@@ -45,36 +56,126 @@ func NewChandelierExit[T helper.Number]() *ChandelierExit[T] {
 
 // Compute function takes a channel of numbers and computes the Chandelier Exit over the specified period.
 func (c *ChandelierExit[T]) Compute(highs, lows, closings <-chan T) (<-chan T, <-chan T) {
-	highsSplice := helper.Duplicate(highs, 2)
-	lowsSplice := helper.Duplicate(lows, 2)
+	chandelierExit := c.Clone()
+
+	values := helper.Compute3(highs, lows, closings, func(high, low, closing T) ([]T, bool) {
+		ceLong, ceShort, ok := chandelierExit.Update(high, low, closing)
+		return []T{ceLong, ceShort}, ok
+	})
+
+	outputs := helper.Unzip(values, 2)
+
+	return outputs[0], outputs[1]
+}
+
+// ComputeSlice function takes a slice of numbers and computes the Chandelier Exit over the specified period.
+func (c *ChandelierExit[T]) ComputeSlice(highs, lows, closings []T) ([]T, []T) {
+	chandelierExit := c.Clone()
+
+	values := helper.ComputeSlice3(highs, lows, closings, func(high, low, closing T) ([]T, bool) {
+		ceLong, ceShort, ok := chandelierExit.Update(high, low, closing)
+		return []T{ceLong, ceShort}, ok
+	})
+
+	outputs := helper.UnzipSlice(values, 2)
+
+	return outputs[0], outputs[1]
+}
+
+// Update function takes a high, a low, and a closing value, and updates the Chandelier Exit. It returns
+// the long and the short values, and whether the Chandelier Exit has passed its idle period and the
+// values are ready.
+func (c *ChandelierExit[T]) Update(high, low, closing T) (T, T, bool) {
+	if c.atr == nil {
+		c.movingMax = trend.NewMovingMaxWithPeriod[T](c.Period)
+		c.movingMin = trend.NewMovingMinWithPeriod[T](c.Period)
+		c.atr = NewAtrWithPeriod[T](c.Period)
+	}
+
+	maxHigh, _ := c.movingMax.Update(high)
+	minLow, _ := c.movingMin.Update(low)
+
+	atr, ok := c.atr.Update(high, low, closing)
+	if !ok {
+		return 0, 0, false
+	}
+
+	atr3 := atr * c.Multiplier
+
+	return maxHigh - atr3, minLow + atr3, true
+}
 
-	movingMax := trend.NewMovingMaxWithPeriod[T](c.Period)
-	movingMin := trend.NewMovingMinWithPeriod[T](c.Period)
+// Reset function resets the state of the Chandelier Exit.
+func (c *ChandelierExit[T]) Reset() {
+	c.movingMax = nil
+	c.movingMin = nil
+	c.atr = nil
+}
+
+// Clone function returns a new Chandelier Exit instance with the same configuration and a fresh state.
+func (c *ChandelierExit[T]) Clone() *ChandelierExit[T] {
+	return &ChandelierExit[T]{
+		Period:     c.Period,
+		Multiplier: c.Multiplier,
+	}
+}
 
-	atr := NewAtrWithPeriod[T](c.Period)
+// chandelierExitState is the state of the Chandelier Exit.
+type chandelierExitState struct {
+	// MovingMax is the moving max state of the highs.
+	MovingMax json.RawMessage `json:"movingMax"`
 
-	maxHighs := helper.Skip(
-		movingMax.Compute(highsSplice[0]),
-		atr.IdlePeriod()-movingMax.IdlePeriod(),
-	)
+	// MovingMin is the moving min state of the lows.
+	MovingMin json.RawMessage `json:"movingMin"`
 
-	minLows := helper.Skip(
-		movingMin.Compute(lowsSplice[0]),
-		atr.IdlePeriod()-movingMin.IdlePeriod(),
-	)
+	// Atr is the ATR state.
+	Atr json.RawMessage `json:"atr"`
+}
 
-	atr3Splice := helper.Duplicate(
-		helper.MultiplyBy(
-			atr.Compute(highsSplice[1], lowsSplice[1], closings),
-			c.Multiplier,
-		),
-		2,
-	)
+// MarshalState function returns the state of the Chandelier Exit as JSON.
+func (c *ChandelierExit[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := chandelierExitState{}
+
+	state.MovingMax, err = helper.MarshalStateOf(c.movingMax)
+	if err != nil {
+		return nil, err
+	}
+
+	state.MovingMin, err = helper.MarshalStateOf(c.movingMin)
+	if err != nil {
+		return nil, err
+	}
 
-	ceLong := helper.Subtract(maxHighs, atr3Splice[0])
-	ceShort := helper.Add(minLows, atr3Splice[1])
+	state.Atr, err = helper.MarshalStateOf(c.atr)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the Chandelier Exit from the given JSON.
+func (c *ChandelierExit[T]) UnmarshalState(data []byte) error {
+	var state chandelierExitState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&c.movingMax, state.MovingMax, func() *trend.MovingMax[T] { return trend.NewMovingMaxWithPeriod[T](c.Period) })
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&c.movingMin, state.MovingMin, func() *trend.MovingMin[T] { return trend.NewMovingMinWithPeriod[T](c.Period) })
+	if err != nil {
+		return err
+	}
 
-	return ceLong, ceShort
+	return helper.UnmarshalStateOf(&c.atr, state.Atr, func() *Atr[T] { return NewAtrWithPeriod[T](c.Period) })
 }
 
 // IdlePeriod is the initial period that Chandelier Exit won't yield any results.
@@ -0,0 +1,236 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volatility_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/volatility"
+)
+
+// checkpointBar is a bar of the synthetic input of the checkpoint tests.
+type checkpointBar struct {
+	Open   float64
+	High   float64
+	Low    float64
+	Close  float64
+	Volume float64
+}
+
+// checkpointCase is an indicator that is checkpointed and restored.
+type checkpointCase struct {
+	// New returns a new instance of the indicator.
+	New func() helper.Stateful
+
+	// Update updates the given indicator with the given bar.
+	Update func(s helper.Stateful, bar checkpointBar) ([]float64, bool)
+}
+
+func checkpointBars(n int) []checkpointBar {
+	bars := make([]checkpointBar, n)
+
+	for i := range bars {
+		x := float64(i)
+		closing := 100 + 10*math.Sin(x/9) + 3*math.Sin(x/2.3) + 0.1*x
+
+		bars[i] = checkpointBar{
+			Open:   closing - 0.5*math.Cos(x),
+			High:   closing + 1 + 0.5*math.Abs(math.Sin(x/1.7)),
+			Low:    closing - 1 - 0.5*math.Abs(math.Cos(x/1.3)),
+			Close:  closing,
+			Volume: 1000 + 300*math.Sin(x/5),
+		}
+	}
+
+	return bars
+}
+
+func values1(value float64, ok bool) ([]float64, bool) {
+	return []float64{value}, ok
+}
+
+func values2(value1, value2 float64, ok bool) ([]float64, bool) {
+	return []float64{value1, value2}, ok
+}
+
+func values3(value1, value2, value3 float64, ok bool) ([]float64, bool) {
+	return []float64{value1, value2, value3}, ok
+}
+
+func TestCheckpointRestore(t *testing.T) {
+	cases := map[string]checkpointCase{
+		"AccelerationBands": {
+			New: func() helper.Stateful { return volatility.NewAccelerationBands[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values3(s.(*volatility.AccelerationBands[float64]).Update(b.High, b.Low, b.Close))
+			},
+		},
+		"Atr": {
+			New: func() helper.Stateful { return volatility.NewAtr[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*volatility.Atr[float64]).Update(b.High, b.Low, b.Close))
+			},
+		},
+		"BollingerBandWidth": {
+			New: func() helper.Stateful { return volatility.NewBollingerBandWidth[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*volatility.BollingerBandWidth[float64]).Update(b.Close))
+			},
+		},
+		"BollingerBands": {
+			New: func() helper.Stateful { return volatility.NewBollingerBands[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values3(s.(*volatility.BollingerBands[float64]).Update(b.Close))
+			},
+		},
+		"ChandelierExit": {
+			New: func() helper.Stateful { return volatility.NewChandelierExit[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values2(s.(*volatility.ChandelierExit[float64]).Update(b.High, b.Low, b.Close))
+			},
+		},
+		"DonchianChannel": {
+			New: func() helper.Stateful { return volatility.NewDonchianChannel[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values3(s.(*volatility.DonchianChannel[float64]).Update(b.Close))
+			},
+		},
+		"GarmanKlass": {
+			New: func() helper.Stateful { return volatility.NewGarmanKlass[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*volatility.GarmanKlass[float64]).Update(b.Open, b.High, b.Low, b.Close))
+			},
+		},
+		"HistoricalVolatility": {
+			New: func() helper.Stateful { return volatility.NewHistoricalVolatility[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*volatility.HistoricalVolatility[float64]).Update(b.Close))
+			},
+		},
+		"KeltnerChannel": {
+			New: func() helper.Stateful { return volatility.NewKeltnerChannel[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values3(s.(*volatility.KeltnerChannel[float64]).Update(b.High, b.Low, b.Close))
+			},
+		},
+		"MovingStd": {
+			New: func() helper.Stateful { return volatility.NewMovingStd[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*volatility.MovingStd[float64]).Update(b.Close))
+			},
+		},
+		"Natr": {
+			New: func() helper.Stateful { return volatility.NewNatr[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*volatility.Natr[float64]).Update(b.High, b.Low, b.Close))
+			},
+		},
+		"Parkinson": {
+			New: func() helper.Stateful { return volatility.NewParkinson[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*volatility.Parkinson[float64]).Update(b.High, b.Low))
+			},
+		},
+		"PercentB": {
+			New: func() helper.Stateful { return volatility.NewPercentB[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*volatility.PercentB[float64]).Update(b.Close))
+			},
+		},
+		"Po": {
+			New: func() helper.Stateful { return volatility.NewPo[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*volatility.Po[float64]).Update(b.High, b.Low, b.Close))
+			},
+		},
+		"RogersSatchell": {
+			New: func() helper.Stateful { return volatility.NewRogersSatchell[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*volatility.RogersSatchell[float64]).Update(b.Open, b.High, b.Low, b.Close))
+			},
+		},
+		"SuperTrend": {
+			New: func() helper.Stateful { return volatility.NewSuperTrend[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*volatility.SuperTrend[float64]).Update(b.High, b.Low, b.Close))
+			},
+		},
+		"UlcerIndex": {
+			New: func() helper.Stateful { return volatility.NewUlcerIndex[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*volatility.UlcerIndex[float64]).Update(b.Close))
+			},
+		},
+		"YangZhang": {
+			New: func() helper.Stateful { return volatility.NewYangZhang[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*volatility.YangZhang[float64]).Update(b.Open, b.High, b.Low, b.Close))
+			},
+		},
+	}
+
+	bars := checkpointBars(120)
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			checkCheckpointRestore(t, c, bars)
+		})
+	}
+}
+
+// checkCheckpointRestore checks that the indicator restored from a checkpoint taken after each bar
+// produces the same output as the uninterrupted one.
+func checkCheckpointRestore(t *testing.T, c checkpointCase, bars []checkpointBar) {
+	t.Helper()
+
+	type output struct {
+		Values []float64
+		Ok     bool
+	}
+
+	expected := make([]output, len(bars))
+
+	uninterrupted := c.New()
+	for i, bar := range bars {
+		expected[i].Values, expected[i].Ok = c.Update(uninterrupted, bar)
+	}
+
+	for split := 0; split <= len(bars); split++ {
+		actual := make([]output, len(bars))
+
+		before := c.New()
+		for i, bar := range bars[:split] {
+			actual[i].Values, actual[i].Ok = c.Update(before, bar)
+		}
+
+		data, err := helper.Checkpoint(before)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		after := c.New()
+
+		// The restored state replaces any state the indicator has.
+		for _, bar := range bars[:3] {
+			c.Update(after, bar)
+		}
+
+		err = helper.Restore(after, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i, bar := range bars[split:] {
+			actual[split+i].Values, actual[split+i].Ok = c.Update(after, bar)
+		}
+
+		if !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("split %d actual %v expected %v", split, actual, expected)
+		}
+	}
+}
@@ -5,6 +5,8 @@
 package volatility
 
 import (
+	"encoding/json"
+
 	"github.com/cinar/indicator/v2/helper"
 	"github.com/cinar/indicator/v2/trend"
 )
@@ -33,6 +35,12 @@ type DonchianChannel[T helper.Number] struct {
 
 	// Min is the Moving Min instance.
 	Min *trend.MovingMin[T]
+
+	// max is the Moving Max state.
+	max *trend.MovingMax[T]
+
+	// min is the Moving Min state.
+	min *trend.MovingMin[T]
 }
 
 // NewDonchianChannel function initializes a new Donchian Channel instance with the default parameters.
@@ -50,24 +58,113 @@ func NewDonchianChannelWithPeriod[T helper.Number](period int) *DonchianChannel[
 
 // Compute function takes a channel of numbers and computes the Donchian Channel over the specified period.
 func (d *DonchianChannel[T]) Compute(c <-chan T) (<-chan T, <-chan T, <-chan T) {
-	closings := helper.Duplicate(c, 2)
+	donchianChannel := d.Clone()
+
+	values := helper.Compute(c, func(closing T) ([]T, bool) {
+		upper, middle, lower, ok := donchianChannel.Update(closing)
+		return []T{upper, middle, lower}, ok
+	})
+
+	outputs := helper.Unzip(values, 3)
+
+	return outputs[0], outputs[1], outputs[2]
+}
+
+// ComputeSlice function takes a slice of numbers and computes the Donchian Channel over the specified period.
+func (d *DonchianChannel[T]) ComputeSlice(c []T) ([]T, []T, []T) {
+	donchianChannel := d.Clone()
+
+	values := helper.ComputeSlice(c, func(closing T) ([]T, bool) {
+		upper, middle, lower, ok := donchianChannel.Update(closing)
+		return []T{upper, middle, lower}, ok
+	})
+
+	outputs := helper.UnzipSlice(values, 3)
+
+	return outputs[0], outputs[1], outputs[2]
+}
+
+// Update function takes a closing value and updates the Donchian Channel. It returns the upper, middle,
+// and lower channels, and whether the Donchian Channel has passed its idle period and the values are
+// ready.
+func (d *DonchianChannel[T]) Update(closing T) (T, T, T, bool) {
+	if d.max == nil {
+		d.max = d.Max.Clone()
+		d.min = d.Min.Clone()
+	}
+
+	upper, _ := d.max.Update(closing)
+
+	lower, ok := d.min.Update(closing)
+	if !ok {
+		return 0, 0, 0, false
+	}
+
+	return upper, (upper + lower) / 2, lower, true
+}
+
+// Reset function resets the state of the Donchian Channel.
+func (d *DonchianChannel[T]) Reset() {
+	d.max = nil
+	d.min = nil
+}
+
+// Clone function returns a new Donchian Channel instance with the same configuration and a fresh state.
+func (d *DonchianChannel[T]) Clone() *DonchianChannel[T] {
+	return &DonchianChannel[T]{
+		Max: d.Max.Clone(),
+		Min: d.Min.Clone(),
+	}
+}
+
+// donchianChannelState is the state of the Donchian Channel.
+type donchianChannelState struct {
+	// Max is the Moving Max state.
+	Max json.RawMessage `json:"max"`
+
+	// Min is the Moving Min state.
+	Min json.RawMessage `json:"min"`
+}
+
+// MarshalState function returns the state of the Donchian Channel as JSON.
+func (d *DonchianChannel[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := donchianChannelState{}
 
-	uppers := helper.Duplicate(
-		d.Max.Compute(closings[0]),
-		2,
-	)
+	state.Max, err = helper.MarshalStateOf(d.max)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Min, err = helper.MarshalStateOf(d.min)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
 
-	lowers := helper.Duplicate(
-		d.Min.Compute(closings[1]),
-		2,
-	)
+// UnmarshalState function restores the state of the Donchian Channel from the given JSON.
+func (d *DonchianChannel[T]) UnmarshalState(data []byte) error {
+	var state donchianChannelState
 
-	middle := helper.DivideBy(
-		helper.Add(uppers[0], lowers[0]),
-		2,
-	)
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&d.max, state.Max, d.Max.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&d.min, state.Min, d.Min.Clone)
+	if err != nil {
+		return err
+	}
 
-	return uppers[1], middle, lowers[1]
+	return nil
 }
 
 // IdlePeriod is the initial period that Donchian Channel won't yield any results.
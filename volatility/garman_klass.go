@@ -0,0 +1,135 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volatility
+
+import (
+	"encoding/json"
+	"math"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+const (
+	// DefaultGarmanKlassPeriod is the default period for the Garman-Klass volatility.
+	DefaultGarmanKlassPeriod = 20
+)
+
+// GarmanKlass represents the configuration parameters for calculating the Garman-Klass volatility.
+// It extends the Parkinson volatility with the opening and the closing, assuming no drift and no
+// opening jumps. The result is an annualized ratio.
+//
+//	Term = 0.5 * Pow(Ln(High / Low), 2) - (2 * Ln(2) - 1) * Pow(Ln(Closing / Opening), 2)
+//	Garman-Klass = Sqrt(Annualization * Sum(Term) / Period)
+//
+// Example:
+//
+//	gk := volatility.NewGarmanKlass[float64]()
+//	result := gk.Compute(openings, highs, lows, closings)
+type GarmanKlass[T helper.Number] struct {
+	// Period is the number of bars.
+	Period int
+
+	// Annualization is the number of periods in a year.
+	Annualization float64
+
+	// sum is the moving sum state of the variance terms.
+	sum *trend.MovingSum[float64]
+}
+
+// NewGarmanKlass function initializes a new Garman-Klass volatility instance with the default parameters.
+func NewGarmanKlass[T helper.Number]() *GarmanKlass[T] {
+	return NewGarmanKlassWithPeriod[T](DefaultGarmanKlassPeriod)
+}
+
+// NewGarmanKlassWithPeriod function initializes a new Garman-Klass volatility instance with the given period.
+func NewGarmanKlassWithPeriod[T helper.Number](period int) *GarmanKlass[T] {
+	return &GarmanKlass[T]{
+		Period:        period,
+		Annualization: DefaultAnnualization,
+	}
+}
+
+// Compute function takes a channel of openings, highs, lows, and closings, and computes the
+// Garman-Klass volatility over the specified period.
+func (g *GarmanKlass[T]) Compute(openings, highs, lows, closings <-chan T) <-chan T {
+	return helper.Compute4(openings, highs, lows, closings, g.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of openings, highs, lows, and closings, and computes the
+// Garman-Klass volatility over the specified period.
+func (g *GarmanKlass[T]) ComputeSlice(openings, highs, lows, closings []T) []T {
+	return helper.ComputeSlice4(openings, highs, lows, closings, g.Clone().Update)
+}
+
+// Update function takes an opening, a high, a low, and a closing value, and updates the Garman-Klass volatility. It returns the
+// Garman-Klass volatility value, and whether the Garman-Klass volatility has passed its idle period and the value is ready.
+func (g *GarmanKlass[T]) Update(opening, high, low, closing T) (T, bool) {
+	if g.sum == nil {
+		g.sum = trend.NewMovingSumWithPeriod[float64](g.Period)
+	}
+
+	hl := math.Log(float64(high) / float64(low))
+	co := math.Log(float64(closing) / float64(opening))
+
+	term := 0.5*hl*hl - (2*math.Ln2-1)*co*co
+
+	variance, ok := movingMean(g.sum, term)
+	if !ok {
+		return 0, false
+	}
+
+	return annualize[T](variance, g.Annualization), true
+}
+
+// Reset function resets the state of the Garman-Klass volatility.
+func (g *GarmanKlass[T]) Reset() {
+	g.sum = nil
+}
+
+// Clone function returns a new Garman-Klass volatility instance with the same configuration and a fresh state.
+func (g *GarmanKlass[T]) Clone() *GarmanKlass[T] {
+	return &GarmanKlass[T]{
+		Period:        g.Period,
+		Annualization: g.Annualization,
+	}
+}
+
+// garmanKlassState is the state of the Garman-Klass volatility.
+type garmanKlassState struct {
+	// Sum is the moving sum state of the variance terms.
+	Sum json.RawMessage `json:"sum"`
+}
+
+// MarshalState function returns the state of the Garman-Klass volatility as JSON.
+func (g *GarmanKlass[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := garmanKlassState{}
+
+	state.Sum, err = helper.MarshalStateOf(g.sum)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the Garman-Klass volatility from the given JSON.
+func (g *GarmanKlass[T]) UnmarshalState(data []byte) error {
+	var state garmanKlassState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	return helper.UnmarshalStateOf(&g.sum, state.Sum, func() *trend.MovingSum[float64] { return trend.NewMovingSumWithPeriod[float64](g.Period) })
+}
+
+// IdlePeriod is the initial period that Garman-Klass volatility won't yield any results.
+func (g *GarmanKlass[T]) IdlePeriod() int {
+	return g.Period - 1
+}
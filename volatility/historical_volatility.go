@@ -0,0 +1,173 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volatility
+
+import (
+	"encoding/json"
+	"math"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+const (
+	// DefaultHistoricalVolatilityPeriod is the default period for the Historical Volatility.
+	DefaultHistoricalVolatilityPeriod = 20
+
+	// DefaultAnnualization is the default number of periods in a year used for annualizing the
+	// volatility estimators, which is the number of trading days.
+	DefaultAnnualization = 252
+)
+
+// HistoricalVolatility represents the configuration parameters for calculating the close-to-close
+// Historical Volatility. It is the annualized sample standard deviation of the logarithmic returns
+// over the specified period. The result is a ratio, such as 0.25 for 25%.
+//
+//	Return = Ln(Closing / Previous Closing)
+//	HV = Sqrt(Annualization * Sum(Pow(Return - Mean(Return), 2)) / (Period - 1))
+//
+// Example:
+//
+//	hv := volatility.NewHistoricalVolatility[float64]()
+//	result := hv.Compute(closings)
+type HistoricalVolatility[T helper.Number] struct {
+	// Period is the number of returns.
+	Period int
+
+	// Annualization is the number of periods in a year.
+	Annualization float64
+
+	// std is the Moving Standard Deviation state of the returns.
+	std *MovingStd[float64]
+
+	// previous is the previous closing.
+	previous T
+}
+
+// NewHistoricalVolatility function initializes a new Historical Volatility instance with the default parameters.
+func NewHistoricalVolatility[T helper.Number]() *HistoricalVolatility[T] {
+	return NewHistoricalVolatilityWithPeriod[T](DefaultHistoricalVolatilityPeriod)
+}
+
+// NewHistoricalVolatilityWithPeriod function initializes a new Historical Volatility instance with the given period.
+func NewHistoricalVolatilityWithPeriod[T helper.Number](period int) *HistoricalVolatility[T] {
+	return &HistoricalVolatility[T]{
+		Period:        period,
+		Annualization: DefaultAnnualization,
+	}
+}
+
+// Compute function takes a channel of closings and computes the Historical Volatility over the specified period.
+func (h *HistoricalVolatility[T]) Compute(closings <-chan T) <-chan T {
+	return helper.Compute(closings, h.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of closings and computes the Historical Volatility over the specified period.
+func (h *HistoricalVolatility[T]) ComputeSlice(closings []T) []T {
+	return helper.ComputeSlice(closings, h.Clone().Update)
+}
+
+// Update function takes a value and updates the Historical Volatility. It returns the Historical Volatility value, and whether the
+// Historical Volatility has passed its idle period and the value is ready.
+func (h *HistoricalVolatility[T]) Update(closing T) (T, bool) {
+	if h.std == nil {
+		h.std = NewMovingStdWithPeriod[float64](h.Period)
+		h.previous = closing
+
+		return 0, false
+	}
+
+	r := math.Log(float64(closing) / float64(h.previous))
+	h.previous = closing
+
+	std, ok := h.std.Update(r)
+	if !ok {
+		return 0, false
+	}
+
+	// Moving standard deviation is over the population, convert it to the sample variance.
+	variance := std * std * float64(h.Period) / float64(h.Period-1)
+
+	return annualize[T](variance, h.Annualization), true
+}
+
+// Reset function resets the state of the Historical Volatility.
+func (h *HistoricalVolatility[T]) Reset() {
+	h.std = nil
+	h.previous = 0
+}
+
+// Clone function returns a new Historical Volatility instance with the same configuration and a fresh state.
+func (h *HistoricalVolatility[T]) Clone() *HistoricalVolatility[T] {
+	return &HistoricalVolatility[T]{
+		Period:        h.Period,
+		Annualization: h.Annualization,
+	}
+}
+
+// historicalVolatilityState is the state of the Historical Volatility.
+type historicalVolatilityState[T helper.Number] struct {
+	// Std is the moving standard deviation state of the log returns.
+	Std json.RawMessage `json:"std"`
+
+	// Previous is the previous closing.
+	Previous T `json:"previous"`
+}
+
+// MarshalState function returns the state of the Historical Volatility as JSON.
+func (h *HistoricalVolatility[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := historicalVolatilityState[T]{
+		Previous: h.previous,
+	}
+
+	state.Std, err = helper.MarshalStateOf(h.std)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the Historical Volatility from the given JSON.
+func (h *HistoricalVolatility[T]) UnmarshalState(data []byte) error {
+	var state historicalVolatilityState[T]
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&h.std, state.Std, func() *MovingStd[float64] { return NewMovingStdWithPeriod[float64](h.Period) })
+	if err != nil {
+		return err
+	}
+
+	h.previous = state.Previous
+
+	return nil
+}
+
+// IdlePeriod is the initial period that Historical Volatility won't yield any results.
+func (h *HistoricalVolatility[T]) IdlePeriod() int {
+	return h.Period
+}
+
+// annualize takes a per period variance, and computes the annualized volatility.
+func annualize[T helper.Number](variance, annualization float64) T {
+	return T(math.Sqrt(math.Max(variance, 0) * annualization))
+}
+
+// movingMean takes a moving sum state and a value, and computes the mean of the values over the
+// period of the moving sum.
+func movingMean(sum *trend.MovingSum[float64], value float64) (float64, bool) {
+	total, ok := sum.Update(value)
+	if !ok {
+		return 0, false
+	}
+
+	return total / float64(sum.Period), true
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volatility_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/volatility"
+)
+
+func TestHistoricalVolatility(t *testing.T) {
+	type Data struct {
+		Close                float64
+		HistoricalVolatility float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/historical_volatility.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := helper.Duplicate(input, 2)
+	closings := helper.Map(inputs[0], func(d *Data) float64 { return d.Close })
+	expected := helper.Map(inputs[1], func(d *Data) float64 { return d.HistoricalVolatility })
+
+	hv := volatility.NewHistoricalVolatility[float64]()
+	actual := hv.Compute(closings)
+	actual = helper.RoundDigits(actual, 4)
+
+	expected = helper.Skip(expected, hv.IdlePeriod())
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -5,6 +5,8 @@
 package volatility
 
 import (
+	"encoding/json"
+
 	"github.com/cinar/indicator/v2/helper"
 	"github.com/cinar/indicator/v2/trend"
 )
@@ -16,11 +18,11 @@ const (
 
 // KeltnerChannel represents the configuration parameters for calculating the Keltner Channel (KC). It provides
 // volatility-based bands that are placed on either side of an asset's price and can aid in determining the
-// direction of a trend.
+// direction of a trend. The middle line is an EMA by default, and any other moving average can be used instead.
 //
-//	Middle Line = EMA(period, closings)
-//	Upper Band = EMA(period, closings) + 2 * ATR(period, highs, lows, closings)
-//	Lower Band = EMA(period, closings) - 2 * ATR(period, highs, lows, closings)
+//	Middle Line = MA(period, closings)
+//	Upper Band = MA(period, closings) + 2 * ATR(period, highs, lows, closings)
+//	Lower Band = MA(period, closings) - 2 * ATR(period, highs, lows, closings)
 //
 // Example:
 //
@@ -30,8 +32,14 @@ type KeltnerChannel[T helper.Number] struct {
 	// Atr is the ATR instance.
 	Atr *Atr[T]
 
-	// Ema is the EMA instance.
-	Ema *trend.Ema[T]
+	// Ma is the moving average instance for the middle line.
+	Ma trend.Ma[T]
+
+	// atr is the ATR state.
+	atr *Atr[T]
+
+	// ma is the moving average state.
+	ma trend.Ma[T]
 }
 
 // NewKeltnerChannel function initializes a new Keltner Channel instance with the default parameters.
@@ -41,44 +49,132 @@ func NewKeltnerChannel[T helper.Number]() *KeltnerChannel[T] {
 
 // NewKeltnerChannelWithPeriod function initializes a new Keltner Channel instance with the given period.
 func NewKeltnerChannelWithPeriod[T helper.Number](period int) *KeltnerChannel[T] {
+	return NewKeltnerChannelWithMa(trend.NewEmaWithPeriod[T](period), period)
+}
+
+// NewKeltnerChannelWithMa function initializes a new Keltner Channel instance with the given moving average
+// instance for the middle line, and the given ATR period.
+func NewKeltnerChannelWithMa[T helper.Number](ma trend.Ma[T], period int) *KeltnerChannel[T] {
 	return &KeltnerChannel[T]{
 		Atr: NewAtrWithPeriod[T](period),
-		Ema: trend.NewEmaWithPeriod[T](period),
+		Ma:  ma,
 	}
 }
 
 // Compute function takes a channel of numbers and computes the Keltner Channel over the specified period.
 func (k *KeltnerChannel[T]) Compute(highs, lows, closings <-chan T) (<-chan T, <-chan T, <-chan T) {
-	closingsSplice := helper.Duplicate(closings, 2)
+	keltnerChannel := k.Clone()
+
+	values := helper.Compute3(highs, lows, closings, func(high, low, closing T) ([]T, bool) {
+		upper, middle, lower, ok := keltnerChannel.Update(high, low, closing)
+		return []T{upper, middle, lower}, ok
+	})
+
+	outputs := helper.Unzip(values, 3)
+
+	return outputs[0], outputs[1], outputs[2]
+}
+
+// ComputeSlice function takes a slice of numbers and computes the Keltner Channel over the specified period.
+func (k *KeltnerChannel[T]) ComputeSlice(highs, lows, closings []T) ([]T, []T, []T) {
+	keltnerChannel := k.Clone()
+
+	values := helper.ComputeSlice3(highs, lows, closings, func(high, low, closing T) ([]T, bool) {
+		upper, middle, lower, ok := keltnerChannel.Update(high, low, closing)
+		return []T{upper, middle, lower}, ok
+	})
+
+	outputs := helper.UnzipSlice(values, 3)
+
+	return outputs[0], outputs[1], outputs[2]
+}
+
+// Update function takes a high, a low, and a closing value, and updates the Keltner Channel. It returns
+// the upper, middle, and lower bands, and whether the Keltner Channel has passed its idle period and the
+// values are ready.
+func (k *KeltnerChannel[T]) Update(high, low, closing T) (T, T, T, bool) {
+	if k.atr == nil {
+		k.atr = k.Atr.Clone()
+		k.ma = trend.CloneMa(k.Ma)
+	}
 
 	//	2 * ATR(period, highs, lows, closings)
-	atrs := helper.Duplicate(
-		helper.MultiplyBy(
-			k.Atr.Compute(highs, lows, closingsSplice[0]),
-			2,
-		),
-		2,
-	)
-
-	//	Middle Line = EMA(period, closings)
-	middles := helper.Duplicate(
-		helper.Skip(
-			k.Ema.Compute(closingsSplice[1]),
-			k.Atr.IdlePeriod()-k.Ema.IdlePeriod(),
-		),
-		3,
-	)
-
-	//	Upper Band = EMA(period, closings) + 2 * ATR(period, highs, lows, closings)
-	upper := helper.Add(middles[0], atrs[0])
-
-	//	Lower Band = EMA(period, closings) - 2 * ATR(period, highs, lows, closings)
-	lower := helper.Subtract(middles[1], atrs[1])
-
-	return upper, middles[2], lower
+	atr, atrOk := k.atr.Update(high, low, closing)
+
+	//	Middle Line = MA(period, closings)
+	middle, maOk := k.ma.Update(closing)
+
+	if !atrOk || !maOk {
+		return 0, 0, 0, false
+	}
+
+	atr2 := atr * 2
+
+	//	Upper Band = MA(period, closings) + 2 * ATR(period, highs, lows, closings)
+	//	Lower Band = MA(period, closings) - 2 * ATR(period, highs, lows, closings)
+	return middle + atr2, middle, middle - atr2, true
+}
+
+// Reset function resets the state of the Keltner Channel.
+func (k *KeltnerChannel[T]) Reset() {
+	k.atr = nil
+	k.ma = nil
+}
+
+// Clone function returns a new Keltner Channel instance with the same configuration and a fresh state.
+func (k *KeltnerChannel[T]) Clone() *KeltnerChannel[T] {
+	return &KeltnerChannel[T]{
+		Atr: k.Atr.Clone(),
+		Ma:  trend.CloneMa(k.Ma),
+	}
+}
+
+// keltnerChannelState is the state of the Keltner Channel.
+type keltnerChannelState struct {
+	// Atr is the ATR state.
+	Atr json.RawMessage `json:"atr"`
+
+	// Ma is the moving average state.
+	Ma json.RawMessage `json:"ma"`
+}
+
+// MarshalState function returns the state of the Keltner Channel as JSON.
+func (k *KeltnerChannel[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := keltnerChannelState{}
+
+	state.Atr, err = helper.MarshalStateOf(k.atr)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Ma, err = helper.MarshalInterfaceState(k.ma)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the Keltner Channel from the given JSON.
+func (k *KeltnerChannel[T]) UnmarshalState(data []byte) error {
+	var state keltnerChannelState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&k.atr, state.Atr, k.Atr.Clone)
+	if err != nil {
+		return err
+	}
+
+	return helper.UnmarshalInterfaceState(&k.ma, state.Ma, func() trend.Ma[T] { return trend.CloneMa(k.Ma) })
 }
 
 // IdlePeriod is the initial period that Keltner Channel won't yield any results.
 func (k *KeltnerChannel[T]) IdlePeriod() int {
-	return k.Atr.IdlePeriod()
+	return max(k.Atr.IdlePeriod(), k.Ma.IdlePeriod())
 }
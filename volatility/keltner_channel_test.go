@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/trend"
 	"github.com/cinar/indicator/v2/volatility"
 )
 
@@ -49,3 +50,38 @@ func TestKeltnerChannel(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestKeltnerChannelWithMa(t *testing.T) {
+	type Data struct {
+		High  float64
+		Low   float64
+		Close float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/keltner_channel.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := helper.Duplicate(input, 4)
+	highs := helper.Map(inputs[0], func(d *Data) float64 { return d.High })
+	lows := helper.Map(inputs[1], func(d *Data) float64 { return d.Low })
+	closings := helper.Map(inputs[2], func(d *Data) float64 { return d.Close })
+
+	t3 := trend.NewT3[float64]()
+	kc := volatility.NewKeltnerChannelWithMa[float64](t3, 5)
+
+	expectedMiddle := helper.Skip(
+		t3.Compute(helper.Map(inputs[3], func(d *Data) float64 { return d.Close })),
+		kc.IdlePeriod()-t3.IdlePeriod(),
+	)
+
+	actualUpper, actualMiddle, actualLower := kc.Compute(highs, lows, closings)
+	go helper.Drain(actualUpper)
+	go helper.Drain(actualLower)
+
+	err = helper.CheckEquals(actualMiddle, expectedMiddle)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -5,6 +5,7 @@
 package volatility
 
 import (
+	"encoding/json"
 	"math"
 
 	"github.com/cinar/indicator/v2/helper"
@@ -22,6 +23,12 @@ const (
 type MovingStd[T helper.Number] struct {
 	// Time period.
 	Period int
+
+	// window is the values in the period.
+	window *helper.Ring[T]
+
+	// sum is the sum of the values in the period.
+	sum T
 }
 
 // NewMovingStd function initializes a new Moving Standard Deviation instance with the default parameters.
@@ -38,34 +45,92 @@ func NewMovingStdWithPeriod[T helper.Number](period int) *MovingStd[T] {
 
 // Compute function takes a channel of numbers and computes the Moving Standard Deviation over the specified period.
 func (m *MovingStd[T]) Compute(c <-chan T) <-chan T {
-	result := make(chan T, cap(c))
+	return helper.Compute(c, m.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the Moving Standard Deviation over the specified period.
+func (m *MovingStd[T]) ComputeSlice(c []T) []T {
+	return helper.ComputeSlice(c, m.Clone().Update)
+}
+
+// Update function takes a value and updates the Moving Standard Deviation. It returns the Moving Standard Deviation value, and whether the
+// Moving Standard Deviation has passed its idle period and the value is ready.
+func (m *MovingStd[T]) Update(value T) (T, bool) {
+	if m.window == nil {
+		m.window = helper.NewRing[T](m.Period)
+	}
+
+	m.sum -= m.window.Put(value)
+	m.sum += value
+
+	if !m.window.IsFull() {
+		return 0, false
+	}
 
 	//	Std = Sqrt(1/Period * Sum(Pow(value - sma), 2))
-	go func() {
-		defer close(result)
+	sma := m.sum / T(m.Period)
+	sum2 := T(0)
 
-		ring := helper.NewRing[T](m.Period)
-		sum := T(0)
+	for i := 0; i < m.Period; i++ {
+		sum2 += T(math.Pow(float64(m.window.At(i)-sma), 2))
+	}
 
-		for n := range c {
-			sum -= ring.Put(n)
-			sum += n
+	return T(math.Sqrt(float64(sum2 / T(m.Period)))), true
+}
 
-			if ring.IsFull() {
-				sma := sum / T(m.Period)
-				sum2 := T(0)
+// Reset function resets the state of the Moving Standard Deviation.
+func (m *MovingStd[T]) Reset() {
+	m.window = nil
+	m.sum = 0
+}
+
+// Clone function returns a new Moving Standard Deviation instance with the same configuration and a fresh state.
+func (m *MovingStd[T]) Clone() *MovingStd[T] {
+	return NewMovingStdWithPeriod[T](m.Period)
+}
 
-				for i := 0; i < m.Period; i++ {
-					sum2 += T(math.Pow(float64(ring.At(i)-sma), 2))
-				}
+// movingStdState is the state of the Moving Standard Deviation.
+type movingStdState[T helper.Number] struct {
+	// Window is the values in the period.
+	Window json.RawMessage `json:"window"`
+
+	// Sum is the sum of the values in the period.
+	Sum T `json:"sum"`
+}
+
+// MarshalState function returns the state of the Moving Standard Deviation as JSON.
+func (m *MovingStd[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := movingStdState[T]{
+		Sum: m.sum,
+	}
+
+	state.Window, err = helper.MarshalStateOf(m.window)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the Moving Standard Deviation from the given JSON.
+func (m *MovingStd[T]) UnmarshalState(data []byte) error {
+	var state movingStdState[T]
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&m.window, state.Window, func() *helper.Ring[T] { return helper.NewRing[T](m.Period) })
+	if err != nil {
+		return err
+	}
 
-				std := T(math.Sqrt(float64(sum2 / T(m.Period))))
-				result <- std
-			}
-		}
-	}()
+	m.sum = state.Sum
 
-	return result
+	return nil
 }
 
 // IdlePeriod is the initial period that Moving Standard Deviation won't yield any results.
@@ -0,0 +1,115 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volatility
+
+import (
+	"encoding/json"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+// Natr represents the configuration parameters for calculating the Normalized Average True Range
+// (NATR). It expresses the ATR as a percentage of the closing, so that it can be compared across
+// assets and over long periods with different price levels.
+//
+//	NATR = 100 * ATR / Closing
+//
+// Example:
+//
+//	natr := volatility.NewNatr[float64]()
+//	result := natr.Compute(highs, lows, closings)
+type Natr[T helper.Number] struct {
+	// Atr is the ATR instance.
+	Atr *Atr[T]
+
+	// atr is the ATR state.
+	atr *Atr[T]
+}
+
+// NewNatr function initializes a new NATR instance with the default parameters.
+func NewNatr[T helper.Number]() *Natr[T] {
+	return NewNatrWithPeriod[T](DefaultAtrPeriod)
+}
+
+// NewNatrWithPeriod function initializes a new NATR instance with the given period.
+func NewNatrWithPeriod[T helper.Number](period int) *Natr[T] {
+	return &Natr[T]{
+		Atr: NewAtrWithPeriod[T](period),
+	}
+}
+
+// Compute function takes a channel of highs, lows, and closings, and computes the NATR over the specified period.
+func (n *Natr[T]) Compute(highs, lows, closings <-chan T) <-chan T {
+	return helper.Compute3(highs, lows, closings, n.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of highs, lows, and closings, and computes the NATR over the specified period.
+func (n *Natr[T]) ComputeSlice(highs, lows, closings []T) []T {
+	return helper.ComputeSlice3(highs, lows, closings, n.Clone().Update)
+}
+
+// Update function takes a high, a low, and a closing value, and updates the NATR. It returns the NATR
+// value, and whether the NATR has passed its idle period and the value is ready.
+func (n *Natr[T]) Update(high, low, closing T) (T, bool) {
+	if n.atr == nil {
+		n.atr = n.Atr.Clone()
+	}
+
+	atr, ok := n.atr.Update(high, low, closing)
+	if !ok {
+		return 0, false
+	}
+
+	return (atr / closing) * 100, true
+}
+
+// Reset function resets the state of the NATR.
+func (n *Natr[T]) Reset() {
+	n.atr = nil
+}
+
+// Clone function returns a new NATR instance with the same configuration and a fresh state.
+func (n *Natr[T]) Clone() *Natr[T] {
+	return &Natr[T]{
+		Atr: n.Atr.Clone(),
+	}
+}
+
+// natrState is the state of the NATR.
+type natrState struct {
+	// Atr is the ATR state.
+	Atr json.RawMessage `json:"atr"`
+}
+
+// MarshalState function returns the state of the NATR as JSON.
+func (n *Natr[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := natrState{}
+
+	state.Atr, err = helper.MarshalStateOf(n.atr)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the NATR from the given JSON.
+func (n *Natr[T]) UnmarshalState(data []byte) error {
+	var state natrState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	return helper.UnmarshalStateOf(&n.atr, state.Atr, n.Atr.Clone)
+}
+
+// IdlePeriod is the initial period that NATR won't yield any results.
+func (n *Natr[T]) IdlePeriod() int {
+	return n.Atr.IdlePeriod()
+}
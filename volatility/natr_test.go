@@ -0,0 +1,43 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volatility_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/volatility"
+)
+
+func TestNatr(t *testing.T) {
+	type Data struct {
+		High  float64
+		Low   float64
+		Close float64
+		Natr  float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/natr.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := helper.Duplicate(input, 4)
+	highs := helper.Map(inputs[0], func(d *Data) float64 { return d.High })
+	lows := helper.Map(inputs[1], func(d *Data) float64 { return d.Low })
+	closings := helper.Map(inputs[2], func(d *Data) float64 { return d.Close })
+	expected := helper.Map(inputs[3], func(d *Data) float64 { return d.Natr })
+
+	natr := volatility.NewNatr[float64]()
+	actual := natr.Compute(highs, lows, closings)
+	actual = helper.RoundDigits(actual, 4)
+
+	expected = helper.Skip(expected, natr.IdlePeriod())
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volatility
+
+import (
+	"encoding/json"
+	"math"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+const (
+	// DefaultParkinsonPeriod is the default period for the Parkinson volatility.
+	DefaultParkinsonPeriod = 20
+)
+
+// Parkinson represents the configuration parameters for calculating the Parkinson volatility. It
+// estimates the volatility from the high-low range, which is more efficient than the closings alone,
+// assuming no drift and no opening jumps. The result is an annualized ratio.
+//
+//	Parkinson = Sqrt(Annualization * Sum(Pow(Ln(High / Low), 2)) / (4 * Period * Ln(2)))
+//
+// Example:
+//
+//	parkinson := volatility.NewParkinson[float64]()
+//	result := parkinson.Compute(highs, lows)
+type Parkinson[T helper.Number] struct {
+	// Period is the number of bars.
+	Period int
+
+	// Annualization is the number of periods in a year.
+	Annualization float64
+
+	// sum is the moving sum state of the variance terms.
+	sum *trend.MovingSum[float64]
+}
+
+// NewParkinson function initializes a new Parkinson volatility instance with the default parameters.
+func NewParkinson[T helper.Number]() *Parkinson[T] {
+	return NewParkinsonWithPeriod[T](DefaultParkinsonPeriod)
+}
+
+// NewParkinsonWithPeriod function initializes a new Parkinson volatility instance with the given period.
+func NewParkinsonWithPeriod[T helper.Number](period int) *Parkinson[T] {
+	return &Parkinson[T]{
+		Period:        period,
+		Annualization: DefaultAnnualization,
+	}
+}
+
+// Compute function takes a channel of highs and lows, and computes the Parkinson volatility over the specified period.
+func (p *Parkinson[T]) Compute(highs, lows <-chan T) <-chan T {
+	return helper.Compute2(highs, lows, p.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of highs and lows, and computes the Parkinson volatility over the specified period.
+func (p *Parkinson[T]) ComputeSlice(highs, lows []T) []T {
+	return helper.ComputeSlice2(highs, lows, p.Clone().Update)
+}
+
+// Update function takes a high and a low value, and updates the Parkinson volatility. It returns the
+// Parkinson volatility value, and whether the Parkinson volatility has passed its idle period and the value is ready.
+func (p *Parkinson[T]) Update(high, low T) (T, bool) {
+	if p.sum == nil {
+		p.sum = trend.NewMovingSumWithPeriod[float64](p.Period)
+	}
+
+	term := math.Pow(math.Log(float64(high)/float64(low)), 2) / (4 * math.Ln2)
+
+	variance, ok := movingMean(p.sum, term)
+	if !ok {
+		return 0, false
+	}
+
+	return annualize[T](variance, p.Annualization), true
+}
+
+// Reset function resets the state of the Parkinson volatility.
+func (p *Parkinson[T]) Reset() {
+	p.sum = nil
+}
+
+// Clone function returns a new Parkinson volatility instance with the same configuration and a fresh state.
+func (p *Parkinson[T]) Clone() *Parkinson[T] {
+	return &Parkinson[T]{
+		Period:        p.Period,
+		Annualization: p.Annualization,
+	}
+}
+
+// parkinsonState is the state of the Parkinson volatility.
+type parkinsonState struct {
+	// Sum is the moving sum state of the variance terms.
+	Sum json.RawMessage `json:"sum"`
+}
+
+// MarshalState function returns the state of the Parkinson volatility as JSON.
+func (p *Parkinson[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := parkinsonState{}
+
+	state.Sum, err = helper.MarshalStateOf(p.sum)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the Parkinson volatility from the given JSON.
+func (p *Parkinson[T]) UnmarshalState(data []byte) error {
+	var state parkinsonState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	return helper.UnmarshalStateOf(&p.sum, state.Sum, func() *trend.MovingSum[float64] { return trend.NewMovingSumWithPeriod[float64](p.Period) })
+}
+
+// IdlePeriod is the initial period that Parkinson volatility won't yield any results.
+func (p *Parkinson[T]) IdlePeriod() int {
+	return p.Period - 1
+}
@@ -5,6 +5,7 @@
 package volatility
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/cinar/indicator/v2/helper"
@@ -16,6 +17,9 @@ import (
 type PercentB[T helper.Number] struct {
 	// BollingerBands is the underlying Bollinger Bands indicator used for calculations.
 	BollingerBands *BollingerBands[T]
+
+	// bollingerBands is the Bollinger Bands state.
+	bollingerBands *BollingerBands[T]
 }
 
 // NewPercentB function initializes a new %B instance with the default parameters.
@@ -32,21 +36,72 @@ func NewPercentBWithPeriod[T helper.Number](period int) *PercentB[T] {
 
 // Compute function takes a channel of numbers and computes the %B over the specified period.
 func (p *PercentB[T]) Compute(closings <-chan T) <-chan T {
-	closingsSplice := helper.Duplicate(closings, 2)
+	return helper.Compute(closings, p.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the %B over the specified period.
+func (p *PercentB[T]) ComputeSlice(closings []T) []T {
+	return helper.ComputeSlice(closings, p.Clone().Update)
+}
+
+// Update function takes a value and updates the %B. It returns the %B value, and whether the
+// %B has passed its idle period and the value is ready.
+func (p *PercentB[T]) Update(closing T) (T, bool) {
+	if p.bollingerBands == nil {
+		p.bollingerBands = p.BollingerBands.Clone()
+	}
+
+	upperBand, _, lowerBand, ok := p.bollingerBands.Update(closing)
+	if !ok {
+		return 0, false
+	}
+
+	// %B = (Close - Lower Band) / (Upper Band - Lower Band)
+	return (closing - lowerBand) / (upperBand - lowerBand), true
+}
+
+// Reset function resets the state of the %B.
+func (p *PercentB[T]) Reset() {
+	p.bollingerBands = nil
+}
 
-	// Compute the Bollinger Bands
-	upperBands, middleBands, lowerBands := p.BollingerBands.Compute(closingsSplice[0])
+// Clone function returns a new %B instance with the same configuration and a fresh state.
+func (p *PercentB[T]) Clone() *PercentB[T] {
+	return &PercentB[T]{
+		BollingerBands: p.BollingerBands.Clone(),
+	}
+}
+
+// percentBState is the state of the %B.
+type percentBState struct {
+	// BollingerBands is the Bollinger Bands state.
+	BollingerBands json.RawMessage `json:"bollingerBands"`
+}
+
+// MarshalState function returns the state of the %B as JSON.
+func (p *PercentB[T]) MarshalState() ([]byte, error) {
+	var err error
 
-	// Skip closings until the Bollinger Bands are available
-	closingsSplice[1] = helper.Skip(closingsSplice[1], p.BollingerBands.IdlePeriod())
+	state := percentBState{}
 
-	// Drain the middle bands
-	go helper.Drain(middleBands)
+	state.BollingerBands, err = helper.MarshalStateOf(p.bollingerBands)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the %B from the given JSON.
+func (p *PercentB[T]) UnmarshalState(data []byte) error {
+	var state percentBState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
 
-	return helper.Operate3(upperBands, lowerBands, closingsSplice[1], func(upperBand, lowerBand, closing T) T {
-		// %B = (Close - Lower Band) / (Upper Band - Lower Band)
-		return (closing - lowerBand) / (upperBand - lowerBand)
-	})
+	return helper.UnmarshalStateOf(&p.bollingerBands, state.BollingerBands, p.BollingerBands.Clone)
 }
 
 // IdlePeriod is the initial period that %B yield any results.
@@ -5,6 +5,8 @@
 package volatility
 
 import (
+	"encoding/json"
+
 	"github.com/cinar/indicator/v2/helper"
 	"github.com/cinar/indicator/v2/trend"
 )
@@ -34,6 +36,21 @@ type Po[T helper.Number] struct {
 
 	// Max is the Moving Max instance.
 	max *trend.MovingMax[T]
+
+	// highMls is the Moving Least Square state of the highs.
+	highMls *trend.Mls[T]
+
+	// lowMls is the Moving Least Square state of the lows.
+	lowMls *trend.Mls[T]
+
+	// plMin is the Moving Min state of the PL.
+	plMin *trend.MovingMin[T]
+
+	// phMax is the Moving Max state of the PH.
+	phMax *trend.MovingMax[T]
+
+	// x is the last x value.
+	x T
 }
 
 // NewPo function initializes a new PO instance with the default parameters.
@@ -52,62 +69,145 @@ func NewPoWithPeriod[T helper.Number](period int) *Po[T] {
 
 // Compute function takes a channel of numbers and computes the PO over the specified period.
 func (p *Po[T]) Compute(highs, lows, closings <-chan T) <-chan T {
-	highsSplice := helper.Duplicate(highs, 2)
-	lowsSplice := helper.Duplicate(lows, 2)
-	closingsSplice := helper.Duplicate(closings, 2)
+	return helper.Compute3(highs, lows, closings, p.Clone().Update)
+}
 
-	xSplice := helper.Duplicate(
-		helper.Count(T(1), closingsSplice[0]),
-		2,
-	)
+// ComputeSlice function takes a slice of numbers and computes the PO over the specified period.
+func (p *Po[T]) ComputeSlice(highs, lows, closings []T) []T {
+	return helper.ComputeSlice3(highs, lows, closings, p.Clone().Update)
+}
 
-	// PL = Min(period, (high + MLS(period, x, high)))
-	plM, plB := p.mls.Compute(xSplice[0], highsSplice[0])
-	go helper.Drain(plB)
+// Update function takes a high, a low, and a closing value, and updates the PO. It returns the PO value,
+// and whether the PO has passed its idle period and the value is ready.
+func (p *Po[T]) Update(high, low, closing T) (T, bool) {
+	if p.highMls == nil {
+		p.highMls = p.mls.Clone()
+		p.lowMls = p.mls.Clone()
+		p.plMin = p.min.Clone()
+		p.phMax = p.max.Clone()
+	}
 
-	highsSplice[1] = helper.Skip(highsSplice[1], p.mls.IdlePeriod())
+	p.x++
 
-	plSplice := helper.Duplicate(
-		p.min.Compute(
-			helper.Add(
-				highsSplice[1],
-				plM,
-			),
-		),
-		2,
-	)
+	// PL = Min(period, (high + MLS(period, x, high)))
+	plM, _, ok := p.highMls.Update(p.x, high)
+	if !ok {
+		return 0, false
+	}
 
-	// PH = Max(period, (low + MLS(period, x, low)))
-	phM, phB := p.mls.Compute(xSplice[1], lowsSplice[0])
-	go helper.Drain(phB)
+	pl, _ := p.plMin.Update(high + plM)
 
-	lowsSplice[1] = helper.Skip(lowsSplice[1], p.mls.IdlePeriod())
+	// PH = Max(period, (low + MLS(period, x, low)))
+	phM, _, _ := p.lowMls.Update(p.x, low)
 
-	ph := p.max.Compute(
-		helper.Add(
-			lowsSplice[1],
-			phM,
-		),
-	)
+	ph, ok := p.phMax.Update(low + phM)
+	if !ok {
+		return 0, false
+	}
 
 	// PO = 100 * (Closing - PL) / (PH - PL)
-	closingsSplice[1] = helper.Skip(closingsSplice[1], p.mls.IdlePeriod()+p.min.IdlePeriod())
-
-	po := helper.MultiplyBy(
-		helper.Divide(
-			helper.Subtract(
-				closingsSplice[1],
-				plSplice[0],
-			),
-			helper.Subtract(
-				ph,
-				plSplice[1],
-			),
-		),
-		T(100),
-	)
-
-	return po
+	return ((closing - pl) / (ph - pl)) * T(100), true
+}
+
+// Reset function resets the state of the PO.
+func (p *Po[T]) Reset() {
+	p.highMls = nil
+	p.lowMls = nil
+	p.plMin = nil
+	p.phMax = nil
+	p.x = 0
+}
+
+// Clone function returns a new PO instance with the same configuration and a fresh state.
+func (p *Po[T]) Clone() *Po[T] {
+	return &Po[T]{
+		mls: p.mls.Clone(),
+		min: p.min.Clone(),
+		max: p.max.Clone(),
+	}
+}
+
+// poState is the state of the PO.
+type poState[T helper.Number] struct {
+	// HighMls is the Moving Least Square state of the highs.
+	HighMls json.RawMessage `json:"highMls"`
+
+	// LowMls is the Moving Least Square state of the lows.
+	LowMls json.RawMessage `json:"lowMls"`
+
+	// PlMin is the moving min state of the projected lows.
+	PlMin json.RawMessage `json:"plMin"`
+
+	// PhMax is the moving max state of the projected highs.
+	PhMax json.RawMessage `json:"phMax"`
+
+	// X is the number of values processed.
+	X T `json:"x"`
+}
+
+// MarshalState function returns the state of the PO as JSON.
+func (p *Po[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := poState[T]{
+		X: p.x,
+	}
+
+	state.HighMls, err = helper.MarshalStateOf(p.highMls)
+	if err != nil {
+		return nil, err
+	}
+
+	state.LowMls, err = helper.MarshalStateOf(p.lowMls)
+	if err != nil {
+		return nil, err
+	}
+
+	state.PlMin, err = helper.MarshalStateOf(p.plMin)
+	if err != nil {
+		return nil, err
+	}
+
+	state.PhMax, err = helper.MarshalStateOf(p.phMax)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the PO from the given JSON.
+func (p *Po[T]) UnmarshalState(data []byte) error {
+	var state poState[T]
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&p.highMls, state.HighMls, p.mls.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&p.lowMls, state.LowMls, p.mls.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&p.plMin, state.PlMin, p.min.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&p.phMax, state.PhMax, p.max.Clone)
+	if err != nil {
+		return err
+	}
+
+	p.x = state.X
+
+	return nil
 }
 
 // IdlePeriod is the initial period that PO won't yield any results.
@@ -0,0 +1,138 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volatility
+
+import (
+	"encoding/json"
+	"math"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/trend"
+)
+
+const (
+	// DefaultRogersSatchellPeriod is the default period for the Rogers-Satchell volatility.
+	DefaultRogersSatchellPeriod = 20
+)
+
+// RogersSatchell represents the configuration parameters for calculating the Rogers-Satchell
+// volatility. Unlike the Parkinson and the Garman-Klass volatility, it allows for a drift, but
+// still assumes no opening jumps. The result is an annualized ratio.
+//
+//	Term = Ln(High / Closing) * Ln(High / Opening) + Ln(Low / Closing) * Ln(Low / Opening)
+//	Rogers-Satchell = Sqrt(Annualization * Sum(Term) / Period)
+//
+// Example:
+//
+//	rs := volatility.NewRogersSatchell[float64]()
+//	result := rs.Compute(openings, highs, lows, closings)
+type RogersSatchell[T helper.Number] struct {
+	// Period is the number of bars.
+	Period int
+
+	// Annualization is the number of periods in a year.
+	Annualization float64
+
+	// sum is the moving sum state of the variance terms.
+	sum *trend.MovingSum[float64]
+}
+
+// NewRogersSatchell function initializes a new Rogers-Satchell volatility instance with the default parameters.
+func NewRogersSatchell[T helper.Number]() *RogersSatchell[T] {
+	return NewRogersSatchellWithPeriod[T](DefaultRogersSatchellPeriod)
+}
+
+// NewRogersSatchellWithPeriod function initializes a new Rogers-Satchell volatility instance with the given period.
+func NewRogersSatchellWithPeriod[T helper.Number](period int) *RogersSatchell[T] {
+	return &RogersSatchell[T]{
+		Period:        period,
+		Annualization: DefaultAnnualization,
+	}
+}
+
+// Compute function takes a channel of openings, highs, lows, and closings, and computes the
+// Rogers-Satchell volatility over the specified period.
+func (r *RogersSatchell[T]) Compute(openings, highs, lows, closings <-chan T) <-chan T {
+	return helper.Compute4(openings, highs, lows, closings, r.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of openings, highs, lows, and closings, and computes the
+// Rogers-Satchell volatility over the specified period.
+func (r *RogersSatchell[T]) ComputeSlice(openings, highs, lows, closings []T) []T {
+	return helper.ComputeSlice4(openings, highs, lows, closings, r.Clone().Update)
+}
+
+// Update function takes an opening, a high, a low, and a closing value, and updates the Rogers-Satchell volatility. It returns the
+// Rogers-Satchell volatility value, and whether the Rogers-Satchell volatility has passed its idle period and the value is ready.
+func (r *RogersSatchell[T]) Update(opening, high, low, closing T) (T, bool) {
+	if r.sum == nil {
+		r.sum = trend.NewMovingSumWithPeriod[float64](r.Period)
+	}
+
+	term := rogersSatchellTerm(opening, high, low, closing)
+
+	variance, ok := movingMean(r.sum, term)
+	if !ok {
+		return 0, false
+	}
+
+	return annualize[T](variance, r.Annualization), true
+}
+
+// Reset function resets the state of the Rogers-Satchell volatility.
+func (r *RogersSatchell[T]) Reset() {
+	r.sum = nil
+}
+
+// Clone function returns a new Rogers-Satchell volatility instance with the same configuration and a fresh state.
+func (r *RogersSatchell[T]) Clone() *RogersSatchell[T] {
+	return &RogersSatchell[T]{
+		Period:        r.Period,
+		Annualization: r.Annualization,
+	}
+}
+
+// rogersSatchellState is the state of the Rogers-Satchell volatility.
+type rogersSatchellState struct {
+	// Sum is the moving sum state of the variance terms.
+	Sum json.RawMessage `json:"sum"`
+}
+
+// MarshalState function returns the state of the Rogers-Satchell volatility as JSON.
+func (r *RogersSatchell[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := rogersSatchellState{}
+
+	state.Sum, err = helper.MarshalStateOf(r.sum)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the Rogers-Satchell volatility from the given JSON.
+func (r *RogersSatchell[T]) UnmarshalState(data []byte) error {
+	var state rogersSatchellState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	return helper.UnmarshalStateOf(&r.sum, state.Sum, func() *trend.MovingSum[float64] { return trend.NewMovingSumWithPeriod[float64](r.Period) })
+}
+
+// IdlePeriod is the initial period that Rogers-Satchell volatility won't yield any results.
+func (r *RogersSatchell[T]) IdlePeriod() int {
+	return r.Period - 1
+}
+
+// rogersSatchellTerm computes the Rogers-Satchell variance term of a single bar.
+func rogersSatchellTerm[T helper.Number](opening, high, low, closing T) float64 {
+	o, h, l, c := float64(opening), float64(high), float64(low), float64(closing)
+	return math.Log(h/c)*math.Log(h/o) + math.Log(l/c)*math.Log(l/o)
+}
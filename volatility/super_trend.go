@@ -5,6 +5,8 @@
 package volatility
 
 import (
+	"encoding/json"
+
 	"github.com/cinar/indicator/v2/helper"
 	"github.com/cinar/indicator/v2/trend"
 )
@@ -39,6 +41,24 @@ const (
 type SuperTrend[T helper.Number] struct {
 	Atr        *Atr[T]
 	Multiplier T
+
+	// atr is the ATR state.
+	atr *Atr[T]
+
+	// upTrend indicates whether the trend is up.
+	upTrend bool
+
+	// previousClosing is the previous closing.
+	previousClosing T
+
+	// finalUpperBand is the last final upper band.
+	finalUpperBand T
+
+	// finalLowerBand is the last final lower band.
+	finalLowerBand T
+
+	// started indicates whether the initial final bands are computed.
+	started bool
 }
 
 // NewSuperTrend function initializes a new Super Trend instance with the default parameters.
@@ -70,92 +90,166 @@ func NewSuperTrendWithMa[T helper.Number](ma trend.Ma[T], multiplier T) *SuperTr
 
 // Compute function calculates the Super Trend, using separate channels for highs, lows, and closings.
 func (s *SuperTrend[T]) Compute(highs, lows, closings <-chan T) <-chan T {
-	highsSplice := helper.Duplicate(highs, 2)
-	lowsSplice := helper.Duplicate(lows, 2)
-	closingsSplice := helper.Duplicate(closings, 2)
-
-	medians :=
-		helper.Skip(
-			helper.DivideBy(
-				helper.Add(highsSplice[0], lowsSplice[0]),
-				2,
-			),
-			s.Atr.IdlePeriod(),
-		)
-
-	atrMultiples :=
-		helper.MultiplyBy(
-			s.Atr.Compute(highsSplice[1], lowsSplice[1], closingsSplice[0]),
-			s.Multiplier,
-		)
-
-	closingsSplice[1] = helper.Skip(closingsSplice[1], s.Atr.IdlePeriod())
-
-	first := true
-	upTrend := false
-	var previousClosing T
-	var finalUpperBand T
-	var finalLowerBand T
-
-	superTrend := helper.Operate3(medians, atrMultiples, closingsSplice[1], func(median, atrMultiple, closing T) T {
-		//	BasicUpperBands = (High + Low) / 2 + Multiplier * ATR
-		basicUpperBand := median + atrMultiple
-
-		//	BasicLowerBands = (High + Low) / 2 - Multiplier * ATR
-		basicLowerBand := median - atrMultiple
-
-		var superTrend T
-
-		if first {
-			first = false
-			finalUpperBand = basicUpperBand
-			finalLowerBand = basicLowerBand
-			superTrend = finalLowerBand
-		} else {
-			//	FinalUpperBands = If (BasicUpperBand < PreviousFinalUpperBand)
-			//	                  Or (PreviousClose > PreviousFinalUpperBand)
-			//	                  Then BasicUpperBand Else PreviousFinalUpperBand
-			if (basicUpperBand < finalUpperBand) || (previousClosing > finalUpperBand) {
-				finalUpperBand = basicUpperBand
-			}
+	return helper.Compute3(highs, lows, closings, s.Clone().Update)
+}
 
-			//	FinalLowerBands = If (BasicLowerBand > PreviousFinalLowerBand)
-			//	                  Or (PreviousClose < PreviousFinalLowerBand)
-			//	                  Then BasicLowerBand Else PreviousFinalLowerBand
-			if (basicLowerBand > finalLowerBand) || (previousClosing < finalLowerBand) {
-				finalLowerBand = basicLowerBand
-			}
+// ComputeSlice function calculates the Super Trend, using separate slices for highs, lows, and closings.
+func (s *SuperTrend[T]) ComputeSlice(highs, lows, closings []T) []T {
+	return helper.ComputeSlice3(highs, lows, closings, s.Clone().Update)
+}
+
+// Update function takes a high, a low, and a closing value, and updates the Super Trend. It returns the
+// Super Trend value, and whether the Super Trend has passed its idle period and the value is ready.
+func (s *SuperTrend[T]) Update(high, low, closing T) (T, bool) {
+	if s.atr == nil {
+		s.atr = s.Atr.Clone()
+	}
 
-			//	SuperTrend = If upTrend
-			//				 Then
-			//	               If (Close <= FinalUpperBand) Then FinalUpperBand Else FinalLowerBand
-			//	             Else
-			//	               If (Close >= FinalLowerBand) Then FinalLowerBand Else FinalUpperBand
-			//
-			//	UpTrend = If (SuperTrend == FinalUpperBand) Then True Else False
-			if upTrend {
-				if closing <= finalUpperBand {
-					superTrend = finalUpperBand
-				} else {
-					superTrend = finalLowerBand
-					upTrend = false
-				}
+	atr, ok := s.atr.Update(high, low, closing)
+	if !ok {
+		return 0, false
+	}
+
+	median := (high + low) / 2
+	atrMultiple := atr * s.Multiplier
+
+	//	BasicUpperBands = (High + Low) / 2 + Multiplier * ATR
+	basicUpperBand := median + atrMultiple
+
+	//	BasicLowerBands = (High + Low) / 2 - Multiplier * ATR
+	basicLowerBand := median - atrMultiple
+
+	var superTrend T
+
+	if !s.started {
+		s.started = true
+		s.finalUpperBand = basicUpperBand
+		s.finalLowerBand = basicLowerBand
+		superTrend = s.finalLowerBand
+	} else {
+		//	FinalUpperBands = If (BasicUpperBand < PreviousFinalUpperBand)
+		//	                  Or (PreviousClose > PreviousFinalUpperBand)
+		//	                  Then BasicUpperBand Else PreviousFinalUpperBand
+		if (basicUpperBand < s.finalUpperBand) || (s.previousClosing > s.finalUpperBand) {
+			s.finalUpperBand = basicUpperBand
+		}
+
+		//	FinalLowerBands = If (BasicLowerBand > PreviousFinalLowerBand)
+		//	                  Or (PreviousClose < PreviousFinalLowerBand)
+		//	                  Then BasicLowerBand Else PreviousFinalLowerBand
+		if (basicLowerBand > s.finalLowerBand) || (s.previousClosing < s.finalLowerBand) {
+			s.finalLowerBand = basicLowerBand
+		}
+
+		//	SuperTrend = If upTrend
+		//				 Then
+		//	               If (Close <= FinalUpperBand) Then FinalUpperBand Else FinalLowerBand
+		//	             Else
+		//	               If (Close >= FinalLowerBand) Then FinalLowerBand Else FinalUpperBand
+		//
+		//	UpTrend = If (SuperTrend == FinalUpperBand) Then True Else False
+		if s.upTrend {
+			if closing <= s.finalUpperBand {
+				superTrend = s.finalUpperBand
 			} else {
-				if closing >= finalLowerBand {
-					superTrend = finalLowerBand
-				} else {
-					superTrend = finalUpperBand
-					upTrend = true
-				}
+				superTrend = s.finalLowerBand
+				s.upTrend = false
+			}
+		} else {
+			if closing >= s.finalLowerBand {
+				superTrend = s.finalLowerBand
+			} else {
+				superTrend = s.finalUpperBand
+				s.upTrend = true
 			}
 		}
+	}
 
-		previousClosing = closing
+	s.previousClosing = closing
+
+	return superTrend, true
+}
+
+// Reset function resets the state of the Super Trend.
+func (s *SuperTrend[T]) Reset() {
+	s.atr = nil
+	s.upTrend = false
+	s.previousClosing = 0
+	s.finalUpperBand = 0
+	s.finalLowerBand = 0
+	s.started = false
+}
+
+// Clone function returns a new Super Trend instance with the same configuration and a fresh state.
+func (s *SuperTrend[T]) Clone() *SuperTrend[T] {
+	return &SuperTrend[T]{
+		Atr:        s.Atr.Clone(),
+		Multiplier: s.Multiplier,
+	}
+}
+
+// superTrendState is the state of the Super Trend.
+type superTrendState[T helper.Number] struct {
+	// Atr is the ATR state.
+	Atr json.RawMessage `json:"atr"`
+
+	// UpTrend indicates whether the trend is up.
+	UpTrend bool `json:"upTrend"`
+
+	// PreviousClosing is the previous closing.
+	PreviousClosing T `json:"previousClosing"`
+
+	// FinalUpperBand is the last final upper band.
+	FinalUpperBand T `json:"finalUpperBand"`
+
+	// FinalLowerBand is the last final lower band.
+	FinalLowerBand T `json:"finalLowerBand"`
+
+	// Started indicates whether the initial final bands are computed.
+	Started bool `json:"started"`
+}
+
+// MarshalState function returns the state of the Super Trend as JSON.
+func (s *SuperTrend[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := superTrendState[T]{
+		UpTrend:         s.upTrend,
+		PreviousClosing: s.previousClosing,
+		FinalUpperBand:  s.finalUpperBand,
+		FinalLowerBand:  s.finalLowerBand,
+		Started:         s.started,
+	}
+
+	state.Atr, err = helper.MarshalStateOf(s.atr)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the Super Trend from the given JSON.
+func (s *SuperTrend[T]) UnmarshalState(data []byte) error {
+	var state superTrendState[T]
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&s.atr, state.Atr, s.Atr.Clone)
+	if err != nil {
+		return err
+	}
 
-		return superTrend
-	})
+	s.upTrend = state.UpTrend
+	s.previousClosing = state.PreviousClosing
+	s.finalUpperBand = state.FinalUpperBand
+	s.finalLowerBand = state.FinalLowerBand
+	s.started = state.Started
 
-	return superTrend
+	return nil
 }
 
 // IdlePeriod is the initial period that Super Trend won't yield any results.
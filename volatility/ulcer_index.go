@@ -5,6 +5,9 @@
 package volatility
 
 import (
+	"encoding/json"
+	"math"
+
 	"github.com/cinar/indicator/v2/helper"
 	"github.com/cinar/indicator/v2/trend"
 )
@@ -30,6 +33,12 @@ const (
 type UlcerIndex[T helper.Number] struct {
 	// Time period.
 	Period int
+
+	// movingMax is the Moving Max state of the closings.
+	movingMax *trend.MovingMax[T]
+
+	// sma is the SMA state of the percentage drawdowns.
+	sma *trend.Sma[T]
 }
 
 // NewUlcerIndex function initializes a new Ulcer Index instance with the default parameters.
@@ -41,37 +50,99 @@ func NewUlcerIndex[T helper.Number]() *UlcerIndex[T] {
 
 // Compute function takes a channel of numbers and computes the Ulcer Index over the specified period.
 func (u *UlcerIndex[T]) Compute(closings <-chan T) <-chan T {
-	closingsSplice := helper.Duplicate(closings, 2)
+	return helper.Compute(closings, u.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the Ulcer Index over the specified period.
+func (u *UlcerIndex[T]) ComputeSlice(closings []T) []T {
+	return helper.ComputeSlice(closings, u.Clone().Update)
+}
+
+// Update function takes a value and updates the Ulcer Index. It returns the Ulcer Index value, and whether the
+// Ulcer Index has passed its idle period and the value is ready.
+func (u *UlcerIndex[T]) Update(closing T) (T, bool) {
+	if u.movingMax == nil {
+		u.movingMax = trend.NewMovingMaxWithPeriod[T](u.Period)
+		u.sma = trend.NewSmaWithPeriod[T](u.Period)
+	}
 
 	//	High Closings = Max(period, Closings)
-	movingMax := trend.NewMovingMaxWithPeriod[T](u.Period)
-	highsSplice := helper.Duplicate(
-		movingMax.Compute(closingsSplice[0]),
-		2,
-	)
+	highClosing, ok := u.movingMax.Update(closing)
+	if !ok {
+		return 0, false
+	}
 
 	//	Percentage Drawdown = 100 * ((Closings - High Closings) / High Closings)
-	closingsSplice[1] = helper.Skip(closingsSplice[1], movingMax.Period-1)
-
-	percentageDrawdown := helper.MultiplyBy(
-		helper.Divide(
-			helper.Subtract(closingsSplice[1], highsSplice[0]),
-			highsSplice[1],
-		),
-		100,
-	)
+	percentageDrawdown := ((closing - highClosing) / highClosing) * 100
 
 	//	Squared Average = Sma(period, Percent Drawdown * Percent Drawdown)
-	sma := trend.NewSmaWithPeriod[T](u.Period)
-	squaredAverage := helper.Pow(
-		sma.Compute(percentageDrawdown),
-		2,
-	)
+	average, ok := u.sma.Update(percentageDrawdown)
+	if !ok {
+		return 0, false
+	}
+
+	squaredAverage := T(math.Pow(float64(average), 2))
 
 	// Ulcer Index = Sqrt(Squared Average)
-	ulcerIndex := helper.Sqrt(squaredAverage)
+	return T(math.Sqrt(float64(squaredAverage))), true
+}
+
+// Reset function resets the state of the Ulcer Index.
+func (u *UlcerIndex[T]) Reset() {
+	u.movingMax = nil
+	u.sma = nil
+}
+
+// Clone function returns a new Ulcer Index instance with the same configuration and a fresh state.
+func (u *UlcerIndex[T]) Clone() *UlcerIndex[T] {
+	return &UlcerIndex[T]{
+		Period: u.Period,
+	}
+}
+
+// ulcerIndexState is the state of the Ulcer Index.
+type ulcerIndexState struct {
+	// MovingMax is the moving max state of the closings.
+	MovingMax json.RawMessage `json:"movingMax"`
+
+	// Sma is the SMA state of the percentage drawdowns.
+	Sma json.RawMessage `json:"sma"`
+}
+
+// MarshalState function returns the state of the Ulcer Index as JSON.
+func (u *UlcerIndex[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := ulcerIndexState{}
+
+	state.MovingMax, err = helper.MarshalStateOf(u.movingMax)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Sma, err = helper.MarshalStateOf(u.sma)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the Ulcer Index from the given JSON.
+func (u *UlcerIndex[T]) UnmarshalState(data []byte) error {
+	var state ulcerIndexState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&u.movingMax, state.MovingMax, func() *trend.MovingMax[T] { return trend.NewMovingMaxWithPeriod[T](u.Period) })
+	if err != nil {
+		return err
+	}
 
-	return ulcerIndex
+	return helper.UnmarshalStateOf(&u.sma, state.Sma, func() *trend.Sma[T] { return trend.NewSmaWithPeriod[T](u.Period) })
 }
 
 // IdlePeriod is the initial period that Ulcer Index won't yield any results.
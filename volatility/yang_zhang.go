@@ -0,0 +1,227 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volatility
+
+import (
+	"encoding/json"
+	"math"
+
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultYangZhangPeriod is the default period for the Yang-Zhang volatility.
+	DefaultYangZhangPeriod = 20
+)
+
+// YangZhang represents the configuration parameters for calculating the Yang-Zhang volatility. It
+// combines the overnight volatility, the open-to-close volatility, and the Rogers-Satchell
+// volatility, allowing for both a drift and opening jumps. The result is an annualized ratio.
+//
+//	Overnight = Ln(Opening / Previous Closing)
+//	Open to Close = Ln(Closing / Opening)
+//	K = 0.34 / (1.34 + (Period + 1) / (Period - 1))
+//	Variance = Var(Overnight) + K * Var(Open to Close) + (1 - K) * Mean(Rogers-Satchell Term)
+//	Yang-Zhang = Sqrt(Annualization * Variance)
+//
+// The variances are sample variances over the period.
+//
+// Example:
+//
+//	yz := volatility.NewYangZhang[float64]()
+//	result := yz.Compute(openings, highs, lows, closings)
+type YangZhang[T helper.Number] struct {
+	// Period is the number of bars.
+	Period int
+
+	// Annualization is the number of periods in a year.
+	Annualization float64
+
+	// overnights is the overnight returns in the period.
+	overnights *helper.Ring[float64]
+
+	// openToCloses is the open to close returns in the period.
+	openToCloses *helper.Ring[float64]
+
+	// terms is the Rogers-Satchell terms in the period.
+	terms *helper.Ring[float64]
+
+	// previous is the previous closing.
+	previous float64
+}
+
+// NewYangZhang function initializes a new Yang-Zhang volatility instance with the default parameters.
+func NewYangZhang[T helper.Number]() *YangZhang[T] {
+	return NewYangZhangWithPeriod[T](DefaultYangZhangPeriod)
+}
+
+// NewYangZhangWithPeriod function initializes a new Yang-Zhang volatility instance with the given period.
+func NewYangZhangWithPeriod[T helper.Number](period int) *YangZhang[T] {
+	return &YangZhang[T]{
+		Period:        period,
+		Annualization: DefaultAnnualization,
+	}
+}
+
+// Compute function takes a channel of openings, highs, lows, and closings, and computes the
+// Yang-Zhang volatility over the specified period.
+func (y *YangZhang[T]) Compute(openings, highs, lows, closings <-chan T) <-chan T {
+	return helper.Compute4(openings, highs, lows, closings, y.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of openings, highs, lows, and closings, and computes the
+// Yang-Zhang volatility over the specified period.
+func (y *YangZhang[T]) ComputeSlice(openings, highs, lows, closings []T) []T {
+	return helper.ComputeSlice4(openings, highs, lows, closings, y.Clone().Update)
+}
+
+// Update function takes an opening, a high, a low, and a closing value, and updates the Yang-Zhang
+// volatility. It returns the Yang-Zhang volatility value, and whether the Yang-Zhang volatility has
+// passed its idle period and the value is ready.
+func (y *YangZhang[T]) Update(opening, high, low, closing T) (T, bool) {
+	// The first bar has no previous closing.
+	if y.terms == nil {
+		y.overnights = helper.NewRing[float64](y.Period)
+		y.openToCloses = helper.NewRing[float64](y.Period)
+		y.terms = helper.NewRing[float64](y.Period)
+		y.previous = float64(closing)
+
+		return 0, false
+	}
+
+	y.overnights.Put(math.Log(float64(opening) / y.previous))
+	y.openToCloses.Put(math.Log(float64(closing) / float64(opening)))
+	y.terms.Put(rogersSatchellTerm(opening, high, low, closing))
+
+	y.previous = float64(closing)
+
+	if !y.terms.IsFull() {
+		return 0, false
+	}
+
+	n := float64(y.Period)
+	k := 0.34 / (1.34 + (n+1)/(n-1))
+
+	variance := sampleVariance(y.overnights, y.Period) +
+		k*sampleVariance(y.openToCloses, y.Period) +
+		(1-k)*mean(y.terms, y.Period)
+
+	return T(math.Sqrt(math.Max(variance, 0) * y.Annualization)), true
+}
+
+// Reset function resets the state of the Yang-Zhang volatility.
+func (y *YangZhang[T]) Reset() {
+	y.overnights = nil
+	y.openToCloses = nil
+	y.terms = nil
+	y.previous = 0
+}
+
+// Clone function returns a new Yang-Zhang volatility instance with the same configuration and a fresh state.
+func (y *YangZhang[T]) Clone() *YangZhang[T] {
+	return &YangZhang[T]{
+		Period:        y.Period,
+		Annualization: y.Annualization,
+	}
+}
+
+// yangZhangState is the state of the Yang-Zhang volatility.
+type yangZhangState struct {
+	// Overnights is the overnight log returns in the period.
+	Overnights json.RawMessage `json:"overnights"`
+
+	// OpenToCloses is the open to close log returns in the period.
+	OpenToCloses json.RawMessage `json:"openToCloses"`
+
+	// Terms is the Rogers-Satchell terms in the period.
+	Terms json.RawMessage `json:"terms"`
+
+	// Previous is the previous closing.
+	Previous float64 `json:"previous"`
+}
+
+// MarshalState function returns the state of the Yang-Zhang volatility as JSON.
+func (y *YangZhang[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := yangZhangState{
+		Previous: y.previous,
+	}
+
+	state.Overnights, err = helper.MarshalStateOf(y.overnights)
+	if err != nil {
+		return nil, err
+	}
+
+	state.OpenToCloses, err = helper.MarshalStateOf(y.openToCloses)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Terms, err = helper.MarshalStateOf(y.terms)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the Yang-Zhang volatility from the given JSON.
+func (y *YangZhang[T]) UnmarshalState(data []byte) error {
+	var state yangZhangState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	init := func() *helper.Ring[float64] { return helper.NewRing[float64](y.Period) }
+
+	err = helper.UnmarshalStateOf(&y.overnights, state.Overnights, init)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&y.openToCloses, state.OpenToCloses, init)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&y.terms, state.Terms, init)
+	if err != nil {
+		return err
+	}
+
+	y.previous = state.Previous
+
+	return nil
+}
+
+// IdlePeriod is the initial period that Yang-Zhang volatility won't yield any results.
+func (y *YangZhang[T]) IdlePeriod() int {
+	return y.Period
+}
+
+// mean computes the mean of the values in the given full ring.
+func mean(ring *helper.Ring[float64], size int) float64 {
+	sum := 0.0
+	for i := 0; i < size; i++ {
+		sum += ring.At(i)
+	}
+
+	return sum / float64(size)
+}
+
+// sampleVariance computes the sample variance of the values in the given full ring.
+func sampleVariance(ring *helper.Ring[float64], size int) float64 {
+	average := mean(ring, size)
+
+	sum := 0.0
+	for i := 0; i < size; i++ {
+		sum += math.Pow(ring.At(i)-average, 2)
+	}
+
+	return sum / float64(size-1)
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volatility_test
+
+import (
+	"testing"
+
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/volatility"
+)
+
+func TestYangZhang(t *testing.T) {
+	type Data struct {
+		Open      float64
+		High      float64
+		Low       float64
+		Close     float64
+		YangZhang float64
+	}
+
+	input, err := helper.ReadFromCsvFile[Data]("testdata/yang_zhang.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := helper.Duplicate(input, 5)
+	openings := helper.Map(inputs[0], func(d *Data) float64 { return d.Open })
+	highs := helper.Map(inputs[1], func(d *Data) float64 { return d.High })
+	lows := helper.Map(inputs[2], func(d *Data) float64 { return d.Low })
+	closings := helper.Map(inputs[3], func(d *Data) float64 { return d.Close })
+	expected := helper.Map(inputs[4], func(d *Data) float64 { return d.YangZhang })
+
+	yz := volatility.NewYangZhang[float64]()
+	actual := yz.Compute(openings, highs, lows, closings)
+	actual = helper.RoundDigits(actual, 4)
+
+	expected = helper.Skip(expected, yz.IdlePeriod())
+
+	err = helper.CheckEquals(actual, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
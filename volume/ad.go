@@ -4,7 +4,11 @@
 
 package volume
 
-import "github.com/cinar/indicator/v2/helper"
+import (
+	"encoding/json"
+
+	"github.com/cinar/indicator/v2/helper"
+)
 
 // Ad holds configuration parameters for calculating Accumulation/Distribution (A/D). It is a cumulative
 // indicator that uses volume and price to assess whether an asset is being accumulated or distributed.
@@ -20,6 +24,9 @@ import "github.com/cinar/indicator/v2/helper"
 type Ad[T helper.Number] struct {
 	// Mfv is the MFV instance.
 	Mfv *Mfv[T]
+
+	// ad is the last A/D value.
+	ad T
 }
 
 // NewAd function initializes a new A/D instance with the default parameters.
@@ -31,14 +38,64 @@ func NewAd[T helper.Number]() *Ad[T] {
 
 // Compute function takes a channel of numbers and computes the A/D.
 func (a *Ad[T]) Compute(highs, lows, closings, volumes <-chan T) <-chan T {
+	return helper.Compute4(highs, lows, closings, volumes, a.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the A/D.
+func (a *Ad[T]) ComputeSlice(highs, lows, closings, volumes []T) []T {
+	return helper.ComputeSlice4(highs, lows, closings, volumes, a.Clone().Update)
+}
+
+// Update function takes a high, a low, a closing, and a volume value, and updates the A/D. It returns
+// the A/D value, and whether the value is ready. The A/D does not have an idle period.
+func (a *Ad[T]) Update(high, low, closing, volume T) (T, bool) {
 	//	MFM = ((Closing - Low) - (High - Closing)) / (High - Low)
 	//	MFV = MFM * Period Volume
-	mfvs := a.Mfv.Compute(highs, lows, closings, volumes)
+	mfv, _ := a.Mfv.Update(high, low, closing, volume)
 
 	//	AD = Previous AD + CMFV
-	return helper.MapWithPrevious(mfvs, func(previous, current T) T {
-		return previous + current
-	}, 0)
+	a.ad += mfv
+
+	return a.ad, true
+}
+
+// Reset function resets the state of the A/D.
+func (a *Ad[T]) Reset() {
+	a.ad = 0
+}
+
+// Clone function returns a new A/D instance with the same configuration and a fresh state.
+func (a *Ad[T]) Clone() *Ad[T] {
+	return &Ad[T]{
+		Mfv: a.Mfv,
+	}
+}
+
+// adState is the state of the A/D.
+type adState[T helper.Number] struct {
+	// Ad is the last A/D value.
+	Ad T `json:"ad"`
+}
+
+// MarshalState function returns the state of the A/D as JSON.
+func (a *Ad[T]) MarshalState() ([]byte, error) {
+	return json.Marshal(&adState[T]{
+		Ad: a.ad,
+	})
+}
+
+// UnmarshalState function restores the state of the A/D from the given JSON.
+func (a *Ad[T]) UnmarshalState(data []byte) error {
+	var state adState[T]
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	a.ad = state.Ad
+
+	return nil
 }
 
 // IdlePeriod is the initial period that A/D won't yield any results.
@@ -0,0 +1,237 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volume
+
+import (
+	"encoding/json"
+	"math"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultAnchoredVwapMultiplier is the default standard deviation multiplier for the anchored
+	// VWAP bands.
+	DefaultAnchoredVwapMultiplier = 2
+)
+
+// AnchoredVwap holds configuration parameters for calculating the anchored Volume Weighted Average
+// Price (VWAP). Unlike the rolling VWAP, it accumulates the typical price and the volume from the
+// start of each session, and resets when a new session starts. The sessions are determined by the
+// session timeframe, such as daily, weekly, a trading session, or from an anchor date. The bands
+// are placed at the given multiple of the volume weighted standard deviation of the typical price.
+//
+//	Typical Price = (High + Low + Closing) / 3
+//	VWAP = Sum(Typical Price * Volume) / Sum(Volume)
+//	Variance = Sum(Typical Price^2 * Volume) / Sum(Volume) - VWAP^2
+//	Upper Band = VWAP + Multiplier * Sqrt(Variance)
+//	Lower Band = VWAP - Multiplier * Sqrt(Variance)
+//
+// Example:
+//
+//	anchoredVwap := volume.NewAnchoredVwap[float64]()
+//	upper, vwap, lower := anchoredVwap.Compute(dates, highs, lows, closings, volumes)
+type AnchoredVwap[T helper.Number] struct {
+	// Session is the timeframe of the sessions.
+	Session asset.Timeframe
+
+	// Multiplier is the standard deviation multiplier for the bands.
+	Multiplier float64
+
+	// session is the start of the current session.
+	session time.Time
+
+	// started indicates whether a session has started.
+	started bool
+
+	// sumVolume is the sum of the volumes in the current session.
+	sumVolume float64
+
+	// sumPrice is the sum of the volume weighted typical prices in the current session.
+	sumPrice float64
+
+	// sumSquare is the sum of the volume weighted squared typical prices in the current session.
+	sumSquare float64
+}
+
+// NewAnchoredVwap function initializes a new anchored VWAP instance with the default parameters.
+func NewAnchoredVwap[T helper.Number]() *AnchoredVwap[T] {
+	return NewAnchoredVwapWith[T](asset.Daily, DefaultAnchoredVwapMultiplier)
+}
+
+// NewAnchoredVwapWith function initializes a new anchored VWAP instance with the given parameters.
+func NewAnchoredVwapWith[T helper.Number](session asset.Timeframe, multiplier float64) *AnchoredVwap[T] {
+	return &AnchoredVwap[T]{
+		Session:    session,
+		Multiplier: multiplier,
+	}
+}
+
+// Compute function takes a channel of dates, highs, lows, closings, and volumes, and computes the
+// anchored VWAP along with its upper and lower bands. Returns upper band, VWAP, and lower band. A
+// session without any volume yields its typical price.
+func (a *AnchoredVwap[T]) Compute(dates <-chan time.Time, highs, lows, closings, volumes <-chan T) (<-chan T, <-chan T, <-chan T) {
+	uppers := make(chan T, cap(closings))
+	vwaps := make(chan T, cap(closings))
+	lowers := make(chan T, cap(closings))
+
+	go func() {
+		defer close(uppers)
+		defer close(vwaps)
+		defer close(lowers)
+		defer helper.Drain(dates)
+		defer helper.Drain(highs)
+		defer helper.Drain(lows)
+		defer helper.Drain(closings)
+		defer helper.Drain(volumes)
+
+		clone := a.Clone()
+
+		for date := range dates {
+			high, ok := <-highs
+			if !ok {
+				break
+			}
+
+			low, ok := <-lows
+			if !ok {
+				break
+			}
+
+			closing, ok := <-closings
+			if !ok {
+				break
+			}
+
+			volume, ok := <-volumes
+			if !ok {
+				break
+			}
+
+			upper, vwap, lower, _ := clone.Update(date, high, low, closing, volume)
+
+			uppers <- upper
+			vwaps <- vwap
+			lowers <- lower
+		}
+	}()
+
+	return uppers, vwaps, lowers
+}
+
+// ComputeSlice function takes a slice of dates, highs, lows, closings, and volumes, and computes the
+// anchored VWAP along with its upper and lower bands. Returns upper band, VWAP, and lower band. A
+// session without any volume yields its typical price.
+func (a *AnchoredVwap[T]) ComputeSlice(dates []time.Time, highs, lows, closings, volumes []T) ([]T, []T, []T) {
+	n := min(len(dates), len(highs), len(lows), len(closings), len(volumes))
+
+	uppers := make([]T, n)
+	vwaps := make([]T, n)
+	lowers := make([]T, n)
+
+	clone := a.Clone()
+
+	for i := 0; i < n; i++ {
+		uppers[i], vwaps[i], lowers[i], _ = clone.Update(dates[i], highs[i], lows[i], closings[i], volumes[i])
+	}
+
+	return uppers, vwaps, lowers
+}
+
+// Update function takes a date, a high, a low, a closing, and a volume value, and updates the
+// anchored VWAP. It returns the upper band, the VWAP, and the lower band values, and whether the
+// values are ready. The anchored VWAP does not have an idle period.
+func (a *AnchoredVwap[T]) Update(date time.Time, high, low, closing, volume T) (T, T, T, bool) {
+	start := a.Session(date)
+	if !a.started || !start.Equal(a.session) {
+		a.started = true
+		a.session = start
+		a.sumVolume, a.sumPrice, a.sumSquare = 0, 0, 0
+	}
+
+	typicalPrice := float64(high+low+closing) / 3
+
+	a.sumVolume += float64(volume)
+	a.sumPrice += typicalPrice * float64(volume)
+	a.sumSquare += typicalPrice * typicalPrice * float64(volume)
+
+	vwap := typicalPrice
+	std := 0.0
+
+	if a.sumVolume > 0 {
+		vwap = a.sumPrice / a.sumVolume
+		std = math.Sqrt(math.Max(a.sumSquare/a.sumVolume-vwap*vwap, 0))
+	}
+
+	return T(vwap + a.Multiplier*std), T(vwap), T(vwap - a.Multiplier*std), true
+}
+
+// Reset function resets the state of the anchored VWAP.
+func (a *AnchoredVwap[T]) Reset() {
+	a.session = time.Time{}
+	a.started = false
+	a.sumVolume, a.sumPrice, a.sumSquare = 0, 0, 0
+}
+
+// Clone function returns a new anchored VWAP instance with the same configuration and a fresh state.
+func (a *AnchoredVwap[T]) Clone() *AnchoredVwap[T] {
+	return NewAnchoredVwapWith[T](a.Session, a.Multiplier)
+}
+
+// anchoredVwapState is the state of the anchored VWAP.
+type anchoredVwapState struct {
+	// Session is the start of the current session.
+	Session time.Time `json:"session"`
+
+	// Started indicates whether a session has started.
+	Started bool `json:"started"`
+
+	// SumVolume is the sum of the volumes in the current session.
+	SumVolume float64 `json:"sumVolume"`
+
+	// SumPrice is the sum of the volume weighted typical prices in the current session.
+	SumPrice float64 `json:"sumPrice"`
+
+	// SumSquare is the sum of the volume weighted squared typical prices in the current session.
+	SumSquare float64 `json:"sumSquare"`
+}
+
+// MarshalState function returns the state of the anchored VWAP as JSON.
+func (a *AnchoredVwap[T]) MarshalState() ([]byte, error) {
+	state := anchoredVwapState{
+		Session:   a.session,
+		Started:   a.started,
+		SumVolume: a.sumVolume,
+		SumPrice:  a.sumPrice,
+		SumSquare: a.sumSquare,
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the anchored VWAP from the given JSON.
+func (a *AnchoredVwap[T]) UnmarshalState(data []byte) error {
+	var state anchoredVwapState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	a.session = state.Session
+	a.started = state.Started
+	a.sumVolume = state.SumVolume
+	a.sumPrice = state.SumPrice
+	a.sumSquare = state.SumSquare
+
+	return nil
+}
+
+// IdlePeriod is the initial period that anchored VWAP won't yield any results.
+func (*AnchoredVwap[T]) IdlePeriod() int {
+	return 0
+}
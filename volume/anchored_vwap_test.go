@@ -0,0 +1,123 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volume_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/volume"
+)
+
+func TestAnchoredVwap(t *testing.T) {
+	type AnchoredVwapData struct {
+		Date   time.Time
+		High   float64
+		Low    float64
+		Close  float64
+		Volume int64
+		Upper  float64
+		Vwap   float64
+		Lower  float64
+	}
+
+	input, err := helper.ReadFromCsvFile[AnchoredVwapData]("testdata/anchored_vwap.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inputs := helper.Duplicate(input, 8)
+	dates := helper.Map(inputs[0], func(m *AnchoredVwapData) time.Time { return m.Date })
+	highs := helper.Map(inputs[1], func(m *AnchoredVwapData) float64 { return m.High })
+	lows := helper.Map(inputs[2], func(m *AnchoredVwapData) float64 { return m.Low })
+	closings := helper.Map(inputs[3], func(m *AnchoredVwapData) float64 { return m.Close })
+	volumes := helper.Map(inputs[4], func(m *AnchoredVwapData) float64 { return float64(m.Volume) })
+	expectedUpper := helper.Map(inputs[5], func(m *AnchoredVwapData) float64 { return m.Upper })
+	expectedVwap := helper.Map(inputs[6], func(m *AnchoredVwapData) float64 { return m.Vwap })
+	expectedLower := helper.Map(inputs[7], func(m *AnchoredVwapData) float64 { return m.Lower })
+
+	anchoredVwap := volume.NewAnchoredVwapWith[float64](asset.Monthly, 2)
+	actualUpper, actualVwap, actualLower := anchoredVwap.Compute(dates, highs, lows, closings, volumes)
+
+	actualUpper = helper.RoundDigits(actualUpper, 2)
+	actualVwap = helper.RoundDigits(actualVwap, 2)
+	actualLower = helper.RoundDigits(actualLower, 2)
+
+	err = helper.CheckEquals(actualUpper, expectedUpper, actualVwap, expectedVwap, actualLower, expectedLower)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAnchoredVwapWithoutVolume(t *testing.T) {
+	dates := helper.SliceToChan([]time.Time{
+		time.Date(2024, 1, 1, 9, 30, 0, 0, time.UTC),
+		time.Date(2024, 1, 1, 9, 31, 0, 0, time.UTC),
+	})
+	highs := helper.SliceToChan([]float64{12, 15})
+	lows := helper.SliceToChan([]float64{9, 12})
+	closings := helper.SliceToChan([]float64{12, 12})
+	volumes := helper.SliceToChan([]float64{0, 0})
+
+	anchoredVwap := volume.NewAnchoredVwap[float64]()
+	uppers, vwaps, lowers := anchoredVwap.Compute(dates, highs, lows, closings, volumes)
+
+	expected := helper.SliceToChan([]float64{11, 13})
+	expectedSplice := helper.Duplicate(expected, 3)
+
+	err := helper.CheckEquals(uppers, expectedSplice[0], vwaps, expectedSplice[1], lowers, expectedSplice[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAnchoredVwapComputeSlice(t *testing.T) {
+	type AnchoredVwapData struct {
+		Date   time.Time
+		High   float64
+		Low    float64
+		Close  float64
+		Volume int64
+	}
+
+	input, err := helper.ReadFromCsvFile[AnchoredVwapData]("testdata/anchored_vwap.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := helper.ChanToSlice(input)
+	dates := make([]time.Time, len(data))
+	highs := make([]float64, len(data))
+	lows := make([]float64, len(data))
+	closings := make([]float64, len(data))
+	volumes := make([]float64, len(data))
+
+	for i, d := range data {
+		dates[i], highs[i], lows[i], closings[i], volumes[i] = d.Date, d.High, d.Low, d.Close, float64(d.Volume)
+	}
+
+	anchoredVwap := volume.NewAnchoredVwapWith[float64](asset.Monthly, 2)
+
+	expectedUpper, expectedVwap, expectedLower := anchoredVwap.Compute(
+		helper.SliceToChan(dates),
+		helper.SliceToChan(highs),
+		helper.SliceToChan(lows),
+		helper.SliceToChan(closings),
+		helper.SliceToChan(volumes),
+	)
+
+	actualUpper, actualVwap, actualLower := anchoredVwap.ComputeSlice(dates, highs, lows, closings, volumes)
+
+	err = helper.CheckEquals(
+		helper.SliceToChan(actualUpper), expectedUpper,
+		helper.SliceToChan(actualVwap), expectedVwap,
+		helper.SliceToChan(actualLower), expectedLower,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
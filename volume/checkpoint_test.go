@@ -0,0 +1,235 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volume_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/volume"
+)
+
+// checkpointBar is a bar of the synthetic input of the checkpoint tests.
+type checkpointBar struct {
+	Date   time.Time
+	Open   float64
+	High   float64
+	Low    float64
+	Close  float64
+	Volume float64
+}
+
+// checkpointCase is an indicator that is checkpointed and restored.
+type checkpointCase struct {
+	// New returns a new instance of the indicator.
+	New func() helper.Stateful
+
+	// Update updates the given indicator with the given bar.
+	Update func(s helper.Stateful, bar checkpointBar) ([]float64, bool)
+}
+
+func checkpointBars(n int) []checkpointBar {
+	bars := make([]checkpointBar, n)
+
+	for i := range bars {
+		x := float64(i)
+		closing := 100 + 10*math.Sin(x/9) + 3*math.Sin(x/2.3) + 0.1*x
+
+		bars[i] = checkpointBar{
+			Date:   time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC).Add(time.Duration(i) * 6 * time.Hour),
+			Open:   closing - 0.5*math.Cos(x),
+			High:   closing + 1 + 0.5*math.Abs(math.Sin(x/1.7)),
+			Low:    closing - 1 - 0.5*math.Abs(math.Cos(x/1.3)),
+			Close:  closing,
+			Volume: 1000 + 300*math.Sin(x/5),
+		}
+	}
+
+	return bars
+}
+
+func values1(value float64, ok bool) ([]float64, bool) {
+	return []float64{value}, ok
+}
+
+func values3(value1, value2, value3 float64, ok bool) ([]float64, bool) {
+	return []float64{value1, value2, value3}, ok
+}
+
+func checkpointSnapshot(bar checkpointBar) *asset.Snapshot {
+	return &asset.Snapshot{
+		Date:   bar.Date,
+		Open:   bar.Open,
+		High:   bar.High,
+		Low:    bar.Low,
+		Close:  bar.Close,
+		Volume: bar.Volume,
+	}
+}
+
+func profileValues(histogram *volume.ProfileHistogram, ok bool) ([]float64, bool) {
+	if histogram == nil {
+		return nil, ok
+	}
+
+	values := []float64{
+		histogram.Low, histogram.BinSize, histogram.PointOfControl, histogram.ValueAreaHigh, histogram.ValueAreaLow,
+	}
+
+	return append(values, histogram.Weights...), ok
+}
+
+func TestCheckpointRestore(t *testing.T) {
+	cases := map[string]checkpointCase{
+		"Ad": {
+			New: func() helper.Stateful { return volume.NewAd[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*volume.Ad[float64]).Update(b.High, b.Low, b.Close, b.Volume))
+			},
+		},
+		"AnchoredVwap": {
+			New: func() helper.Stateful { return volume.NewAnchoredVwap[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values3(s.(*volume.AnchoredVwap[float64]).Update(b.Date, b.High, b.Low, b.Close, b.Volume))
+			},
+		},
+		"Cmf": {
+			New: func() helper.Stateful { return volume.NewCmf[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*volume.Cmf[float64]).Update(b.High, b.Low, b.Close, b.Volume))
+			},
+		},
+		"Emv": {
+			New: func() helper.Stateful { return volume.NewEmv[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*volume.Emv[float64]).Update(b.High, b.Low, b.Volume))
+			},
+		},
+		"Fi": {
+			New: func() helper.Stateful { return volume.NewFi[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*volume.Fi[float64]).Update(b.Close, b.Volume))
+			},
+		},
+		"Mfi": {
+			New: func() helper.Stateful { return volume.NewMfi[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*volume.Mfi[float64]).Update(b.High, b.Low, b.Close, b.Volume))
+			},
+		},
+		"Mfm": {
+			New: func() helper.Stateful { return volume.NewMfm[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*volume.Mfm[float64]).Update(b.High, b.Low, b.Close))
+			},
+		},
+		"Mfv": {
+			New: func() helper.Stateful { return volume.NewMfv[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*volume.Mfv[float64]).Update(b.High, b.Low, b.Close, b.Volume))
+			},
+		},
+		"Nvi": {
+			New: func() helper.Stateful { return volume.NewNvi[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*volume.Nvi[float64]).Update(b.Close, b.Volume))
+			},
+		},
+		"Obv": {
+			New: func() helper.Stateful { return volume.NewObv[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*volume.Obv[float64]).Update(b.Close, b.Volume))
+			},
+		},
+		"Vpt": {
+			New: func() helper.Stateful { return volume.NewVpt[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*volume.Vpt[float64]).Update(b.Close, b.Volume))
+			},
+		},
+		"Vwap": {
+			New: func() helper.Stateful { return volume.NewVwap[float64]() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return values1(s.(*volume.Vwap[float64]).Update(b.Close, b.Volume))
+			},
+		},
+		"Profile": {
+			New: func() helper.Stateful { return volume.NewProfile() },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return profileValues(s.(*volume.Profile).Update(checkpointSnapshot(b)))
+			},
+		},
+		"ProfileSession": {
+			New: func() helper.Stateful { return volume.NewProfileWithSession(asset.Daily) },
+			Update: func(s helper.Stateful, b checkpointBar) ([]float64, bool) {
+				return profileValues(s.(*volume.Profile).Update(checkpointSnapshot(b)))
+			},
+		},
+	}
+
+	bars := checkpointBars(120)
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			checkCheckpointRestore(t, c, bars)
+		})
+	}
+}
+
+// checkCheckpointRestore checks that the indicator restored from a checkpoint taken after each bar
+// produces the same output as the uninterrupted one.
+func checkCheckpointRestore(t *testing.T, c checkpointCase, bars []checkpointBar) {
+	t.Helper()
+
+	type output struct {
+		Values []float64
+		Ok     bool
+	}
+
+	expected := make([]output, len(bars))
+
+	uninterrupted := c.New()
+	for i, bar := range bars {
+		expected[i].Values, expected[i].Ok = c.Update(uninterrupted, bar)
+	}
+
+	for split := 0; split <= len(bars); split++ {
+		actual := make([]output, len(bars))
+
+		before := c.New()
+		for i, bar := range bars[:split] {
+			actual[i].Values, actual[i].Ok = c.Update(before, bar)
+		}
+
+		data, err := helper.Checkpoint(before)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		after := c.New()
+
+		// The restored state replaces any state the indicator has.
+		for _, bar := range bars[:3] {
+			c.Update(after, bar)
+		}
+
+		err = helper.Restore(after, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i, bar := range bars[split:] {
+			actual[split+i].Values, actual[split+i].Ok = c.Update(after, bar)
+		}
+
+		if !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("split %d actual %v expected %v", split, actual, expected)
+		}
+	}
+}
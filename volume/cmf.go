@@ -5,6 +5,8 @@
 package volume
 
 import (
+	"encoding/json"
+
 	"github.com/cinar/indicator/v2/helper"
 	"github.com/cinar/indicator/v2/trend"
 )
@@ -31,6 +33,12 @@ type Cmf[T helper.Number] struct {
 
 	// Sum is the Moving Sum instance.
 	Sum *trend.MovingSum[T]
+
+	// mfvs is the moving sum of the MFV values.
+	mfvs *trend.MovingSum[T]
+
+	// volumes is the moving sum of the volumes.
+	volumes *trend.MovingSum[T]
 }
 
 // NewCmf function initializes a new CMF instance with the default parameters.
@@ -48,17 +56,99 @@ func NewCmfWithPeriod[T helper.Number](period int) *Cmf[T] {
 
 // Compute function takes a channel of numbers and computes the CMF.
 func (c *Cmf[T]) Compute(highs, lows, closings, volumes <-chan T) <-chan T {
-	volumesSplice := helper.Duplicate(volumes, 2)
+	return helper.Compute4(highs, lows, closings, volumes, c.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the CMF.
+func (c *Cmf[T]) ComputeSlice(highs, lows, closings, volumes []T) []T {
+	return helper.ComputeSlice4(highs, lows, closings, volumes, c.Clone().Update)
+}
+
+// Update function takes a high, a low, a closing, and a volume value, and updates the CMF. It returns
+// the CMF value, and whether the CMF has passed its idle period and the value is ready.
+func (c *Cmf[T]) Update(high, low, closing, volume T) (T, bool) {
+	if c.mfvs == nil {
+		c.mfvs = c.Sum.Clone()
+		c.volumes = c.Sum.Clone()
+	}
 
 	//	MFM = ((Closing - Low) - (High - Closing)) / (High - Low)
 	//	MFV = MFM * Volume
-	mfvs := c.Mfv.Compute(highs, lows, closings, volumesSplice[0])
+	mfv, _ := c.Mfv.Update(high, low, closing, volume)
 
 	//	CMF = Sum(20, Money Flow Volume) / Sum(20, Volume)
-	return helper.Divide(
-		c.Sum.Compute(mfvs),
-		c.Sum.Compute(volumesSplice[1]),
-	)
+	mfvs, _ := c.mfvs.Update(mfv)
+
+	volumes, ok := c.volumes.Update(volume)
+	if !ok {
+		return 0, false
+	}
+
+	return mfvs / volumes, true
+}
+
+// Reset function resets the state of the CMF.
+func (c *Cmf[T]) Reset() {
+	c.mfvs = nil
+	c.volumes = nil
+}
+
+// Clone function returns a new CMF instance with the same configuration and a fresh state.
+func (c *Cmf[T]) Clone() *Cmf[T] {
+	return &Cmf[T]{
+		Mfv: c.Mfv,
+		Sum: c.Sum.Clone(),
+	}
+}
+
+// cmfState is the state of the CMF.
+type cmfState struct {
+	// Mfvs is the moving sum of the MFV values.
+	Mfvs json.RawMessage `json:"mfvs"`
+
+	// Volumes is the moving sum of the volumes.
+	Volumes json.RawMessage `json:"volumes"`
+}
+
+// MarshalState function returns the state of the CMF as JSON.
+func (c *Cmf[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := cmfState{}
+
+	state.Mfvs, err = helper.MarshalStateOf(c.mfvs)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Volumes, err = helper.MarshalStateOf(c.volumes)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the CMF from the given JSON.
+func (c *Cmf[T]) UnmarshalState(data []byte) error {
+	var state cmfState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&c.mfvs, state.Mfvs, c.Sum.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&c.volumes, state.Volumes, c.Sum.Clone)
+	if err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // IdlePeriod is the initial period that MFV won't yield any results.
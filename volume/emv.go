@@ -5,6 +5,8 @@
 package volume
 
 import (
+	"encoding/json"
+
 	"github.com/cinar/indicator/v2/helper"
 	"github.com/cinar/indicator/v2/trend"
 )
@@ -29,6 +31,18 @@ const (
 type Emv[T helper.Number] struct {
 	// Sma is the SMA instance.
 	Sma *trend.Sma[T]
+
+	// sma is the SMA state.
+	sma *trend.Sma[T]
+
+	// previousMedian is the previous median price.
+	previousMedian T
+
+	// previousBoxRatio is the previous box ratio.
+	previousBoxRatio T
+
+	// started indicates whether the previous values are set.
+	started bool
 }
 
 // NewEmv function initializes a new EMV instance with the default parameters.
@@ -45,43 +59,115 @@ func NewEmvWithPeriod[T helper.Number](period int) *Emv[T] {
 
 // Compute function takes a channel of numbers and computes the EMV.
 func (e *Emv[T]) Compute(highs, lows, volumes <-chan T) <-chan T {
-	highsSplice := helper.Duplicate(highs, 2)
-	lowsSplice := helper.Duplicate(lows, 2)
+	return helper.Compute3(highs, lows, volumes, e.Clone().Update)
+}
 
-	//	Distance Moved = ((High + Low) / 2) - ((Priod High + Prior Low) /2)
-	distanceMoved := helper.Change(
-		helper.DivideBy(
-			helper.Add(
-				highsSplice[0],
-				lowsSplice[0],
-			),
-			2,
-		),
-		1,
-	)
+// ComputeSlice function takes a slice of numbers and computes the EMV.
+func (e *Emv[T]) ComputeSlice(highs, lows, volumes []T) []T {
+	return helper.ComputeSlice3(highs, lows, volumes, e.Clone().Update)
+}
+
+// Update function takes a high, a low, and a volume value, and updates the EMV. It returns the EMV
+// value, and whether the EMV has passed its idle period and the value is ready.
+func (e *Emv[T]) Update(high, low, volume T) (T, bool) {
+	if e.sma == nil {
+		e.sma = e.Sma.Clone()
+	}
+
+	median := (high + low) / 2
 
 	d := 100000000
 
 	// Box Ratio = ((Volume / 100000000) / (High - Low))
-	boxRatio := helper.Divide(
-		helper.DivideBy(
-			volumes,
-			T(d),
-		),
-		helper.Subtract(
-			highsSplice[1],
-			lowsSplice[1],
-		),
-	)
+	boxRatio := (volume / T(d)) / (high - low)
+
+	if !e.started {
+		e.previousMedian = median
+		e.previousBoxRatio = boxRatio
+		e.started = true
+		return 0, false
+	}
+
+	//	Distance Moved = ((High + Low) / 2) - ((Priod High + Prior Low) /2)
+	distanceMoved := median - e.previousMedian
 
 	// EMV(1) = Distance Moved / Box Ratio
+	emv := distanceMoved / e.previousBoxRatio
+
+	e.previousMedian = median
+	e.previousBoxRatio = boxRatio
+
 	// EMV(14) = SMA(14, EMV(1))
-	return e.Sma.Compute(
-		helper.Divide(
-			distanceMoved,
-			boxRatio,
-		),
-	)
+	return e.sma.Update(emv)
+}
+
+// Reset function resets the state of the EMV.
+func (e *Emv[T]) Reset() {
+	e.sma = nil
+	e.previousMedian = 0
+	e.previousBoxRatio = 0
+	e.started = false
+}
+
+// Clone function returns a new EMV instance with the same configuration and a fresh state.
+func (e *Emv[T]) Clone() *Emv[T] {
+	return &Emv[T]{
+		Sma: e.Sma.Clone(),
+	}
+}
+
+// emvState is the state of the EMV.
+type emvState[T helper.Number] struct {
+	// Sma is the SMA state.
+	Sma json.RawMessage `json:"sma"`
+
+	// PreviousMedian is the previous median price.
+	PreviousMedian T `json:"previousMedian"`
+
+	// PreviousBoxRatio is the previous box ratio.
+	PreviousBoxRatio T `json:"previousBoxRatio"`
+
+	// Started indicates whether the previous values are set.
+	Started bool `json:"started"`
+}
+
+// MarshalState function returns the state of the EMV as JSON.
+func (e *Emv[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := emvState[T]{
+		PreviousMedian:   e.previousMedian,
+		PreviousBoxRatio: e.previousBoxRatio,
+		Started:          e.started,
+	}
+
+	state.Sma, err = helper.MarshalStateOf(e.sma)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the EMV from the given JSON.
+func (e *Emv[T]) UnmarshalState(data []byte) error {
+	var state emvState[T]
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&e.sma, state.Sma, e.Sma.Clone)
+	if err != nil {
+		return err
+	}
+
+	e.previousMedian = state.PreviousMedian
+	e.previousBoxRatio = state.PreviousBoxRatio
+	e.started = state.Started
+
+	return nil
 }
 
 // IdlePeriod is the initial period that EMV won't yield any results.
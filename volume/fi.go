@@ -5,6 +5,8 @@
 package volume
 
 import (
+	"encoding/json"
+
 	"github.com/cinar/indicator/v2/helper"
 	"github.com/cinar/indicator/v2/trend"
 )
@@ -26,6 +28,18 @@ const (
 type Fi[T helper.Number] struct {
 	// Ema is the EMA instance.
 	Ema *trend.Ema[T]
+
+	// ema is the EMA state.
+	ema *trend.Ema[T]
+
+	// previousClosing is the previous closing value.
+	previousClosing T
+
+	// previousVolume is the previous volume value.
+	previousVolume T
+
+	// started indicates whether the previous values are set.
+	started bool
 }
 
 // NewFi function initializes a new FI instance with the default parameters.
@@ -42,12 +56,103 @@ func NewFiWithPeriod[T helper.Number](period int) *Fi[T] {
 
 // Compute function takes a channel of numbers and computes the FI.
 func (f *Fi[T]) Compute(closings, volumes <-chan T) <-chan T {
-	return f.Ema.Compute(
-		helper.Multiply(
-			helper.Change(closings, 1),
-			volumes,
-		),
-	)
+	return helper.Compute2(closings, volumes, f.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the FI.
+func (f *Fi[T]) ComputeSlice(closings, volumes []T) []T {
+	return helper.ComputeSlice2(closings, volumes, f.Clone().Update)
+}
+
+// Update function takes a closing and a volume value, and updates the FI. It returns the FI value,
+// and whether the FI has passed its idle period and the value is ready.
+func (f *Fi[T]) Update(closing, volume T) (T, bool) {
+	if f.ema == nil {
+		f.ema = f.Ema.Clone()
+	}
+
+	if !f.started {
+		f.previousClosing = closing
+		f.previousVolume = volume
+		f.started = true
+		return 0, false
+	}
+
+	force := (closing - f.previousClosing) * f.previousVolume
+
+	f.previousClosing = closing
+	f.previousVolume = volume
+
+	return f.ema.Update(force)
+}
+
+// Reset function resets the state of the FI.
+func (f *Fi[T]) Reset() {
+	f.ema = nil
+	f.previousClosing = 0
+	f.previousVolume = 0
+	f.started = false
+}
+
+// Clone function returns a new FI instance with the same configuration and a fresh state.
+func (f *Fi[T]) Clone() *Fi[T] {
+	return &Fi[T]{
+		Ema: f.Ema.Clone(),
+	}
+}
+
+// fiState is the state of the FI.
+type fiState[T helper.Number] struct {
+	// Ema is the EMA state.
+	Ema json.RawMessage `json:"ema"`
+
+	// PreviousClosing is the previous closing value.
+	PreviousClosing T `json:"previousClosing"`
+
+	// PreviousVolume is the previous volume value.
+	PreviousVolume T `json:"previousVolume"`
+
+	// Started indicates whether the previous values are set.
+	Started bool `json:"started"`
+}
+
+// MarshalState function returns the state of the FI as JSON.
+func (f *Fi[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := fiState[T]{
+		PreviousClosing: f.previousClosing,
+		PreviousVolume:  f.previousVolume,
+		Started:         f.started,
+	}
+
+	state.Ema, err = helper.MarshalStateOf(f.ema)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the FI from the given JSON.
+func (f *Fi[T]) UnmarshalState(data []byte) error {
+	var state fiState[T]
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&f.ema, state.Ema, f.Ema.Clone)
+	if err != nil {
+		return err
+	}
+
+	f.previousClosing = state.PreviousClosing
+	f.previousVolume = state.PreviousVolume
+	f.started = state.Started
+
+	return nil
 }
 
 // IdlePeriod is the initial period that FI won't yield any results.
@@ -5,6 +5,9 @@
 package volume
 
 import (
+	"encoding/json"
+	"math"
+
 	"github.com/cinar/indicator/v2/helper"
 	"github.com/cinar/indicator/v2/trend"
 )
@@ -32,6 +35,18 @@ type Mfi[T helper.Number] struct {
 
 	// Sum is the Moving Sum instance.
 	Sum *trend.MovingSum[T]
+
+	// positives is the moving sum of the positive money flows.
+	positives *trend.MovingSum[T]
+
+	// negatives is the moving sum of the negative money flows.
+	negatives *trend.MovingSum[T]
+
+	// previousRawMoneyFlow is the previous raw money flow.
+	previousRawMoneyFlow T
+
+	// started indicates whether the previous raw money flow is set.
+	started bool
 }
 
 // NewMfi function initializes a new MFI instance with the default parameters.
@@ -44,52 +59,132 @@ func NewMfi[T helper.Number]() *Mfi[T] {
 
 // Compute function takes a channel of numbers and computes the MFI.
 func (m *Mfi[T]) Compute(highs, lows, closings, volumes <-chan T) <-chan T {
+	return helper.Compute4(highs, lows, closings, volumes, m.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the MFI.
+func (m *Mfi[T]) ComputeSlice(highs, lows, closings, volumes []T) []T {
+	return helper.ComputeSlice4(highs, lows, closings, volumes, m.Clone().Update)
+}
+
+// Update function takes a high, a low, a closing, and a volume value, and updates the MFI. It returns
+// the MFI value, and whether the MFI has passed its idle period and the value is ready.
+func (m *Mfi[T]) Update(high, low, closing, volume T) (T, bool) {
+	if m.positives == nil {
+		m.positives = m.Sum.Clone()
+		m.negatives = m.Sum.Clone()
+	}
+
 	//	Raw Money Flow = Typical Price * Volume
-	rawMoneyFlowSplice := helper.Duplicate(
-		helper.Multiply(
-			m.TypicalPrice.Compute(highs, lows, closings),
-			volumes,
-		),
-		2,
-	)
-
-	moneyFlowSplice := helper.Duplicate(
-		helper.Multiply(
-			helper.Sign(
-				helper.Change(rawMoneyFlowSplice[0], 1),
-			),
-			helper.Skip(rawMoneyFlowSplice[1], 1),
-		),
-		2,
-	)
+	typicalPrice, _ := m.TypicalPrice.Update(high, low, closing)
+	rawMoneyFlow := typicalPrice * volume
+
+	if !m.started {
+		m.previousRawMoneyFlow = rawMoneyFlow
+		m.started = true
+		return 0, false
+	}
+
+	var moneyFlow T
+
+	if rawMoneyFlow > m.previousRawMoneyFlow {
+		moneyFlow = rawMoneyFlow
+	} else if rawMoneyFlow < m.previousRawMoneyFlow {
+		moneyFlow = -rawMoneyFlow
+	}
+
+	m.previousRawMoneyFlow = rawMoneyFlow
+
+	positives, _ := m.positives.Update(max(moneyFlow, 0))
+
+	negatives, ok := m.negatives.Update(min(moneyFlow, 0) * -1)
+	if !ok {
+		return 0, false
+	}
 
 	// Money Ratio = Positive Money Flow / Negative Money Flow
-	moneyRatio := helper.Divide(
-		m.Sum.Compute(
-			helper.KeepPositives(moneyFlowSplice[0]),
-		),
-		m.Sum.Compute(
-			helper.MultiplyBy(
-				helper.KeepNegatives(moneyFlowSplice[1]),
-				-1,
-			),
-		),
-	)
+	moneyRatio := positives / negatives
 
 	// Money Flow Index = 100 - (100 / (1 + Money Ratio))
-	return helper.IncrementBy(
-		helper.MultiplyBy(
-			helper.Pow(
-				helper.IncrementBy(
-					moneyRatio,
-					1,
-				),
-				-1,
-			),
-			-100,
-		),
-		100,
-	)
+	return T(math.Pow(float64(moneyRatio+1), -1))*-100 + 100, true
+}
+
+// Reset function resets the state of the MFI.
+func (m *Mfi[T]) Reset() {
+	m.positives = nil
+	m.negatives = nil
+	m.previousRawMoneyFlow = 0
+	m.started = false
+}
+
+// Clone function returns a new MFI instance with the same configuration and a fresh state.
+func (m *Mfi[T]) Clone() *Mfi[T] {
+	return &Mfi[T]{
+		TypicalPrice: m.TypicalPrice,
+		Sum:          m.Sum.Clone(),
+	}
+}
+
+// mfiState is the state of the MFI.
+type mfiState[T helper.Number] struct {
+	// Positives is the moving sum of the positive money flows.
+	Positives json.RawMessage `json:"positives"`
+
+	// Negatives is the moving sum of the negative money flows.
+	Negatives json.RawMessage `json:"negatives"`
+
+	// PreviousRawMoneyFlow is the previous raw money flow.
+	PreviousRawMoneyFlow T `json:"previousRawMoneyFlow"`
+
+	// Started indicates whether the previous raw money flow is set.
+	Started bool `json:"started"`
+}
+
+// MarshalState function returns the state of the MFI as JSON.
+func (m *Mfi[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := mfiState[T]{
+		PreviousRawMoneyFlow: m.previousRawMoneyFlow,
+		Started:              m.started,
+	}
+
+	state.Positives, err = helper.MarshalStateOf(m.positives)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Negatives, err = helper.MarshalStateOf(m.negatives)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the MFI from the given JSON.
+func (m *Mfi[T]) UnmarshalState(data []byte) error {
+	var state mfiState[T]
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&m.positives, state.Positives, m.Sum.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&m.negatives, state.Negatives, m.Sum.Clone)
+	if err != nil {
+		return err
+	}
+
+	m.previousRawMoneyFlow = state.PreviousRawMoneyFlow
+	m.started = state.Started
+
+	return nil
 }
 
 // IdlePeriod is the initial period that MFI won't yield any results.
@@ -4,7 +4,11 @@
 
 package volume
 
-import "github.com/cinar/indicator/v2/helper"
+import (
+	"encoding/json"
+
+	"github.com/cinar/indicator/v2/helper"
+)
 
 // Mfm holds configuration parameters for calculating the Money Flow Multiplier (MFM),
 // which adjusts volume based on the closing price's position within the high-low range:
@@ -28,21 +32,40 @@ func NewMfm[T helper.Number]() *Mfm[T] {
 }
 
 // Compute function takes a channel of numbers and computes the MFM.
-func (*Mfm[T]) Compute(highs, lows, closings <-chan T) <-chan T {
-	highsSplice := helper.Duplicate(highs, 2)
-	lowsSplice := helper.Duplicate(lows, 2)
-	closingsSplice := helper.Duplicate(closings, 2)
+func (m *Mfm[T]) Compute(highs, lows, closings <-chan T) <-chan T {
+	return helper.Compute3(highs, lows, closings, m.Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the MFM.
+func (m *Mfm[T]) ComputeSlice(highs, lows, closings []T) []T {
+	return helper.ComputeSlice3(highs, lows, closings, m.Update)
+}
+
+// Update function takes a high, a low, and a closing value, and computes the MFM. The MFM does not
+// have an idle period, and the value is always ready.
+func (*Mfm[T]) Update(high, low, closing T) (T, bool) {
+	return ((closing - low) - (high - closing)) / (high - low), true
+}
 
-	return helper.Divide(
-		helper.Subtract(
-			helper.Subtract(closingsSplice[0], lowsSplice[0]),
-			helper.Subtract(highsSplice[0], closingsSplice[1]),
-		),
-		helper.Subtract(highsSplice[1], lowsSplice[1]),
-	)
+// Reset function resets the state of the MFM. The MFM does not have a state.
+func (*Mfm[T]) Reset() {
 }
 
 // IdlePeriod is the initial period that MFM won't yield any results.
 func (*Mfm[T]) IdlePeriod() int {
 	return 0
 }
+
+// mfmState is the state of the MFM. The MFM does not have a state.
+type mfmState struct{}
+
+// MarshalState function returns the state of the MFM as JSON. The MFM does not have a state.
+func (*Mfm[T]) MarshalState() ([]byte, error) {
+	return json.Marshal(&mfmState{})
+}
+
+// UnmarshalState function restores the state of the MFM from the given JSON. The MFM does not have
+// a state.
+func (*Mfm[T]) UnmarshalState(data []byte) error {
+	return json.Unmarshal(data, &mfmState{})
+}
@@ -4,7 +4,11 @@
 
 package volume
 
-import "github.com/cinar/indicator/v2/helper"
+import (
+	"encoding/json"
+
+	"github.com/cinar/indicator/v2/helper"
+)
 
 // Mfv holds configuration parameters for calculating Money Flow Volume (MFV), a volume-based indicator that
 // incorporates the Money Flow Multiplier (MFM) to gauge the intensity of buying and selling pressure. MFV
@@ -32,13 +36,40 @@ func NewMfv[T helper.Number]() *Mfv[T] {
 
 // Compute function takes a channel of numbers and computes the MFV.
 func (m *Mfv[T]) Compute(highs, lows, closings, volumes <-chan T) <-chan T {
-	return helper.Multiply(
-		m.Mfm.Compute(highs, lows, closings),
-		volumes,
-	)
+	return helper.Compute4(highs, lows, closings, volumes, m.Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the MFV.
+func (m *Mfv[T]) ComputeSlice(highs, lows, closings, volumes []T) []T {
+	return helper.ComputeSlice4(highs, lows, closings, volumes, m.Update)
+}
+
+// Update function takes a high, a low, a closing, and a volume value, and computes the MFV. The MFV
+// does not have an idle period, and the value is always ready.
+func (m *Mfv[T]) Update(high, low, closing, volume T) (T, bool) {
+	mfm, _ := m.Mfm.Update(high, low, closing)
+	return mfm * volume, true
+}
+
+// Reset function resets the state of the MFV. The MFV does not have a state.
+func (*Mfv[T]) Reset() {
 }
 
 // IdlePeriod is the initial period that MFV won't yield any results.
 func (*Mfv[T]) IdlePeriod() int {
 	return 0
 }
+
+// mfvState is the state of the MFV. The MFV does not have a state.
+type mfvState struct{}
+
+// MarshalState function returns the state of the MFV as JSON. The MFV does not have a state.
+func (*Mfv[T]) MarshalState() ([]byte, error) {
+	return json.Marshal(&mfvState{})
+}
+
+// UnmarshalState function restores the state of the MFV from the given JSON. The MFV does not have
+// a state.
+func (*Mfv[T]) UnmarshalState(data []byte) error {
+	return json.Unmarshal(data, &mfvState{})
+}
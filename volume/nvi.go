@@ -5,6 +5,8 @@
 package volume
 
 import (
+	"encoding/json"
+
 	"github.com/cinar/indicator/v2/helper"
 )
 
@@ -31,6 +33,18 @@ const (
 type Nvi[T helper.Number] struct {
 	// Initial is the initial NVI value.
 	Initial T
+
+	// nvi is the last NVI value.
+	nvi T
+
+	// previousClosing is the previous closing value.
+	previousClosing T
+
+	// previousVolume is the previous volume value.
+	previousVolume T
+
+	// started indicates whether the previous values are set.
+	started bool
 }
 
 // NewNvi function initializes a new NVI instance with the default parameters.
@@ -44,27 +58,99 @@ func NewNvi[T helper.Number]() *Nvi[T] {
 
 // Compute function takes a channel of numbers and computes the NVI.
 func (n *Nvi[T]) Compute(closings, volumes <-chan T) <-chan T {
-	closingRatios := helper.ChangeRatio(closings, 1)
-	volumeChanges := helper.Change(volumes, 1)
+	return helper.Compute2(closings, volumes, n.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the NVI.
+func (n *Nvi[T]) ComputeSlice(closings, volumes []T) []T {
+	return helper.ComputeSlice2(closings, volumes, n.Clone().Update)
+}
+
+// Update function takes a closing and a volume value, and updates the NVI. It returns the NVI value,
+// and whether the NVI has passed its idle period and the value is ready.
+func (n *Nvi[T]) Update(closing, volume T) (T, bool) {
+	if !n.started {
+		n.nvi = n.Initial
+		n.previousClosing = closing
+		n.previousVolume = volume
+		n.started = true
+		return 0, false
+	}
+
+	closingRatio := (closing - n.previousClosing) / n.previousClosing
+	volumeChange := volume - n.previousVolume
+
+	n.previousClosing = closing
+	n.previousVolume = volume
+
+	// If Volume is greather than Previous Volume:
+	//	NVI = Previous NVI
+	// Otherwise:
+	//	NVI = Previous NVI + (((Closing - Previous Closing) / Previous Closing) * Previous NVI)
+	if volumeChange <= 0 {
+		n.nvi += closingRatio * n.nvi
+	}
+
+	return n.nvi, true
+}
+
+// Reset function resets the state of the NVI.
+func (n *Nvi[T]) Reset() {
+	n.nvi = 0
+	n.previousClosing = 0
+	n.previousVolume = 0
+	n.started = false
+}
+
+// Clone function returns a new NVI instance with the same configuration and a fresh state.
+func (n *Nvi[T]) Clone() *Nvi[T] {
+	return &Nvi[T]{
+		Initial: n.Initial,
+	}
+}
 
-	previous := n.Initial
+// nviState is the state of the NVI.
+type nviState[T helper.Number] struct {
+	// Nvi is the last NVI value.
+	Nvi T `json:"nvi"`
 
-	return helper.Operate(closingRatios, volumeChanges, func(closingRatio, volumeChange T) T {
-		// If Volume is greather than Previous Volume:
-		//	NVI = Previous NVI
-		current := previous
+	// PreviousClosing is the previous closing.
+	PreviousClosing T `json:"previousClosing"`
 
-		// Otherwise:
-		//	NVI = Previous NVI + (((Closing - Previous Closing) / Previous Closing) * Previous NVI)
-		if volumeChange <= 0 {
-			current += closingRatio * previous
-		}
+	// PreviousVolume is the previous volume.
+	PreviousVolume T `json:"previousVolume"`
 
-		previous = current
-		return current
+	// Started indicates whether the first closing is received.
+	Started bool `json:"started"`
+}
+
+// MarshalState function returns the state of the NVI as JSON.
+func (n *Nvi[T]) MarshalState() ([]byte, error) {
+	return json.Marshal(&nviState[T]{
+		Nvi:             n.nvi,
+		PreviousClosing: n.previousClosing,
+		PreviousVolume:  n.previousVolume,
+		Started:         n.started,
 	})
 }
 
+// UnmarshalState function restores the state of the NVI from the given JSON.
+func (n *Nvi[T]) UnmarshalState(data []byte) error {
+	var state nviState[T]
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	n.nvi = state.Nvi
+	n.previousClosing = state.PreviousClosing
+	n.previousVolume = state.PreviousVolume
+	n.started = state.Started
+
+	return nil
+}
+
 // IdlePeriod is the initial period that NVI won't yield any results.
 func (*Nvi[T]) IdlePeriod() int {
 	return 1
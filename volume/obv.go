@@ -4,7 +4,11 @@
 
 package volume
 
-import "github.com/cinar/indicator/v2/helper"
+import (
+	"encoding/json"
+
+	"github.com/cinar/indicator/v2/helper"
+)
 
 // Obv holds configuration parameters for calculating the On-Balance Volume (OBV). It is a technical trading momentum
 // indicator that uses volume flow to predict changes in asset price.
@@ -18,7 +22,10 @@ import "github.com/cinar/indicator/v2/helper"
 //
 //	obv := volume.NewObv[float64]()
 //	result := obv.Compute(closings, volumes)
-type Obv[T helper.Number] struct{}
+type Obv[T helper.Number] struct {
+	// obv is the last OBV value.
+	obv T
+}
 
 // NewObv function initializes a new OBV instance with the default parameters.
 func NewObv[T helper.Number]() *Obv[T] {
@@ -26,23 +33,64 @@ func NewObv[T helper.Number]() *Obv[T] {
 }
 
 // Compute function takes a channel of numbers and computes the OBV.
-func (*Obv[T]) Compute(closings, volumes <-chan T) <-chan T {
-	var previous T
+func (o *Obv[T]) Compute(closings, volumes <-chan T) <-chan T {
+	return helper.Compute2(closings, volumes, o.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the OBV.
+func (o *Obv[T]) ComputeSlice(closings, volumes []T) []T {
+	return helper.ComputeSlice2(closings, volumes, o.Clone().Update)
+}
+
+// Update function takes a closing and a volume value, and updates the OBV. It returns the OBV value,
+// and whether the value is ready. The OBV does not have an idle period.
+func (o *Obv[T]) Update(closing, volume T) (T, bool) {
+	if closing > o.obv {
+		o.obv += volume
+	} else if closing < o.obv {
+		o.obv -= volume
+	}
+
+	return o.obv, true
+}
+
+// Reset function resets the state of the OBV.
+func (o *Obv[T]) Reset() {
+	o.obv = 0
+}
 
-	return helper.Operate(closings, volumes, func(closing, volume T) T {
-		current := previous
+// Clone function returns a new OBV instance with the same configuration and a fresh state.
+func (*Obv[T]) Clone() *Obv[T] {
+	return &Obv[T]{}
+}
 
-		if closing > previous {
-			current += volume
-		} else if closing < previous {
-			current -= volume
-		}
+// obvState is the state of the OBV.
+type obvState[T helper.Number] struct {
+	// Obv is the last OBV value.
+	Obv T `json:"obv"`
+}
 
-		previous = current
-		return current
+// MarshalState function returns the state of the OBV as JSON.
+func (o *Obv[T]) MarshalState() ([]byte, error) {
+	return json.Marshal(&obvState[T]{
+		Obv: o.obv,
 	})
 }
 
+// UnmarshalState function restores the state of the OBV from the given JSON.
+func (o *Obv[T]) UnmarshalState(data []byte) error {
+	var state obvState[T]
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	o.obv = state.Obv
+
+	return nil
+}
+
 // IdlePeriod is the initial period that OBV won't yield any results.
 func (*Obv[T]) IdlePeriod() int {
 	return 0
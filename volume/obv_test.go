@@ -37,3 +37,34 @@ func TestObv(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestObvUpdate(t *testing.T) {
+	type ObvData struct {
+		Close  float64
+		Volume int64
+		Obv    float64
+	}
+
+	input, err := helper.ReadFromCsvFile[ObvData]("testdata/obv.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := helper.ChanToSlice(input)
+	obv := volume.NewObv[float64]()
+
+	for range 2 {
+		for i, d := range data {
+			actual, ok := obv.Update(d.Close, float64(d.Volume))
+			if !ok {
+				t.Fatalf("index %d not ready", i)
+			}
+
+			if helper.RoundDigit(actual, 2) != d.Obv {
+				t.Fatalf("index %d actual %v expected %v", i, actual, d.Obv)
+			}
+		}
+
+		obv.Reset()
+	}
+}
@@ -0,0 +1,433 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volume
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+)
+
+const (
+	// DefaultProfileBins is the default number of price bins for the profile.
+	DefaultProfileBins = 24
+
+	// DefaultProfilePeriod is the default rolling window period for the profile.
+	DefaultProfilePeriod = 20
+
+	// DefaultProfileValueArea is the default ratio of the total volume within the value area.
+	DefaultProfileValueArea = 0.7
+)
+
+// ProfileWeight is what each bar contributes to the price bins of the profile.
+type ProfileWeight int
+
+const (
+	// ProfileByVolume distributes the volume of each bar across its high-low range, as in the
+	// volume profile.
+	ProfileByVolume ProfileWeight = iota
+
+	// ProfileByTime adds a single time price opportunity (TPO) of each bar to every bin within its
+	// high-low range, as in the market profile.
+	ProfileByTime
+)
+
+// ProfileHistogram is the distribution of the weights by price for a bar, along with the levels
+// derived from it. The bins span from the lowest low to the highest high in equal sizes.
+type ProfileHistogram struct {
+	// Low is the low price of the first bin.
+	Low float64
+
+	// BinSize is the price range of each bin.
+	BinSize float64
+
+	// Weights is the weight of each bin, from the lowest price to the highest price.
+	Weights []float64
+
+	// PointOfControl is the middle price of the bin with the highest weight.
+	PointOfControl float64
+
+	// ValueAreaHigh is the high price of the value area.
+	ValueAreaHigh float64
+
+	// ValueAreaLow is the low price of the value area.
+	ValueAreaLow float64
+
+	// HighVolumeNodes is the middle prices of the bins with a peak weight above the average.
+	HighVolumeNodes []float64
+
+	// LowVolumeNodes is the middle prices of the bins with a trough weight below the average.
+	LowVolumeNodes []float64
+}
+
+// Price returns the middle price of the bin at the given index.
+func (h *ProfileHistogram) Price(i int) float64 {
+	return h.Low + (float64(i)+0.5)*h.BinSize
+}
+
+// Profile holds configuration parameters for calculating the volume profile, or the market profile,
+// which bins the bars by price over a rolling window or per session. The point of control (POC) is
+// the price with the highest weight, and the value area is the price range around it holding the
+// value area ratio of the total weight. It is built by adding the higher of the neighboring bins
+// one at a time, starting from the point of control. The high and low volume nodes are the local
+// peaks and troughs of the histogram.
+//
+// Example:
+//
+//	profile := volume.NewProfileWithSession(asset.Daily)
+//	histograms := profile.Compute(snapshots)
+//	pocs := volume.ProfilesAsPointOfControl(histograms)
+type Profile struct {
+	// Bins is the number of price bins.
+	Bins int
+
+	// Period is the rolling window period, used when the session is not set.
+	Period int
+
+	// Session is the timeframe of the sessions that the profile is reset at.
+	Session asset.Timeframe
+
+	// ValueArea is the ratio of the total weight within the value area.
+	ValueArea float64
+
+	// Weight is what each bar contributes to the price bins.
+	Weight ProfileWeight
+
+	// session is the start of the current session.
+	session time.Time
+
+	// window is the bars of the current window or session.
+	window []*asset.Snapshot
+}
+
+// NewProfile function initializes a new volume profile instance with the default parameters.
+func NewProfile() *Profile {
+	return NewProfileWithPeriod(DefaultProfilePeriod)
+}
+
+// NewProfileWithPeriod function initializes a new volume profile instance over a rolling window of
+// the given period.
+func NewProfileWithPeriod(period int) *Profile {
+	return &Profile{
+		Bins:      DefaultProfileBins,
+		Period:    period,
+		ValueArea: DefaultProfileValueArea,
+		Weight:    ProfileByVolume,
+	}
+}
+
+// NewProfileWithSession function initializes a new volume profile instance that is reset at each
+// session of the given timeframe.
+func NewProfileWithSession(session asset.Timeframe) *Profile {
+	profile := NewProfile()
+	profile.Session = session
+
+	return profile
+}
+
+// Compute function takes a channel of snapshots and computes the profile histogram at each bar.
+func (p *Profile) Compute(snapshots <-chan *asset.Snapshot) <-chan *ProfileHistogram {
+	return helper.Compute(snapshots, p.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of snapshots and computes the profile histogram at each bar.
+func (p *Profile) ComputeSlice(snapshots []*asset.Snapshot) []*ProfileHistogram {
+	return helper.ComputeSlice(snapshots, p.Clone().Update)
+}
+
+// Update function takes a snapshot and updates the profile. It returns the profile histogram, and
+// whether the profile has passed its idle period and the histogram is ready.
+func (p *Profile) Update(snapshot *asset.Snapshot) (*ProfileHistogram, bool) {
+	if p.window == nil {
+		p.window = make([]*asset.Snapshot, 0, max(p.Period, 1))
+	}
+
+	if p.Session != nil {
+		start := p.Session(snapshot.Date)
+		if len(p.window) == 0 || !start.Equal(p.session) {
+			p.session = start
+			p.window = p.window[:0]
+		}
+	} else if len(p.window) == p.Period {
+		p.window = append(p.window[:0], p.window[1:]...)
+	}
+
+	p.window = append(p.window, snapshot)
+
+	if p.Session == nil && len(p.window) < p.Period {
+		return nil, false
+	}
+
+	return p.histogram(p.window), true
+}
+
+// Reset function resets the state of the profile.
+func (p *Profile) Reset() {
+	p.session = time.Time{}
+	p.window = nil
+}
+
+// Clone function returns a new profile instance with the same configuration and a fresh state.
+func (p *Profile) Clone() *Profile {
+	return &Profile{
+		Bins:      p.Bins,
+		Period:    p.Period,
+		Session:   p.Session,
+		ValueArea: p.ValueArea,
+		Weight:    p.Weight,
+	}
+}
+
+// profileState is the state of the volume profile.
+type profileState struct {
+	// Session is the start of the current session.
+	Session time.Time `json:"session"`
+
+	// Window is the bars of the current window or session.
+	Window []*asset.Snapshot `json:"window"`
+}
+
+// MarshalState function returns the state of the volume profile as JSON.
+func (p *Profile) MarshalState() ([]byte, error) {
+	return json.Marshal(&profileState{
+		Session: p.session,
+		Window:  p.window,
+	})
+}
+
+// UnmarshalState function restores the state of the volume profile from the given JSON.
+func (p *Profile) UnmarshalState(data []byte) error {
+	var state profileState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	if p.Session == nil && len(state.Window) > p.Period {
+		return fmt.Errorf("invalid profile state with %d bars of period %d", len(state.Window), p.Period)
+	}
+
+	p.session = state.Session
+
+	p.window = nil
+	if state.Window != nil {
+		p.window = append(make([]*asset.Snapshot, 0, max(p.Period, 1)), state.Window...)
+	}
+
+	return nil
+}
+
+// IdlePeriod is the initial period that the profile won't yield any results.
+func (p *Profile) IdlePeriod() int {
+	if p.Session != nil {
+		return 0
+	}
+
+	return p.Period - 1
+}
+
+// Report processes the provided asset snapshots and generates a report with the point of control and
+// the value area along with the closings, and a histogram of the final profile.
+func (p *Profile) Report(c <-chan *asset.Snapshot) *helper.Report {
+	//
+	// snapshots[0] -> dates
+	// snapshots[1] -> closings
+	// snapshots[2] -> histograms[0] -> pocs
+	//                 histograms[1] -> value area highs
+	//                 histograms[2] -> value area lows
+	//                 histograms[3] -> bins
+	//
+	snapshots := helper.Duplicate(c, 3)
+
+	dates := helper.Skip(asset.SnapshotsAsDates(snapshots[0]), p.IdlePeriod())
+	closings := helper.Skip(asset.SnapshotsAsClosings(snapshots[1]), p.IdlePeriod())
+	histograms := helper.Duplicate(p.Compute(snapshots[2]), 4)
+
+	bins := make(chan helper.ReportHistogramBin)
+
+	go func() {
+		defer close(bins)
+
+		var last *ProfileHistogram
+		for histogram := range histograms[3] {
+			last = histogram
+		}
+
+		if last == nil {
+			return
+		}
+
+		// The highest price is drawn at the top.
+		for i := len(last.Weights) - 1; i >= 0; i-- {
+			bins <- helper.ReportHistogramBin{
+				Label: fmt.Sprintf("%.2f", last.Price(i)),
+				Value: last.Weights[i],
+			}
+		}
+	}()
+
+	report := helper.NewReport("Volume Profile", dates)
+	report.AddColumn(helper.NewNumericReportColumn("Close", closings))
+	report.AddColumn(helper.NewNumericReportColumn("POC", ProfilesAsPointOfControl(histograms[0])))
+	report.AddColumn(helper.NewNumericReportColumn("VAH", ProfilesAsValueAreaHigh(histograms[1])))
+	report.AddColumn(helper.NewNumericReportColumn("VAL", ProfilesAsValueAreaLow(histograms[2])))
+	report.AddHistogram("Volume Profile", bins)
+
+	return report
+}
+
+// histogram computes the profile histogram of the given bars.
+func (p *Profile) histogram(bars []*asset.Snapshot) *ProfileHistogram {
+	low := math.Inf(1)
+	high := math.Inf(-1)
+
+	for _, bar := range bars {
+		low = math.Min(low, bar.Low)
+		high = math.Max(high, bar.High)
+	}
+
+	bins := max(p.Bins, 1)
+
+	h := &ProfileHistogram{
+		Low:     low,
+		BinSize: (high - low) / float64(bins),
+		Weights: make([]float64, bins),
+	}
+
+	for _, bar := range bars {
+		weight := bar.Volume
+		if p.Weight == ProfileByTime {
+			weight = 1
+		}
+
+		// A bar without a range belongs to a single bin.
+		if bar.High == bar.Low || h.BinSize == 0 {
+			i := 0
+			if h.BinSize > 0 {
+				i = min(int((bar.Low-low)/h.BinSize), bins-1)
+			}
+
+			h.Weights[i] += weight
+			continue
+		}
+
+		for i := range h.Weights {
+			binLow := low + float64(i)*h.BinSize
+			overlap := math.Min(bar.High, binLow+h.BinSize) - math.Max(bar.Low, binLow)
+
+			if overlap <= 0 {
+				continue
+			}
+
+			if p.Weight == ProfileByTime {
+				h.Weights[i]++
+			} else {
+				h.Weights[i] += weight * overlap / (bar.High - bar.Low)
+			}
+		}
+	}
+
+	p.levels(h)
+
+	return h
+}
+
+// levels computes the point of control, the value area, and the volume nodes of the given histogram.
+func (p *Profile) levels(h *ProfileHistogram) {
+	n := len(h.Weights)
+	poc := 0
+	total := 0.0
+
+	for i, weight := range h.Weights {
+		total += weight
+
+		if weight > h.Weights[poc] {
+			poc = i
+		}
+	}
+
+	// Expand the value area towards the higher neighboring bin.
+	lower, upper := poc, poc
+	area := h.Weights[poc]
+
+	for area < p.ValueArea*total && (lower > 0 || upper < n-1) {
+		above, below := -1.0, -1.0
+
+		if upper < n-1 {
+			above = h.Weights[upper+1]
+		}
+
+		if lower > 0 {
+			below = h.Weights[lower-1]
+		}
+
+		if above >= below {
+			upper++
+			area += above
+		} else {
+			lower--
+			area += below
+		}
+	}
+
+	h.PointOfControl = h.Price(poc)
+	h.ValueAreaHigh = h.Low + float64(upper+1)*h.BinSize
+	h.ValueAreaLow = h.Low + float64(lower)*h.BinSize
+
+	average := total / float64(n)
+
+	for i, weight := range h.Weights {
+		above := i == 0 || weight > h.Weights[i-1]
+		below := i == n-1 || weight > h.Weights[i+1]
+
+		if above && below && weight > average {
+			h.HighVolumeNodes = append(h.HighVolumeNodes, h.Price(i))
+		}
+
+		if i > 0 && i < n-1 && weight < h.Weights[i-1] && weight < h.Weights[i+1] && weight < average {
+			h.LowVolumeNodes = append(h.LowVolumeNodes, h.Price(i))
+		}
+	}
+}
+
+// ProfilesAsPointOfControl extracts the point of control field from each profile histogram.
+func ProfilesAsPointOfControl(histograms <-chan *ProfileHistogram) <-chan float64 {
+	return helper.Map(histograms, func(h *ProfileHistogram) float64 {
+		return h.PointOfControl
+	})
+}
+
+// ProfilesAsValueAreaHigh extracts the value area high field from each profile histogram.
+func ProfilesAsValueAreaHigh(histograms <-chan *ProfileHistogram) <-chan float64 {
+	return helper.Map(histograms, func(h *ProfileHistogram) float64 {
+		return h.ValueAreaHigh
+	})
+}
+
+// ProfilesAsValueAreaLow extracts the value area low field from each profile histogram.
+func ProfilesAsValueAreaLow(histograms <-chan *ProfileHistogram) <-chan float64 {
+	return helper.Map(histograms, func(h *ProfileHistogram) float64 {
+		return h.ValueAreaLow
+	})
+}
+
+// ProfilesAsHighVolumeNodes extracts the high volume nodes field from each profile histogram.
+func ProfilesAsHighVolumeNodes(histograms <-chan *ProfileHistogram) <-chan []float64 {
+	return helper.Map(histograms, func(h *ProfileHistogram) []float64 {
+		return h.HighVolumeNodes
+	})
+}
+
+// ProfilesAsLowVolumeNodes extracts the low volume nodes field from each profile histogram.
+func ProfilesAsLowVolumeNodes(histograms <-chan *ProfileHistogram) <-chan []float64 {
+	return helper.Map(histograms, func(h *ProfileHistogram) []float64 {
+		return h.LowVolumeNodes
+	})
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2021-2024 Onur Cinar.
+// The source code is provided under GNU AGPLv3 License.
+// https://github.com/cinar/indicator
+
+package volume_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cinar/indicator/v2/asset"
+	"github.com/cinar/indicator/v2/helper"
+	"github.com/cinar/indicator/v2/volume"
+)
+
+// profileSnapshots returns hourly snapshots with the given lows, highs, and volumes.
+func profileSnapshots(date time.Time, bars ...[3]float64) []*asset.Snapshot {
+	snapshots := make([]*asset.Snapshot, len(bars))
+
+	for i, bar := range bars {
+		snapshots[i] = &asset.Snapshot{
+			Date:   date.Add(time.Duration(i) * time.Hour),
+			Open:   bar[0],
+			High:   bar[1],
+			Low:    bar[0],
+			Close:  bar[1],
+			Volume: bar[2],
+		}
+	}
+
+	return snapshots
+}
+
+func TestProfile(t *testing.T) {
+	date := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	snapshots := profileSnapshots(date,
+		[3]float64{10, 14, 40},
+		[3]float64{12, 14, 20},
+		[3]float64{10, 11, 10},
+		[3]float64{12, 13, 10},
+	)
+
+	profile := volume.NewProfileWithPeriod(4)
+	profile.Bins = 4
+
+	actual := helper.ChanToSlice(profile.Compute(helper.SliceToChan(snapshots)))
+
+	expected := []*volume.ProfileHistogram{
+		{
+			Low:             10,
+			BinSize:         1,
+			Weights:         []float64{20, 10, 30, 20},
+			PointOfControl:  12.5,
+			ValueAreaHigh:   14,
+			ValueAreaLow:    11,
+			HighVolumeNodes: []float64{12.5},
+			LowVolumeNodes:  []float64{11.5},
+		},
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("actual %v expected %v", actual[0], expected[0])
+	}
+}
+
+func TestProfileByTime(t *testing.T) {
+	date := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	snapshots := profileSnapshots(date,
+		[3]float64{10, 14, 40},
+		[3]float64{12, 14, 20},
+		[3]float64{10, 11, 10},
+		[3]float64{12, 13, 10},
+		[3]float64{13, 13, 10},
+	)
+
+	profile := volume.NewProfileWithPeriod(4)
+	profile.Bins = 4
+	profile.Weight = volume.ProfileByTime
+
+	actual := helper.ChanToSlice(profile.Compute(helper.SliceToChan(snapshots)))
+	if len(actual) != 2 {
+		t.Fatalf("actual %d expected 2", len(actual))
+	}
+
+	// The bar touching the boundary of a bin is not counted in it.
+	if !reflect.DeepEqual(actual[0].Weights, []float64{2, 1, 3, 2}) {
+		t.Fatalf("actual %v", actual[0].Weights)
+	}
+
+	// The first bar is out of the window, and the bar without a range belongs to a single bin.
+	if actual[1].Low != 10 || actual[1].BinSize != 1 || !reflect.DeepEqual(actual[1].Weights, []float64{1, 0, 2, 2}) {
+		t.Fatalf("actual %v", actual[1])
+	}
+
+	if actual[1].PointOfControl != 12.5 || len(actual[1].LowVolumeNodes) != 1 || actual[1].LowVolumeNodes[0] != 11.5 {
+		t.Fatalf("actual %v", actual[1])
+	}
+}
+
+func TestProfileWithSession(t *testing.T) {
+	date := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	snapshots := append(
+		profileSnapshots(date, [3]float64{10, 12, 10}, [3]float64{11, 12, 10}),
+		profileSnapshots(date.AddDate(0, 0, 1), [3]float64{20, 20, 10})...,
+	)
+
+	profile := volume.NewProfileWithSession(asset.Daily)
+	profile.Bins = 2
+
+	if profile.IdlePeriod() != 0 {
+		t.Fatalf("actual %d expected 0", profile.IdlePeriod())
+	}
+
+	pocs := volume.ProfilesAsPointOfControl(profile.Compute(helper.SliceToChan(snapshots)))
+	expected := helper.SliceToChan([]float64{10.5, 11.5, 20})
+
+	err := helper.CheckEquals(pocs, expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProfileAsFields(t *testing.T) {
+	histograms := helper.Duplicate(helper.SliceToChan([]*volume.ProfileHistogram{
+		{ValueAreaHigh: 3, ValueAreaLow: 1, HighVolumeNodes: []float64{2}, LowVolumeNodes: []float64{4}},
+	}), 4)
+
+	if actual := <-volume.ProfilesAsValueAreaHigh(histograms[0]); actual != 3 {
+		t.Fatalf("actual %v expected 3", actual)
+	}
+
+	if actual := <-volume.ProfilesAsValueAreaLow(histograms[1]); actual != 1 {
+		t.Fatalf("actual %v expected 1", actual)
+	}
+
+	if actual := <-volume.ProfilesAsHighVolumeNodes(histograms[2]); !reflect.DeepEqual(actual, []float64{2}) {
+		t.Fatalf("actual %v expected [2]", actual)
+	}
+
+	if actual := <-volume.ProfilesAsLowVolumeNodes(histograms[3]); !reflect.DeepEqual(actual, []float64{4}) {
+		t.Fatalf("actual %v expected [4]", actual)
+	}
+}
+
+func TestProfileReport(t *testing.T) {
+	snapshots, err := helper.ReadFromCsvFile[asset.Snapshot]("testdata/brk-b.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	profile := volume.NewProfile()
+
+	report := profile.Report(snapshots)
+
+	fileName := "profile.html"
+	defer helper.Remove(t, fileName)
+
+	err = report.WriteToFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -5,6 +5,8 @@
 package volume
 
 import (
+	"encoding/json"
+
 	"github.com/cinar/indicator/v2/helper"
 )
 
@@ -17,7 +19,16 @@ import (
 //
 //	vpt := volume.NewVpt[float64]()
 //	result := vpt.Compute(closings, volumes)
-type Vpt[T helper.Number] struct{}
+type Vpt[T helper.Number] struct {
+	// vpt is the last VPT value.
+	vpt T
+
+	// previousClosing is the previous closing value.
+	previousClosing T
+
+	// started indicates whether the previous closing is set.
+	started bool
+}
 
 // NewVpt function initializes a new VPT instance with the default parameters.
 func NewVpt[T helper.Number]() *Vpt[T] {
@@ -25,15 +36,77 @@ func NewVpt[T helper.Number]() *Vpt[T] {
 }
 
 // Compute function takes a channel of numbers and computes the VPT.
-func (*Vpt[T]) Compute(closings, volumes <-chan T) <-chan T {
-	ratios := helper.Multiply(
-		helper.ChangeRatio(closings, 1),
-		helper.Skip(volumes, 1),
-	)
-
-	return helper.MapWithPrevious(ratios, func(previous, current T) T {
-		return previous + current
-	}, 0)
+func (v *Vpt[T]) Compute(closings, volumes <-chan T) <-chan T {
+	return helper.Compute2(closings, volumes, v.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the VPT.
+func (v *Vpt[T]) ComputeSlice(closings, volumes []T) []T {
+	return helper.ComputeSlice2(closings, volumes, v.Clone().Update)
+}
+
+// Update function takes a closing and a volume value, and updates the VPT. It returns the VPT value,
+// and whether the VPT has passed its idle period and the value is ready.
+func (v *Vpt[T]) Update(closing, volume T) (T, bool) {
+	if !v.started {
+		v.previousClosing = closing
+		v.started = true
+		return 0, false
+	}
+
+	v.vpt += ((closing - v.previousClosing) / v.previousClosing) * volume
+	v.previousClosing = closing
+
+	return v.vpt, true
+}
+
+// Reset function resets the state of the VPT.
+func (v *Vpt[T]) Reset() {
+	v.vpt = 0
+	v.previousClosing = 0
+	v.started = false
+}
+
+// Clone function returns a new VPT instance with the same configuration and a fresh state.
+func (*Vpt[T]) Clone() *Vpt[T] {
+	return &Vpt[T]{}
+}
+
+// vptState is the state of the VPT.
+type vptState[T helper.Number] struct {
+	// Vpt is the last VPT value.
+	Vpt T `json:"vpt"`
+
+	// PreviousClosing is the previous closing.
+	PreviousClosing T `json:"previousClosing"`
+
+	// Started indicates whether the first closing is received.
+	Started bool `json:"started"`
+}
+
+// MarshalState function returns the state of the VPT as JSON.
+func (v *Vpt[T]) MarshalState() ([]byte, error) {
+	return json.Marshal(&vptState[T]{
+		Vpt:             v.vpt,
+		PreviousClosing: v.previousClosing,
+		Started:         v.started,
+	})
+}
+
+// UnmarshalState function restores the state of the VPT from the given JSON.
+func (v *Vpt[T]) UnmarshalState(data []byte) error {
+	var state vptState[T]
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	v.vpt = state.Vpt
+	v.previousClosing = state.PreviousClosing
+	v.started = state.Started
+
+	return nil
 }
 
 // IdlePeriod is the initial period that VPT won't yield any results.
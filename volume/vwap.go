@@ -5,6 +5,8 @@
 package volume
 
 import (
+	"encoding/json"
+
 	"github.com/cinar/indicator/v2/helper"
 	"github.com/cinar/indicator/v2/trend"
 )
@@ -26,6 +28,12 @@ const (
 type Vwap[T helper.Number] struct {
 	// Sum is the Moving Sum instance.
 	Sum *trend.MovingSum[T]
+
+	// closingVolumes is the moving sum of the closing and volume products.
+	closingVolumes *trend.MovingSum[T]
+
+	// volumes is the moving sum of the volumes.
+	volumes *trend.MovingSum[T]
 }
 
 // NewVwap function initializes a new VWAP instance with the default parameters.
@@ -42,19 +50,93 @@ func NewVwapWithPeriod[T helper.Number](period int) *Vwap[T] {
 
 // Compute function takes a channel of numbers and computes the VWAP.
 func (v *Vwap[T]) Compute(closings, volumes <-chan T) <-chan T {
-	volumesSplice := helper.Duplicate(volumes, 2)
-
-	return helper.Divide(
-		v.Sum.Compute(
-			helper.Multiply(
-				closings,
-				volumesSplice[0],
-			),
-		),
-		v.Sum.Compute(
-			volumesSplice[1],
-		),
-	)
+	return helper.Compute2(closings, volumes, v.Clone().Update)
+}
+
+// ComputeSlice function takes a slice of numbers and computes the VWAP.
+func (v *Vwap[T]) ComputeSlice(closings, volumes []T) []T {
+	return helper.ComputeSlice2(closings, volumes, v.Clone().Update)
+}
+
+// Update function takes a closing and a volume value, and updates the VWAP. It returns the VWAP value,
+// and whether the VWAP has passed its idle period and the value is ready.
+func (v *Vwap[T]) Update(closing, volume T) (T, bool) {
+	if v.volumes == nil {
+		v.closingVolumes = v.Sum.Clone()
+		v.volumes = v.Sum.Clone()
+	}
+
+	closingVolumes, _ := v.closingVolumes.Update(closing * volume)
+
+	volumes, ok := v.volumes.Update(volume)
+	if !ok {
+		return 0, false
+	}
+
+	return closingVolumes / volumes, true
+}
+
+// Reset function resets the state of the VWAP.
+func (v *Vwap[T]) Reset() {
+	v.closingVolumes = nil
+	v.volumes = nil
+}
+
+// Clone function returns a new VWAP instance with the same configuration and a fresh state.
+func (v *Vwap[T]) Clone() *Vwap[T] {
+	return &Vwap[T]{
+		Sum: v.Sum.Clone(),
+	}
+}
+
+// vwapState is the state of the VWAP.
+type vwapState struct {
+	// ClosingVolumes is the moving sum of the closing and volume products.
+	ClosingVolumes json.RawMessage `json:"closingVolumes"`
+
+	// Volumes is the moving sum of the volumes.
+	Volumes json.RawMessage `json:"volumes"`
+}
+
+// MarshalState function returns the state of the VWAP as JSON.
+func (v *Vwap[T]) MarshalState() ([]byte, error) {
+	var err error
+
+	state := vwapState{}
+
+	state.ClosingVolumes, err = helper.MarshalStateOf(v.closingVolumes)
+	if err != nil {
+		return nil, err
+	}
+
+	state.Volumes, err = helper.MarshalStateOf(v.volumes)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&state)
+}
+
+// UnmarshalState function restores the state of the VWAP from the given JSON.
+func (v *Vwap[T]) UnmarshalState(data []byte) error {
+	var state vwapState
+
+	err := json.Unmarshal(data, &state)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&v.closingVolumes, state.ClosingVolumes, v.Sum.Clone)
+	if err != nil {
+		return err
+	}
+
+	err = helper.UnmarshalStateOf(&v.volumes, state.Volumes, v.Sum.Clone)
+	if err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // IdlePeriod is the initial period that VWAP won't yield any results.